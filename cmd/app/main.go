@@ -5,6 +5,9 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -26,9 +29,9 @@ func main() {
 	gotenv.Load(fmt.Sprintf(".env.%v", env))
 	gotenv.Load(fmt.Sprintf(".env.%v.local", env))
 
-	err := db.InitDatabaseClient(os.Getenv("GOOGLE_CLOUD_PROJECT_ID"))
-	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+	storage := os.Getenv("STORAGE_BACKEND")
+	if storage == "" {
+		storage = "firestore"
 	}
 
 	router := gin.Default()
@@ -38,13 +41,221 @@ func main() {
 	corsConfig.AllowOrigins = []string{os.Getenv("ALLOW_ORIGIN")}
 	router.Use(cors.New(corsConfig))
 
-	auth0Config := middleware.Auth0JwTConfig{
-		Domain:   os.Getenv("AUTH0_DOMAIN"),
-		Audience: os.Getenv("AUTH0_AUDIENCE"),
+	var projectRepository repository.ProjectRepository
+	var chapterRepository repository.ChapterRepository
+	var paperRepository repository.PaperRepository
+	var graphRepository repository.GraphRepository
+	var trashRepository repository.TrashRepository
+	var linkRepository repository.LinkRepository
+	var shareRepository repository.ShareRepository
+	var accessTokenRepository repository.AccessTokenRepository
+
+	switch storage {
+	case "firestore":
+		err := db.InitDatabaseClient(os.Getenv("GOOGLE_CLOUD_PROJECT_ID"))
+		if err != nil {
+			log.Fatalf("Failed to initialize database: %v", err)
+		}
+
+		client := db.FirestoreClient()
+		if client == nil {
+			log.Fatalf("Failed to get firestore client")
+		}
+
+		projectRepository = repository.NewProjectRepository(*client)
+		chapterRepository = repository.NewChapterRepository(*client)
+		paperRepository = repository.NewPaperRepository(*client)
+		graphRepository = repository.NewGraphRepository(*client)
+		trashRepository = repository.NewTrashRepository(*client)
+		linkRepository = repository.NewLinkRepository(*client)
+		shareRepository = repository.NewShareRepository(*client)
+		accessTokenRepository = repository.NewAccessTokenRepository(*client)
+	case "sqlite", "postgres":
+		driver := db.SQLiteDriver
+		if storage == "postgres" {
+			driver = db.PostgresDriver
+		}
+
+		err := db.InitSQLClient(driver, os.Getenv("SQL_DSN"))
+		if err != nil {
+			log.Fatalf("Failed to initialize database: %v", err)
+		}
+
+		database := db.SQLClient()
+
+		projectRepository = repository.NewSQLProjectRepository(*database)
+		chapterRepository = repository.NewSQLChapterRepository(*database)
+		paperRepository = repository.NewSQLPaperRepository(*database)
+		graphRepository = repository.NewSQLGraphRepository(*database)
+		trashRepository = repository.NewSQLTrashRepository(*database)
+		linkRepository = repository.NewSQLLinkRepository(*database)
+		shareRepository = repository.NewSQLShareRepository(*database)
+		accessTokenRepository = repository.NewSQLAccessTokenRepository(*database)
+	case "memory":
+		store := repository.NewMemoryStore()
+
+		projectRepository = repository.NewMemoryProjectRepository(store)
+		chapterRepository = repository.NewMemoryChapterRepository(store)
+		paperRepository = repository.NewMemoryPaperRepository(store)
+		graphRepository = repository.NewMemoryGraphRepository(store)
+		trashRepository = repository.NewMemoryTrashRepository(store)
+		linkRepository = repository.NewMemoryLinkRepository(store)
+		shareRepository = repository.NewMemoryShareRepository(store)
+		accessTokenRepository = repository.NewMemoryAccessTokenRepository(store)
+	default:
+		log.Fatalf("Unknown storage backend: %v", storage)
+	}
+
+	searchIndexTTL := service.DefaultSearchIndexTTL
+	if value := os.Getenv("SEARCH_INDEX_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid search index ttl: %v", err)
+		}
+		searchIndexTTL = ttl
+	}
+	searchIndexMaxUsers := service.DefaultSearchIndexMaxUsers
+	if value := os.Getenv("SEARCH_INDEX_MAX_USERS"); value != "" {
+		maxUsers, err := strconv.Atoi(value)
+		if err != nil || maxUsers <= 0 {
+			log.Fatalf("Invalid search index max users: %v", value)
+		}
+		searchIndexMaxUsers = maxUsers
+	}
+	searchIndex := service.NewSearchIndex(searchIndexTTL, searchIndexMaxUsers)
+
+	projectService := service.NewIndexedProjectService(
+		service.NewProjectService(projectRepository), searchIndex)
+	projectMemberService := service.NewIndexedProjectMemberService(
+		service.NewProjectMemberService(projectRepository), searchIndex)
+	chapterService := service.NewIndexedChapterService(
+		service.NewChapterService(chapterRepository), searchIndex)
+	paperService := service.NewIndexedPaperService(
+		service.NewPaperService(paperRepository, service.DefaultRevisionRetention), searchIndex)
+	graphService := service.NewIndexedGraphService(
+		service.NewGraphService(graphRepository, service.DefaultRevisionRetention), searchIndex)
+	trashService := service.NewIndexedTrashService(
+		service.NewTrashService(trashRepository), searchIndex)
+	linkService := service.NewLinkService(linkRepository)
+	shareService := service.NewShareService(shareRepository)
+	publicService := service.NewPublicService(
+		shareRepository, projectRepository, chapterRepository, paperRepository, graphRepository)
+	accessTokenService := service.NewAccessTokenService(accessTokenRepository)
+	projectGraphService := service.NewProjectGraphService(chapterRepository, graphRepository)
+	projectArchiveService := service.NewIndexedProjectArchiveService(
+		service.NewProjectArchiveService(
+			projectRepository, chapterRepository, paperRepository, graphRepository, linkRepository),
+		searchIndex,
+	)
+	searchService := service.NewSearchService(
+		searchIndex, projectRepository, chapterRepository, paperRepository, graphRepository)
+
+	go func() {
+		count, sErr := projectService.ResumeDeletingProjects()
+		if sErr != nil {
+			log.Printf("Failed to resume project deletion: %v", sErr)
+			return
+		}
+		if count > 0 {
+			log.Printf("Resumed project deletion: %d documents deleted", count)
+		}
+	}()
+
+	go func() {
+		count, sErr := graphService.AssignMissingGraphChildIds()
+		if sErr != nil {
+			log.Printf("Failed to assign graph child ids: %v", sErr)
+			return
+		}
+		if count > 0 {
+			log.Printf("Assigned graph child ids: %d graphs updated", count)
+		}
+	}()
+
+	trashRetention := service.DefaultTrashRetention
+	if value := os.Getenv("TRASH_RETENTION"); value != "" {
+		retention, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid trash retention: %v", err)
+		}
+		trashRetention = retention
+	}
+
+	go func() {
+		count, sErr := trashService.ResumePurgingTrashItems()
+		if sErr != nil {
+			log.Printf("Failed to resume trash purge: %v", sErr)
+		}
+		if count > 0 {
+			log.Printf("Resumed trash purge: %d documents deleted", count)
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for ; ; <-ticker.C {
+			count, sErr := trashService.PurgeTrashItems(trashRetention)
+			if sErr != nil {
+				log.Printf("Failed to purge trash: %v", sErr)
+			}
+			if count > 0 {
+				log.Printf("Purged trash: %d documents deleted", count)
+			}
+		}
+	}()
+
+	projectUseCase := usecase.NewProjectUseCase(projectService, projectGraphService, projectArchiveService)
+	projectMemberUseCase := usecase.NewProjectMemberUseCase(projectMemberService)
+	chapterUseCase := usecase.NewChapterUseCase(chapterService)
+	paperUseCase := usecase.NewPaperUseCase(paperService, graphService)
+	graphUseCase := usecase.NewGraphUseCase(graphService, projectGraphService)
+	trashUseCase := usecase.NewTrashUseCase(trashService)
+	linkUseCase := usecase.NewLinkUseCase(linkService)
+	searchUseCase := usecase.NewSearchUseCase(searchService)
+	shareUseCase := usecase.NewShareUseCase(shareService)
+	publicUseCase := usecase.NewPublicUseCase(publicService)
+	accessTokenUseCase := usecase.NewAccessTokenUseCase(accessTokenService)
+
+	authIssuerURL := os.Getenv("AUTH_ISSUER_URL")
+	authAudiences := strings.FieldsFunc(os.Getenv("AUTH_AUDIENCES"), isComma)
+	if authIssuerURL == "" && os.Getenv("AUTH0_DOMAIN") != "" {
+		authIssuerURL = fmt.Sprintf("https://%v/", os.Getenv("AUTH0_DOMAIN"))
+		authAudiences = []string{os.Getenv("AUTH0_AUDIENCE")}
+	}
+
+	authMode := os.Getenv("AUTH_MODE")
+	if authMode == "" {
+		authMode = "oidc"
+	}
+
+	var jwtMiddleware gin.HandlerFunc
+	switch authMode {
+	case "oidc":
+		jwtMiddleware = middleware.OIDCJWT(middleware.OIDCConfig{
+			IssuerURL:   authIssuerURL,
+			JWKSURL:     os.Getenv("AUTH_JWKS_URL"),
+			Audiences:   authAudiences,
+			Algorithms:  strings.FieldsFunc(os.Getenv("AUTH_ALGORITHMS"), isComma),
+			UserIdClaim: os.Getenv("AUTH_USER_ID_CLAIM"),
+		})
+	case "dev":
+		log.Printf("Running in dev auth mode: JWTs are validated with a local secret")
+		jwtMiddleware = middleware.DevJWT(middleware.DevJWTConfig{
+			Secret:      os.Getenv("AUTH_DEV_SECRET"),
+			Issuer:      authIssuerURL,
+			Audiences:   authAudiences,
+			UserIdClaim: os.Getenv("AUTH_USER_ID_CLAIM"),
+		})
+	default:
+		log.Fatalf("Unknown auth mode: %v", authMode)
 	}
-	router.Use(middleware.Auth0JWT(auth0Config))
 
-	router.GET("/", func(cxt *gin.Context) {
+	// Routes under /api/public are mounted on router directly and stay reachable without a token.
+	authorized := router.Group("")
+	authorized.Use(middleware.AccessToken(accessTokenService, jwtMiddleware), middleware.Subject())
+
+	authorized.GET("/", func(cxt *gin.Context) {
 		cxt.JSON(http.StatusOK, gin.H{
 			"environment": env,
 			"ginVersion":  gin.Version,
@@ -52,58 +263,169 @@ func main() {
 		})
 	})
 
-	client := db.FirestoreClient()
-	if client == nil {
-		log.Fatalf("Failed to get firestore client")
-	}
+	projectApi := api.NewProjectsApi(projectUseCase)
+	projectMemberApi := api.NewProjectMembersApi(projectMemberUseCase)
+	chapterApi := api.NewChaptersApi(chapterUseCase)
+	paperApi := api.NewPapersApi(paperUseCase)
+	graphApi := api.NewGraphApi(graphUseCase)
+	trashApi := api.NewTrashApi(trashUseCase)
+	linkApi := api.NewLinksApi(linkUseCase)
+	searchApi := api.NewSearchApi(searchUseCase)
+	shareApi := api.NewSharesApi(shareUseCase)
+	accessTokenApi := api.NewAccessTokensApi(accessTokenUseCase)
 
-	projectRepository := repository.NewProjectRepository(*client)
-	chapterRepository := repository.NewChapterRepository(*client)
-	paperRepository := repository.NewPaperRepository(*client)
-	graphRepository := repository.NewGraphRepository(*client)
+	// v1 routes accept the deprecated user ID in requests as long as it matches the authenticated user,
+	// while v2 routes bind requests without it and take the user only from the token.
+	v1 := authorized.Group("/api", middleware.DeprecatedUserId())
+	v2 := authorized.Group("/api/v2")
 
-	projectService := service.NewProjectService(projectRepository)
-	chapterService := service.NewChapterService(chapterRepository, paperRepository)
-	paperService := service.NewPaperService(paperRepository)
-	graphService := service.NewGraphService(graphRepository, chapterRepository)
+	v1.GET("/projects/list", projectApi.ProjectsList)
+	v1.POST("/projects/create", projectApi.ProjectsCreate)
+	v1.GET("/projects/find", projectApi.ProjectsFind)
+	v1.GET("/projects/graph", projectApi.ProjectsGraph)
+	v1.GET("/projects/export", projectApi.ProjectsExport)
+	v1.POST("/projects/import", projectApi.ProjectsImport)
+	v1.GET("/projects/compile", projectApi.ProjectsCompile)
+	v1.POST("/projects/update", projectApi.ProjectsUpdate)
+	v1.POST("/projects/delete", projectApi.ProjectsDelete)
 
-	projectUseCase := usecase.NewProjectUseCase(projectService)
-	chapterUseCase := usecase.NewChapterUseCase(chapterService)
-	paperUseCase := usecase.NewPaperUseCase(paperService)
-	graphUseCase := usecase.NewGraphUseCase(graphService)
-
-	userVerifier := middleware.NewUserVerifier()
-
-	projectApi := api.NewProjectsApi(userVerifier, projectUseCase)
-	router.GET("/api/projects/list", projectApi.ProjectsList)
-	router.POST("/api/projects/create", projectApi.ProjectsCreate)
-	router.GET("/api/projects/find", projectApi.ProjectsFind)
-	router.POST("/api/projects/update", projectApi.ProjectsUpdate)
-	router.POST("/api/projects/delete", projectApi.ProjectsDelete)
-
-	chapterApi := api.NewChaptersApi(userVerifier, chapterUseCase)
-	router.GET("/api/chapters/list", chapterApi.ChaptersList)
-	router.POST("/api/chapters/create", chapterApi.ChaptersCreate)
-	router.POST("/api/chapters/update", chapterApi.ChaptersUpdate)
-	router.POST("/api/chapters/delete", chapterApi.ChaptersDelete)
-
-	paperApi := api.NewPapersApi(userVerifier, paperUseCase)
-	router.GET("/api/papers/find", paperApi.PapersFind)
-	router.POST("/api/papers/update", paperApi.PapersUpdate)
-
-	graphApi := api.NewGraphApi(userVerifier, graphUseCase)
-	router.GET("/api/graphs/find", graphApi.GraphsFind)
-	router.POST("/api/graphs/update", graphApi.GraphsUpdate)
-	router.POST("/api/graphs/delete", graphApi.GraphsDelete)
-	router.POST("/api/graphs/sectionalize", graphApi.GraphsSectionalize)
-
-	err = router.Run(":8080")
+	v1.GET("/projects/members/list", projectMemberApi.ProjectMembersList)
+	v1.POST("/projects/members/invite", projectMemberApi.ProjectMembersInvite)
+	v1.POST("/projects/members/remove", projectMemberApi.ProjectMembersRemove)
+
+	v1.GET("/chapters/list", chapterApi.ChaptersList)
+	v1.POST("/chapters/create", chapterApi.ChaptersCreate)
+	v1.POST("/chapters/update", chapterApi.ChaptersUpdate)
+	v1.POST("/chapters/delete", chapterApi.ChaptersDelete)
+
+	v1.GET("/papers/find", paperApi.PapersFind)
+	v1.POST("/papers/update", paperApi.PapersUpdate)
+	v1.POST("/papers/sectionalize", paperApi.PapersSectionalize)
+	v1.GET("/papers/revisions/list", paperApi.PapersRevisionsList)
+	v1.GET("/papers/revisions/find", paperApi.PapersRevisionsFind)
+	v1.GET("/papers/revisions/diff", paperApi.PapersRevisionsDiff)
+	v1.POST("/papers/revisions/restore", paperApi.PapersRevisionsRestore)
+
+	v1.GET("/graphs/find", graphApi.GraphsFind)
+	v1.GET("/graphs/export", graphApi.GraphsExport)
+	v1.POST("/graphs/import", graphApi.GraphsImport)
+	v1.POST("/graphs/update", graphApi.GraphsUpdate)
+	v1.POST("/graphs/delete", graphApi.GraphsDelete)
+	v1.POST("/graphs/sectionalize", graphApi.GraphsSectionalize)
+	v1.POST("/graphs/resectionalize/preview", graphApi.GraphsResectionalizePreview)
+	v1.POST("/graphs/resectionalize", graphApi.GraphsResectionalize)
+	v1.POST("/graphs/nodes/add", graphApi.GraphsNodesAdd)
+	v1.POST("/graphs/nodes/rename", graphApi.GraphsNodesRename)
+	v1.POST("/graphs/nodes/update", graphApi.GraphsNodesUpdate)
+	v1.POST("/graphs/nodes/move", graphApi.GraphsNodesMove)
+	v1.POST("/graphs/nodes/delete", graphApi.GraphsNodesDelete)
+	v1.GET("/graphs/revisions/list", graphApi.GraphsRevisionsList)
+	v1.GET("/graphs/revisions/find", graphApi.GraphsRevisionsFind)
+	v1.GET("/graphs/revisions/diff", graphApi.GraphsRevisionsDiff)
+	v1.POST("/graphs/revisions/restore", graphApi.GraphsRevisionsRestore)
+
+	v1.GET("/trash/list", trashApi.TrashList)
+	v1.POST("/trash/restore", trashApi.TrashRestore)
+
+	v1.GET("/links/list", linkApi.LinksList)
+	v1.POST("/links/create", linkApi.LinksCreate)
+	v1.POST("/links/update", linkApi.LinksUpdate)
+	v1.POST("/links/delete", linkApi.LinksDelete)
+
+	v1.GET("/search", searchApi.Search)
+
+	v1.GET("/shares/list", shareApi.SharesList)
+	v1.POST("/shares/create", shareApi.SharesCreate)
+	v1.POST("/shares/revoke", shareApi.SharesRevoke)
+
+	v1.GET("/tokens/list", accessTokenApi.AccessTokensList)
+	v1.POST("/tokens/create", accessTokenApi.AccessTokensCreate)
+	v1.POST("/tokens/revoke", accessTokenApi.AccessTokensRevoke)
+
+	v2.GET("/projects/list", projectApi.V2ProjectsList)
+	v2.POST("/projects/create", projectApi.V2ProjectsCreate)
+	v2.GET("/projects/find", projectApi.V2ProjectsFind)
+	v2.GET("/projects/graph", projectApi.V2ProjectsGraph)
+	v2.GET("/projects/export", projectApi.V2ProjectsExport)
+	v2.POST("/projects/import", projectApi.V2ProjectsImport)
+	v2.GET("/projects/compile", projectApi.V2ProjectsCompile)
+	v2.POST("/projects/update", projectApi.V2ProjectsUpdate)
+	v2.POST("/projects/delete", projectApi.V2ProjectsDelete)
+
+	v2.GET("/projects/members/list", projectMemberApi.V2ProjectMembersList)
+	v2.POST("/projects/members/invite", projectMemberApi.V2ProjectMembersInvite)
+	v2.POST("/projects/members/remove", projectMemberApi.V2ProjectMembersRemove)
+
+	v2.GET("/chapters/list", chapterApi.V2ChaptersList)
+	v2.POST("/chapters/create", chapterApi.V2ChaptersCreate)
+	v2.POST("/chapters/update", chapterApi.V2ChaptersUpdate)
+	v2.POST("/chapters/delete", chapterApi.V2ChaptersDelete)
+
+	v2.GET("/papers/find", paperApi.V2PapersFind)
+	v2.POST("/papers/update", paperApi.V2PapersUpdate)
+	v2.POST("/papers/sectionalize", paperApi.V2PapersSectionalize)
+	v2.GET("/papers/revisions/list", paperApi.V2PapersRevisionsList)
+	v2.GET("/papers/revisions/find", paperApi.V2PapersRevisionsFind)
+	v2.GET("/papers/revisions/diff", paperApi.V2PapersRevisionsDiff)
+	v2.POST("/papers/revisions/restore", paperApi.V2PapersRevisionsRestore)
+
+	v2.GET("/graphs/find", graphApi.V2GraphsFind)
+	v2.GET("/graphs/export", graphApi.V2GraphsExport)
+	v2.POST("/graphs/import", graphApi.V2GraphsImport)
+	v2.POST("/graphs/update", graphApi.V2GraphsUpdate)
+	v2.POST("/graphs/delete", graphApi.V2GraphsDelete)
+	v2.POST("/graphs/sectionalize", graphApi.V2GraphsSectionalize)
+	v2.POST("/graphs/resectionalize/preview", graphApi.V2GraphsResectionalizePreview)
+	v2.POST("/graphs/resectionalize", graphApi.V2GraphsResectionalize)
+	v2.POST("/graphs/nodes/add", graphApi.V2GraphsNodesAdd)
+	v2.POST("/graphs/nodes/rename", graphApi.V2GraphsNodesRename)
+	v2.POST("/graphs/nodes/update", graphApi.V2GraphsNodesUpdate)
+	v2.POST("/graphs/nodes/move", graphApi.V2GraphsNodesMove)
+	v2.POST("/graphs/nodes/delete", graphApi.V2GraphsNodesDelete)
+	v2.GET("/graphs/revisions/list", graphApi.V2GraphsRevisionsList)
+	v2.GET("/graphs/revisions/find", graphApi.V2GraphsRevisionsFind)
+	v2.GET("/graphs/revisions/diff", graphApi.V2GraphsRevisionsDiff)
+	v2.POST("/graphs/revisions/restore", graphApi.V2GraphsRevisionsRestore)
+
+	v2.GET("/trash/list", trashApi.V2TrashList)
+	v2.POST("/trash/restore", trashApi.V2TrashRestore)
+
+	v2.GET("/links/list", linkApi.V2LinksList)
+	v2.POST("/links/create", linkApi.V2LinksCreate)
+	v2.POST("/links/update", linkApi.V2LinksUpdate)
+	v2.POST("/links/delete", linkApi.V2LinksDelete)
+
+	v2.GET("/search", searchApi.V2Search)
+
+	v2.GET("/shares/list", shareApi.V2SharesList)
+	v2.POST("/shares/create", shareApi.V2SharesCreate)
+	v2.POST("/shares/revoke", shareApi.V2SharesRevoke)
+
+	v2.GET("/tokens/list", accessTokenApi.V2AccessTokensList)
+	v2.POST("/tokens/create", accessTokenApi.V2AccessTokensCreate)
+	v2.POST("/tokens/revoke", accessTokenApi.V2AccessTokensRevoke)
+
+	publicApi := api.NewPublicApi(publicUseCase)
+	router.GET("/api/public/projects/find", publicApi.PublicProjectsFind)
+	router.GET("/api/public/papers/find", publicApi.PublicPapersFind)
+	router.GET("/api/public/graphs/find", publicApi.PublicGraphsFind)
+
+	err := router.Run(":8080")
 	if err != nil {
 		log.Fatalf("Failed to run gin server: %v", err)
 	}
 
-	err = db.FinalizeDatabaseClient()
+	switch storage {
+	case "firestore":
+		err = db.FinalizeDatabaseClient()
+	case "sqlite", "postgres":
+		err = db.FinalizeSQLClient()
+	}
 	if err != nil {
 		log.Fatalf("Failed to finalize database: %v", err)
 	}
 }
+
+func isComma(r rune) bool {
+	return r == ','
+}
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+type accessTokensApi struct {
+	usecase usecase.AccessTokenUseCase
+}
+
+func NewAccessTokensApi(usecase usecase.AccessTokenUseCase) openapi.AccessTokensAPI {
+	return accessTokensApi{usecase: usecase}
+}
+
+func (api accessTokensApi) AccessTokensList(c *gin.Context) {
+	var request openapi.AccessTokenListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listAccessTokens(c, request)
+}
+
+func (api accessTokensApi) V2AccessTokensList(c *gin.Context) {
+	api.listAccessTokens(c, openapi.AccessTokenListRequest{UserId: middleware.GetSubject(c)})
+}
+
+func (api accessTokensApi) listAccessTokens(c *gin.Context, request openapi.AccessTokenListRequest) {
+	res, ucErr := api.usecase.ListAccessTokens(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			UserId:  resErr.UserId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.AccessTokenListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api accessTokensApi) AccessTokensCreate(c *gin.Context) {
+	var request openapi.AccessTokenCreateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.createAccessToken(c, request)
+}
+
+func (api accessTokensApi) V2AccessTokensCreate(c *gin.Context) {
+	var request openapi.AccessTokenCreateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.createAccessToken(c, openapi.AccessTokenCreateRequest{
+		User:        openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		AccessToken: request.AccessToken,
+	})
+}
+
+func (api accessTokensApi) createAccessToken(c *gin.Context, request openapi.AccessTokenCreateRequest) {
+	res, ucErr := api.usecase.CreateAccessToken(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenCreateErrorResponse{
+			Message:     UseCaseErrorToMessage(ucErr),
+			User:        resErr.User,
+			AccessToken: resErr.AccessToken,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenCreateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.AccessTokenCreateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (api accessTokensApi) AccessTokensRevoke(c *gin.Context) {
+	var request openapi.AccessTokenRevokeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenRevokeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.revokeAccessToken(c, request)
+}
+
+func (api accessTokensApi) V2AccessTokensRevoke(c *gin.Context) {
+	var request openapi.AccessTokenRevokeV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenRevokeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.revokeAccessToken(c, openapi.AccessTokenRevokeRequest{
+		User:        openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		AccessToken: request.AccessToken,
+	})
+}
+
+func (api accessTokensApi) revokeAccessToken(c *gin.Context, request openapi.AccessTokenRevokeRequest) {
+	ucErr := api.usecase.RevokeAccessToken(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenRevokeErrorResponse{
+			Message:     UseCaseErrorToMessage(ucErr),
+			User:        resErr.User,
+			AccessToken: resErr.AccessToken,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.AccessTokenRevokeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.AccessTokenRevokeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,255 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessTokensCreateListRevoke(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{"id": userId},
+		"accessToken": map[string]any{
+			"name":      "CI from API",
+			"scope":     "read",
+			"expiresAt": "2100-01-01T00:00:00Z",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/tokens/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var createResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &createResponseBody))
+
+	secret, ok := createResponseBody["secret"].(string)
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(secret, domain.AccessTokenSecretPrefix))
+
+	accessToken := createResponseBody["accessToken"].(map[string]any)
+	accessTokenId := accessToken["id"]
+	assert.NotEmpty(t, accessTokenId)
+	createdAt := accessToken["createdAt"]
+	assert.NotEmpty(t, createdAt)
+	assert.Equal(t, map[string]any{
+		"id":        accessTokenId,
+		"name":      "CI from API",
+		"scope":     "read",
+		"expiresAt": "2100-01-01T00:00:00Z",
+		"createdAt": createdAt,
+	}, accessToken)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/tokens/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponseBody))
+	assert.Contains(t, listResponseBody["accessTokens"], accessToken)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":        map[string]any{"id": userId},
+		"accessToken": map[string]any{"id": accessTokenId},
+	})
+	req, _ = http.NewRequest("POST", "/api/tokens/revoke", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "null", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/tokens/list", nil)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponseBody))
+	assert.NotContains(t, listResponseBody["accessTokens"], accessToken)
+}
+
+func TestAccessTokensCreateInvalidArgument(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"accessToken": map[string]any{
+			"name":      "Expired CI from API",
+			"scope":     "write",
+			"expiresAt": "2000-01-01T00:00:00Z",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/tokens/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":     "invalid request value: failed to create access token: access token expiration must be in the future",
+		"user":        map[string]any{},
+		"accessToken": map[string]any{},
+	}, responseBody)
+}
+
+func TestAccessTokensCreateNotFound(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"accessToken": map[string]any{
+			"name":      "CI of Unknown Project from API",
+			"scope":     "write",
+			"projectId": "UNKNOWN_PROJECT",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/tokens/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":     "not found",
+		"user":        map[string]any{},
+		"accessToken": map[string]any{},
+	}, responseBody)
+}
+
+func TestAccessTokensRevokeNotFound(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":        map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"accessToken": map[string]any{"id": "UNKNOWN_ACCESS_TOKEN"},
+	})
+	req, _ := http.NewRequest("POST", "/api/tokens/revoke", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":     "not found",
+		"user":        map[string]any{},
+		"accessToken": map[string]any{},
+	}, responseBody)
+}
+
+func TestAccessTokensCreateDomainValidationError(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":        map[string]any{"id": ""},
+		"accessToken": map[string]any{"name": "", "scope": "admin"},
+	})
+	req, _ := http.NewRequest("POST", "/api/tokens/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{"id": "user id is required, but got ''"},
+		"accessToken": map[string]any{
+			"name":  "access token name is required, but got ''",
+			"scope": "access token scope must be read or write, but got 'admin'",
+		},
+	}, responseBody)
+}
+
+func TestAccessTokensListDomainValidationError(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/tokens/list", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"userId":  "user id is required, but got ''",
+	}, responseBody)
+}
+
+func TestAccessTokensCreateInvalidRequestFormat(t *testing.T) {
+	router := setupAccessTokenRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/tokens/create", strings.NewReader(`{"accessToken": {"expiresAt": "tomorrow"}}`))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":     "invalid request format",
+		"user":        map[string]any{},
+		"accessToken": map[string]any{},
+	}, responseBody)
+}
+
+func setupAccessTokenRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewAccessTokenRepository(*client)
+	s := service.NewAccessTokenService(r)
+
+	uc := usecase.NewAccessTokenUseCase(s)
+	api := api.NewAccessTokensApi(uc)
+
+	router.GET("/api/tokens/list", api.AccessTokensList)
+	router.POST("/api/tokens/create", api.AccessTokensCreate)
+	router.POST("/api/tokens/revoke", api.AccessTokensRevoke)
+
+	return router
+}
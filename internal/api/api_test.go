@@ -1,10 +1,15 @@
 package api_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"testing"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gin-gonic/gin"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
 )
 
 func TestMain(m *testing.M) {
@@ -12,3 +17,32 @@ func TestMain(m *testing.M) {
 	m.Run()
 	db.FinalizeDatabaseClient()
 }
+
+// v2UserIdHeader carries the user of v2 requests in tests, which have no user in their query or body
+const v2UserIdHeader = "X-Test-User-Id"
+
+// authenticateAsRequestUser stands in for the authentication middleware.
+// It authenticates each request as the user in its query or JSON body, or in v2UserIdHeader,
+// so that tests can act as different users on the same router.
+func authenticateAsRequestUser(c *gin.Context) {
+	if userId := c.GetHeader(v2UserIdHeader); userId != "" {
+		c.Set(middleware.SubjectKey, userId)
+		return
+	}
+
+	userId := c.Query("userId")
+	if userId == "" && c.Request.Body != nil {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var request struct {
+			User struct {
+				Id string `json:"id"`
+			} `json:"user"`
+		}
+		if json.Unmarshal(body, &request) == nil {
+			userId = request.User.Id
+		}
+	}
+	c.Set(middleware.SubjectKey, userId)
+}
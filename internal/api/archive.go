@@ -0,0 +1,30 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/archive"
+)
+
+const ArchiveContentType = archive.MediaType
+
+const maxArchiveBytes = 32 << 20
+
+func ShouldBindArchive(c *gin.Context, query any, content *[]byte) error {
+	if err := c.ShouldBindQuery(query); err != nil {
+		return err
+	}
+	if c.ContentType() != ArchiveContentType {
+		return fmt.Errorf("content type must be %v, but got '%v'", ArchiveContentType, c.ContentType())
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxArchiveBytes))
+	if err != nil {
+		return err
+	}
+	*content = body
+	return nil
+}
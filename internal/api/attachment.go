@@ -0,0 +1,14 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RenderAttachment sends content as a file to be downloaded rather than displayed
+func RenderAttachment(c *gin.Context, fileName string, mediaType string, content []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, mediaType, content)
+}
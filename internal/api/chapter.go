@@ -10,12 +10,11 @@ import (
 )
 
 type chaptersApi struct {
-	verifier middleware.UserVerifier
-	usecase  usecase.ChapterUseCase
+	usecase usecase.ChapterUseCase
 }
 
-func NewChaptersApi(verifier middleware.UserVerifier, usecase usecase.ChapterUseCase) openapi.ChaptersAPI {
-	return chaptersApi{verifier: verifier, usecase: usecase}
+func NewChaptersApi(usecase usecase.ChapterUseCase) openapi.ChaptersAPI {
+	return chaptersApi{usecase: usecase}
 }
 
 func (api chaptersApi) ChaptersList(c *gin.Context) {
@@ -27,22 +26,26 @@ func (api chaptersApi) ChaptersList(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.UserId)
+	request.UserId = middleware.GetSubject(c)
+	api.listChapters(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api chaptersApi) V2ChaptersList(c *gin.Context) {
+	var request openapi.ChapterListV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ChapterListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.listChapters(c, openapi.ChapterListRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
 
+func (api chaptersApi) listChapters(c *gin.Context, request openapi.ChapterListRequest) {
 	res, ucErr := api.usecase.ListChapters(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -88,22 +91,27 @@ func (api chaptersApi) ChaptersCreate(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.createChapter(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api chaptersApi) V2ChaptersCreate(c *gin.Context) {
+	var request openapi.ChapterCreateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ChapterCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.createChapter(c, openapi.ChapterCreateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+	})
+}
 
+func (api chaptersApi) createChapter(c *gin.Context, request openapi.ChapterCreateRequest) {
 	res, ucErr := api.usecase.CreateChapter(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -150,22 +158,27 @@ func (api chaptersApi) ChaptersUpdate(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.updateChapter(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api chaptersApi) V2ChaptersUpdate(c *gin.Context) {
+	var request openapi.ChapterUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ChapterUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.updateChapter(c, openapi.ChapterUpdateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+	})
+}
 
+func (api chaptersApi) updateChapter(c *gin.Context, request openapi.ChapterUpdateRequest) {
 	res, ucErr := api.usecase.UpdateChapter(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -193,6 +206,14 @@ func (api chaptersApi) ChaptersUpdate(c *gin.Context) {
 		return
 	}
 
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.ChapterUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Chapter: res.Chapter,
+		})
+		return
+	}
+
 	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
@@ -212,22 +233,27 @@ func (api chaptersApi) ChaptersDelete(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.deleteChapter(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api chaptersApi) V2ChaptersDelete(c *gin.Context) {
+	var request openapi.ChapterDeleteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ChapterDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.deleteChapter(c, openapi.ChapterDeleteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+	})
+}
 
+func (api chaptersApi) deleteChapter(c *gin.Context, request openapi.ChapterDeleteRequest) {
 	ucErr := api.usecase.DeleteChapter(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
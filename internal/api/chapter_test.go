@@ -15,9 +15,7 @@ import (
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
 	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
 	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
-	mock_middleware "github.com/kumachan-mis/knodeledge-api/mock/middleware"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
 )
 
 func TestChapterList(t *testing.T) {
@@ -39,9 +37,10 @@ func TestChapterList(t *testing.T) {
 	assert.Equal(t, map[string]any{
 		"chapters": []any{
 			map[string]any{
-				"id":     "CHAPTER_ONE",
-				"name":   "Chapter One",
-				"number": float64(1), // json.Unmarshal converts number to float64
+				"id":        "CHAPTER_ONE",
+				"name":      "Chapter One",
+				"number":    float64(1), // json.Unmarshal converts number to float64
+				"updatedAt": "2024-01-01T00:00:00Z",
 				"sections": []any{
 					map[string]any{
 						"id":   "SECTION_ONE",
@@ -54,10 +53,11 @@ func TestChapterList(t *testing.T) {
 				},
 			},
 			map[string]any{
-				"id":       "CHAPTER_TWO",
-				"name":     "Chapter Two",
-				"number":   float64(2), // json.Unmarshal converts number to float64
-				"sections": []any{},
+				"id":        "CHAPTER_TWO",
+				"name":      "Chapter Two",
+				"number":    float64(2), // json.Unmarshal converts number to float64
+				"sections":  []any{},
+				"updatedAt": "2024-01-01T00:00:00Z",
 			},
 		},
 	}, responseBody)
@@ -241,17 +241,88 @@ func TestChapterCreate(t *testing.T) {
 	//chapterId is generated by firestore and it's not predictable
 	chapterId := responseBody["chapter"].(map[string]any)["id"]
 	assert.NotEmpty(t, chapterId)
+	updatedAt := responseBody["chapter"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
 
 	assert.Equal(t, map[string]any{
 		"chapter": map[string]any{
-			"id":       chapterId,
-			"name":     "Chapter One",
-			"number":   float64(1), // json.Unmarshal converts number to float64
-			"sections": []any{},
+			"id":        chapterId,
+			"name":      "Chapter One",
+			"number":    float64(1), // json.Unmarshal converts number to float64
+			"sections":  []any{},
+			"updatedAt": updatedAt,
 		},
 	}, responseBody)
 }
 
+func TestChapterListV2(t *testing.T) {
+	router := setupChapterRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v2/chapters/list", nil)
+	req.Header.Set(v2UserIdHeader, testutil.ReadOnlyUserId())
+	query := req.URL.Query()
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Len(t, responseBody["chapters"], 2)
+}
+
+func TestChapterCreateV2(t *testing.T) {
+	router := setupChapterRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"project": map[string]any{
+			"id": "PROJECT_WITH_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"chapter": map[string]any{
+			"name":   "Chapter One",
+			"number": 1,
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/v2/chapters/create", strings.NewReader(string(requestBody)))
+	req.Header.Set(v2UserIdHeader, testutil.ModifyOnlyUserId())
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, "Chapter One", responseBody["chapter"].(map[string]any)["name"])
+}
+
+func TestChapterCreateV2IgnoresUserInBody(t *testing.T) {
+	router := setupChapterRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITH_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"chapter": map[string]any{
+			"name":   "Chapter One",
+			"number": 1,
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/v2/chapters/create", strings.NewReader(string(requestBody)))
+	req.Header.Set(v2UserIdHeader, testutil.ReadOnlyUserId())
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
 func TestChapterCreateNotFound(t *testing.T) {
 	tt := []struct {
 		name    string
@@ -553,12 +624,55 @@ func TestChapterUpdate(t *testing.T) {
 	var responseBody map[string]any
 	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
 
+	updatedAt := responseBody["chapter"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+
+	assert.Equal(t, map[string]any{
+		"chapter": map[string]any{
+			"id":        "CHAPTER_ONE",
+			"name":      "Updated Chapter One",
+			"number":    float64(1), // json.Unmarshal converts number to float64
+			"sections":  []any{},
+			"updatedAt": updatedAt,
+		},
+	}, responseBody)
+}
+
+func TestChapterUpdateConflict(t *testing.T) {
+	router := setupChapterRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ReadOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION",
+		},
+		"chapter": map[string]any{
+			"id":        "CHAPTER_TWO",
+			"name":      "Updated Chapter Two",
+			"number":    1,
+			"updatedAt": "2023-12-31T00:00:00Z",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/chapters/update", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+
 	assert.Equal(t, map[string]any{
+		"message": "conflict",
 		"chapter": map[string]any{
-			"id":       "CHAPTER_ONE",
-			"name":     "Updated Chapter One",
-			"number":   float64(1), // json.Unmarshal converts number to float64
-			"sections": []any{},
+			"id":        "CHAPTER_TWO",
+			"name":      "Chapter Two",
+			"number":    float64(2), // json.Unmarshal converts number to float64
+			"sections":  []any{},
+			"updatedAt": "2024-01-01T00:00:00Z",
 		},
 	}, responseBody)
 }
@@ -1070,29 +1184,25 @@ func TestChapterDeleteDomainValidationError(t *testing.T) {
 }
 
 func setupChapterRouter(t *testing.T) *gin.Engine {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
 	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
 
 	client := db.FirestoreClient()
 	r := repository.NewChapterRepository(*client)
-	pr := repository.NewPaperRepository(*client)
-	s := service.NewChapterService(r, pr)
-
-	v := mock_middleware.NewMockUserVerifier(ctrl)
-	v.EXPECT().
-		Verify(gomock.Any(), gomock.Any()).
-		Return(nil).
-		AnyTimes()
+	s := service.NewChapterService(r)
 
 	uc := usecase.NewChapterUseCase(s)
-	api := api.NewChaptersApi(v, uc)
+	api := api.NewChaptersApi(uc)
 
 	router.GET("/api/chapters/list", api.ChaptersList)
 	router.POST("/api/chapters/create", api.ChaptersCreate)
 	router.POST("/api/chapters/update", api.ChaptersUpdate)
 	router.POST("/api/chapters/delete", api.ChaptersDelete)
 
+	router.GET("/api/v2/chapters/list", api.V2ChaptersList)
+	router.POST("/api/v2/chapters/create", api.V2ChaptersCreate)
+	router.POST("/api/v2/chapters/update", api.V2ChaptersUpdate)
+	router.POST("/api/v2/chapters/delete", api.V2ChaptersDelete)
+
 	return router
 }
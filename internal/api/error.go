@@ -3,7 +3,6 @@ package api
 import (
 	"fmt"
 
-	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
 	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
 	"github.com/sirupsen/logrus"
 )
@@ -12,16 +11,6 @@ func JsonBindErrorToMessage(err error) string {
 	return "invalid request format"
 }
 
-func MiddlewareErrorToMessage(err *middleware.Error) string {
-	switch err.Code() {
-	case middleware.AuthorizationError:
-		return "authorization error"
-	default:
-		logrus.WithError(err).Error("internal error")
-		return "internal error"
-	}
-}
-
 func UseCaseErrorToMessage[ErrorResponse any](err *usecase.Error[ErrorResponse]) string {
 	switch err.Code() {
 	case usecase.DomainValidationError:
@@ -30,6 +19,8 @@ func UseCaseErrorToMessage[ErrorResponse any](err *usecase.Error[ErrorResponse])
 		return fmt.Sprintf("invalid request value: %v", err.Message())
 	case usecase.NotFoundError:
 		return "not found"
+	case usecase.ConflictError:
+		return "conflict"
 	default:
 		logrus.WithError(err).Error("internal error")
 		return "internal error"
@@ -10,12 +10,11 @@ import (
 )
 
 type graphsApi struct {
-	verifier middleware.UserVerifier
-	usecase  usecase.GraphUseCase
+	usecase usecase.GraphUseCase
 }
 
-func NewGraphApi(verifier middleware.UserVerifier, usecase usecase.GraphUseCase) openapi.GraphsAPI {
-	return graphsApi{verifier: verifier, usecase: usecase}
+func NewGraphApi(usecase usecase.GraphUseCase) openapi.GraphsAPI {
+	return graphsApi{usecase: usecase}
 }
 
 func (api graphsApi) GraphsFind(c *gin.Context) {
@@ -27,39 +26,181 @@ func (api graphsApi) GraphsFind(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.UserId)
+	request.UserId = middleware.GetSubject(c)
+	api.findGraph(c, request)
+}
+
+func (api graphsApi) V2GraphsFind(c *gin.Context) {
+	var request openapi.GraphFindV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.findGraph(c, openapi.GraphFindRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		ChapterId: request.ChapterId,
+		SectionId: request.SectionId,
+	})
+}
+
+func (api graphsApi) findGraph(c *gin.Context, request openapi.GraphFindRequest) {
+	res, ucErr := api.usecase.FindGraph(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphFindErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			ChapterId: resErr.ChapterId,
+			SectionId: resErr.SectionId,
+		})
+		return
+	}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphFindErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
-	if vErr != nil {
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
-	res, ucErr := api.usecase.FindGraph(request)
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsExport(c *gin.Context) {
+	var request openapi.GraphExportRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphExportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.exportGraph(c, request)
+}
+
+func (api graphsApi) V2GraphsExport(c *gin.Context) {
+	var request openapi.GraphExportV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphExportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.exportGraph(c, openapi.GraphExportRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		ChapterId: request.ChapterId,
+		SectionId: request.SectionId,
+		Format:    request.Format,
+	})
+}
+
+func (api graphsApi) exportGraph(c *gin.Context, request openapi.GraphExportRequest) {
+	res, ucErr := api.usecase.ExportGraph(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
 		resErr := UseCaseErrorToResponse(ucErr)
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphFindErrorResponse{
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphExportErrorResponse{
 			Message:   UseCaseErrorToMessage(ucErr),
 			UserId:    resErr.UserId,
 			ProjectId: resErr.ProjectId,
 			ChapterId: resErr.ChapterId,
 			SectionId: resErr.SectionId,
+			Format:    resErr.Format,
 		})
 		return
 	}
 
 	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
-		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphFindErrorResponse{
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphExportErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsImport(c *gin.Context) {
+	var request openapi.GraphImportRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphImportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.importGraph(c, request)
+}
+
+func (api graphsApi) V2GraphsImport(c *gin.Context) {
+	var request openapi.GraphImportV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphImportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.importGraph(c, openapi.GraphImportRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+		Section: request.Section,
+		Source:  request.Source,
+	})
+}
+
+func (api graphsApi) importGraph(c *gin.Context, request openapi.GraphImportRequest) {
+	res, ucErr := api.usecase.ImportGraph(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphImportErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Chapter: resErr.Chapter,
+			Section: resErr.Section,
+			Source:  resErr.Source,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphImportErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.GraphUpdateConflictResponse{
 			Message: UseCaseErrorToMessage(ucErr),
+			Graph:   res.Graph,
 		})
 		return
 	}
@@ -75,6 +216,11 @@ func (api graphsApi) GraphsFind(c *gin.Context) {
 }
 
 func (api graphsApi) GraphsUpdate(c *gin.Context) {
+	if IsJsonPatchRequest(c) {
+		api.graphsPatch(c)
+		return
+	}
+
 	var request openapi.GraphUpdateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphUpdateErrorResponse{
@@ -83,22 +229,33 @@ func (api graphsApi) GraphsUpdate(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.updateGraph(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
+func (api graphsApi) V2GraphsUpdate(c *gin.Context) {
+	if IsJsonPatchRequest(c) {
+		api.v2GraphsPatch(c)
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	var request openapi.GraphUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
+	api.updateGraph(c, openapi.GraphUpdateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+		Graph:   request.Graph,
+	})
+}
+
+func (api graphsApi) updateGraph(c *gin.Context, request openapi.GraphUpdateRequest) {
 	res, ucErr := api.usecase.UpdateGraph(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -120,6 +277,14 @@ func (api graphsApi) GraphsUpdate(c *gin.Context) {
 		return
 	}
 
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.GraphUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Graph:   res.Graph,
+		})
+		return
+	}
+
 	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
@@ -130,31 +295,110 @@ func (api graphsApi) GraphsUpdate(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-func (api graphsApi) GraphsDelete(c *gin.Context) {
-	var request openapi.GraphDeleteRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphDeleteErrorResponse{
+func (api graphsApi) graphsPatch(c *gin.Context) {
+	var request openapi.GraphPatchRequest
+	if err := ShouldBindJsonPatch(c, &request, &request.Patch); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphPatchErrorResponse{
 			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.UserId = middleware.GetSubject(c)
+	api.patchGraph(c, request)
+}
+
+func (api graphsApi) v2GraphsPatch(c *gin.Context) {
+	var request openapi.GraphPatchV2Request
+	if err := ShouldBindJsonPatch(c, &request, &request.Patch); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphPatchErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.patchGraph(c, openapi.GraphPatchRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		ChapterId: request.ChapterId,
+		SectionId: request.SectionId,
+		Patch:     request.Patch,
+	})
+}
+
+func (api graphsApi) patchGraph(c *gin.Context, request openapi.GraphPatchRequest) {
+	res, ucErr := api.usecase.PatchGraph(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphPatchErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			ChapterId: resErr.ChapterId,
+			SectionId: resErr.SectionId,
+			Patch:     resErr.Patch,
+			Graph:     resErr.Graph,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphPatchErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.GraphUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Graph:   res.Graph,
 		})
 		return
 	}
 
-	if vErr != nil {
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsDelete(c *gin.Context) {
+	var request openapi.GraphDeleteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.deleteGraph(c, request)
+}
+
+func (api graphsApi) V2GraphsDelete(c *gin.Context) {
+	var request openapi.GraphDeleteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
+	api.deleteGraph(c, openapi.GraphDeleteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+		Section: request.Section,
+	})
+}
+
+func (api graphsApi) deleteGraph(c *gin.Context, request openapi.GraphDeleteRequest) {
 	ucErr := api.usecase.DeleteGraph(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -195,22 +439,28 @@ func (api graphsApi) GraphsSectionalize(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.sectionalizeGraph(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api graphsApi) V2GraphsSectionalize(c *gin.Context) {
+	var request openapi.GraphSectionalizeV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphSectionalizeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.sectionalizeGraph(c, openapi.GraphSectionalizeRequest{
+		User:     openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:  request.Project,
+		Chapter:  request.Chapter,
+		Sections: request.Sections,
+	})
+}
 
+func (api graphsApi) sectionalizeGraph(c *gin.Context, request openapi.GraphSectionalizeRequest) {
 	res, ucErr := api.usecase.SectionalizeGraph(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -248,3 +498,757 @@ func (api graphsApi) GraphsSectionalize(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, res)
 }
+
+func (api graphsApi) GraphsResectionalizePreview(c *gin.Context) {
+	var request openapi.GraphResectionalizePreviewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizePreviewErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.previewResectionalization(c, request)
+}
+
+func (api graphsApi) V2GraphsResectionalizePreview(c *gin.Context) {
+	var request openapi.GraphResectionalizePreviewV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizePreviewErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.previewResectionalization(c, openapi.GraphResectionalizePreviewRequest{
+		User:     openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:  request.Project,
+		Chapter:  request.Chapter,
+		Sections: request.Sections,
+	})
+}
+
+func (api graphsApi) previewResectionalization(c *gin.Context, request openapi.GraphResectionalizePreviewRequest) {
+	res, ucErr := api.usecase.PreviewResectionalization(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizePreviewErrorResponse{
+			Message:  UseCaseErrorToMessage(ucErr),
+			User:     resErr.User,
+			Project:  resErr.Project,
+			Chapter:  resErr.Chapter,
+			Sections: resErr.Sections,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphResectionalizePreviewErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsResectionalize(c *gin.Context) {
+	var request openapi.GraphResectionalizeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.resectionalizeGraph(c, request)
+}
+
+func (api graphsApi) V2GraphsResectionalize(c *gin.Context) {
+	var request openapi.GraphResectionalizeV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.resectionalizeGraph(c, openapi.GraphResectionalizeRequest{
+		User:         openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:      request.Project,
+		Chapter:      request.Chapter,
+		Sections:     request.Sections,
+		OrphanAction: request.OrphanAction,
+	})
+}
+
+func (api graphsApi) resectionalizeGraph(c *gin.Context, request openapi.GraphResectionalizeRequest) {
+	res, ucErr := api.usecase.ResectionalizeGraph(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphResectionalizeErrorResponse{
+			Message:      UseCaseErrorToMessage(ucErr),
+			User:         resErr.User,
+			Project:      resErr.Project,
+			Chapter:      resErr.Chapter,
+			Sections:     resErr.Sections,
+			OrphanAction: resErr.OrphanAction,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphResectionalizeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.GraphResectionalizeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsNodesAdd(c *gin.Context) {
+	var request openapi.GraphNodeAddRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeAddErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.addGraphNode(c, request)
+}
+
+func (api graphsApi) V2GraphsNodesAdd(c *gin.Context) {
+	var request openapi.GraphNodeAddV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeAddErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.addGraphNode(c, openapi.GraphNodeAddRequest{
+		User:       openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:    request.Project,
+		Chapter:    request.Chapter,
+		Section:    request.Section,
+		ParentPath: request.ParentPath,
+		Child:      request.Child,
+	})
+}
+
+func (api graphsApi) addGraphNode(c *gin.Context, request openapi.GraphNodeAddRequest) {
+	res, ucErr := api.usecase.AddGraphNode(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeAddErrorResponse{
+			Message:    UseCaseErrorToMessage(ucErr),
+			User:       resErr.User,
+			Project:    resErr.Project,
+			Chapter:    resErr.Chapter,
+			Section:    resErr.Section,
+			ParentPath: resErr.ParentPath,
+			Child:      resErr.Child,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeAddErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphNodeAddErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsNodesDelete(c *gin.Context) {
+	var request openapi.GraphNodeDeleteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.deleteGraphNode(c, request)
+}
+
+func (api graphsApi) V2GraphsNodesDelete(c *gin.Context) {
+	var request openapi.GraphNodeDeleteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.deleteGraphNode(c, openapi.GraphNodeDeleteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+		Section: request.Section,
+		Path:    request.Path,
+	})
+}
+
+func (api graphsApi) deleteGraphNode(c *gin.Context, request openapi.GraphNodeDeleteRequest) {
+	res, ucErr := api.usecase.DeleteGraphNode(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeDeleteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Chapter: resErr.Chapter,
+			Section: resErr.Section,
+			Path:    resErr.Path,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeDeleteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphNodeDeleteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsNodesMove(c *gin.Context) {
+	var request openapi.GraphNodeMoveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeMoveErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.moveGraphNode(c, request)
+}
+
+func (api graphsApi) V2GraphsNodesMove(c *gin.Context) {
+	var request openapi.GraphNodeMoveV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeMoveErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.moveGraphNode(c, openapi.GraphNodeMoveRequest{
+		User:       openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:    request.Project,
+		Chapter:    request.Chapter,
+		Section:    request.Section,
+		Path:       request.Path,
+		ParentPath: request.ParentPath,
+	})
+}
+
+func (api graphsApi) moveGraphNode(c *gin.Context, request openapi.GraphNodeMoveRequest) {
+	res, ucErr := api.usecase.MoveGraphNode(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeMoveErrorResponse{
+			Message:    UseCaseErrorToMessage(ucErr),
+			User:       resErr.User,
+			Project:    resErr.Project,
+			Chapter:    resErr.Chapter,
+			Section:    resErr.Section,
+			Path:       resErr.Path,
+			ParentPath: resErr.ParentPath,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeMoveErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphNodeMoveErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsNodesRename(c *gin.Context) {
+	var request openapi.GraphNodeRenameRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeRenameErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.renameGraphNode(c, request)
+}
+
+func (api graphsApi) V2GraphsNodesRename(c *gin.Context) {
+	var request openapi.GraphNodeRenameV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeRenameErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.renameGraphNode(c, openapi.GraphNodeRenameRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Chapter: request.Chapter,
+		Section: request.Section,
+		Path:    request.Path,
+		Name:    request.Name,
+	})
+}
+
+func (api graphsApi) renameGraphNode(c *gin.Context, request openapi.GraphNodeRenameRequest) {
+	res, ucErr := api.usecase.RenameGraphNode(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeRenameErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Chapter: resErr.Chapter,
+			Section: resErr.Section,
+			Path:    resErr.Path,
+			Name:    resErr.Name,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeRenameErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphNodeRenameErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsNodesUpdate(c *gin.Context) {
+	var request openapi.GraphNodeUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.updateGraphNode(c, request)
+}
+
+func (api graphsApi) V2GraphsNodesUpdate(c *gin.Context) {
+	var request openapi.GraphNodeUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.updateGraphNode(c, openapi.GraphNodeUpdateRequest{
+		User:        openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:     request.Project,
+		Chapter:     request.Chapter,
+		Section:     request.Section,
+		Path:        request.Path,
+		Relation:    request.Relation,
+		Description: request.Description,
+	})
+}
+
+func (api graphsApi) updateGraphNode(c *gin.Context, request openapi.GraphNodeUpdateRequest) {
+	res, ucErr := api.usecase.UpdateGraphNode(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeUpdateErrorResponse{
+			Message:     UseCaseErrorToMessage(ucErr),
+			User:        resErr.User,
+			Project:     resErr.Project,
+			Chapter:     resErr.Chapter,
+			Section:     resErr.Section,
+			Path:        resErr.Path,
+			Relation:    resErr.Relation,
+			Description: resErr.Description,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphNodeUpdateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphNodeUpdateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsRevisionsList(c *gin.Context) {
+	var request openapi.GraphRevisionListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listGraphRevisions(c, request)
+}
+
+func (api graphsApi) V2GraphsRevisionsList(c *gin.Context) {
+	var request openapi.GraphRevisionListV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.listGraphRevisions(c, openapi.GraphRevisionListRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		ChapterId: request.ChapterId,
+		SectionId: request.SectionId,
+	})
+}
+
+func (api graphsApi) listGraphRevisions(c *gin.Context, request openapi.GraphRevisionListRequest) {
+	res, ucErr := api.usecase.ListGraphRevisions(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionListErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			ChapterId: resErr.ChapterId,
+			SectionId: resErr.SectionId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphRevisionListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsRevisionsFind(c *gin.Context) {
+	var request openapi.GraphRevisionFindRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.findGraphRevision(c, request)
+}
+
+func (api graphsApi) V2GraphsRevisionsFind(c *gin.Context) {
+	var request openapi.GraphRevisionFindV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.findGraphRevision(c, openapi.GraphRevisionFindRequest{
+		UserId:     middleware.GetSubject(c),
+		ProjectId:  request.ProjectId,
+		ChapterId:  request.ChapterId,
+		SectionId:  request.SectionId,
+		RevisionId: request.RevisionId,
+	})
+}
+
+func (api graphsApi) findGraphRevision(c *gin.Context, request openapi.GraphRevisionFindRequest) {
+	res, ucErr := api.usecase.FindGraphRevision(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionFindErrorResponse{
+			Message:    UseCaseErrorToMessage(ucErr),
+			UserId:     resErr.UserId,
+			ProjectId:  resErr.ProjectId,
+			ChapterId:  resErr.ChapterId,
+			SectionId:  resErr.SectionId,
+			RevisionId: resErr.RevisionId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphRevisionFindErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsRevisionsDiff(c *gin.Context) {
+	var request openapi.GraphRevisionDiffRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionDiffErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.diffGraphRevisions(c, request)
+}
+
+func (api graphsApi) V2GraphsRevisionsDiff(c *gin.Context) {
+	var request openapi.GraphRevisionDiffV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionDiffErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.diffGraphRevisions(c, openapi.GraphRevisionDiffRequest{
+		UserId:         middleware.GetSubject(c),
+		ProjectId:      request.ProjectId,
+		ChapterId:      request.ChapterId,
+		SectionId:      request.SectionId,
+		FromRevisionId: request.FromRevisionId,
+		ToRevisionId:   request.ToRevisionId,
+	})
+}
+
+func (api graphsApi) diffGraphRevisions(c *gin.Context, request openapi.GraphRevisionDiffRequest) {
+	res, ucErr := api.usecase.DiffGraphRevisions(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionDiffErrorResponse{
+			Message:        UseCaseErrorToMessage(ucErr),
+			UserId:         resErr.UserId,
+			ProjectId:      resErr.ProjectId,
+			ChapterId:      resErr.ChapterId,
+			SectionId:      resErr.SectionId,
+			FromRevisionId: resErr.FromRevisionId,
+			ToRevisionId:   resErr.ToRevisionId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphRevisionDiffErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api graphsApi) GraphsRevisionsRestore(c *gin.Context) {
+	var request openapi.GraphRevisionRestoreRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.restoreGraphRevision(c, request)
+}
+
+func (api graphsApi) V2GraphsRevisionsRestore(c *gin.Context) {
+	var request openapi.GraphRevisionRestoreV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.restoreGraphRevision(c, openapi.GraphRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:  request.Project,
+		Chapter:  request.Chapter,
+		Section:  request.Section,
+		Revision: request.Revision,
+	})
+}
+
+func (api graphsApi) restoreGraphRevision(c *gin.Context, request openapi.GraphRevisionRestoreRequest) {
+	res, ucErr := api.usecase.RestoreGraphRevision(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.GraphRevisionRestoreErrorResponse{
+			Message:  UseCaseErrorToMessage(ucErr),
+			User:     resErr.User,
+			Project:  resErr.Project,
+			Chapter:  resErr.Chapter,
+			Section:  resErr.Section,
+			Revision: resErr.Revision,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.GraphRevisionRestoreErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
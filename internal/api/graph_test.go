@@ -11,13 +11,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kumachan-mis/knodeledge-api/internal/api"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
 	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
 	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
-	mock_middleware "github.com/kumachan-mis/knodeledge-api/mock/middleware"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
 )
 
 func TestGraphFind(t *testing.T) {
@@ -45,6 +44,7 @@ func TestGraphFind(t *testing.T) {
 			"id":        "SECTION_ONE",
 			"name":      "Introduction",
 			"paragraph": "This is an example project of kNODEledge.",
+			"updatedAt": "2024-01-01T00:00:00Z",
 			"children": []any{
 				map[string]any{
 					"name":        "Background",
@@ -264,6 +264,272 @@ func TestGraphFindInternalError(t *testing.T) {
 	}, responseBody)
 }
 
+func TestGraphExport(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/export", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "SECTION_ONE")
+	query.Add("format", "mermaid")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"export": map[string]any{
+			"format":    "mermaid",
+			"mediaType": "text/vnd.mermaid",
+			"content": "flowchart TD\n" +
+				"  n0[\"Introduction\"]\n" +
+				"  n1[\"Background\"]\n" +
+				"  n2[\"IT in Education\"]\n" +
+				"  n3[\"Motivation\"]\n" +
+				"  n4[\"Literature Review\"]\n" +
+				"  n0 -->|\"part of\"| n1\n" +
+				"  %% n0 -> n1: This is background part.\n" +
+				"  n1 -->|\"one of\"| n2\n" +
+				"  %% n1 -> n2: This is IT in Education part.\n" +
+				"  n0 -->|\"part of\"| n3\n" +
+				"  %% n0 -> n3: This is motivation part.\n" +
+				"  n0 -->|\"part of\"| n4\n" +
+				"  %% n0 -> n4: This is literature review part.\n",
+		},
+	}, responseBody)
+}
+
+func TestGraphExportNotFound(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/export", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("chapterId", "UNKNOWN_CHAPTER")
+	query.Add("format", "dot")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestGraphExportDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/export", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("sectionId", "SECTION_ONE")
+	query.Add("format", "svg")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"chapterId": "chapter id is required, but got ''",
+		"format":    "export format must be one of cytoscape, dot, graphml, mermaid, but got 'svg'",
+	}, responseBody)
+}
+
+func TestGraphImport(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Import Graph from API",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+	graphIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"chapter": map[string]any{"id": chapterId},
+		"section": map[string]any{"id": graphIds[0]},
+		"source": map[string]any{
+			"format": "mermaid",
+			"content": "flowchart TD\n" +
+				"  n0[\"Section One\"]\n" +
+				"  n1[\"Node A\"]\n" +
+				"  n2[\"Node B\"]\n" +
+				"  n0 -->|\"part of\"| n1\n" +
+				"  %% n0 -> n1: This is node A.\n" +
+				"  n1 -->|\"one of\"| n2\n" +
+				"  n2 --> n1\n",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/import", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	graph := responseBody["graph"].(map[string]any)
+	assert.NotEmpty(t, graph["updatedAt"])
+	delete(graph, "updatedAt")
+	assert.Len(t, popGraphChildIds(t, graph["children"]), 2)
+	assert.Equal(t, map[string]any{
+		"id":        graphIds[0],
+		"name":      "Section One",
+		"paragraph": "Paragraph One",
+		"children": []any{
+			map[string]any{
+				"name":        "Node A",
+				"relation":    "part of",
+				"description": "This is node A.",
+				"children": []any{
+					map[string]any{
+						"name":        "Node B",
+						"relation":    "one of",
+						"description": "",
+						"children":    []any{},
+					},
+				},
+			},
+		},
+	}, graph)
+	assert.Equal(t, []any{
+		map[string]any{
+			"location": "line 8",
+			"message":  "edge from 'n2' to 'n1' is dropped to break a cycle",
+		},
+	}, responseBody["warnings"])
+}
+
+func TestGraphImportNotFound(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project": map[string]any{"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API"},
+		"chapter": map[string]any{"id": "CHAPTER_ONE"},
+		"section": map[string]any{"id": "UNKNOWN_SECTION"},
+		"source":  map[string]any{"format": "markdown", "content": "- Node A\n"},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/import", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{},
+		"source":  map[string]any{},
+	}, responseBody)
+}
+
+func TestGraphImportDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project": map[string]any{"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API"},
+		"chapter": map[string]any{"id": "CHAPTER_ONE"},
+		"section": map[string]any{"id": ""},
+		"source":  map[string]any{"format": "svg", "content": "<svg/>"},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/import", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{"id": "section id is required, but got ''"},
+		"source": map[string]any{
+			"format": "import format must be one of graphml, markdown, mermaid, opml, but got 'svg'",
+		},
+	}, responseBody)
+}
+
+func TestGraphImportInvalidRequestFormat(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/graphs/import", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{},
+		"source":  map[string]any{},
+	}, responseBody)
+}
+
 func TestGraphUpdate(t *testing.T) {
 	router := setupGraphRouter(t)
 
@@ -326,11 +592,17 @@ func TestGraphUpdate(t *testing.T) {
 	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
 	assert.Nil(t, err)
 
+	//updatedAt and ids of children are set by the server and they're not predictable
+	updatedAt := responseBody["graph"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+	assert.Len(t, popGraphChildIds(t, responseBody["graph"].(map[string]any)["children"]), 5)
+
 	assert.Equal(t, map[string]any{
 		"graph": map[string]any{
 			"id":        "SECTION_TWO",
 			"name":      "Section of Chapter One",
 			"paragraph": "Updated paragraph content.",
+			"updatedAt": updatedAt,
 			"children": []any{
 				map[string]any{
 					"name":        "Background",
@@ -368,6 +640,75 @@ func TestGraphUpdate(t *testing.T) {
 	}, responseBody)
 }
 
+func TestGraphUpdateConflict(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ReadOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION",
+		},
+		"chapter": map[string]any{
+			"id": "CHAPTER_ONE",
+		},
+		"graph": map[string]any{
+			"id":        "SECTION_ONE",
+			"paragraph": "Updated paragraph content.",
+			"updatedAt": "2023-12-31T00:00:00Z",
+			"children":  []any{},
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/update", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "conflict",
+		"graph": map[string]any{
+			"id":        "SECTION_ONE",
+			"name":      "Introduction",
+			"paragraph": "This is an example project of kNODEledge.",
+			"updatedAt": "2024-01-01T00:00:00Z",
+			"children": []any{
+				map[string]any{
+					"name":        "Background",
+					"relation":    "part of",
+					"description": "This is background part.",
+					"children": []any{
+						map[string]any{
+							"name":        "IT in Education",
+							"relation":    "one of",
+							"description": "This is IT in Education part.",
+							"children":    []any{},
+						},
+					},
+				},
+				map[string]any{
+					"name":        "Motivation",
+					"relation":    "part of",
+					"description": "This is motivation part.",
+					"children":    []any{},
+				},
+				map[string]any{
+					"name":        "Literature Review",
+					"relation":    "part of",
+					"description": "This is literature review part.",
+					"children":    []any{},
+				},
+			},
+		},
+	}, responseBody)
+}
+
 func TestGraphUpdateNotFound(t *testing.T) {
 
 	tt := []struct {
@@ -908,38 +1249,201 @@ func TestGraphUpdateInvalidRequestFormat(t *testing.T) {
 	}, responseBody)
 }
 
-func TestGraphDelete(t *testing.T) {
+func TestGraphPatch(t *testing.T) {
 	router := setupGraphRouter(t)
 
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Patch Graph from API",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+	graphIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
 	recorder := httptest.NewRecorder()
-	requestBody, _ := json.Marshal(map[string]any{
-		"user": map[string]any{
-			"id": testutil.ModifyOnlyUserId(),
-		},
-		"project": map[string]any{
-			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_DELETE_FROM_API",
-		},
-		"chapter": map[string]any{
-			"id": "CHAPTER_ONE",
-		},
-		"section": map[string]any{
-			"id": "SECTION_TWO",
-		},
+	requestBody, _ := json.Marshal([]map[string]any{
+		{"op": "test", "path": "/paragraph", "value": "Paragraph One"},
+		{"op": "replace", "path": "/paragraph", "value": "Patched Paragraph"},
+		{"op": "add", "path": "/children/-", "value": map[string]any{
+			"name":        "Node A",
+			"relation":    "part of",
+			"description": "",
+			"children":    []any{},
+		}},
 	})
-	req, _ := http.NewRequest("POST", "/api/graphs/delete", strings.NewReader(string(requestBody)))
+	req, _ := http.NewRequest("POST", "/api/graphs/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	query.Add("chapterId", chapterId)
+	query.Add("sectionId", graphIds[0])
+	req.URL.RawQuery = query.Encode()
 
 	router.ServeHTTP(recorder, req)
 
-	assert.Equal(t, http.StatusNoContent, recorder.Code)
-}
+	assert.Equal(t, http.StatusOK, recorder.Code)
 
-func TestGraphDeleteNotFound(t *testing.T) {
-	tt := []struct {
-		name      string
-		userId    string
-		projectId string
-		chapterId string
-		sectionId string
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	graph := responseBody["graph"].(map[string]any)
+	assert.NotEmpty(t, graph["updatedAt"])
+	delete(graph, "updatedAt")
+	assert.Len(t, popGraphChildIds(t, graph["children"]), 1)
+	assert.Equal(t, map[string]any{
+		"id":        graphIds[0],
+		"name":      "Section One",
+		"paragraph": "Patched Paragraph",
+		"children": []any{
+			map[string]any{
+				"name":        "Node A",
+				"relation":    "part of",
+				"description": "",
+				"children":    []any{},
+			},
+		},
+	}, graph)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal([]map[string]any{
+		{"op": "test", "path": "/paragraph", "value": "Paragraph One"},
+	})
+	req, _ = http.NewRequest("POST", "/api/graphs/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"patch": map[string]any{
+			"items": []any{
+				map[string]any{
+					"message": "json patch test failed, value at '/paragraph' is not equal to the expected one",
+				},
+			},
+		},
+		"graph": map[string]any{
+			"children": map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestGraphPatchDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "paragraph", "value": "Patched Paragraph"},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"sectionId": "section id is required, but got ''",
+		"patch": map[string]any{
+			"items": []any{
+				map[string]any{
+					"path": "json pointer must be empty or start with '/', but got 'paragraph'",
+				},
+			},
+		},
+		"graph": map[string]any{
+			"children": map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestGraphPatchInvalidRequestFormat(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/graphs/update", strings.NewReader(`{"op": "add"}`))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"graph": map[string]any{
+			"children": map[string]any{},
+		},
+		"patch": map[string]any{},
+	}, responseBody)
+}
+
+func TestGraphDelete(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_DELETE_FROM_API",
+		},
+		"chapter": map[string]any{
+			"id": "CHAPTER_ONE",
+		},
+		"section": map[string]any{
+			"id": "SECTION_TWO",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/delete", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestGraphDeleteNotFound(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		chapterId string
+		sectionId string
 	}{
 		{
 			name:      "should return error when project not found",
@@ -1230,6 +1734,10 @@ func TestGraphSectionalize(t *testing.T) {
 	assert.NotEmpty(t, graphId1)
 	graphId2 := responseBody["graphs"].([]any)[1].(map[string]any)["id"]
 	assert.NotEmpty(t, graphId2)
+	updatedAt1 := responseBody["graphs"].([]any)[0].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt1)
+	updatedAt2 := responseBody["graphs"].([]any)[1].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt2)
 
 	assert.Equal(t, map[string]any{
 		"graphs": []any{
@@ -1237,12 +1745,14 @@ func TestGraphSectionalize(t *testing.T) {
 				"id":        graphId1,
 				"name":      "Section One",
 				"paragraph": "Content of Section One",
+				"updatedAt": updatedAt1,
 				"children":  []any{},
 			},
 			map[string]any{
 				"id":        graphId2,
 				"name":      maxLengthSectionName,
 				"paragraph": maxLengthSectionContent,
+				"updatedAt": updatedAt2,
 				"children":  []any{},
 			},
 		},
@@ -1697,30 +2207,685 @@ func TestGraphSectionalizeInvalidRequestFormat(t *testing.T) {
 	}, responseBody)
 }
 
-func setupGraphRouter(t *testing.T) *gin.Engine {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestGraphResectionalize(t *testing.T) {
+	router := setupGraphRouter(t)
 
-	router := gin.Default()
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Resectionalize Graphs from API",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+	graphIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{
+			Name:      "Introduction",
+			Paragraph: "This chapter introduces graphs.",
+			Children: []record.GraphChildEntry{
+				{Name: "Graph", Relation: "about", Description: "", Children: []record.GraphChildEntry{}},
+			},
+		},
+		{Name: "Old Section", Paragraph: "Obsolete text.", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	sections := []map[string]any{
+		{"name": "Introduction", "content": "This chapter introduces knowledge graphs."},
+		{"name": "Summary", "content": "A brand new summary."},
+	}
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":     map[string]any{"id": userId},
+		"project":  map[string]any{"id": projectId},
+		"chapter":  map[string]any{"id": chapterId},
+		"sections": sections,
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/resectionalize/preview", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var previewResponseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &previewResponseBody)
+	assert.Nil(t, err)
+
+	orphans := previewResponseBody["orphans"].([]any)
+	assert.Len(t, orphans, 1)
+	assert.Equal(t, graphIds[1], orphans[0].(map[string]any)["id"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "Introduction", "graphId": graphIds[0]},
+		map[string]any{"name": "Summary"},
+	}, previewResponseBody["matches"])
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":         map[string]any{"id": userId},
+		"project":      map[string]any{"id": projectId},
+		"chapter":      map[string]any{"id": chapterId},
+		"sections":     sections,
+		"orphanAction": "archive",
+	})
+	req, _ = http.NewRequest("POST", "/api/graphs/resectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	graphs := responseBody["graphs"].([]any)
+	assert.Len(t, graphs, 2)
+	assert.Equal(t, graphIds[0], graphs[0].(map[string]any)["id"])
+	assert.Equal(t, "This chapter introduces knowledge graphs.", graphs[0].(map[string]any)["paragraph"])
+	assert.Len(t, graphs[0].(map[string]any)["children"], 1)
+	assert.NotEmpty(t, graphs[1].(map[string]any)["id"])
+	assert.Equal(t, "Summary", graphs[1].(map[string]any)["name"])
+	assert.Equal(t, orphans, responseBody["orphans"])
+}
+
+func TestGraphResectionalizeNotFound(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ReadOnlyUserId()},
+		"project": map[string]any{"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API"},
+		"chapter": map[string]any{"id": "CHAPTER_ONE"},
+		"sections": []map[string]any{
+			{"name": "Section One", "content": "Content of Section One"},
+		},
+		"orphanAction": "delete",
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/resectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message":  "not found",
+		"user":     map[string]any{},
+		"project":  map[string]any{},
+		"chapter":  map[string]any{},
+		"sections": map[string]any{},
+	}, responseBody)
+}
+
+func TestGraphResectionalizeDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project": map[string]any{"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API"},
+		"chapter": map[string]any{"id": "CHAPTER_ONE"},
+		"sections": []map[string]any{
+			{"name": "Section One", "content": "Content of Section One"},
+		},
+		"orphanAction": "keep",
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/resectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"sections": map[string]any{
+			"items": []any{
+				map[string]any{},
+			},
+		},
+		"orphanAction": "orphan action must be one of archive, delete, but got 'keep'",
+	}, responseBody)
+}
+
+func TestGraphResectionalizeInvalidRequestFormat(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/graphs/resectionalize/preview", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func TestGraphNodes(t *testing.T) {
+	router := setupGraphRouter(t)
 
 	client := db.FirestoreClient()
-	r := repository.NewGraphRepository(*client)
+	pr := repository.NewProjectRepository(*client)
 	cr := repository.NewChapterRepository(*client)
-	s := service.NewGraphService(r, cr)
+	gr := repository.NewGraphRepository(*client)
 
-	v := mock_middleware.NewMockUserVerifier(ctrl)
-	v.EXPECT().
-		Verify(gomock.Any(), gomock.Any()).
-		Return(nil).
-		AnyTimes()
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Edit Graph Nodes from API",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+	graphIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
 
-	uc := usecase.NewGraphUseCase(s)
-	api := api.NewGraphApi(v, uc)
+	ids := map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"chapter": map[string]any{"id": chapterId},
+		"section": map[string]any{"id": graphIds[0]},
+	}
+	post := func(path string, body map[string]any) (int, map[string]any) {
+		for key, value := range ids {
+			body[key] = value
+		}
+		recorder := httptest.NewRecorder()
+		requestBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", path, strings.NewReader(string(requestBody)))
+
+		router.ServeHTTP(recorder, req)
+
+		var responseBody map[string]any
+		err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+		assert.Nil(t, err)
+		return recorder.Code, responseBody
+	}
+	childIds := []any{}
+	children := func(responseBody map[string]any) any {
+		children := responseBody["graph"].(map[string]any)["children"]
+		childIds = popGraphChildIds(t, children)
+		return children
+	}
+
+	code, responseBody := post("/api/graphs/nodes/add", map[string]any{
+		"parentPath": []string{},
+		"child": map[string]any{
+			"name": "Node A", "relation": "part of", "description": "", "children": []any{},
+		},
+	})
+	assert.Equal(t, http.StatusOK, code)
+	children(responseBody)
+	nodeAId := childIds[0]
+
+	code, responseBody = post("/api/graphs/nodes/add", map[string]any{
+		"parentPath": []string{},
+		"child": map[string]any{
+			"name": "Node B", "relation": "", "description": "", "children": []any{},
+		},
+	})
+	assert.Equal(t, http.StatusOK, code)
+
+	code, responseBody = post("/api/graphs/nodes/rename", map[string]any{
+		"path": []string{"Node A"},
+		"name": "Node B",
+	})
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to rename graph child: failed to update graph children: " +
+			"names of children must be unique, but got 'Node B' duplicated",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{},
+	}, responseBody)
+
+	code, responseBody = post("/api/graphs/nodes/update", map[string]any{
+		"path":        []string{"Node A"},
+		"relation":    "depends on",
+		"description": "updated description",
+	})
+	assert.Equal(t, http.StatusOK, code)
+
+	code, responseBody = post("/api/graphs/nodes/move", map[string]any{
+		"path":       []string{"Node A"},
+		"parentPath": []string{"Node B"},
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []any{
+		map[string]any{
+			"name": "Node B", "relation": "", "description": "", "children": []any{
+				map[string]any{
+					"name": "Node A", "relation": "depends on", "description": "updated description", "children": []any{},
+				},
+			},
+		},
+	}, children(responseBody))
+	assert.Equal(t, nodeAId, childIds[1])
+
+	code, responseBody = post("/api/graphs/nodes/delete", map[string]any{
+		"path": []string{"Node B", "Node A"},
+	})
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []any{
+		map[string]any{"name": "Node B", "relation": "", "description": "", "children": []any{}},
+	}, children(responseBody))
+
+	code, responseBody = post("/api/graphs/nodes/delete", map[string]any{
+		"path": []string{"Node A"},
+	})
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to delete graph child: failed to update graph children: " +
+			"graph child is not found at [\"Node A\"]",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{},
+	}, responseBody)
+}
+
+func TestGraphNodesDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":       map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project":    map[string]any{"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API"},
+		"chapter":    map[string]any{"id": "CHAPTER_ONE"},
+		"section":    map[string]any{"id": "SECTION_ONE"},
+		"path":       []string{"Node A", ""},
+		"parentPath": []string{},
+	})
+	req, _ := http.NewRequest("POST", "/api/graphs/nodes/move", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"chapter": map[string]any{},
+		"section": map[string]any{},
+		"path":    "graph path is invalid at 1: graph name is required, but got ''",
+	}, responseBody)
+}
+
+func TestGraphNodesInvalidRequestFormat(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/graphs/nodes/add", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func TestGraphRevisions(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	for _, parent := range []string{"Background", "Motivation"} {
+		children := map[string][]any{"Background": {}, "Motivation": {}}
+		children[parent] = []any{
+			map[string]any{
+				"name":        "IT in Education",
+				"relation":    "one of",
+				"description": "This is IT in Education part.",
+				"children":    []any{},
+			},
+		}
+
+		recorder := httptest.NewRecorder()
+		requestBody, _ := json.Marshal(map[string]any{
+			"user": map[string]any{
+				"id": testutil.ModifyOnlyUserId(),
+			},
+			"project": map[string]any{
+				"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+			},
+			"chapter": map[string]any{
+				"id": "CHAPTER_ONE",
+			},
+			"graph": map[string]any{
+				"id":        "SECTION_TWO",
+				"paragraph": "Updated paragraph content.",
+				"children": []any{
+					map[string]any{
+						"name":        "Background",
+						"relation":    "part of",
+						"description": "This is background part.",
+						"children":    children["Background"],
+					},
+					map[string]any{
+						"name":        "Motivation",
+						"relation":    "part of",
+						"description": "This is motivation part of " + parent + ".",
+						"children":    children["Motivation"],
+					},
+				},
+			},
+		})
+		req, _ := http.NewRequest("POST", "/api/graphs/update", strings.NewReader(string(requestBody)))
+
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/revisions/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "SECTION_TWO")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &listResponseBody)
+	assert.Nil(t, err)
+
+	revisions := listResponseBody["revisions"].([]any)
+	assert.GreaterOrEqual(t, len(revisions), 2)
+
+	latestRevision := revisions[0].(map[string]any)
+	previousRevision := revisions[1].(map[string]any)
+	assert.Equal(t, testutil.ModifyOnlyUserId(), latestRevision["authorId"])
+	assert.NotEmpty(t, latestRevision["createdAt"])
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/graphs/revisions/find", nil)
+	query = req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "SECTION_TWO")
+	query.Add("revisionId", previousRevision["id"].(string))
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var findResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &findResponseBody)
+	assert.Nil(t, err)
+	assert.Len(t, popGraphChildIds(t, findResponseBody["revision"].(map[string]any)["children"]), 3)
+
+	previousChildren := []any{
+		map[string]any{
+			"name":        "Background",
+			"relation":    "part of",
+			"description": "This is background part.",
+			"children": []any{
+				map[string]any{
+					"name":        "IT in Education",
+					"relation":    "one of",
+					"description": "This is IT in Education part.",
+					"children":    []any{},
+				},
+			},
+		},
+		map[string]any{
+			"name":        "Motivation",
+			"relation":    "part of",
+			"description": "This is motivation part of Background.",
+			"children":    []any{},
+		},
+	}
+
+	assert.Equal(t, map[string]any{
+		"revision": map[string]any{
+			"id":        previousRevision["id"],
+			"paragraph": "Updated paragraph content.",
+			"children":  previousChildren,
+			"authorId":  testutil.ModifyOnlyUserId(),
+			"createdAt": previousRevision["createdAt"],
+		},
+	}, findResponseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/graphs/revisions/diff", nil)
+	query = req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "SECTION_TWO")
+	query.Add("fromRevisionId", previousRevision["id"].(string))
+	query.Add("toRevisionId", latestRevision["id"].(string))
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var diffResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &diffResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"diff": map[string]any{
+			"fromRevisionId": previousRevision["id"],
+			"toRevisionId":   latestRevision["id"],
+			"changes": []any{
+				map[string]any{
+					"type":      "description",
+					"fromPath":  []any{"Motivation"},
+					"toPath":    []any{"Motivation"},
+					"fromValue": "This is motivation part of Background.",
+					"toValue":   "This is motivation part of Motivation.",
+				},
+				map[string]any{
+					"type":     "moved",
+					"fromPath": []any{"Background", "IT in Education"},
+					"toPath":   []any{"Motivation", "IT in Education"},
+				},
+			},
+		},
+	}, diffResponseBody)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"chapter": map[string]any{
+			"id": "CHAPTER_ONE",
+		},
+		"section": map[string]any{
+			"id": "SECTION_TWO",
+		},
+		"revision": map[string]any{
+			"id": previousRevision["id"],
+		},
+	})
+	req, _ = http.NewRequest("POST", "/api/graphs/revisions/restore", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var restoreResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &restoreResponseBody)
+	assert.Nil(t, err)
+
+	updatedAt := restoreResponseBody["graph"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+	assert.Len(t, popGraphChildIds(t, restoreResponseBody["graph"].(map[string]any)["children"]), 3)
+
+	assert.Equal(t, map[string]any{
+		"graph": map[string]any{
+			"id":        "SECTION_TWO",
+			"name":      "Section of Chapter One",
+			"paragraph": "Updated paragraph content.",
+			"children":  previousChildren,
+			"updatedAt": updatedAt,
+		},
+	}, restoreResponseBody)
+}
+
+func TestGraphRevisionsFindNotFound(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/revisions/find", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "SECTION_ONE")
+	query.Add("revisionId", "UNKNOWN_REVISION")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestGraphRevisionsListDomainValidationError(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/graphs/revisions/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("chapterId", "CHAPTER_ONE")
+	query.Add("sectionId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"sectionId": "section id is required, but got ''",
+	}, responseBody)
+}
+
+func TestGraphRevisionsRestoreInvalidRequestFormat(t *testing.T) {
+	router := setupGraphRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/graphs/revisions/restore", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func setupGraphRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+	s := service.NewGraphService(r, service.RevisionRetention{})
+	gs := service.NewProjectGraphService(repository.NewChapterRepository(*client), r)
+
+	uc := usecase.NewGraphUseCase(s, gs)
+	api := api.NewGraphApi(uc)
 
 	router.GET("/api/graphs/find", api.GraphsFind)
+	router.GET("/api/graphs/export", api.GraphsExport)
+	router.POST("/api/graphs/import", api.GraphsImport)
 	router.POST("/api/graphs/update", api.GraphsUpdate)
 	router.POST("/api/graphs/delete", api.GraphsDelete)
 	router.POST("/api/graphs/sectionalize", api.GraphsSectionalize)
+	router.POST("/api/graphs/resectionalize/preview", api.GraphsResectionalizePreview)
+	router.POST("/api/graphs/resectionalize", api.GraphsResectionalize)
+	router.POST("/api/graphs/nodes/add", api.GraphsNodesAdd)
+	router.POST("/api/graphs/nodes/rename", api.GraphsNodesRename)
+	router.POST("/api/graphs/nodes/update", api.GraphsNodesUpdate)
+	router.POST("/api/graphs/nodes/move", api.GraphsNodesMove)
+	router.POST("/api/graphs/nodes/delete", api.GraphsNodesDelete)
+	router.GET("/api/graphs/revisions/list", api.GraphsRevisionsList)
+	router.GET("/api/graphs/revisions/find", api.GraphsRevisionsFind)
+	router.GET("/api/graphs/revisions/diff", api.GraphsRevisionsDiff)
+	router.POST("/api/graphs/revisions/restore", api.GraphsRevisionsRestore)
 
 	return router
 }
+
+// popGraphChildIds removes the ids assigned by the server from children in place
+// and returns them in depth-first order
+func popGraphChildIds(t *testing.T, children any) []any {
+	ids := []any{}
+	for _, child := range children.([]any) {
+		child := child.(map[string]any)
+		assert.NotEmpty(t, child["id"])
+		ids = append(ids, child["id"])
+		delete(child, "id")
+		ids = append(ids, popGraphChildIds(t, child["children"])...)
+	}
+	return ids
+}
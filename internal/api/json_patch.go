@@ -0,0 +1,37 @@
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+const JsonPatchContentType = "application/json-patch+json"
+
+func IsJsonPatchRequest(c *gin.Context) bool {
+	return c.ContentType() == JsonPatchContentType
+}
+
+func ShouldBindJsonPatch(c *gin.Context, query any, patch *[]openapi.JsonPatchOperation) error {
+	if err := c.ShouldBindQuery(query); err != nil {
+		return err
+	}
+	if err := c.ShouldBindBodyWith(patch, binding.JSON); err != nil {
+		return err
+	}
+
+	// "value": null is bound to nil as a missing value is, so operations with the member are marked
+	var members []map[string]json.RawMessage
+	if err := json.Unmarshal(c.MustGet(gin.BodyBytesKey).([]byte), &members); err != nil {
+		return err
+	}
+	for i, member := range members {
+		if _, ok := member["value"]; ok && (*patch)[i].Value == nil {
+			(*patch)[i].Value = usecase.JsonPatchNull{}
+		}
+	}
+	return nil
+}
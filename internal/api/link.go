@@ -0,0 +1,277 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+type linksApi struct {
+	usecase usecase.LinkUseCase
+}
+
+func NewLinksApi(usecase usecase.LinkUseCase) openapi.LinksAPI {
+	return linksApi{usecase: usecase}
+}
+
+func (api linksApi) LinksList(c *gin.Context) {
+	var request openapi.LinkListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listLinks(c, request)
+}
+
+func (api linksApi) V2LinksList(c *gin.Context) {
+	var request openapi.LinkListV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.listLinks(c, openapi.LinkListRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
+
+func (api linksApi) listLinks(c *gin.Context, request openapi.LinkListRequest) {
+	res, ucErr := api.usecase.ListLinks(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkListErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.LinkListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api linksApi) LinksCreate(c *gin.Context) {
+	var request openapi.LinkCreateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.createLink(c, request)
+}
+
+func (api linksApi) V2LinksCreate(c *gin.Context) {
+	var request openapi.LinkCreateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.createLink(c, openapi.LinkCreateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Link:    request.Link,
+	})
+}
+
+func (api linksApi) createLink(c *gin.Context, request openapi.LinkCreateRequest) {
+	res, ucErr := api.usecase.CreateLink(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkCreateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Link:    resErr.Link,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkCreateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.LinkCreateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (api linksApi) LinksUpdate(c *gin.Context) {
+	var request openapi.LinkUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.updateLink(c, request)
+}
+
+func (api linksApi) V2LinksUpdate(c *gin.Context) {
+	var request openapi.LinkUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.updateLink(c, openapi.LinkUpdateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Link:    request.Link,
+	})
+}
+
+func (api linksApi) updateLink(c *gin.Context, request openapi.LinkUpdateRequest) {
+	res, ucErr := api.usecase.UpdateLink(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkUpdateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Link:    resErr.Link,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkUpdateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.LinkUpdateErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api linksApi) LinksDelete(c *gin.Context) {
+	var request openapi.LinkDeleteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.deleteLink(c, request)
+}
+
+func (api linksApi) V2LinksDelete(c *gin.Context) {
+	var request openapi.LinkDeleteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.deleteLink(c, openapi.LinkDeleteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Link:    request.Link,
+	})
+}
+
+func (api linksApi) deleteLink(c *gin.Context, request openapi.LinkDeleteRequest) {
+	ucErr := api.usecase.DeleteLink(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.LinkDeleteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Link:    resErr.Link,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.LinkDeleteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,318 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinksCreateListUpdateDelete(t *testing.T) {
+	router := setupLinkRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Link Graph Nodes from API",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter to Link",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	sectionIds, graphs, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{
+			{Name: "Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+		}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	from := map[string]any{
+		"chapterId": chapterId,
+		"sectionId": sectionIds[0],
+		"nodeId":    graphs[0].Children[0].Id,
+	}
+	to := map[string]any{
+		"chapterId": chapterId,
+		"sectionId": sectionIds[1],
+	}
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"link": map[string]any{
+			"from":        from,
+			"to":          to,
+			"relation":    "related to",
+			"description": "description",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/links/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var createResponseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &createResponseBody)
+	assert.Nil(t, err)
+
+	link := createResponseBody["link"].(map[string]any)
+	assert.NotEmpty(t, link["id"])
+	assert.Equal(t, from, link["from"])
+	assert.Equal(t, to, link["to"])
+	assert.Equal(t, "related to", link["relation"])
+	assert.Equal(t, "description", link["description"])
+	assert.NotEmpty(t, link["updatedAt"])
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/links/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &listResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{"links": []any{link}}, listResponseBody)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"link": map[string]any{
+			"id":          link["id"],
+			"from":        to,
+			"to":          from,
+			"relation":    "depends on",
+			"description": "",
+		},
+	})
+	req, _ = http.NewRequest("POST", "/api/links/update", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var updateResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &updateResponseBody)
+	assert.Nil(t, err)
+
+	updated := updateResponseBody["link"].(map[string]any)
+	assert.Equal(t, link["id"], updated["id"])
+	assert.Equal(t, to, updated["from"])
+	assert.Equal(t, from, updated["to"])
+	assert.Equal(t, "depends on", updated["relation"])
+	assert.Equal(t, "", updated["description"])
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"link":    map[string]any{"id": link["id"]},
+	})
+	req, _ = http.NewRequest("POST", "/api/links/delete", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/links/delete", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var notFoundResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &notFoundResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"link":    map[string]any{},
+	}, notFoundResponseBody)
+}
+
+func TestLinksCreateInvalidArgument(t *testing.T) {
+	router := setupLinkRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Link Unknown Graph Nodes from API",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter without Graph",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"link": map[string]any{
+			"from":     map[string]any{"chapterId": chapterId, "sectionId": "UNKNOWN_SECTION"},
+			"to":       map[string]any{"chapterId": "UNKNOWN_CHAPTER", "sectionId": "UNKNOWN_SECTION"},
+			"relation": "related to",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/links/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to create link: link endpoint does not exist",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"link": map[string]any{
+			"from": map[string]any{},
+			"to":   map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestLinksCreateDomainValidationError(t *testing.T) {
+	router := setupLinkRouter(t)
+
+	endpoint := map[string]any{"chapterId": "1000000000000001", "sectionId": "2000000000000001"}
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project": map[string]any{"id": "0000000000000001"},
+		"link": map[string]any{
+			"from":     endpoint,
+			"to":       endpoint,
+			"relation": "related to",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/links/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"link": map[string]any{
+			"message": "link cannot connect a node to itself",
+			"from":    map[string]any{},
+			"to":      map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestLinksListDomainValidationError(t *testing.T) {
+	router := setupLinkRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/links/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"projectId": "project id is required, but got ''",
+	}, responseBody)
+}
+
+func TestLinksUpdateInvalidRequestFormat(t *testing.T) {
+	router := setupLinkRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/links/update", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"link": map[string]any{
+			"from": map[string]any{},
+			"to":   map[string]any{},
+		},
+	}, responseBody)
+}
+
+func setupLinkRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewLinkRepository(*client)
+	s := service.NewLinkService(r)
+
+	uc := usecase.NewLinkUseCase(s)
+	api := api.NewLinksApi(uc)
+
+	router.GET("/api/links/list", api.LinksList)
+	router.POST("/api/links/create", api.LinksCreate)
+	router.POST("/api/links/update", api.LinksUpdate)
+	router.POST("/api/links/delete", api.LinksDelete)
+
+	return router
+}
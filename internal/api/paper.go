@@ -10,12 +10,11 @@ import (
 )
 
 type papersApi struct {
-	verifier middleware.UserVerifier
-	usecase  usecase.PaperUseCase
+	usecase usecase.PaperUseCase
 }
 
-func NewPapersApi(verifier middleware.UserVerifier, usecase usecase.PaperUseCase) openapi.PapersAPI {
-	return papersApi{verifier: verifier, usecase: usecase}
+func NewPapersApi(usecase usecase.PaperUseCase) openapi.PapersAPI {
+	return papersApi{usecase: usecase}
 }
 
 func (api papersApi) PapersFind(c *gin.Context) {
@@ -27,22 +26,27 @@ func (api papersApi) PapersFind(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.UserId)
+	request.UserId = middleware.GetSubject(c)
+	api.findPaper(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api papersApi) V2PapersFind(c *gin.Context) {
+	var request openapi.PaperFindV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.findPaper(c, openapi.PaperFindRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		ChapterId: request.ChapterId,
+	})
+}
 
+func (api papersApi) findPaper(c *gin.Context, request openapi.PaperFindRequest) {
 	res, ucErr := api.usecase.FindPaper(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -82,22 +86,27 @@ func (api papersApi) PapersUpdate(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.updatePaper(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api papersApi) V2PapersUpdate(c *gin.Context) {
+	var request openapi.PaperUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
-		return
-	}
+	api.updatePaper(c, openapi.PaperUpdateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Paper:   request.Paper,
+	})
+}
 
+func (api papersApi) updatePaper(c *gin.Context, request openapi.PaperUpdateRequest) {
 	res, ucErr := api.usecase.UpdatePaper(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -118,6 +127,14 @@ func (api papersApi) PapersUpdate(c *gin.Context) {
 		return
 	}
 
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.PaperUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Paper:   res.Paper,
+		})
+		return
+	}
+
 	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
@@ -127,3 +144,318 @@ func (api papersApi) PapersUpdate(c *gin.Context) {
 
 	c.JSON(http.StatusOK, res)
 }
+
+func (api papersApi) PapersRevisionsList(c *gin.Context) {
+	var request openapi.PaperRevisionListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listPaperRevisions(c, request)
+}
+
+func (api papersApi) V2PapersRevisionsList(c *gin.Context) {
+	var request openapi.PaperRevisionListV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.listPaperRevisions(c, openapi.PaperRevisionListRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		PaperId:   request.PaperId,
+	})
+}
+
+func (api papersApi) listPaperRevisions(c *gin.Context, request openapi.PaperRevisionListRequest) {
+	res, ucErr := api.usecase.ListPaperRevisions(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionListErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			PaperId:   resErr.PaperId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.PaperRevisionListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api papersApi) PapersRevisionsFind(c *gin.Context) {
+	var request openapi.PaperRevisionFindRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.findPaperRevision(c, request)
+}
+
+func (api papersApi) V2PapersRevisionsFind(c *gin.Context) {
+	var request openapi.PaperRevisionFindV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.findPaperRevision(c, openapi.PaperRevisionFindRequest{
+		UserId:     middleware.GetSubject(c),
+		ProjectId:  request.ProjectId,
+		PaperId:    request.PaperId,
+		RevisionId: request.RevisionId,
+	})
+}
+
+func (api papersApi) findPaperRevision(c *gin.Context, request openapi.PaperRevisionFindRequest) {
+	res, ucErr := api.usecase.FindPaperRevision(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionFindErrorResponse{
+			Message:    UseCaseErrorToMessage(ucErr),
+			UserId:     resErr.UserId,
+			ProjectId:  resErr.ProjectId,
+			PaperId:    resErr.PaperId,
+			RevisionId: resErr.RevisionId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.PaperRevisionFindErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api papersApi) PapersRevisionsDiff(c *gin.Context) {
+	var request openapi.PaperRevisionDiffRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionDiffErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.diffPaperRevisions(c, request)
+}
+
+func (api papersApi) V2PapersRevisionsDiff(c *gin.Context) {
+	var request openapi.PaperRevisionDiffV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionDiffErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.diffPaperRevisions(c, openapi.PaperRevisionDiffRequest{
+		UserId:         middleware.GetSubject(c),
+		ProjectId:      request.ProjectId,
+		PaperId:        request.PaperId,
+		FromRevisionId: request.FromRevisionId,
+		ToRevisionId:   request.ToRevisionId,
+	})
+}
+
+func (api papersApi) diffPaperRevisions(c *gin.Context, request openapi.PaperRevisionDiffRequest) {
+	res, ucErr := api.usecase.DiffPaperRevisions(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionDiffErrorResponse{
+			Message:        UseCaseErrorToMessage(ucErr),
+			UserId:         resErr.UserId,
+			ProjectId:      resErr.ProjectId,
+			PaperId:        resErr.PaperId,
+			FromRevisionId: resErr.FromRevisionId,
+			ToRevisionId:   resErr.ToRevisionId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.PaperRevisionDiffErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api papersApi) PapersRevisionsRestore(c *gin.Context) {
+	var request openapi.PaperRevisionRestoreRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.restorePaperRevision(c, request)
+}
+
+func (api papersApi) V2PapersRevisionsRestore(c *gin.Context) {
+	var request openapi.PaperRevisionRestoreV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.restorePaperRevision(c, openapi.PaperRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project:  request.Project,
+		Paper:    request.Paper,
+		Revision: request.Revision,
+	})
+}
+
+func (api papersApi) restorePaperRevision(c *gin.Context, request openapi.PaperRevisionRestoreRequest) {
+	res, ucErr := api.usecase.RestorePaperRevision(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperRevisionRestoreErrorResponse{
+			Message:  UseCaseErrorToMessage(ucErr),
+			User:     resErr.User,
+			Project:  resErr.Project,
+			Paper:    resErr.Paper,
+			Revision: resErr.Revision,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.PaperRevisionRestoreErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api papersApi) PapersSectionalize(c *gin.Context) {
+	var request openapi.PaperSectionalizeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperSectionalizeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.sectionalizePaper(c, request)
+}
+
+func (api papersApi) V2PapersSectionalize(c *gin.Context) {
+	var request openapi.PaperSectionalizeV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperSectionalizeErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.sectionalizePaper(c, openapi.PaperSectionalizeRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Paper:   request.Paper,
+	})
+}
+
+func (api papersApi) sectionalizePaper(c *gin.Context, request openapi.PaperSectionalizeRequest) {
+	res, ucErr := api.usecase.SectionalizePaper(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperSectionalizeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Paper:   resErr.Paper,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.PaperSectionalizeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.PaperSectionalizeErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
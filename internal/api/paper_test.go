@@ -10,13 +10,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kumachan-mis/knodeledge-api/internal/api"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
 	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
 	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
-	mock_middleware "github.com/kumachan-mis/knodeledge-api/mock/middleware"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
 )
 
 func TestPaperFind(t *testing.T) {
@@ -49,8 +48,9 @@ func TestPaperFind(t *testing.T) {
 
 	assert.Equal(t, map[string]any{
 		"paper": map[string]any{
-			"id":      "CHAPTER_ONE",
-			"content": content,
+			"id":        "CHAPTER_ONE",
+			"content":   content,
+			"updatedAt": "2024-01-01T00:00:00Z",
 		},
 	}, responseBody)
 }
@@ -249,10 +249,60 @@ func TestPaperUpdate(t *testing.T) {
 	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
 	assert.Nil(t, err)
 
+	updatedAt := responseBody["paper"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+
 	assert.Equal(t, map[string]any{
 		"paper": map[string]any{
-			"id":      "CHAPTER_ONE",
-			"content": content,
+			"id":        "CHAPTER_ONE",
+			"content":   content,
+			"updatedAt": updatedAt,
+		},
+	}, responseBody)
+}
+
+func TestPaperUpdateConflict(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ReadOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION",
+		},
+		"paper": map[string]any{
+			"id":        "CHAPTER_ONE",
+			"content":   "Updated content",
+			"updatedAt": "2023-12-31T00:00:00Z",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/papers/update", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	content := strings.Join([]string{
+		"[** Introduction]",
+		"This is an example project of kNODEledge.",
+		"",
+		"[** Section of Chapter One]",
+		"Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One.",
+		"Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One. Section of Chapter One.",
+		""}, "\n")
+
+	assert.Equal(t, map[string]any{
+		"message": "conflict",
+		"paper": map[string]any{
+			"id":        "CHAPTER_ONE",
+			"content":   content,
+			"updatedAt": "2024-01-01T00:00:00Z",
 		},
 	}, responseBody)
 }
@@ -482,27 +532,419 @@ func TestPaperUpdateInvalidRequestFormat(t *testing.T) {
 	}, responseBody)
 }
 
-func setupPaperRouter(t *testing.T) *gin.Engine {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestPaperRevisions(t *testing.T) {
+	router := setupPaperRouter(t)
 
-	router := gin.Default()
+	for _, content := range []string{"first line\nsecond line\n", "first line\nsecond line updated\n"} {
+		recorder := httptest.NewRecorder()
+		requestBody, _ := json.Marshal(map[string]any{
+			"user": map[string]any{
+				"id": testutil.ModifyOnlyUserId(),
+			},
+			"project": map[string]any{
+				"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+			},
+			"paper": map[string]any{
+				"id":      "CHAPTER_ONE",
+				"content": content,
+			},
+		})
+		req, _ := http.NewRequest("POST", "/api/papers/update", strings.NewReader(string(requestBody)))
+
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/papers/revisions/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("paperId", "CHAPTER_ONE")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &listResponseBody)
+	assert.Nil(t, err)
+
+	revisions := listResponseBody["revisions"].([]any)
+	assert.GreaterOrEqual(t, len(revisions), 2)
+
+	latestRevision := revisions[0].(map[string]any)
+	previousRevision := revisions[1].(map[string]any)
+	assert.Equal(t, testutil.ModifyOnlyUserId(), latestRevision["authorId"])
+	assert.NotEmpty(t, latestRevision["createdAt"])
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/papers/revisions/find", nil)
+	query = req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("paperId", "CHAPTER_ONE")
+	query.Add("revisionId", previousRevision["id"].(string))
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var findResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &findResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"revision": map[string]any{
+			"id":        previousRevision["id"],
+			"content":   "first line\nsecond line\n",
+			"authorId":  testutil.ModifyOnlyUserId(),
+			"createdAt": previousRevision["createdAt"],
+		},
+	}, findResponseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/papers/revisions/diff", nil)
+	query = req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API")
+	query.Add("paperId", "CHAPTER_ONE")
+	query.Add("fromRevisionId", previousRevision["id"].(string))
+	query.Add("toRevisionId", latestRevision["id"].(string))
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var diffResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &diffResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"diff": map[string]any{
+			"fromRevisionId": previousRevision["id"],
+			"toRevisionId":   latestRevision["id"],
+			"unifiedDiff": strings.Join([]string{
+				"--- " + previousRevision["id"].(string),
+				"+++ " + latestRevision["id"].(string),
+				"@@ -1,2 +1,2 @@",
+				" first line",
+				"-second line",
+				"+second line updated",
+				"",
+			}, "\n"),
+		},
+	}, diffResponseBody)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"paper": map[string]any{
+			"id": "CHAPTER_ONE",
+		},
+		"revision": map[string]any{
+			"id": previousRevision["id"],
+		},
+	})
+	req, _ = http.NewRequest("POST", "/api/papers/revisions/restore", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var restoreResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &restoreResponseBody)
+	assert.Nil(t, err)
+
+	updatedAt := restoreResponseBody["paper"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+
+	assert.Equal(t, map[string]any{
+		"paper": map[string]any{
+			"id":        "CHAPTER_ONE",
+			"content":   "first line\nsecond line\n",
+			"updatedAt": updatedAt,
+		},
+	}, restoreResponseBody)
+}
+
+func TestPaperRevisionsFindNotFound(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/papers/revisions/find", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("paperId", "CHAPTER_ONE")
+	query.Add("revisionId", "UNKNOWN_REVISION")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestPaperRevisionsListDomainValidationError(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/papers/revisions/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "PROJECT_WITHOUT_DESCRIPTION")
+	query.Add("paperId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"paperId": "paper id is required, but got ''",
+	}, responseBody)
+}
+
+func TestPaperRevisionsRestoreInvalidRequestFormat(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/papers/revisions/restore", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func TestPaperSectionalize(t *testing.T) {
+	router := setupPaperRouter(t)
 
 	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
 	r := repository.NewPaperRepository(*client)
-	s := service.NewPaperService(r)
 
-	v := mock_middleware.NewMockUserVerifier(ctrl)
-	v.EXPECT().
-		Verify(gomock.Any(), gomock.Any()).
-		Return(nil).
-		AnyTimes()
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Sectionalize Paper from API",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter to Sectionalize",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	_, rErr = r.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "---\ntitle: Paper\n---\n# Paper\n\n## Introduction\nintro\n```\n# code\n```\n\n## Conclusion\nconclusion\n",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": userId,
+		},
+		"project": map[string]any{
+			"id": projectId,
+		},
+		"paper": map[string]any{
+			"id": chapterId,
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/papers/sectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	//graphId is generated by firestore and it's not predictable
+	graphs := responseBody["graphs"].([]any)
+	assert.Len(t, graphs, 2)
+	for _, graph := range graphs {
+		assert.NotEmpty(t, graph.(map[string]any)["id"])
+		assert.NotEmpty(t, graph.(map[string]any)["updatedAt"])
+	}
+
+	assert.Equal(t, map[string]any{
+		"graphs": []any{
+			map[string]any{
+				"id":        graphs[0].(map[string]any)["id"],
+				"name":      "Introduction",
+				"paragraph": "intro\n```\n# code\n```",
+				"updatedAt": graphs[0].(map[string]any)["updatedAt"],
+				"children":  []any{},
+			},
+			map[string]any{
+				"id":        graphs[1].(map[string]any)["id"],
+				"name":      "Conclusion",
+				"paragraph": "conclusion",
+				"updatedAt": graphs[1].(map[string]any)["updatedAt"],
+				"children":  []any{},
+			},
+		},
+	}, responseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/papers/sectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	err = json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to sectionalize into graphs: graph already exists",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"paper":   map[string]any{},
+	}, responseBody)
+}
+
+func TestPaperSectionalizeNotFound(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"paper": map[string]any{
+			"id": "UNKNOWN_PAPER",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/papers/sectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"paper":   map[string]any{},
+	}, responseBody)
+}
+
+func TestPaperSectionalizeDomainValidationError(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"project": map[string]any{
+			"id": "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_API",
+		},
+		"paper": map[string]any{
+			"id": "",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/papers/sectionalize", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"paper": map[string]any{
+			"id": "paper id is required, but got ''",
+		},
+	}, responseBody)
+}
+
+func TestPaperSectionalizeInvalidRequestFormat(t *testing.T) {
+	router := setupPaperRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/papers/sectionalize", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func setupPaperRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewPaperRepository(*client)
+	s := service.NewPaperService(r, service.RevisionRetention{})
+	gr := repository.NewGraphRepository(*client)
+	gs := service.NewGraphService(gr, service.RevisionRetention{})
 
-	uc := usecase.NewPaperUseCase(s)
-	api := api.NewPapersApi(v, uc)
+	uc := usecase.NewPaperUseCase(s, gs)
+	api := api.NewPapersApi(uc)
 
 	router.GET("/api/papers/find", api.PapersFind)
 	router.POST("/api/papers/update", api.PapersUpdate)
+	router.POST("/api/papers/sectionalize", api.PapersSectionalize)
+	router.GET("/api/papers/revisions/list", api.PapersRevisionsList)
+	router.GET("/api/papers/revisions/find", api.PapersRevisionsFind)
+	router.GET("/api/papers/revisions/diff", api.PapersRevisionsDiff)
+	router.POST("/api/papers/revisions/restore", api.PapersRevisionsRestore)
 
 	return router
 }
@@ -10,12 +10,11 @@ import (
 )
 
 type projectsApi struct {
-	verifier middleware.UserVerifier
-	usecase  usecase.ProjectUseCase
+	usecase usecase.ProjectUseCase
 }
 
-func NewProjectsApi(verifier middleware.UserVerifier, usecase usecase.ProjectUseCase) openapi.ProjectsAPI {
-	return projectsApi{verifier: verifier, usecase: usecase}
+func NewProjectsApi(usecase usecase.ProjectUseCase) openapi.ProjectsAPI {
+	return projectsApi{usecase: usecase}
 }
 
 func (api projectsApi) ProjectsList(c *gin.Context) {
@@ -27,29 +26,80 @@ func (api projectsApi) ProjectsList(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.UserId)
+	request.UserId = middleware.GetSubject(c)
+	api.listProjects(c, request)
+}
+
+func (api projectsApi) V2ProjectsList(c *gin.Context) {
+	api.listProjects(c, openapi.ProjectListRequest{UserId: middleware.GetSubject(c)})
+}
+
+func (api projectsApi) listProjects(c *gin.Context, request openapi.ProjectListRequest) {
+	res, ucErr := api.usecase.ListProjects(request)
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			UserId:  resErr.UserId,
 		})
 		return
 	}
 
-	if vErr != nil {
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
-	res, ucErr := api.usecase.ListProjects(request)
+	c.JSON(http.StatusOK, res)
+}
+
+func (api projectsApi) ProjectsFind(c *gin.Context) {
+	var request openapi.ProjectFindRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.findProject(c, request)
+}
+
+func (api projectsApi) V2ProjectsFind(c *gin.Context) {
+	var request openapi.ProjectFindV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectFindErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.findProject(c, openapi.ProjectFindRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
+
+func (api projectsApi) findProject(c *gin.Context, request openapi.ProjectFindRequest) {
+	res, ucErr := api.usecase.FindProject(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
 		resErr := UseCaseErrorToResponse(ucErr)
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectListErrorResponse{
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectFindErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectFindErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
-			UserId:  resErr.UserId,
 		})
 		return
 	}
@@ -64,36 +114,98 @@ func (api projectsApi) ProjectsList(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-func (api projectsApi) ProjectsFind(c *gin.Context) {
-	var request openapi.ProjectFindRequest
+func (api projectsApi) ProjectsGraph(c *gin.Context) {
+	var request openapi.ProjectGraphRequest
 	if err := c.ShouldBindQuery(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectFindErrorResponse{
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectGraphErrorResponse{
 			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.UserId)
+	request.UserId = middleware.GetSubject(c)
+	api.findProjectGraph(c, request)
+}
+
+func (api projectsApi) V2ProjectsGraph(c *gin.Context) {
+	var request openapi.ProjectGraphV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectGraphErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.findProjectGraph(c, openapi.ProjectGraphRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
+
+func (api projectsApi) findProjectGraph(c *gin.Context, request openapi.ProjectGraphRequest) {
+	res, ucErr := api.usecase.FindProjectGraph(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectGraphErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+		})
+		return
+	}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectGraphErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
-	if vErr != nil {
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
-	res, ucErr := api.usecase.FindProject(request)
+	c.JSON(http.StatusOK, res)
+}
+
+func (api projectsApi) ProjectsExport(c *gin.Context) {
+	var request openapi.ProjectExportRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectExportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.exportProject(c, request)
+}
+
+func (api projectsApi) V2ProjectsExport(c *gin.Context) {
+	var request openapi.ProjectExportV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectExportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.exportProject(c, openapi.ProjectExportRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
+
+func (api projectsApi) exportProject(c *gin.Context, request openapi.ProjectExportRequest) {
+	res, ucErr := api.usecase.ExportProject(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
 		resErr := UseCaseErrorToResponse(ucErr)
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectFindErrorResponse{
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectExportErrorResponse{
 			Message:   UseCaseErrorToMessage(ucErr),
 			UserId:    resErr.UserId,
 			ProjectId: resErr.ProjectId,
@@ -102,7 +214,7 @@ func (api projectsApi) ProjectsFind(c *gin.Context) {
 	}
 
 	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
-		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectFindErrorResponse{
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectExportErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
@@ -115,34 +227,150 @@ func (api projectsApi) ProjectsFind(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, res)
+	RenderAttachment(c, res.FileName, ArchiveContentType, res.Archive)
 }
 
-func (api projectsApi) ProjectsCreate(c *gin.Context) {
-	var request openapi.ProjectCreateRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCreateErrorResponse{
+func (api projectsApi) ProjectsImport(c *gin.Context) {
+	var request openapi.ProjectImportRequest
+	if err := ShouldBindArchive(c, &request, &request.Archive); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectImportErrorResponse{
 			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.UserId = middleware.GetSubject(c)
+	api.importProject(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+func (api projectsApi) V2ProjectsImport(c *gin.Context) {
+	var request openapi.ProjectImportV2Request
+	if err := ShouldBindArchive(c, &request, &request.Archive); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectImportErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	if vErr != nil {
+	api.importProject(c, openapi.ProjectImportRequest{
+		UserId:  middleware.GetSubject(c),
+		Archive: request.Archive,
+	})
+}
+
+func (api projectsApi) importProject(c *gin.Context, request openapi.ProjectImportRequest) {
+	res, ucErr := api.usecase.ImportProject(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectImportErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			UserId:  resErr.UserId,
+			Archive: resErr.Archive,
+		})
+		return
+	}
+
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (api projectsApi) ProjectsCompile(c *gin.Context) {
+	var request openapi.ProjectCompileRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCompileErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
+	request.UserId = middleware.GetSubject(c)
+	api.compileProject(c, request)
+}
+
+func (api projectsApi) V2ProjectsCompile(c *gin.Context) {
+	var request openapi.ProjectCompileV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCompileErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.compileProject(c, openapi.ProjectCompileRequest{
+		UserId:        middleware.GetSubject(c),
+		ProjectId:     request.ProjectId,
+		Format:        request.Format,
+		IncludeGraphs: request.IncludeGraphs,
+	})
+}
+
+func (api projectsApi) compileProject(c *gin.Context, request openapi.ProjectCompileRequest) {
+	res, ucErr := api.usecase.CompileProject(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCompileErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			Format:    resErr.Format,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectCompileErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	RenderAttachment(c, res.FileName, res.MediaType, res.Content)
+}
+
+func (api projectsApi) ProjectsCreate(c *gin.Context) {
+	var request openapi.ProjectCreateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.createProject(c, request)
+}
+
+func (api projectsApi) V2ProjectsCreate(c *gin.Context) {
+	var request openapi.ProjectCreateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectCreateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.createProject(c, openapi.ProjectCreateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+	})
+}
+
+func (api projectsApi) createProject(c *gin.Context, request openapi.ProjectCreateRequest) {
 	res, ucErr := api.usecase.CreateProject(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -166,6 +394,11 @@ func (api projectsApi) ProjectsCreate(c *gin.Context) {
 }
 
 func (api projectsApi) ProjectsUpdate(c *gin.Context) {
+	if IsJsonPatchRequest(c) {
+		api.projectsPatch(c)
+		return
+	}
+
 	var request openapi.ProjectUpdateRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectUpdateErrorResponse{
@@ -174,22 +407,31 @@ func (api projectsApi) ProjectsUpdate(c *gin.Context) {
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.User.Id = middleware.GetSubject(c)
+	api.updateProject(c, request)
+}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
-		})
+func (api projectsApi) V2ProjectsUpdate(c *gin.Context) {
+	if IsJsonPatchRequest(c) {
+		api.v2ProjectsPatch(c)
 		return
 	}
 
-	if vErr != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	var request openapi.ProjectUpdateV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectUpdateErrorResponse{
+			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
+	api.updateProject(c, openapi.ProjectUpdateRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+	})
+}
+
+func (api projectsApi) updateProject(c *gin.Context, request openapi.ProjectUpdateRequest) {
 	res, ucErr := api.usecase.UpdateProject(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
@@ -209,6 +451,14 @@ func (api projectsApi) ProjectsUpdate(c *gin.Context) {
 		return
 	}
 
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.ProjectUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Project: res.Project,
+		})
+		return
+	}
+
 	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
 			Message: UseCaseErrorToMessage(ucErr),
@@ -219,31 +469,104 @@ func (api projectsApi) ProjectsUpdate(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-func (api projectsApi) ProjectsDelete(c *gin.Context) {
-	var request openapi.ProjectDeleteRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectDeleteErrorResponse{
+func (api projectsApi) projectsPatch(c *gin.Context) {
+	var request openapi.ProjectPatchRequest
+	if err := ShouldBindJsonPatch(c, &request, &request.Patch); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectPatchErrorResponse{
 			Message: JsonBindErrorToMessage(err),
 		})
 		return
 	}
 
-	vErr := api.verifier.Verify(c.Request.Context(), request.User.Id)
+	request.UserId = middleware.GetSubject(c)
+	api.patchProject(c, request)
+}
+
+func (api projectsApi) v2ProjectsPatch(c *gin.Context) {
+	var request openapi.ProjectPatchV2Request
+	if err := ShouldBindJsonPatch(c, &request, &request.Patch); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectPatchErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.patchProject(c, openapi.ProjectPatchRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+		Patch:     request.Patch,
+	})
+}
+
+func (api projectsApi) patchProject(c *gin.Context, request openapi.ProjectPatchRequest) {
+	res, ucErr := api.usecase.PatchProject(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectPatchErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+			Patch:     resErr.Patch,
+			Project:   resErr.Project,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectPatchErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
 
-	if vErr != nil && vErr.Code() == middleware.AuthorizationError {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+	if ucErr != nil && ucErr.Code() == usecase.ConflictError {
+		c.AbortWithStatusJSON(http.StatusConflict, openapi.ProjectUpdateConflictResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			Project: res.Project,
 		})
 		return
 	}
 
-	if vErr != nil {
+	if ucErr != nil {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
-			Message: MiddlewareErrorToMessage(vErr),
+			Message: UseCaseErrorToMessage(ucErr),
 		})
 		return
 	}
 
+	c.JSON(http.StatusOK, res)
+}
+
+func (api projectsApi) ProjectsDelete(c *gin.Context) {
+	var request openapi.ProjectDeleteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.deleteProject(c, request)
+}
+
+func (api projectsApi) V2ProjectsDelete(c *gin.Context) {
+	var request openapi.ProjectDeleteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectDeleteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.deleteProject(c, openapi.ProjectDeleteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+	})
+}
+
+func (api projectsApi) deleteProject(c *gin.Context, request openapi.ProjectDeleteRequest) {
 	ucErr := api.usecase.DeleteProject(request)
 
 	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
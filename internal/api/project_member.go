@@ -0,0 +1,217 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+type projectMembersApi struct {
+	usecase usecase.ProjectMemberUseCase
+}
+
+func NewProjectMembersApi(usecase usecase.ProjectMemberUseCase) openapi.ProjectMembersAPI {
+	return projectMembersApi{usecase: usecase}
+}
+
+func (api projectMembersApi) ProjectMembersList(c *gin.Context) {
+	var request openapi.ProjectMemberListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listProjectMembers(c, request)
+}
+
+func (api projectMembersApi) V2ProjectMembersList(c *gin.Context) {
+	var request openapi.ProjectMemberListV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.listProjectMembers(c, openapi.ProjectMemberListRequest{
+		UserId:    middleware.GetSubject(c),
+		ProjectId: request.ProjectId,
+	})
+}
+
+func (api projectMembersApi) listProjectMembers(c *gin.Context, request openapi.ProjectMemberListRequest) {
+	res, ucErr := api.usecase.ListProjectMembers(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberListErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			ProjectId: resErr.ProjectId,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectMemberListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api projectMembersApi) ProjectMembersInvite(c *gin.Context) {
+	var request openapi.ProjectMemberInviteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberInviteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.inviteProjectMember(c, request)
+}
+
+func (api projectMembersApi) V2ProjectMembersInvite(c *gin.Context) {
+	var request openapi.ProjectMemberInviteV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberInviteErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.inviteProjectMember(c, openapi.ProjectMemberInviteRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Member:  request.Member,
+	})
+}
+
+func (api projectMembersApi) inviteProjectMember(c *gin.Context, request openapi.ProjectMemberInviteRequest) {
+	res, ucErr := api.usecase.InviteProjectMember(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberInviteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Member:  resErr.Member,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberInviteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectMemberInviteErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (api projectMembersApi) ProjectMembersRemove(c *gin.Context) {
+	var request openapi.ProjectMemberRemoveRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberRemoveErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.removeProjectMember(c, request)
+}
+
+func (api projectMembersApi) V2ProjectMembersRemove(c *gin.Context) {
+	var request openapi.ProjectMemberRemoveV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberRemoveErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.removeProjectMember(c, openapi.ProjectMemberRemoveRequest{
+		User:    openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Project: request.Project,
+		Member:  request.Member,
+	})
+}
+
+func (api projectMembersApi) removeProjectMember(c *gin.Context, request openapi.ProjectMemberRemoveRequest) {
+	ucErr := api.usecase.RemoveProjectMember(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberRemoveErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Project: resErr.Project,
+			Member:  resErr.Member,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.InvalidArgumentError {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ProjectMemberRemoveErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.ProjectMemberRemoveErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
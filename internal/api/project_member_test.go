@@ -0,0 +1,197 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectMembersInviteListRemove(t *testing.T) {
+	router := setupProjectMemberRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	memberId := testutil.ReadOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Share from API",
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"member":  map[string]any{"id": memberId, "role": "viewer"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/members/invite", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var inviteResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &inviteResponseBody))
+	assert.Equal(t, map[string]any{
+		"member": map[string]any{"id": memberId, "role": "viewer"},
+	}, inviteResponseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/projects/members/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", memberId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponseBody))
+	assert.Equal(t, map[string]any{
+		"members": []any{
+			map[string]any{"id": userId, "role": "owner"},
+			map[string]any{"id": memberId, "role": "viewer"},
+		},
+	}, listResponseBody)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"member":  map[string]any{"id": memberId},
+	})
+	req, _ = http.NewRequest("POST", "/api/projects/members/remove", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "null", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/projects/members/list", nil)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var notFoundResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &notFoundResponseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, notFoundResponseBody)
+}
+
+func TestProjectMembersInviteInvalidArgument(t *testing.T) {
+	router := setupProjectMemberRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Invite Owner from API",
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"member":  map[string]any{"id": userId, "role": "editor"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/members/invite", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to invite project member: project owner cannot be a member",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"member":  map[string]any{},
+	}, responseBody)
+}
+
+func TestProjectMembersInviteDomainValidationError(t *testing.T) {
+	router := setupProjectMemberRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ModifyOnlyUserId()},
+		"project": map[string]any{"id": "0000000000000001"},
+		"member":  map[string]any{"id": testutil.ReadOnlyUserId(), "role": "owner"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/members/invite", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"member": map[string]any{
+			"role": "project member role must be editor or viewer, but got 'owner'",
+		},
+	}, responseBody)
+}
+
+func TestProjectMembersRemoveInvalidRequestFormat(t *testing.T) {
+	router := setupProjectMemberRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/projects/members/remove", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"member":  map[string]any{},
+	}, responseBody)
+}
+
+func setupProjectMemberRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewProjectRepository(*client)
+	s := service.NewProjectMemberService(r)
+
+	uc := usecase.NewProjectMemberUseCase(s)
+	api := api.NewProjectMembersApi(uc)
+
+	router.GET("/api/projects/members/list", api.ProjectMembersList)
+	router.POST("/api/projects/members/invite", api.ProjectMembersInvite)
+	router.POST("/api/projects/members/remove", api.ProjectMembersRemove)
+
+	return router
+}
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,13 +12,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kumachan-mis/knodeledge-api/internal/api"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
 	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
 	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
-	mock_middleware "github.com/kumachan-mis/knodeledge-api/mock/middleware"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
 )
 
 func TestProjectList(t *testing.T) {
@@ -38,18 +39,53 @@ func TestProjectList(t *testing.T) {
 	assert.Equal(t, map[string]any{
 		"projects": []any{
 			map[string]any{
-				"id":   "PROJECT_WITHOUT_DESCRIPTION",
-				"name": "No Description Project",
+				"id":        "PROJECT_WITHOUT_DESCRIPTION",
+				"name":      "No Description Project",
+				"updatedAt": "2024-01-01T00:00:00Z",
+				"role":      "owner",
 			},
 			map[string]any{
 				"id":          "PROJECT_WITH_DESCRIPTION",
 				"name":        "Described Project",
 				"description": "This is project description",
+				"updatedAt":   "2023-12-31T23:00:00Z",
+				"role":        "owner",
 			},
 		},
 	}, responseBody)
 }
 
+func TestProjectListWithoutUserId(t *testing.T) {
+	router := gin.Default()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.SubjectKey, testutil.ReadOnlyUserId())
+	})
+
+	client := db.FirestoreClient()
+	r := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+	uc := usecase.NewProjectUseCase(
+		service.NewProjectService(r),
+		service.NewProjectGraphService(cr, gr),
+		service.NewProjectArchiveService(
+			r, cr, repository.NewPaperRepository(*client), gr, repository.NewLinkRepository(*client)),
+	)
+	a := api.NewProjectsApi(uc)
+	router.GET("/api/v2/projects/list", a.ProjectsList)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v2/projects/list", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Len(t, responseBody["projects"], 2)
+}
+
 func TestProjectListDomainValidationError(t *testing.T) {
 	tt := []struct {
 		name             string
@@ -134,8 +170,10 @@ func TestProjectFind(t *testing.T) {
 			},
 			expectedResponse: map[string]any{
 				"project": map[string]any{
-					"id":   "PROJECT_WITHOUT_DESCRIPTION",
-					"name": "No Description Project",
+					"id":        "PROJECT_WITHOUT_DESCRIPTION",
+					"name":      "No Description Project",
+					"updatedAt": "2024-01-01T00:00:00Z",
+					"role":      "owner",
 				},
 			},
 		},
@@ -150,6 +188,8 @@ func TestProjectFind(t *testing.T) {
 					"id":          "PROJECT_WITH_DESCRIPTION",
 					"name":        "Described Project",
 					"description": "This is project description",
+					"updatedAt":   "2023-12-31T23:00:00Z",
+					"role":        "owner",
 				},
 			},
 		},
@@ -308,6 +348,435 @@ func TestProjectFindInternalError(t *testing.T) {
 	}, responseBody)
 }
 
+func TestProjectGraph(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Merge Graphs from API",
+	})
+	assert.Nil(t, rErr)
+
+	chapterIds := make([]string, 2)
+	sectionIds := make([]string, 2)
+	nodeIds := make([]string, 2)
+	for i := range chapterIds {
+		chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   fmt.Sprintf("Chapter %d", i+1),
+			Number: i + 1,
+		})
+		assert.Nil(t, rErr)
+
+		keys, graphs, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+			{Name: "Section", Paragraph: "Paragraph", Children: []record.GraphChildEntry{
+				{Name: "Concept", Relation: "part of", Description: "description", Children: []record.GraphChildEntry{}},
+			}},
+		})
+		assert.Nil(t, rErr)
+
+		chapterIds[i] = chapterId
+		sectionIds[i] = keys[0]
+		nodeIds[i] = graphs[0].Children[0].Id
+	}
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/graph", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+
+	childOccurrences := []any{
+		map[string]any{"chapterId": chapterIds[0], "sectionId": sectionIds[0], "nodeId": nodeIds[0]},
+		map[string]any{"chapterId": chapterIds[1], "sectionId": sectionIds[1], "nodeId": nodeIds[1]},
+	}
+	assert.Equal(t, map[string]any{
+		"graph": map[string]any{
+			"nodes": []any{
+				map[string]any{
+					"name": "Section",
+					"occurrences": []any{
+						map[string]any{"chapterId": chapterIds[0], "sectionId": sectionIds[0]},
+						map[string]any{"chapterId": chapterIds[1], "sectionId": sectionIds[1]},
+					},
+				},
+				map[string]any{
+					"name":        "Concept",
+					"occurrences": childOccurrences,
+				},
+			},
+			"edges": []any{
+				map[string]any{
+					"source":      "Section",
+					"target":      "Concept",
+					"relation":    "part of",
+					"description": "description",
+					"occurrences": childOccurrences,
+				},
+			},
+		},
+	}, responseBody)
+}
+
+func TestProjectGraphNotFound(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/graph", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "NOT_FOUND_PROJECT")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestProjectGraphDomainValidationError(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/graph", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"userId":    "user id is required, but got ''",
+		"projectId": "project id is required, but got ''",
+	}, responseBody)
+}
+
+func TestProjectExportImport(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	ppr := repository.NewPaperRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name:        "Project to Export from API",
+		Description: "Description",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	_, rErr = ppr.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Section\n",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	_, _, rErr = gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section", Paragraph: "Paragraph", Children: []record.GraphChildEntry{
+			{Name: "Concept", Relation: "part of", Description: "description", Children: []record.GraphChildEntry{}},
+		}},
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/export", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, fmt.Sprintf("attachment; filename=\"%s.zip\"", projectId), recorder.Header().Get("Content-Disposition"))
+
+	content := recorder.Body.Bytes()
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/projects/import", bytes.NewReader(content))
+	req.Header.Set("Content-Type", "application/zip")
+	query = req.URL.Query()
+	query.Add("userId", userId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+
+	projectMap, ok := responseBody["project"].(map[string]any)
+	assert.True(t, ok)
+	assert.NotEqual(t, projectId, projectMap["id"])
+	assert.Equal(t, "Project to Export from API", projectMap["name"])
+	assert.Equal(t, "Description", projectMap["description"])
+
+	importedProjectId, ok := projectMap["id"].(string)
+	assert.True(t, ok)
+
+	chapters, rErr := cr.FetchChapters(userId, importedProjectId)
+	assert.Nil(t, rErr)
+	assert.Len(t, chapters, 1)
+	for importedChapterId, chapter := range chapters {
+		assert.NotEqual(t, chapterId, importedChapterId)
+		assert.Equal(t, "Chapter", chapter.Name)
+		assert.Equal(t, 1, chapter.Number)
+
+		paper, rErr := ppr.FetchPaper(userId, importedProjectId, importedChapterId)
+		assert.Nil(t, rErr)
+		assert.Equal(t, "## Section\n", paper.Content)
+
+		_, graphs, rErr := gr.FetchGraphs(userId, importedProjectId, importedChapterId)
+		assert.Nil(t, rErr)
+		assert.Len(t, graphs, 1)
+		assert.Equal(t, "Paragraph", graphs[0].Paragraph)
+		assert.Equal(t, "Concept", graphs[0].Children[0].Name)
+	}
+}
+
+func TestProjectExportNotFound(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/export", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "NOT_FOUND_PROJECT")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestProjectExportDomainValidationError(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/export", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"userId":    "user id is required, but got ''",
+		"projectId": "project id is required, but got ''",
+	}, responseBody)
+}
+
+func TestProjectImportDomainValidationError(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/projects/import", strings.NewReader("not a zip file"))
+	req.Header.Set("Content-Type", "application/zip")
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"archive": map[string]any{
+			"message": "failed to read archive: zip: not a valid zip file",
+			"project": map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestProjectImportInvalidRequestFormat(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/projects/import", strings.NewReader("{}"))
+	req.Header.Set("Content-Type", "application/json")
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"archive": map[string]any{
+			"project": map[string]any{},
+		},
+	}, responseBody)
+}
+
+func TestProjectCompile(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	ppr := repository.NewPaperRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Compile from API",
+	})
+	assert.Nil(t, rErr)
+
+	for i, name := range []string{"Second", "First"} {
+		chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   name,
+			Number: 1,
+		})
+		assert.Nil(t, rErr)
+
+		_, rErr = ppr.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+			Content: fmt.Sprintf("## Section %d\n\nContent of %s chapter\n", i+1, name),
+		}, nil)
+		assert.Nil(t, rErr)
+
+		_, _, rErr = gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+			{Name: fmt.Sprintf("Section %d", i+1), Paragraph: "Paragraph", Children: []record.GraphChildEntry{
+				{Name: "Concept", Relation: "part of", Children: []record.GraphChildEntry{}},
+			}},
+		})
+		assert.Nil(t, rErr)
+	}
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/compile", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	query.Add("format", "markdown")
+	query.Add("includeGraphs", "true")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/markdown; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, fmt.Sprintf("attachment; filename=\"%s.md\"", projectId), recorder.Header().Get("Content-Disposition"))
+	assert.Equal(t, "# Project to Compile from API\n"+
+		"\n"+
+		"## Contents\n"+
+		"\n"+
+		"- [1. First](#chapter-1)\n"+
+		"  - [Section 2](#chapter-1-section-1)\n"+
+		"- [2. Second](#chapter-2)\n"+
+		"  - [Section 1](#chapter-2-section-1)\n"+
+		"\n"+
+		"<a id=\"chapter-1\"></a>\n"+
+		"\n"+
+		"## 1. First\n"+
+		"\n"+
+		"<a id=\"chapter-1-section-1\"></a>\n"+
+		"\n"+
+		"### Section 2\n"+
+		"\n"+
+		"Content of First chapter\n"+
+		"\n"+
+		"> Paragraph\n"+
+		"\n"+
+		"- **Concept** _(part of)_\n"+
+		"\n"+
+		"<a id=\"chapter-2\"></a>\n"+
+		"\n"+
+		"## 2. Second\n"+
+		"\n"+
+		"<a id=\"chapter-2-section-1\"></a>\n"+
+		"\n"+
+		"### Section 1\n"+
+		"\n"+
+		"Content of Second chapter\n"+
+		"\n"+
+		"> Paragraph\n"+
+		"\n"+
+		"- **Concept** _(part of)_\n", recorder.Body.String())
+}
+
+func TestProjectCompileNotFound(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/compile", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("projectId", "NOT_FOUND_PROJECT")
+	query.Add("format", "html")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestProjectCompileDomainValidationError(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/compile", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"userId":    "user id is required, but got ''",
+		"projectId": "project id is required, but got ''",
+		"format":    "compile format must be one of epub, html, markdown, but got ''",
+	}, responseBody)
+}
+
 func TestProjectCreate(t *testing.T) {
 	maxLengthProjectName := testutil.RandomString(100)
 	maxLengthProjectDescription := testutil.RandomString(400)
@@ -359,9 +828,13 @@ func TestProjectCreate(t *testing.T) {
 			//projectId is generated by firestore and it's not predictable
 			projectId := responseBody["project"].(map[string]any)["id"]
 			assert.NotEmpty(t, projectId)
+			updatedAt := responseBody["project"].(map[string]any)["updatedAt"]
+			assert.NotEmpty(t, updatedAt)
 
 			projectWithId := tc.project
 			projectWithId["id"] = projectId
+			projectWithId["updatedAt"] = updatedAt
+			projectWithId["role"] = "owner"
 			assert.Equal(t, map[string]any{
 				"project": projectWithId,
 			}, responseBody)
@@ -529,13 +1002,50 @@ func TestProjectUpdate(t *testing.T) {
 			var responseBody map[string]any
 			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
 
+			updatedAt := responseBody["project"].(map[string]any)["updatedAt"]
+			assert.NotEmpty(t, updatedAt)
+
+			updatedProject := tc.project
+			updatedProject["updatedAt"] = updatedAt
+			updatedProject["role"] = "owner"
 			assert.Equal(t, map[string]any{
-				"project": tc.project,
+				"project": updatedProject,
 			}, responseBody)
 		})
 	}
 }
 
+func TestProjectUpdateConflict(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{"id": testutil.ReadOnlyUserId()},
+		"project": map[string]any{
+			"id":        "PROJECT_WITHOUT_DESCRIPTION",
+			"name":      "Updated Project",
+			"updatedAt": "2023-12-31T00:00:00Z",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/update", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "conflict",
+		"project": map[string]any{
+			"id":        "PROJECT_WITHOUT_DESCRIPTION",
+			"name":      "No Description Project",
+			"updatedAt": "2024-01-01T00:00:00Z",
+			"role":      "owner",
+		},
+	}, responseBody)
+}
+
 func TestProjectUpdateNotFound(t *testing.T) {
 	tt := []struct {
 		name    string
@@ -749,6 +1259,112 @@ func TestProjectUpdateInternalError(t *testing.T) {
 	}, responseBody)
 }
 
+func TestProjectPatch(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Patch from API",
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal([]map[string]any{
+		{"op": "test", "path": "/name", "value": "Project to Patch from API"},
+		{"op": "add", "path": "/description", "value": nil},
+		{"op": "test", "path": "/description", "value": nil},
+		{"op": "replace", "path": "/description", "value": "Patched project description"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+
+	updatedAt := responseBody["project"].(map[string]any)["updatedAt"]
+	assert.NotEmpty(t, updatedAt)
+
+	assert.Equal(t, map[string]any{
+		"project": map[string]any{
+			"id":          projectId,
+			"name":        "Project to Patch from API",
+			"description": "Patched project description",
+			"updatedAt":   updatedAt,
+			"role":        "owner",
+		},
+	}, responseBody)
+}
+
+func TestProjectPatchNotFound(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/name", "value": "Patched Project"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "UNKNOWN_PROJECT")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+		"patch":   map[string]any{},
+		"project": map[string]any{},
+	}, responseBody)
+}
+
+func TestProjectPatchDomainValidationError(t *testing.T) {
+	router := setupProjectRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal([]map[string]any{
+		{"op": "move", "path": "/name"},
+	})
+	req, _ := http.NewRequest("POST", "/api/projects/update", strings.NewReader(string(requestBody)))
+	req.Header.Set("Content-Type", "application/json-patch+json")
+	query := req.URL.Query()
+	query.Add("userId", testutil.ModifyOnlyUserId())
+	query.Add("projectId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"projectId": "project id is required, but got ''",
+		"patch": map[string]any{
+			"items": []any{
+				map[string]any{"message": "json patch from is required for move operation"},
+			},
+		},
+		"project": map[string]any{},
+	}, responseBody)
+}
+
 func TestProjectDelete(t *testing.T) {
 	router := setupProjectRouter(t)
 
@@ -927,26 +1543,28 @@ func TestProjectDeleteInternalError(t *testing.T) {
 
 func setupProjectRouter(t *testing.T) *gin.Engine {
 	router := gin.Default()
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	router.Use(authenticateAsRequestUser)
 
 	client := db.FirestoreClient()
 	r := repository.NewProjectRepository(*client)
 	s := service.NewProjectService(r)
 
-	v := mock_middleware.NewMockUserVerifier(ctrl)
-	v.EXPECT().
-		Verify(gomock.Any(), gomock.Any()).
-		Return(nil).
-		AnyTimes()
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+	gs := service.NewProjectGraphService(cr, gr)
+	as := service.NewProjectArchiveService(
+		r, cr, repository.NewPaperRepository(*client), gr, repository.NewLinkRepository(*client))
 
-	uc := usecase.NewProjectUseCase(s)
-	a := api.NewProjectsApi(v, uc)
+	uc := usecase.NewProjectUseCase(s, gs, as)
+	a := api.NewProjectsApi(uc)
 
 	router.GET("/api/projects/list", a.ProjectsList)
 	router.POST("/api/projects/create", a.ProjectsCreate)
 	router.GET("/api/projects/find", a.ProjectsFind)
+	router.GET("/api/projects/graph", a.ProjectsGraph)
+	router.GET("/api/projects/export", a.ProjectsExport)
+	router.POST("/api/projects/import", a.ProjectsImport)
+	router.GET("/api/projects/compile", a.ProjectsCompile)
 	router.POST("/api/projects/update", a.ProjectsUpdate)
 	router.POST("/api/projects/delete", a.ProjectsDelete)
 	return router
@@ -0,0 +1,305 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicFind(t *testing.T) {
+	router := setupPublicRouter()
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	ppr := repository.NewPaperRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+	sr := repository.NewShareRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name:        "Project to Read in Public",
+		Description: "Shared description",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Shared Chapter",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	_, rErr = ppr.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Shared Section\n",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	sectionIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Shared Section", Paragraph: "shared paragraph", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	token, _, rErr := sr.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/public/projects/find", nil)
+	query := req.URL.Query()
+	query.Add("token", token)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), userId)
+
+	var projectResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &projectResponseBody))
+
+	project := projectResponseBody["project"].(map[string]any)
+	assert.NotEmpty(t, project["updatedAt"])
+	chapter := projectResponseBody["chapters"].([]any)[0].(map[string]any)
+	assert.NotEmpty(t, chapter["updatedAt"])
+	assert.Equal(t, map[string]any{
+		"project": map[string]any{
+			"id":          projectId,
+			"name":        "Project to Read in Public",
+			"description": "Shared description",
+			"updatedAt":   project["updatedAt"],
+		},
+		"chapters": []any{
+			map[string]any{
+				"id":     chapterId,
+				"name":   "Shared Chapter",
+				"number": float64(1),
+				"sections": []any{
+					map[string]any{"id": sectionIds[0], "name": "Shared Section"},
+				},
+				"updatedAt": chapter["updatedAt"],
+			},
+		},
+	}, projectResponseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/public/papers/find", nil)
+	query = req.URL.Query()
+	query.Add("token", token)
+	query.Add("chapterId", chapterId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var paperResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &paperResponseBody))
+
+	paper := paperResponseBody["paper"].(map[string]any)
+	assert.NotEmpty(t, paper["updatedAt"])
+	assert.Equal(t, map[string]any{
+		"paper": map[string]any{
+			"id":        chapterId,
+			"content":   "## Shared Section\n",
+			"updatedAt": paper["updatedAt"],
+		},
+	}, paperResponseBody)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/public/graphs/find", nil)
+	query = req.URL.Query()
+	query.Add("token", token)
+	query.Add("chapterId", chapterId)
+	query.Add("sectionId", sectionIds[0])
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var graphResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &graphResponseBody))
+
+	graph := graphResponseBody["graph"].(map[string]any)
+	assert.NotEmpty(t, graph["updatedAt"])
+	assert.Equal(t, map[string]any{
+		"graph": map[string]any{
+			"id":        sectionIds[0],
+			"name":      "Shared Section",
+			"paragraph": "shared paragraph",
+			"children":  []any{},
+			"updatedAt": graph["updatedAt"],
+		},
+	}, graphResponseBody)
+}
+
+func TestPublicFindChapterShare(t *testing.T) {
+	router := setupPublicRouter()
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	sr := repository.NewShareRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Read Chapter in Public",
+	})
+	assert.Nil(t, rErr)
+
+	sharedChapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Shared Chapter",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	privateChapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Private Chapter",
+		Number: 2,
+	})
+	assert.Nil(t, rErr)
+
+	token, _, rErr := sr.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{
+		ChapterId: sharedChapterId,
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/public/projects/find", nil)
+	query := req.URL.Query()
+	query.Add("token", token)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var projectResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &projectResponseBody))
+
+	chapters := projectResponseBody["chapters"].([]any)
+	assert.Len(t, chapters, 1)
+	assert.Equal(t, sharedChapterId, chapters[0].(map[string]any)["id"])
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/public/papers/find", nil)
+	query = req.URL.Query()
+	query.Add("token", token)
+	query.Add("chapterId", privateChapterId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var paperResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &paperResponseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, paperResponseBody)
+}
+
+func TestPublicFindExpiredOrRevokedShare(t *testing.T) {
+	router := setupPublicRouter()
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	sr := repository.NewShareRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Expire in Public",
+	})
+	assert.Nil(t, rErr)
+
+	expiresAt := time.Now().Add(-time.Hour).UTC()
+	expiredToken, _, rErr := sr.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{
+		ExpiresAt: &expiresAt,
+	})
+	assert.Nil(t, rErr)
+
+	revokedToken, _, rErr := sr.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{})
+	assert.Nil(t, rErr)
+	assert.Nil(t, sr.DeleteShare(userId, projectId, revokedToken))
+
+	tt := []struct {
+		name  string
+		token string
+	}{
+		{name: "should return not found when share has expired", token: expiredToken},
+		{name: "should return not found when share has been revoked", token: revokedToken},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/public/projects/find", nil)
+			query := req.URL.Query()
+			query.Add("token", tc.token)
+			req.URL.RawQuery = query.Encode()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+			var responseBody map[string]any
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+			assert.Equal(t, map[string]any{
+				"message": "not found",
+			}, responseBody)
+		})
+	}
+}
+
+func TestPublicGraphsFindDomainValidationError(t *testing.T) {
+	router := setupPublicRouter()
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/public/graphs/find", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message":   "invalid request value",
+		"token":     "share token is required, but got ''",
+		"chapterId": "chapter id is required, but got ''",
+		"sectionId": "section id is required, but got ''",
+	}, responseBody)
+}
+
+func setupPublicRouter() *gin.Engine {
+	router := gin.Default()
+
+	client := db.FirestoreClient()
+	s := service.NewPublicService(
+		repository.NewShareRepository(*client),
+		repository.NewProjectRepository(*client),
+		repository.NewChapterRepository(*client),
+		repository.NewPaperRepository(*client),
+		repository.NewGraphRepository(*client),
+	)
+
+	uc := usecase.NewPublicUseCase(s)
+	api := api.NewPublicApi(uc)
+
+	router.GET("/api/public/projects/find", api.PublicProjectsFind)
+	router.GET("/api/public/papers/find", api.PublicPapersFind)
+	router.GET("/api/public/graphs/find", api.PublicGraphsFind)
+
+	return router
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+type searchApi struct {
+	usecase usecase.SearchUseCase
+}
+
+func NewSearchApi(usecase usecase.SearchUseCase) openapi.SearchAPI {
+	return searchApi{usecase: usecase}
+}
+
+func (api searchApi) Search(c *gin.Context) {
+	var request openapi.SearchRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.SearchErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.search(c, request)
+}
+
+func (api searchApi) V2Search(c *gin.Context) {
+	var request openapi.SearchV2Request
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.SearchErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.search(c, openapi.SearchRequest{
+		UserId:    middleware.GetSubject(c),
+		Query:     request.Query,
+		ProjectId: request.ProjectId,
+		Limit:     request.Limit,
+	})
+}
+
+func (api searchApi) search(c *gin.Context, request openapi.SearchRequest) {
+	res, ucErr := api.usecase.Search(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.SearchErrorResponse{
+			Message:   UseCaseErrorToMessage(ucErr),
+			UserId:    resErr.UserId,
+			Query:     resErr.Query,
+			ProjectId: resErr.ProjectId,
+			Limit:     resErr.Limit,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.SearchErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,224 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	router := setupSearchRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	ppr := repository.NewPaperRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name:        "Project to Search from API",
+		Description: "Zettelkasten notes",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	_, rErr = ppr.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Section\nThe zettelkasten method links notes.\n",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	sectionIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section", Paragraph: "Paragraph", Children: []record.GraphChildEntry{
+			{Id: "SEARCH_NODE", Name: "知識グラフ", Relation: "part of", Description: "", Children: []record.GraphChildEntry{}},
+		}},
+	})
+	assert.Nil(t, rErr)
+
+	tt := []struct {
+		name     string
+		query    string
+		expected []any
+	}{
+		{
+			name:  "should return hits in descending order of score",
+			query: "zettelkasten",
+			expected: []any{
+				map[string]any{
+					"kind":      "project",
+					"projectId": projectId,
+					"title":     "Project to Search from API",
+					"snippet": map[string]any{
+						"text": "Zettelkasten notes",
+						"highlights": []any{
+							map[string]any{"offset": float64(0), "length": float64(12)},
+						},
+					},
+				},
+				map[string]any{
+					"kind":      "paper",
+					"projectId": projectId,
+					"chapterId": chapterId,
+					"title":     "Chapter",
+					"snippet": map[string]any{
+						"text": "## Section The zettelkasten method links notes. ",
+						"highlights": []any{
+							map[string]any{"offset": float64(15), "length": float64(12)},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "should return hits of cjk query",
+			query: "グラフ",
+			expected: []any{
+				map[string]any{
+					"kind":      "node",
+					"projectId": projectId,
+					"chapterId": chapterId,
+					"sectionId": sectionIds[0],
+					"nodeId":    "SEARCH_NODE",
+					"title":     "知識グラフ",
+					"snippet": map[string]any{
+						"text": "知識グラフ",
+						"highlights": []any{
+							map[string]any{"offset": float64(2), "length": float64(3)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/search", nil)
+			query := req.URL.Query()
+			query.Add("userId", userId)
+			query.Add("query", tc.query)
+			query.Add("projectId", projectId)
+			req.URL.RawQuery = query.Encode()
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var responseBody map[string]any
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+
+			hits, ok := responseBody["hits"].([]any)
+			assert.True(t, ok)
+			for _, hit := range hits {
+				score, ok := hit.(map[string]any)["score"].(float64)
+				assert.True(t, ok)
+				assert.Greater(t, score, 0.0)
+				delete(hit.(map[string]any), "score")
+			}
+			assert.Equal(t, tc.expected, hits)
+		})
+	}
+}
+
+func TestSearchNotFound(t *testing.T) {
+	router := setupSearchRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/search", nil)
+	query := req.URL.Query()
+	query.Add("userId", testutil.ReadOnlyUserId())
+	query.Add("query", "project")
+	query.Add("projectId", "NOT_FOUND_PROJECT")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, responseBody)
+}
+
+func TestSearchDomainValidationError(t *testing.T) {
+	router := setupSearchRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/search", nil)
+	query := req.URL.Query()
+	query.Add("limit", "101")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"userId":  "user id is required, but got ''",
+		"query":   "search query is required, but got ''",
+		"limit":   "search limit must be between 1 and 100, but got 101",
+	}, responseBody)
+}
+
+func TestSearchInvalidRequestFormat(t *testing.T) {
+	router := setupSearchRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/search", nil)
+	query := req.URL.Query()
+	query.Add("limit", "many")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func setupSearchRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	s := service.NewSearchService(
+		service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers),
+		repository.NewProjectRepository(*client),
+		repository.NewChapterRepository(*client),
+		repository.NewPaperRepository(*client),
+		repository.NewGraphRepository(*client),
+	)
+
+	uc := usecase.NewSearchUseCase(s)
+	api := api.NewSearchApi(uc)
+
+	router.GET("/api/search", api.Search)
+
+	return router
+}
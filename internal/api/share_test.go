@@ -0,0 +1,223 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharesCreateListRevoke(t *testing.T) {
+	router := setupShareRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Publish from API",
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"share":   map[string]any{"expiresAt": "2100-01-01T00:00:00Z"},
+	})
+	req, _ := http.NewRequest("POST", "/api/shares/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var createResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &createResponseBody))
+
+	share := createResponseBody["share"].(map[string]any)
+	token := share["token"]
+	assert.NotEmpty(t, token)
+	createdAt := share["createdAt"]
+	assert.NotEmpty(t, createdAt)
+	assert.Equal(t, map[string]any{
+		"token":     token,
+		"expiresAt": "2100-01-01T00:00:00Z",
+		"createdAt": createdAt,
+	}, share)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/shares/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	query.Add("projectId", projectId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponseBody))
+	assert.Equal(t, map[string]any{
+		"shares": []any{share},
+	}, listResponseBody)
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ = json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"share":   map[string]any{"token": token},
+	})
+	req, _ = http.NewRequest("POST", "/api/shares/revoke", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "null", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/shares/list", nil)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponseBody))
+	assert.Equal(t, map[string]any{
+		"shares": []any{},
+	}, listResponseBody)
+}
+
+func TestSharesCreateInvalidArgument(t *testing.T) {
+	router := setupShareRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Publish Expired from API",
+	})
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": userId},
+		"project": map[string]any{"id": projectId},
+		"share":   map[string]any{"expiresAt": "2000-01-01T00:00:00Z"},
+	})
+	req, _ := http.NewRequest("POST", "/api/shares/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value: failed to create share: share expiration must be in the future",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"share":   map[string]any{},
+	}, responseBody)
+}
+
+func TestSharesCreateNotFound(t *testing.T) {
+	router := setupShareRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": testutil.ReadOnlyUserId()},
+		"project": map[string]any{"id": "0000000000000001"},
+		"share":   map[string]any{},
+	})
+	req, _ := http.NewRequest("POST", "/api/shares/create", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"share":   map[string]any{},
+	}, responseBody)
+}
+
+func TestSharesRevokeDomainValidationError(t *testing.T) {
+	router := setupShareRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user":    map[string]any{"id": ""},
+		"project": map[string]any{"id": ""},
+		"share":   map[string]any{"token": ""},
+	})
+	req, _ := http.NewRequest("POST", "/api/shares/revoke", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{"id": "user id is required, but got ''"},
+		"project": map[string]any{"id": "project id is required, but got ''"},
+		"share":   map[string]any{"token": "share token is required, but got ''"},
+	}, responseBody)
+}
+
+func TestSharesCreateInvalidRequestFormat(t *testing.T) {
+	router := setupShareRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/shares/create", strings.NewReader(`{"share": {"expiresAt": "tomorrow"}}`))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseBody))
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+		"user":    map[string]any{},
+		"project": map[string]any{},
+		"share":   map[string]any{},
+	}, responseBody)
+}
+
+func setupShareRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewShareRepository(*client)
+	s := service.NewShareService(r)
+
+	uc := usecase.NewShareUseCase(s)
+	api := api.NewSharesApi(uc)
+
+	router.GET("/api/shares/list", api.SharesList)
+	router.POST("/api/shares/create", api.SharesCreate)
+	router.POST("/api/shares/revoke", api.SharesRevoke)
+
+	return router
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+)
+
+type trashApi struct {
+	usecase usecase.TrashUseCase
+}
+
+func NewTrashApi(usecase usecase.TrashUseCase) openapi.TrashAPI {
+	return trashApi{usecase: usecase}
+}
+
+func (api trashApi) TrashList(c *gin.Context) {
+	var request openapi.TrashItemListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.TrashItemListErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.UserId = middleware.GetSubject(c)
+	api.listTrashItems(c, request)
+}
+
+func (api trashApi) V2TrashList(c *gin.Context) {
+	api.listTrashItems(c, openapi.TrashItemListRequest{UserId: middleware.GetSubject(c)})
+}
+
+func (api trashApi) listTrashItems(c *gin.Context, request openapi.TrashItemListRequest) {
+	res, ucErr := api.usecase.ListTrashItems(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.TrashItemListErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			UserId:  resErr.UserId,
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (api trashApi) TrashRestore(c *gin.Context) {
+	var request openapi.TrashItemRestoreRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.TrashItemRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	request.User.Id = middleware.GetSubject(c)
+	api.restoreTrashItem(c, request)
+}
+
+func (api trashApi) V2TrashRestore(c *gin.Context) {
+	var request openapi.TrashItemRestoreV2Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.TrashItemRestoreErrorResponse{
+			Message: JsonBindErrorToMessage(err),
+		})
+		return
+	}
+
+	api.restoreTrashItem(c, openapi.TrashItemRestoreRequest{
+		User: openapi.UserOnlyId{Id: middleware.GetSubject(c)},
+		Item: request.Item,
+	})
+}
+
+func (api trashApi) restoreTrashItem(c *gin.Context, request openapi.TrashItemRestoreRequest) {
+	res, ucErr := api.usecase.RestoreTrashItem(request)
+
+	if ucErr != nil && ucErr.Code() == usecase.DomainValidationError {
+		resErr := UseCaseErrorToResponse(ucErr)
+		c.AbortWithStatusJSON(http.StatusBadRequest, openapi.TrashItemRestoreErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+			User:    resErr.User,
+			Item:    resErr.Item,
+		})
+		return
+	}
+
+	if ucErr != nil && ucErr.Code() == usecase.NotFoundError {
+		c.AbortWithStatusJSON(http.StatusNotFound, openapi.TrashItemRestoreErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	if ucErr != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+			Message: UseCaseErrorToMessage(ucErr),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,199 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/api"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashListAndRestore(t *testing.T) {
+	router := setupTrashRouter(t)
+
+	client := db.FirestoreClient()
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Restore Chapter from API",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter to Trash",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	rErr = cr.TrashChapter(userId, projectId, chapterId)
+	assert.Nil(t, rErr)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/trash/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", userId)
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var listResponseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &listResponseBody)
+	assert.Nil(t, err)
+
+	var trashed map[string]any
+	for _, item := range listResponseBody["items"].([]any) {
+		if item.(map[string]any)["projectId"] == projectId {
+			trashed = item.(map[string]any)
+		}
+	}
+	assert.NotNil(t, trashed)
+	assert.Equal(t, "chapter", trashed["type"])
+	assert.Equal(t, "Chapter to Trash", trashed["name"])
+	assert.Equal(t, chapterId, trashed["chapterId"])
+	assert.NotContains(t, trashed, "sectionId")
+	assert.NotEmpty(t, trashed["deletedAt"])
+
+	recorder = httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": userId,
+		},
+		"item": map[string]any{
+			"id": trashed["id"],
+		},
+	})
+	req, _ = http.NewRequest("POST", "/api/trash/restore", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var restoreResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &restoreResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{"item": trashed}, restoreResponseBody)
+
+	chapter, rErr := cr.FetchChapter(userId, projectId, chapterId)
+	assert.Nil(t, rErr)
+	assert.Equal(t, 1, chapter.Number)
+
+	recorder = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/trash/restore", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	var notFoundResponseBody map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &notFoundResponseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "not found",
+	}, notFoundResponseBody)
+}
+
+func TestTrashListDomainValidationError(t *testing.T) {
+	router := setupTrashRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/trash/list", nil)
+	query := req.URL.Query()
+	query.Add("userId", "")
+	req.URL.RawQuery = query.Encode()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"userId":  "user id is required, but got ''",
+	}, responseBody)
+}
+
+func TestTrashRestoreDomainValidationError(t *testing.T) {
+	router := setupTrashRouter(t)
+
+	recorder := httptest.NewRecorder()
+	requestBody, _ := json.Marshal(map[string]any{
+		"user": map[string]any{
+			"id": testutil.ModifyOnlyUserId(),
+		},
+		"item": map[string]any{
+			"id": "",
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/trash/restore", strings.NewReader(string(requestBody)))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request value",
+		"user":    map[string]any{},
+		"item": map[string]any{
+			"id": "trash item id is required, but got ''",
+		},
+	}, responseBody)
+}
+
+func TestTrashRestoreInvalidRequestFormat(t *testing.T) {
+	router := setupTrashRouter(t)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/trash/restore", strings.NewReader("invalid request"))
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var responseBody map[string]any
+	err := json.Unmarshal(recorder.Body.Bytes(), &responseBody)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]any{
+		"message": "invalid request format",
+	}, responseBody)
+}
+
+func setupTrashRouter(t *testing.T) *gin.Engine {
+	router := gin.Default()
+	router.Use(authenticateAsRequestUser)
+
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+	s := service.NewTrashService(r)
+
+	uc := usecase.NewTrashUseCase(s)
+	api := api.NewTrashApi(uc)
+
+	router.GET("/api/trash/list", api.TrashList)
+	router.POST("/api/trash/restore", api.TrashRestore)
+
+	return router
+}
@@ -0,0 +1,79 @@
+package archive
+
+// Version is written into the manifest and is the only version Read accepts
+const Version = 1
+
+const MediaType = "application/zip"
+
+const manifestPath = "manifest.json"
+
+type Archive struct {
+	Project  Project
+	Chapters []Chapter
+	Links    []Link
+}
+
+type Project struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Chapter struct {
+	Id       string
+	Name     string
+	Paper    string
+	Sections []Section
+}
+
+type Section struct {
+	Id        string
+	Name      string
+	Paragraph string
+	Children  []Child
+}
+
+type Child struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Relation    string  `json:"relation"`
+	Description string  `json:"description"`
+	Children    []Child `json:"children"`
+}
+
+type Link struct {
+	From        Endpoint `json:"from"`
+	To          Endpoint `json:"to"`
+	Relation    string   `json:"relation"`
+	Description string   `json:"description"`
+}
+
+type Endpoint struct {
+	ChapterId string `json:"chapterId"`
+	SectionId string `json:"sectionId"`
+	NodeId    string `json:"nodeId,omitempty"`
+}
+
+type manifest struct {
+	Version  int               `json:"version"`
+	Project  Project           `json:"project"`
+	Chapters []manifestChapter `json:"chapters"`
+	Links    []Link            `json:"links"`
+}
+
+type manifestChapter struct {
+	Id       string            `json:"id"`
+	Name     string            `json:"name"`
+	Paper    string            `json:"paper"`
+	Sections []manifestSection `json:"sections"`
+}
+
+type manifestSection struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Graph string `json:"graph"`
+}
+
+type graphFile struct {
+	Paragraph string  `json:"paragraph"`
+	Children  []Child `json:"children"`
+}
@@ -0,0 +1,100 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// maxFileSize bounds each decompressed entry so that a small archive cannot expand without limit
+const maxFileSize = 1 << 20
+
+// Read unpacks an archive written by Write. Files not referenced from the manifest are ignored
+func Read(data []byte) (*Archive, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	var m manifest
+	if err := readJson(files, manifestPath, &m); err != nil {
+		return nil, err
+	}
+	if m.Version != Version {
+		return nil, fmt.Errorf("unsupported archive version: %v", m.Version)
+	}
+
+	archive := &Archive{
+		Project:  m.Project,
+		Chapters: make([]Chapter, len(m.Chapters)),
+		Links:    m.Links,
+	}
+	if archive.Links == nil {
+		archive.Links = []Link{}
+	}
+
+	for i, chapter := range m.Chapters {
+		paper, err := readFile(files, chapter.Paper)
+		if err != nil {
+			return nil, err
+		}
+
+		sections := make([]Section, len(chapter.Sections))
+		for j, section := range chapter.Sections {
+			var graph graphFile
+			if err := readJson(files, section.Graph, &graph); err != nil {
+				return nil, err
+			}
+			sections[j] = Section{
+				Id:        section.Id,
+				Name:      section.Name,
+				Paragraph: graph.Paragraph,
+				Children:  normalizeChildren(graph.Children),
+			}
+		}
+
+		archive.Chapters[i] = Chapter{Id: chapter.Id, Name: chapter.Name, Paper: string(paper), Sections: sections}
+	}
+
+	return archive, nil
+}
+
+func readFile(files map[string]*zip.File, path string) ([]byte, error) {
+	f, ok := files[path]
+	if !ok {
+		return nil, fmt.Errorf("file not found in archive: %v", path)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %v: %w", path, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %w", path, err)
+	}
+	if len(content) > maxFileSize {
+		return nil, fmt.Errorf("file is too large in archive: %v", path)
+	}
+	return content, nil
+}
+
+func readJson(files map[string]*zip.File, path string, v any) error {
+	content, err := readFile(files, path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		return fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package archive_test
+
+import (
+	stdzip "archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/archive"
+	"github.com/stretchr/testify/assert"
+)
+
+func newArchive() archive.Archive {
+	return archive.Archive{
+		Project: archive.Project{Name: "Project", Description: "Description <&>"},
+		Chapters: []archive.Chapter{
+			{
+				Id:    "CHAPTER_ONE",
+				Name:  "Chapter One",
+				Paper: "## Introduction\n\nThis is introduction.\n",
+				Sections: []archive.Section{
+					{
+						Id:        "SECTION_ONE",
+						Name:      "Introduction",
+						Paragraph: "This is introduction.",
+						Children: []archive.Child{
+							{Id: "NODE_ONE", Name: "Background", Relation: "part of", Description: "background"},
+						},
+					},
+				},
+			},
+			{Id: "CHAPTER_TWO", Name: "Chapter Two", Paper: ""},
+		},
+		Links: []archive.Link{
+			{
+				From:     archive.Endpoint{ChapterId: "CHAPTER_ONE", SectionId: "SECTION_ONE", NodeId: "NODE_ONE"},
+				To:       archive.Endpoint{ChapterId: "CHAPTER_ONE", SectionId: "SECTION_ONE"},
+				Relation: "related to",
+			},
+		},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	data, err := archive.Write(newArchive())
+	assert.NoError(t, err)
+
+	r, err := stdzip.NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.NoError(t, err)
+	names := make([]string, len(r.File))
+	for i, f := range r.File {
+		names[i] = f.Name
+	}
+	assert.Equal(t, []string{
+		"chapters/1/paper.md",
+		"chapters/1/sections/1.json",
+		"chapters/2/paper.md",
+		"manifest.json",
+	}, names)
+
+	again, err := archive.Write(newArchive())
+	assert.NoError(t, err)
+	assert.Equal(t, data, again)
+
+	read, err := archive.Read(data)
+	assert.NoError(t, err)
+
+	expected := newArchive()
+	expected.Chapters[0].Sections[0].Children[0].Children = []archive.Child{}
+	expected.Chapters[1].Sections = []archive.Section{}
+	assert.Equal(t, expected, *read)
+}
+
+func TestReadError(t *testing.T) {
+	tt := []struct {
+		name          string
+		files         map[string]string
+		expectedError string
+	}{
+		{
+			name:          "should return error when manifest is missing",
+			files:         map[string]string{"chapters/1/paper.md": ""},
+			expectedError: "file not found in archive: manifest.json",
+		},
+		{
+			name:          "should return error when manifest is not json",
+			files:         map[string]string{"manifest.json": "version: 1"},
+			expectedError: "failed to parse manifest.json: invalid character 'v' looking for beginning of value",
+		},
+		{
+			name:          "should return error when version is not supported",
+			files:         map[string]string{"manifest.json": `{"version": 2}`},
+			expectedError: "unsupported archive version: 2",
+		},
+		{
+			name: "should return error when paper is missing",
+			files: map[string]string{
+				"manifest.json": `{"version": 1, "chapters": [{"id": "C", "name": "C", "paper": "chapters/1/paper.md"}]}`,
+			},
+			expectedError: "file not found in archive: chapters/1/paper.md",
+		},
+		{
+			name: "should return error when file is too large",
+			files: map[string]string{
+				"manifest.json":       `{"version": 1, "chapters": [{"id": "C", "name": "C", "paper": "chapters/1/paper.md"}]}`,
+				"chapters/1/paper.md": strings.Repeat("a", 1<<20+1),
+			},
+			expectedError: "file is too large in archive: chapters/1/paper.md",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := stdzip.NewWriter(&buf)
+			for name, content := range tc.files {
+				f, err := w.Create(name)
+				assert.NoError(t, err)
+				_, err = io.WriteString(f, content)
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, w.Close())
+
+			read, err := archive.Read(buf.Bytes())
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Nil(t, read)
+		})
+	}
+}
+
+func TestReadInvalidZip(t *testing.T) {
+	read, err := archive.Read([]byte("not a zip"))
+	assert.EqualError(t, err, "failed to read archive: zip: not a valid zip file")
+	assert.Nil(t, read)
+}
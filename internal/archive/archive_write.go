@@ -0,0 +1,91 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Write packs a project into a zip archive with a manifest, papers as Markdown and graphs as JSON.
+// Entries carry no timestamps, so the same project always produces the same bytes
+func Write(archive Archive) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	m := manifest{
+		Version:  Version,
+		Project:  archive.Project,
+		Chapters: make([]manifestChapter, len(archive.Chapters)),
+		Links:    archive.Links,
+	}
+	if m.Links == nil {
+		m.Links = []Link{}
+	}
+
+	for i, chapter := range archive.Chapters {
+		paperPath := fmt.Sprintf("chapters/%d/paper.md", i+1)
+		if err := writeFile(w, paperPath, []byte(chapter.Paper)); err != nil {
+			return nil, err
+		}
+
+		sections := make([]manifestSection, len(chapter.Sections))
+		for j, section := range chapter.Sections {
+			graphPath := fmt.Sprintf("chapters/%d/sections/%d.json", i+1, j+1)
+			content, err := marshal(graphFile{Paragraph: section.Paragraph, Children: normalizeChildren(section.Children)})
+			if err != nil {
+				return nil, err
+			}
+			if err := writeFile(w, graphPath, content); err != nil {
+				return nil, err
+			}
+			sections[j] = manifestSection{Id: section.Id, Name: section.Name, Graph: graphPath}
+		}
+
+		m.Chapters[i] = manifestChapter{Id: chapter.Id, Name: chapter.Name, Paper: paperPath, Sections: sections}
+	}
+
+	content, err := marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(w, manifestPath, content); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(w *zip.Writer, path string, content []byte) error {
+	f, err := w.CreateHeader(&zip.FileHeader{Name: path, Method: zip.Deflate})
+	if err != nil {
+		return fmt.Errorf("failed to write %v: %w", path, err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("failed to write %v: %w", path, err)
+	}
+	return nil
+}
+
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return nil, fmt.Errorf("failed to encode json: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func normalizeChildren(children []Child) []Child {
+	normalized := make([]Child, len(children))
+	for i, child := range children {
+		child.Children = normalizeChildren(child.Children)
+		normalized[i] = child
+	}
+	return normalized
+}
@@ -0,0 +1,499 @@
+package compile
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var thematicBreakPattern = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+
+var blockquotePattern = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+
+var listItemPattern = regexp.MustCompile(`^( {0,3})([-*+]|\d{1,9}[.)])(?:( +)(.*))?$`)
+
+var autolinkPattern = regexp.MustCompile(`^<((?:https?://|mailto:)[^\s<>]+)>`)
+
+var urlSchemePattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9+.-]*):`)
+
+// markdownToHTML converts the subset of CommonMark used in papers to XHTML-compatible html.
+// Raw html in Markdown is escaped rather than passed through
+func markdownToHTML(content string) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = expandLeadingTabs(line)
+	}
+
+	var b strings.Builder
+	writeBlocks(&b, lines, false)
+	return b.String()
+}
+
+func writeBlocks(b *strings.Builder, lines []string, tight bool) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		if isBlank(line) {
+			i++
+			continue
+		}
+
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			i = writeFencedCode(b, lines, i, match[1], strings.TrimSpace(match[2]))
+			continue
+		}
+
+		if match := headingPattern.FindStringSubmatch(line); match != nil {
+			fmt.Fprintf(b, "<h%d>%s</h%d>\n", len(match[1]), inlineHTML(strings.TrimSpace(match[2])), len(match[1]))
+			i++
+			continue
+		}
+
+		if thematicBreakPattern.MatchString(line) {
+			b.WriteString("<hr />\n")
+			i++
+			continue
+		}
+
+		if blockquotePattern.MatchString(line) {
+			quoted := []string{}
+			for ; i < len(lines) && blockquotePattern.MatchString(lines[i]); i++ {
+				quoted = append(quoted, blockquotePattern.FindStringSubmatch(lines[i])[1])
+			}
+			b.WriteString("<blockquote>\n")
+			writeBlocks(b, quoted, false)
+			b.WriteString("</blockquote>\n")
+			continue
+		}
+
+		if listItemPattern.MatchString(line) {
+			i = writeList(b, lines, i)
+			continue
+		}
+
+		if indentOf(line) >= 4 {
+			code := []string{}
+			for ; i < len(lines) && (isBlank(lines[i]) || indentOf(lines[i]) >= 4); i++ {
+				code = append(code, strings.TrimPrefix(lines[i], "    "))
+			}
+			fmt.Fprintf(b, "<pre><code>%s\n</code></pre>\n", html.EscapeString(strings.TrimRight(strings.Join(code, "\n"), "\n ")))
+			continue
+		}
+
+		paragraph := []string{}
+		for ; i < len(lines) && !isBlank(lines[i]) && (len(paragraph) == 0 || !startsBlock(lines[i])); i++ {
+			paragraph = append(paragraph, strings.TrimLeft(lines[i], " "))
+		}
+		if tight {
+			fmt.Fprintf(b, "%s\n", inlineHTML(strings.Join(paragraph, "\n")))
+		} else {
+			fmt.Fprintf(b, "<p>%s</p>\n", inlineHTML(strings.Join(paragraph, "\n")))
+		}
+	}
+}
+
+func writeFencedCode(b *strings.Builder, lines []string, start int, fence string, info string) int {
+	indent := indentOf(lines[start])
+	code := []string{}
+	i := start + 1
+	for ; i < len(lines); i++ {
+		if match := fencePattern.FindStringSubmatch(lines[i]); match != nil &&
+			match[1][0] == fence[0] && len(match[1]) >= len(fence) && strings.TrimSpace(match[2]) == "" {
+			i++
+			break
+		}
+		line := lines[i]
+		line = line[min(indent, indentOf(line)):]
+		code = append(code, line)
+	}
+
+	content := ""
+	if len(code) > 0 {
+		content = strings.Join(code, "\n") + "\n"
+	}
+	if language := strings.Fields(info); len(language) > 0 {
+		fmt.Fprintf(b, "<pre><code class=\"language-%s\">%s</code></pre>\n",
+			html.EscapeString(language[0]), html.EscapeString(content))
+	} else {
+		fmt.Fprintf(b, "<pre><code>%s</code></pre>\n", html.EscapeString(content))
+	}
+	return i
+}
+
+type listItem struct {
+	lines []string
+	loose bool
+}
+
+func writeList(b *strings.Builder, lines []string, start int) int {
+	first := listItemPattern.FindStringSubmatch(lines[start])
+	ordered := first[2][0] >= '0' && first[2][0] <= '9'
+	delimiter := first[2][len(first[2])-1]
+
+	items := []listItem{}
+	loose := false
+	i := start
+	for i < len(lines) {
+		match := listItemPattern.FindStringSubmatch(lines[i])
+		if match == nil || !sameList(match[2], ordered, delimiter) || thematicBreakPattern.MatchString(lines[i]) {
+			break
+		}
+
+		offset := len(match[1]) + len(match[2]) + 1
+		if spaces := len(match[3]); spaces > 0 && spaces <= 4 {
+			offset = len(match[1]) + len(match[2]) + spaces
+		}
+
+		item := listItem{lines: []string{match[4]}}
+		i++
+		for i < len(lines) {
+			line := lines[i]
+			if isBlank(line) {
+				j := i
+				for j < len(lines) && isBlank(lines[j]) {
+					j++
+				}
+				if j == len(lines) || indentOf(lines[j]) < offset {
+					break
+				}
+				item.lines = append(item.lines, "")
+				item.loose = true
+				i++
+				continue
+			}
+			if indentOf(line) >= offset {
+				item.lines = append(item.lines, line[offset:])
+				i++
+				continue
+			}
+			if listItemPattern.MatchString(line) || startsBlock(line) {
+				break
+			}
+			item.lines = append(item.lines, strings.TrimLeft(line, " "))
+			i++
+		}
+		items = append(items, item)
+
+		j := i
+		for j < len(lines) && isBlank(lines[j]) {
+			j++
+		}
+		next := []string(nil)
+		if j < len(lines) {
+			next = listItemPattern.FindStringSubmatch(lines[j])
+		}
+		if next == nil || !sameList(next[2], ordered, delimiter) || thematicBreakPattern.MatchString(lines[j]) {
+			break
+		}
+		if j > i {
+			loose = true
+		}
+		i = j
+	}
+
+	for _, item := range items {
+		loose = loose || (item.loose && hasBlockAfterBlank(item.lines))
+	}
+
+	if ordered {
+		number := strings.TrimLeft(first[2][:len(first[2])-1], "0")
+		if number == "" || number == "1" {
+			b.WriteString("<ol>\n")
+		} else {
+			fmt.Fprintf(b, "<ol start=\"%s\">\n", number)
+		}
+	} else {
+		b.WriteString("<ul>\n")
+	}
+	for _, item := range items {
+		b.WriteString("<li>")
+		if !loose {
+			var inner strings.Builder
+			writeBlocks(&inner, item.lines, true)
+			b.WriteString(strings.TrimSuffix(inner.String(), "\n"))
+		} else {
+			b.WriteString("\n")
+			writeBlocks(b, item.lines, false)
+		}
+		b.WriteString("</li>\n")
+	}
+	if ordered {
+		b.WriteString("</ol>\n")
+	} else {
+		b.WriteString("</ul>\n")
+	}
+	return i
+}
+
+func sameList(marker string, ordered bool, delimiter byte) bool {
+	markerOrdered := marker[0] >= '0' && marker[0] <= '9'
+	return markerOrdered == ordered && marker[len(marker)-1] == delimiter
+}
+
+func hasBlockAfterBlank(lines []string) bool {
+	blank := false
+	for _, line := range lines {
+		if isBlank(line) {
+			blank = true
+			continue
+		}
+		if blank && indentOf(line) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func startsBlock(line string) bool {
+	return fencePattern.MatchString(line) ||
+		headingPattern.MatchString(line) ||
+		thematicBreakPattern.MatchString(line) ||
+		blockquotePattern.MatchString(line) ||
+		listItemPattern.MatchString(line)
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func expandLeadingTabs(line string) string {
+	var b strings.Builder
+	for i, c := range line {
+		switch c {
+		case ' ':
+			b.WriteByte(' ')
+		case '\t':
+			b.WriteString(strings.Repeat(" ", 4-b.Len()%4))
+		default:
+			b.WriteString(line[i:])
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+func inlineHTML(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && isASCIIPunctuation(text[i+1]):
+			b.WriteString(html.EscapeString(text[i+1 : i+2]))
+			i += 2
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			b.WriteString("<br />\n")
+			i += 2
+		case c == '`':
+			i = writeCodeSpan(&b, text, i)
+		case c == '!' && i+1 < len(text) && text[i+1] == '[':
+			if label, url, end, ok := parseLink(text, i+1); ok {
+				fmt.Fprintf(&b, "<img src=\"%s\" alt=\"%s\" />", html.EscapeString(safeURL(url)), html.EscapeString(label))
+				i = end
+			} else {
+				b.WriteString("!")
+				i++
+			}
+		case c == '[':
+			if label, url, end, ok := parseLink(text, i); ok {
+				fmt.Fprintf(&b, "<a href=\"%s\">%s</a>", html.EscapeString(safeURL(url)), inlineHTML(label))
+				i = end
+			} else {
+				b.WriteString("[")
+				i++
+			}
+		case c == '<' && autolinkPattern.MatchString(text[i:]):
+			match := autolinkPattern.FindStringSubmatch(text[i:])
+			fmt.Fprintf(&b, "<a href=\"%s\">%s</a>", html.EscapeString(match[1]), html.EscapeString(match[1]))
+			i += len(match[0])
+		case c == '*' || c == '_' || c == '~':
+			i = writeEmphasis(&b, text, i)
+		case c == ' ':
+			j := i
+			for j < len(text) && text[j] == ' ' {
+				j++
+			}
+			switch {
+			case j < len(text) && text[j] == '\n' && j-i >= 2:
+				b.WriteString("<br />")
+			case j < len(text) && text[j] == '\n':
+			default:
+				b.WriteString(text[i:j])
+			}
+			i = j
+		default:
+			j := i + 1
+			for j < len(text) && !strings.ContainsRune("\\`![<*_~ ", rune(text[j])) {
+				j++
+			}
+			b.WriteString(html.EscapeString(text[i:j]))
+			i = j
+		}
+	}
+	return b.String()
+}
+
+func writeCodeSpan(b *strings.Builder, text string, start int) int {
+	n := runLength(text, start)
+	for k := start + n; k < len(text); {
+		if text[k] != '`' {
+			k++
+			continue
+		}
+		m := runLength(text, k)
+		if m == n {
+			code := strings.ReplaceAll(text[start+n:k], "\n", " ")
+			if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			fmt.Fprintf(b, "<code>%s</code>", html.EscapeString(code))
+			return k + m
+		}
+		k += m
+	}
+	b.WriteString(text[start : start+n])
+	return start + n
+}
+
+func writeEmphasis(b *strings.Builder, text string, start int) int {
+	c := text[start]
+	n := runLength(text, start)
+	opens := start+n < len(text) && text[start+n] != ' ' && text[start+n] != '\n'
+	if c == '_' && start > 0 && isWordCharacter(text[start-1]) {
+		opens = false
+	}
+	if c == '~' && n != 2 {
+		opens = false
+	}
+	if !opens || n > 3 {
+		b.WriteString(text[start : start+n])
+		return start + n
+	}
+
+	for k := start + n; k < len(text); {
+		switch text[k] {
+		case '\\':
+			k += 2
+			continue
+		case '`':
+			k += runLength(text, k)
+			continue
+		case c:
+		default:
+			k++
+			continue
+		}
+		m := runLength(text, k)
+		closes := m == n && text[k-1] != ' ' && text[k-1] != '\n'
+		if c == '_' && k+m < len(text) && isWordCharacter(text[k+m]) {
+			closes = false
+		}
+		if !closes {
+			k += m
+			continue
+		}
+
+		inner := inlineHTML(text[start+n : k])
+		switch {
+		case c == '~':
+			fmt.Fprintf(b, "<del>%s</del>", inner)
+		case n == 1:
+			fmt.Fprintf(b, "<em>%s</em>", inner)
+		case n == 2:
+			fmt.Fprintf(b, "<strong>%s</strong>", inner)
+		default:
+			fmt.Fprintf(b, "<em><strong>%s</strong></em>", inner)
+		}
+		return k + m
+	}
+
+	b.WriteString(text[start : start+n])
+	return start + n
+}
+
+// parseLink reads [label](url) or [label](url "title") starting at the opening bracket
+func parseLink(text string, start int) (string, string, int, bool) {
+	depth := 0
+	k := start
+	for ; k < len(text); k++ {
+		if text[k] == '\\' {
+			k++
+			continue
+		}
+		if text[k] == '[' {
+			depth++
+		}
+		if text[k] == ']' {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+	if k+1 >= len(text) || text[k+1] != '(' {
+		return "", "", 0, false
+	}
+	label := text[start+1 : k]
+
+	rest := text[k+2:]
+	end := -1
+	for j, parens := 0, 0; j < len(rest) && end < 0; j++ {
+		switch rest[j] {
+		case '(':
+			parens++
+		case ')':
+			if parens == 0 {
+				end = j
+			}
+			parens--
+		}
+	}
+	if end < 0 {
+		return "", "", 0, false
+	}
+	destination := strings.TrimSpace(rest[:end])
+	if fields := strings.Fields(destination); len(fields) > 0 {
+		destination = fields[0]
+	}
+	destination = strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">")
+	return label, destination, k + 2 + end + 1, true
+}
+
+// safeURL drops urls with schemes other than http, https and mailto, such as javascript.
+// Urls with control characters are dropped too, since browsers strip them and may find a scheme after them
+func safeURL(url string) string {
+	if strings.IndexFunc(url, unicode.IsControl) >= 0 {
+		return "#"
+	}
+
+	match := urlSchemePattern.FindStringSubmatch(url)
+	if match == nil {
+		return url
+	}
+	switch strings.ToLower(match[1]) {
+	case "http", "https", "mailto":
+		return url
+	}
+	return "#"
+}
+
+func runLength(text string, start int) int {
+	n := 0
+	for start+n < len(text) && text[start+n] == text[start] {
+		n++
+	}
+	return n
+}
+
+func isASCIIPunctuation(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isWordCharacter(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= 0x80
+}
@@ -0,0 +1,129 @@
+package compile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+)
+
+// Document is a whole project laid out for publishing. Chapters are in reading order
+type Document struct {
+	Identifier  string
+	Title       string
+	Description string
+	Modified    time.Time
+	Chapters    []Chapter
+}
+
+type Chapter struct {
+	Name     string
+	Paper    string
+	Sections []Section
+}
+
+// Section holds the graph of a section. Graph is nil when graphs are not compiled
+type Section struct {
+	Name  string
+	Graph *Graph
+}
+
+type Graph struct {
+	Paragraph string
+	Nodes     []Node
+}
+
+type Node struct {
+	Name        string
+	Relation    string
+	Description string
+	Children    []Node
+}
+
+func FromProjectArchive(identifier string, archive domain.ProjectArchiveEntity, includeGraphs bool) *Document {
+	document := &Document{
+		Identifier:  identifier,
+		Title:       archive.Project().Name().Value(),
+		Description: archive.Project().Description().Value(),
+		Chapters:    make([]Chapter, len(archive.Chapters())),
+	}
+	for i, chapter := range archive.Chapters() {
+		sections := make([]Section, len(chapter.Sections()))
+		for j, section := range chapter.Sections() {
+			sections[j] = Section{Name: section.Name().Value()}
+			if includeGraphs {
+				sections[j].Graph = &Graph{
+					Paragraph: section.Paragraph().Value(),
+					Nodes:     nodesFromChildren(*section.Children()),
+				}
+			}
+		}
+		document.Chapters[i] = Chapter{
+			Name:     chapter.Name().Value(),
+			Paper:    chapter.Paper().Value(),
+			Sections: sections,
+		}
+	}
+	return document
+}
+
+func nodesFromChildren(children domain.GraphChildrenEntity) []Node {
+	nodes := make([]Node, children.Len())
+	for i, child := range children.Value() {
+		nodes[i] = Node{
+			Name:        child.Name().Value(),
+			Relation:    child.Relation().Value(),
+			Description: child.Description().Value(),
+			Children:    nodesFromChildren(*child.Children()),
+		}
+	}
+	return nodes
+}
+
+func Render(document Document, format string) ([]byte, error) {
+	switch format {
+	case "epub":
+		return EPUB(document)
+	case "html":
+		return []byte(HTML(document)), nil
+	case "markdown":
+		return []byte(Markdown(document)), nil
+	}
+	return nil, fmt.Errorf("unsupported compile format: %v", format)
+}
+
+func MediaType(format string) string {
+	switch format {
+	case "epub":
+		return "application/epub+zip"
+	case "html":
+		return "text/html; charset=utf-8"
+	case "markdown":
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+func Extension(format string) string {
+	switch format {
+	case "epub":
+		return "epub"
+	case "html":
+		return "html"
+	case "markdown":
+		return "md"
+	}
+	return "bin"
+}
+
+func chapterAnchor(chapterIndex int) string {
+	return fmt.Sprintf("chapter-%d", chapterIndex+1)
+}
+
+func sectionAnchor(chapterIndex int, sectionIndex int) string {
+	return fmt.Sprintf("chapter-%d-section-%d", chapterIndex+1, sectionIndex+1)
+}
+
+func chapterTitle(chapterIndex int, chapter Chapter) string {
+	return fmt.Sprintf("%d. %s", chapterIndex+1, chapter.Name)
+}
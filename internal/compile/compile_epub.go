@@ -0,0 +1,124 @@
+package compile
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+)
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+type epubFile struct {
+	path    string
+	content string
+}
+
+// EPUB renders an EPUB 3 book with a title page, a navigation document and one file per chapter
+func EPUB(document Document) ([]byte, error) {
+	files := []epubFile{
+		{path: "META-INF/container.xml", content: epubContainer},
+		{path: "OEBPS/content.opf", content: epubPackage(document)},
+		{path: "OEBPS/style.css", content: stylesheet},
+		{path: "OEBPS/title.xhtml", content: epubPage(document.Title, titleHTML(document))},
+		{path: "OEBPS/nav.xhtml", content: epubPage("Contents",
+			"<nav epub:type=\"toc\" id=\"toc\">\n<h2>Contents</h2>\n"+
+				contentsHTML(document, epubChapterFile)+
+				"</nav>\n")},
+	}
+	for i, chapter := range document.Chapters {
+		files = append(files, epubFile{
+			path:    "OEBPS/" + epubChapterFile(i),
+			content: epubPage(chapterTitle(i, chapter), chapterHTML(i, chapter)),
+		})
+	}
+
+	var b bytes.Buffer
+	writer := zip.NewWriter(&b)
+
+	// mimetype must come first without compression so that readers can detect the format
+	mimetype, err := writer.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write epub: %w", err)
+	}
+	if _, err := mimetype.Write([]byte("application/epub+zip")); err != nil {
+		return nil, fmt.Errorf("failed to write epub: %w", err)
+	}
+
+	for _, file := range files {
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: file.path, Method: zip.Deflate})
+		if err != nil {
+			return nil, fmt.Errorf("failed to write epub: %w", err)
+		}
+		if _, err := w.Write([]byte(file.content)); err != nil {
+			return nil, fmt.Errorf("failed to write epub: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write epub: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+func epubChapterFile(chapterIndex int) string {
+	return fmt.Sprintf("chapter-%d.xhtml", chapterIndex+1)
+}
+
+func epubPackage(document Document) string {
+	var manifest strings.Builder
+	var spine strings.Builder
+	for i := range document.Chapters {
+		fmt.Fprintf(&manifest, "    <item id=\"chapter-%d\" href=\"%s\" media-type=\"application/xhtml+xml\"/>\n",
+			i+1, epubChapterFile(i))
+		fmt.Fprintf(&spine, "    <itemref idref=\"chapter-%d\"/>\n", i+1)
+	}
+
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="uid">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="uid">urn:knodeledge:project:%s</dc:identifier>
+    <dc:title>%s</dc:title>
+    <dc:language>und</dc:language>
+    <meta property="dcterms:modified">%s</meta>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+    <item id="title" href="title.xhtml" media-type="application/xhtml+xml"/>
+%s  </manifest>
+  <spine>
+    <itemref idref="title"/>
+    <itemref idref="nav"/>
+%s  </spine>
+</package>
+`,
+		html.EscapeString(document.Identifier),
+		html.EscapeString(document.Title),
+		document.Modified.UTC().Format("2006-01-02T15:04:05Z"),
+		manifest.String(),
+		spine.String(),
+	)
+}
+
+func epubPage(title string, body string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops">
+<head>
+<meta charset="utf-8" />
+<title>%s</title>
+<link rel="stylesheet" type="text/css" href="style.css" />
+</head>
+<body>
+%s</body>
+</html>
+`, html.EscapeString(title), body)
+}
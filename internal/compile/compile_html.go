@@ -0,0 +1,90 @@
+package compile
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const stylesheet = `body { max-width: 48em; margin: 0 auto; padding: 1em; font-family: sans-serif; line-height: 1.6; }
+pre { overflow-x: auto; padding: 0.5em; background: #f5f5f5; }
+blockquote { margin-left: 0; padding-left: 1em; border-left: 0.25em solid #ddd; color: #555; }
+.outline { margin: 1em 0; padding: 0.5em 1em; border: 1px solid #ddd; border-radius: 0.25em; }
+`
+
+// HTML renders a standalone page with inline styles, which needs no other files to be viewed
+func HTML(document Document) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n")
+	b.WriteString("<html>\n<head>\n<meta charset=\"utf-8\" />\n")
+	b.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\" />\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n", html.EscapeString(document.Title))
+	fmt.Fprintf(&b, "<style>\n%s</style>\n", stylesheet)
+	b.WriteString("</head>\n<body>\n")
+	b.WriteString(titleHTML(document))
+	b.WriteString("<nav id=\"contents\">\n<h2>Contents</h2>\n")
+	b.WriteString(contentsHTML(document, func(int) string { return "" }))
+	b.WriteString("</nav>\n")
+	for i, chapter := range document.Chapters {
+		b.WriteString(chapterHTML(i, chapter))
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+func titleHTML(document Document) string {
+	var b strings.Builder
+	b.WriteString("<header>\n")
+	fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(document.Title))
+	if document.Description != "" {
+		fmt.Fprintf(&b, "<p>%s</p>\n", strings.ReplaceAll(html.EscapeString(document.Description), "\n", "<br />\n"))
+	}
+	b.WriteString("</header>\n")
+	return b.String()
+}
+
+// contentsHTML renders the table of contents. chapterHref is prepended to anchors,
+// which is the file of the chapter when chapters are split into files
+func contentsHTML(document Document, chapterHref func(chapterIndex int) string) string {
+	var b strings.Builder
+	b.WriteString("<ol>\n")
+	for i, chapter := range document.Chapters {
+		fmt.Fprintf(&b, "<li><a href=\"%s#%s\">%s</a>",
+			chapterHref(i), chapterAnchor(i), html.EscapeString(chapterTitle(i, chapter)))
+		if len(chapter.Sections) > 0 {
+			b.WriteString("\n<ol>\n")
+			for j, section := range chapter.Sections {
+				fmt.Fprintf(&b, "<li><a href=\"%s#%s\">%s</a></li>\n",
+					chapterHref(i), sectionAnchor(i, j), html.EscapeString(section.Name))
+			}
+			b.WriteString("</ol>\n")
+		}
+		b.WriteString("</li>\n")
+	}
+	b.WriteString("</ol>\n")
+	return b.String()
+}
+
+func chapterHTML(chapterIndex int, chapter Chapter) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<section id=\"%s\">\n", chapterAnchor(chapterIndex))
+	fmt.Fprintf(&b, "<h%d>%s</h%d>\n",
+		chapterHeadingLevel, html.EscapeString(chapterTitle(chapterIndex, chapter)), chapterHeadingLevel)
+	for _, part := range splitPaper(chapter) {
+		if part.section != nil {
+			fmt.Fprintf(&b, "<h%d id=\"%s\">%s</h%d>\n",
+				part.level, sectionAnchor(chapterIndex, part.sectionIndex), html.EscapeString(part.section.Name), part.level)
+		}
+		b.WriteString(markdownToHTML(part.body))
+		if part.section == nil || part.section.Graph == nil {
+			continue
+		}
+		if outline := outlineMarkdown(*part.section.Graph); outline != "" {
+			b.WriteString("<div class=\"outline\">\n")
+			b.WriteString(markdownToHTML(outline))
+			b.WriteString("</div>\n")
+		}
+	}
+	b.WriteString("</section>\n")
+	return b.String()
+}
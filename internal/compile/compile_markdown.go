@@ -0,0 +1,84 @@
+package compile
+
+import (
+	"fmt"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"#", `\#`,
+	"\n", " ",
+)
+
+// Markdown renders the document in a single file. Anchors are written as html tags
+// since Markdown itself has no syntax for them
+func Markdown(document Document) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", markdownEscaper.Replace(document.Title))
+	if document.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", document.Description)
+	}
+
+	b.WriteString("## Contents\n\n")
+	for i, chapter := range document.Chapters {
+		fmt.Fprintf(&b, "- [%s](#%s)\n", markdownEscaper.Replace(chapterTitle(i, chapter)), chapterAnchor(i))
+		for j, section := range chapter.Sections {
+			fmt.Fprintf(&b, "  - [%s](#%s)\n", markdownEscaper.Replace(section.Name), sectionAnchor(i, j))
+		}
+	}
+
+	for i, chapter := range document.Chapters {
+		fmt.Fprintf(&b, "\n<a id=\"%s\"></a>\n\n", chapterAnchor(i))
+		fmt.Fprintf(&b, "%s %s\n", strings.Repeat("#", chapterHeadingLevel), markdownEscaper.Replace(chapterTitle(i, chapter)))
+		for _, part := range splitPaper(chapter) {
+			if part.section != nil {
+				fmt.Fprintf(&b, "\n<a id=\"%s\"></a>\n\n", sectionAnchor(i, part.sectionIndex))
+				fmt.Fprintf(&b, "%s %s\n", strings.Repeat("#", part.level), markdownEscaper.Replace(part.section.Name))
+			}
+			if part.body != "" {
+				fmt.Fprintf(&b, "\n%s\n", part.body)
+			}
+			if part.section != nil && part.section.Graph != nil {
+				b.WriteString(outlineMarkdown(*part.section.Graph))
+			}
+		}
+	}
+	return b.String()
+}
+
+func outlineMarkdown(graph Graph) string {
+	var b strings.Builder
+	if graph.Paragraph != "" {
+		b.WriteString("\n")
+		for _, line := range strings.Split(strings.TrimRight(graph.Paragraph, "\n"), "\n") {
+			fmt.Fprintf(&b, "> %s\n", line)
+		}
+	}
+	if len(graph.Nodes) > 0 {
+		b.WriteString("\n")
+		writeOutlineNodes(&b, graph.Nodes, 0)
+	}
+	return b.String()
+}
+
+func writeOutlineNodes(b *strings.Builder, nodes []Node, depth int) {
+	for _, node := range nodes {
+		fmt.Fprintf(b, "%s- **%s**", strings.Repeat("  ", depth), markdownEscaper.Replace(node.Name))
+		if node.Relation != "" {
+			fmt.Fprintf(b, " _(%s)_", markdownEscaper.Replace(node.Relation))
+		}
+		if node.Description != "" {
+			fmt.Fprintf(b, ": %s", markdownEscaper.Replace(node.Description))
+		}
+		b.WriteString("\n")
+		writeOutlineNodes(b, node.Children, depth+1)
+	}
+}
@@ -0,0 +1,88 @@
+package compile
+
+import (
+	"regexp"
+	"strings"
+)
+
+var headingPattern = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+
+var fencePattern = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})(.*)$")
+
+// chapterHeadingLevel is the level of chapter headings. Headings in papers are shifted below it
+const chapterHeadingLevel = 2
+
+// chapterPart is a fragment of a paper. A part of a section starts at the heading
+// whose text is the name of the section, which is taken out of body
+type chapterPart struct {
+	section      *Section
+	sectionIndex int
+	level        int
+	body         string
+}
+
+// splitPaper claims headings for sections in order. Sections which no heading is claimed for
+// are appended at the end with empty body so that they still appear in the document
+func splitPaper(chapter Chapter) []chapterPart {
+	parts := []chapterPart{{sectionIndex: -1}}
+	body := []string{}
+	next := 0
+	fence := ""
+
+	flush := func() {
+		parts[len(parts)-1].body = strings.Trim(strings.Join(body, "\n"), "\n")
+		body = []string{}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(chapter.Paper, "\r\n", "\n"), "\n") {
+		if fence != "" {
+			if match := fencePattern.FindStringSubmatch(line); match != nil &&
+				match[1][0] == fence[0] && len(match[1]) >= len(fence) && strings.TrimSpace(match[2]) == "" {
+				fence = ""
+			}
+			body = append(body, line)
+			continue
+		}
+		if match := fencePattern.FindStringSubmatch(line); match != nil {
+			fence = match[1]
+			body = append(body, line)
+			continue
+		}
+
+		match := headingPattern.FindStringSubmatch(line)
+		if match == nil {
+			body = append(body, line)
+			continue
+		}
+
+		level := shiftHeadingLevel(len(match[1]))
+		text := strings.TrimSpace(match[2])
+		current := parts[len(parts)-1]
+		switch {
+		case next < len(chapter.Sections) && text == chapter.Sections[next].Name:
+			flush()
+			parts = append(parts, chapterPart{section: &chapter.Sections[next], sectionIndex: next, level: level})
+			next++
+		case current.section != nil && level <= current.level:
+			flush()
+			parts = append(parts, chapterPart{sectionIndex: -1})
+			body = append(body, strings.Repeat("#", level)+" "+text)
+		default:
+			body = append(body, strings.Repeat("#", level)+" "+text)
+		}
+	}
+	flush()
+
+	for ; next < len(chapter.Sections); next++ {
+		parts = append(parts, chapterPart{
+			section:      &chapter.Sections[next],
+			sectionIndex: next,
+			level:        chapterHeadingLevel + 1,
+		})
+	}
+	return parts
+}
+
+func shiftHeadingLevel(level int) int {
+	return min(level+chapterHeadingLevel-1, 6)
+}
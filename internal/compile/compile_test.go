@@ -0,0 +1,285 @@
+package compile_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/compile"
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const paper = "Introduction with **strong**, *emphasis*, `code` and [a link](https://example.com).\n" +
+	"Unsafe [link](javascript:alert(1)) and <script>alert(1)</script> are escaped.\n" +
+	"\n" +
+	"## Section \"One\"\n" +
+	"\n" +
+	"- item 1\n" +
+	"- item 2\n" +
+	"  1. nested\n" +
+	"\n" +
+	"```go\n" +
+	"## not a heading\n" +
+	"fmt.Println(\"<>\")\n" +
+	"```\n" +
+	"\n" +
+	"### Detail\n" +
+	"\n" +
+	"> quoted  \n" +
+	"> text\n" +
+	"\n" +
+	"## References\n" +
+	"\n" +
+	"---\n" +
+	"\n" +
+	"## セクション2\n" +
+	"\n" +
+	"日本語の本文\n"
+
+func TestRenderGolden(t *testing.T) {
+	tt := []struct {
+		name          string
+		includeGraphs bool
+		extension     string
+		format        string
+	}{
+		{name: "project", includeGraphs: false, extension: "md", format: "markdown"},
+		{name: "project", includeGraphs: false, extension: "html", format: "html"},
+		{name: "project-graphs", includeGraphs: true, extension: "md", format: "markdown"},
+		{name: "project-graphs", includeGraphs: true, extension: "html", format: "html"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name+"."+tc.format, func(t *testing.T) {
+			document := compile.FromProjectArchive("0000000000000001", *newProjectArchive(t), tc.includeGraphs)
+
+			content, err := compile.Render(*document, tc.format)
+			assert.NoError(t, err)
+
+			path := filepath.Join("testdata", tc.name+"."+tc.extension)
+			if *update {
+				assert.NoError(t, os.WriteFile(path, content, 0644))
+			}
+
+			golden, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, string(golden), string(content))
+		})
+	}
+}
+
+func TestRenderEPUB(t *testing.T) {
+	document := compile.FromProjectArchive("0000000000000001", *newProjectArchive(t), true)
+	document.Modified = testutil.Date()
+
+	content, err := compile.Render(*document, "epub")
+	assert.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	assert.NoError(t, err)
+
+	names := make([]string, len(reader.File))
+	for i, file := range reader.File {
+		names[i] = file.Name
+	}
+	assert.Equal(t, []string{
+		"mimetype",
+		"META-INF/container.xml",
+		"OEBPS/content.opf",
+		"OEBPS/style.css",
+		"OEBPS/title.xhtml",
+		"OEBPS/nav.xhtml",
+		"OEBPS/chapter-1.xhtml",
+		"OEBPS/chapter-2.xhtml",
+	}, names)
+	assert.Equal(t, zip.Store, reader.File[0].Method)
+	assert.Equal(t, "application/epub+zip", readZipFile(t, reader.File[0]))
+
+	opf := readZipFile(t, reader.File[2])
+	assert.Contains(t, opf, "<dc:identifier id=\"uid\">urn:knodeledge:project:0000000000000001</dc:identifier>")
+	assert.Contains(t, opf, "<meta property=\"dcterms:modified\">"+testutil.Date().UTC().Format("2006-01-02T15:04:05Z")+"</meta>")
+
+	nav := readZipFile(t, reader.File[5])
+	assert.Contains(t, nav, "<a href=\"chapter-1.xhtml#chapter-1-section-1\">Section &#34;One&#34;</a>")
+
+	for _, file := range reader.File {
+		if !strings.HasSuffix(file.Name, ".xhtml") && !strings.HasSuffix(file.Name, ".xml") &&
+			!strings.HasSuffix(file.Name, ".opf") {
+			continue
+		}
+		assertWellFormed(t, file.Name, readZipFile(t, file))
+	}
+}
+
+func TestRenderUnsafeURL(t *testing.T) {
+	tt := []struct {
+		name        string
+		destination string
+		expected    string
+	}{
+		{name: "should keep https url", destination: "https://example.com/a", expected: "https://example.com/a"},
+		{name: "should keep relative url", destination: "#section", expected: "#section"},
+		{name: "should drop javascript url", destination: "JavaScript:alert(1)", expected: "#"},
+		{name: "should drop url with leading C0 control", destination: "\x01javascript:alert(1)", expected: "#"},
+		{name: "should drop url with inner C0 control", destination: "java\x1fscript:alert(1)", expected: "#"},
+		{name: "should drop url with DEL", destination: "\x7fjavascript:alert(1)", expected: "#"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			projectName, err := domain.NewProjectNameObject("Project")
+			assert.NoError(t, err)
+			projectDescription, err := domain.NewProjectDescriptionObject("")
+			assert.NoError(t, err)
+			project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+
+			content := "[link](" + tc.destination + ") and ![image](" + tc.destination + ")\n"
+			chapters := []domain.ProjectArchiveChapterEntity{
+				*newChapter(t, "1000000000000001", "Chapter One", content, []domain.ProjectArchiveSectionEntity{}),
+			}
+			archive, err := domain.NewProjectArchiveEntity(*project, chapters, []domain.LinkWithoutAutofieldEntity{})
+			assert.NoError(t, err)
+
+			rendered, err := compile.Render(*compile.FromProjectArchive("0000000000000001", *archive, false), "html")
+			assert.NoError(t, err)
+			assert.Contains(t, string(rendered), "<a href=\""+tc.expected+"\">link</a>")
+			assert.Contains(t, string(rendered), "<img src=\""+tc.expected+"\" alt=\"image\" />")
+		})
+	}
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	content, err := compile.Render(compile.Document{}, "pdf")
+	assert.EqualError(t, err, "unsupported compile format: pdf")
+	assert.Nil(t, content)
+}
+
+func TestMediaType(t *testing.T) {
+	assert.Equal(t, "application/epub+zip", compile.MediaType("epub"))
+	assert.Equal(t, "text/html; charset=utf-8", compile.MediaType("html"))
+	assert.Equal(t, "text/markdown; charset=utf-8", compile.MediaType("markdown"))
+}
+
+func TestExtension(t *testing.T) {
+	assert.Equal(t, "epub", compile.Extension("epub"))
+	assert.Equal(t, "html", compile.Extension("html"))
+	assert.Equal(t, "md", compile.Extension("markdown"))
+}
+
+func readZipFile(t *testing.T, file *zip.File) string {
+	r, err := file.Open()
+	assert.NoError(t, err)
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func assertWellFormed(t *testing.T, name string, content string) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if !assert.NoError(t, err, name) {
+			return
+		}
+	}
+}
+
+func newProjectArchive(t *testing.T) *domain.ProjectArchiveEntity {
+	projectName, err := domain.NewProjectNameObject("Project <One>")
+	assert.NoError(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("Description of project")
+	assert.NoError(t, err)
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+
+	chapters := []domain.ProjectArchiveChapterEntity{
+		*newChapter(t, "1000000000000001", "Chapter One", paper, []domain.ProjectArchiveSectionEntity{
+			*newSection(t, "2000000000000001", "Section \"One\"", "Paragraph of section one", []domain.GraphChildEntity{
+				*newGraphChild(t, "Concept", "part of", "concept *description*", []domain.GraphChildEntity{
+					*newGraphChild(t, "Detail", "example of", "", []domain.GraphChildEntity{}),
+				}),
+			}),
+			*newSection(t, "2000000000000002", "セクション2", "", []domain.GraphChildEntity{}),
+			*newSection(t, "2000000000000003", "Section Without Heading", "", []domain.GraphChildEntity{
+				*newGraphChild(t, "Orphan", "", "", []domain.GraphChildEntity{}),
+			}),
+		}),
+		*newChapter(t, "1000000000000002", "Chapter Two", "", []domain.ProjectArchiveSectionEntity{}),
+	}
+
+	archive, err := domain.NewProjectArchiveEntity(*project, chapters, []domain.LinkWithoutAutofieldEntity{})
+	assert.NoError(t, err)
+	return archive
+}
+
+func newChapter(
+	t *testing.T,
+	id string,
+	name string,
+	content string,
+	sections []domain.ProjectArchiveSectionEntity,
+) *domain.ProjectArchiveChapterEntity {
+	chapterId, err := domain.NewChapterIdObject(id)
+	assert.NoError(t, err)
+	chapterName, err := domain.NewChapterNameObject(name)
+	assert.NoError(t, err)
+	paperContent, err := domain.NewPaperContentObject(content)
+	assert.NoError(t, err)
+
+	chapter, err := domain.NewProjectArchiveChapterEntity(*chapterId, *chapterName, *paperContent, sections)
+	assert.NoError(t, err)
+	return chapter
+}
+
+func newSection(
+	t *testing.T,
+	id string,
+	name string,
+	paragraph string,
+	children []domain.GraphChildEntity,
+) *domain.ProjectArchiveSectionEntity {
+	sectionId, err := domain.NewSectionIdObject(id)
+	assert.NoError(t, err)
+	sectionName, err := domain.NewSectionNameObject(name)
+	assert.NoError(t, err)
+	sectionParagraph, err := domain.NewGraphParagraphObject(paragraph)
+	assert.NoError(t, err)
+	sectionChildren, err := domain.NewGraphChildrenEntity(children)
+	assert.NoError(t, err)
+
+	return domain.NewProjectArchiveSectionEntity(*sectionId, *sectionName, *sectionParagraph, *sectionChildren)
+}
+
+func newGraphChild(
+	t *testing.T,
+	name string,
+	relation string,
+	description string,
+	children []domain.GraphChildEntity,
+) *domain.GraphChildEntity {
+	childName, err := domain.NewGraphNameObject(name)
+	assert.NoError(t, err)
+	childRelation, err := domain.NewGraphRelationObject(relation)
+	assert.NoError(t, err)
+	childDescription, err := domain.NewGraphDescriptionObject(description)
+	assert.NoError(t, err)
+	childChildren, err := domain.NewGraphChildrenEntity(children)
+	assert.NoError(t, err)
+
+	return domain.NewGraphChildEntity(nil, *childName, *childRelation, *childDescription, *childChildren)
+}
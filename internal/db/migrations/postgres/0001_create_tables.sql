@@ -0,0 +1,60 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id          TEXT        NOT NULL PRIMARY KEY,
+    user_id     TEXT        NOT NULL,
+    name        TEXT        NOT NULL,
+    description TEXT        NOT NULL DEFAULT '',
+    deleting    BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS projects_user_id ON projects (user_id);
+
+CREATE TABLE IF NOT EXISTS chapters (
+    id         TEXT        NOT NULL PRIMARY KEY,
+    project_id TEXT        NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    name       TEXT        NOT NULL,
+    number     INTEGER     NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS chapters_project_id_number ON chapters (project_id, number);
+
+CREATE TABLE IF NOT EXISTS sections (
+    chapter_id TEXT    NOT NULL REFERENCES chapters (id) ON DELETE CASCADE,
+    id         TEXT    NOT NULL,
+    name       TEXT    NOT NULL,
+    position   INTEGER NOT NULL,
+    PRIMARY KEY (chapter_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS papers (
+    chapter_id TEXT        NOT NULL PRIMARY KEY REFERENCES chapters (id) ON DELETE CASCADE,
+    content    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS graphs (
+    chapter_id TEXT        NOT NULL REFERENCES chapters (id) ON DELETE CASCADE,
+    id         TEXT        NOT NULL,
+    paragraph  TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (chapter_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS graph_children (
+    id          TEXT    NOT NULL PRIMARY KEY,
+    chapter_id  TEXT    NOT NULL,
+    graph_id    TEXT    NOT NULL,
+    parent_id   TEXT    REFERENCES graph_children (id) ON DELETE CASCADE,
+    position    INTEGER NOT NULL,
+    name        TEXT    NOT NULL,
+    relation    TEXT    NOT NULL,
+    description TEXT    NOT NULL,
+    FOREIGN KEY (chapter_id, graph_id) REFERENCES graphs (chapter_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS graph_children_graph ON graph_children (chapter_id, graph_id);
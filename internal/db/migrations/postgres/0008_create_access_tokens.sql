@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS access_tokens (
+    id         TEXT        NOT NULL PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    scope      TEXT        NOT NULL,
+    project_id TEXT        NOT NULL DEFAULT '',
+    token_hash TEXT        NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    user_id    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS access_tokens_user_id ON access_tokens (user_id);
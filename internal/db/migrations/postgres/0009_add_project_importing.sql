@@ -0,0 +1 @@
+ALTER TABLE projects ADD COLUMN importing BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS paper_revisions (
+    id         TEXT     NOT NULL PRIMARY KEY,
+    chapter_id TEXT     NOT NULL REFERENCES papers (chapter_id) ON DELETE CASCADE,
+    content    TEXT     NOT NULL,
+    author_id  TEXT     NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS paper_revisions_chapter_id ON paper_revisions (chapter_id, created_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS graph_revisions (
+    id         TEXT     NOT NULL PRIMARY KEY,
+    chapter_id TEXT     NOT NULL,
+    graph_id   TEXT     NOT NULL,
+    paragraph  TEXT     NOT NULL,
+    children   TEXT     NOT NULL,
+    author_id  TEXT     NOT NULL,
+    created_at DATETIME NOT NULL,
+    FOREIGN KEY (chapter_id, graph_id) REFERENCES graphs (chapter_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS graph_revisions_graph ON graph_revisions (chapter_id, graph_id, created_at);
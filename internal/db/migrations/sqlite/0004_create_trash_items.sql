@@ -0,0 +1,18 @@
+ALTER TABLE projects ADD COLUMN trashed BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE chapters ADD COLUMN trashed BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS trash_items (
+    id         TEXT     NOT NULL PRIMARY KEY,
+    user_id    TEXT     NOT NULL,
+    type       TEXT     NOT NULL,
+    name       TEXT     NOT NULL,
+    project_id TEXT     NOT NULL,
+    chapter_id TEXT     NOT NULL DEFAULT '',
+    section_id TEXT     NOT NULL DEFAULT '',
+    position   INTEGER  NOT NULL,
+    deleted_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS trash_items_user_id ON trash_items (user_id, project_id);
+CREATE INDEX IF NOT EXISTS trash_items_deleted_at ON trash_items (deleted_at);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS links (
+    id              TEXT     NOT NULL PRIMARY KEY,
+    project_id      TEXT     NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    from_chapter_id TEXT     NOT NULL,
+    from_section_id TEXT     NOT NULL,
+    from_node_id    TEXT     NOT NULL DEFAULT '',
+    to_chapter_id   TEXT     NOT NULL,
+    to_section_id   TEXT     NOT NULL,
+    to_node_id      TEXT     NOT NULL DEFAULT '',
+    relation        TEXT     NOT NULL,
+    description     TEXT     NOT NULL,
+    created_at      DATETIME NOT NULL,
+    updated_at      DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS links_project_id ON links (project_id);
+CREATE INDEX IF NOT EXISTS links_from ON links (from_chapter_id, from_section_id);
+CREATE INDEX IF NOT EXISTS links_to ON links (to_chapter_id, to_section_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS shares (
+    id         TEXT     NOT NULL PRIMARY KEY,
+    project_id TEXT     NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    chapter_id TEXT     NOT NULL DEFAULT '',
+    expires_at DATETIME,
+    user_id    TEXT     NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS shares_project_id ON shares (project_id);
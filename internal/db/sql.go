@@ -0,0 +1,170 @@
+package db
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	SQLiteDriver   = "sqlite3"
+	PostgresDriver = "postgres"
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+type SQLDatabase struct {
+	DB     *sql.DB
+	Driver string
+}
+
+var sqlDatabase *SQLDatabase
+
+func InitSQLClient(driver string, dsn string) error {
+	database, err := OpenSQLDatabase(driver, dsn)
+	if err != nil {
+		return err
+	}
+
+	sqlDatabase = database
+	return nil
+}
+
+func FinalizeSQLClient() error {
+	err := sqlDatabase.DB.Close()
+	sqlDatabase = nil
+	return err
+}
+
+func SQLClient() *SQLDatabase {
+	return sqlDatabase
+}
+
+func OpenSQLDatabase(driver string, dsn string) (*SQLDatabase, error) {
+	var dir string
+	switch driver {
+	case SQLiteDriver:
+		dir = "migrations/sqlite"
+	case PostgresDriver:
+		dir = "migrations/postgres"
+	default:
+		return nil, fmt.Errorf("unsupported sql driver: %v", driver)
+	}
+
+	if driver == SQLiteDriver {
+		dsn = sqliteDSN(dsn)
+	}
+
+	conn, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if driver == SQLiteDriver {
+		// sqlite allows only one writer at a time
+		conn.SetMaxOpenConns(1)
+	}
+
+	database := &SQLDatabase{DB: conn, Driver: driver}
+	if err := database.migrate(dir); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return database, nil
+}
+
+// sqliteDSN enables foreign keys in the DSN, since sqlite enables them per connection
+// and database/sql may open a new connection at any time
+func sqliteDSN(dsn string) string {
+	if strings.Contains(dsn, "_foreign_keys=") || strings.Contains(dsn, "_fk=") {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&_foreign_keys=on"
+	}
+	return dsn + "?_foreign_keys=on"
+}
+
+func (d SQLDatabase) Rebind(query string) string {
+	if d.Driver != PostgresDriver {
+		return query
+	}
+
+	var builder strings.Builder
+	n := 0
+	for _, r := range query {
+		if r != '?' {
+			builder.WriteRune(r)
+			continue
+		}
+		n++
+		builder.WriteString("$" + strconv.Itoa(n))
+	}
+	return builder.String()
+}
+
+func (d SQLDatabase) ForUpdate() string {
+	if d.Driver != PostgresDriver {
+		return ""
+	}
+	return " FOR UPDATE"
+}
+
+func (d SQLDatabase) migrate(dir string) error {
+	_, err := d.DB.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version TEXT NOT NULL PRIMARY KEY)")
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	names, err := fs.Glob(migrations, dir+"/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		version := strings.TrimSuffix(name[len(dir)+1:], ".sql")
+
+		var count int
+		err := d.DB.QueryRow(d.Rebind("SELECT COUNT(*) FROM schema_migrations WHERE version = ?"), version).
+			Scan(&count)
+		if err != nil {
+			return fmt.Errorf("failed to read schema_migrations: %w", err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		content, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		tx, err := d.DB.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(string(content)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %v: %w", version, err)
+		}
+		if _, err := tx.Exec(d.Rebind("INSERT INTO schema_migrations (version) VALUES (?)"), version); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record migration %v: %w", version, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to apply migration %v: %w", version, err)
+		}
+	}
+
+	return nil
+}
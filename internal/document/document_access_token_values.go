@@ -0,0 +1,13 @@
+package document
+
+import "time"
+
+type AccessTokenValues struct {
+	Name      string     `firestore:"name"`
+	Scope     string     `firestore:"scope"`
+	ProjectId string     `firestore:"projectId,omitempty"`
+	TokenHash string     `firestore:"tokenHash"`
+	ExpiresAt *time.Time `firestore:"expiresAt,omitempty"`
+	UserId    string     `firestore:"userId"`
+	CreatedAt time.Time  `firestore:"createdAt"`
+}
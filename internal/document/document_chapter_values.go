@@ -5,6 +5,7 @@ import "time"
 type ChapterValues struct {
 	Name      string          `firestore:"name"`
 	Sections  []SectionValues `firestore:"sections"`
+	Trashed   bool            `firestore:"trashed,omitempty"`
 	CreatedAt time.Time       `firestore:"createdAt"`
 	UpdatedAt time.Time       `firestore:"updatedAt"`
 }
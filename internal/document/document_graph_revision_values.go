@@ -0,0 +1,10 @@
+package document
+
+import "time"
+
+type GraphRevisionValues struct {
+	Paragraph string             `firestore:"paragraph"`
+	Children  []GraphChildValues `firestore:"children"`
+	AuthorId  string             `firestore:"authorId"`
+	CreatedAt time.Time          `firestore:"createdAt"`
+}
@@ -5,11 +5,13 @@ import "time"
 type GraphValues struct {
 	Paragraph string             `firestore:"paragraph"`
 	Children  []GraphChildValues `firestore:"children"`
+	Trashed   bool               `firestore:"trashed,omitempty"`
 	CreatedAt time.Time          `firestore:"createdAt"`
 	UpdatedAt time.Time          `firestore:"updatedAt"`
 }
 
 type GraphChildValues struct {
+	Id         string             `firestore:"id"`
 	Name       string             `firestore:"name"`
 	Relation   string             `firestore:"relation"`
 	Descrition string             `firestore:"description"`
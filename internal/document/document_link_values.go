@@ -0,0 +1,18 @@
+package document
+
+import "time"
+
+type LinkValues struct {
+	From        LinkEndpointValues `firestore:"from"`
+	To          LinkEndpointValues `firestore:"to"`
+	Relation    string             `firestore:"relation"`
+	Description string             `firestore:"description"`
+	CreatedAt   time.Time          `firestore:"createdAt"`
+	UpdatedAt   time.Time          `firestore:"updatedAt"`
+}
+
+type LinkEndpointValues struct {
+	ChapterId string `firestore:"chapterId"`
+	SectionId string `firestore:"sectionId"`
+	NodeId    string `firestore:"nodeId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package document
+
+import "time"
+
+type PaperRevisionValues struct {
+	Content   string    `firestore:"content"`
+	AuthorId  string    `firestore:"authorId"`
+	CreatedAt time.Time `firestore:"createdAt"`
+}
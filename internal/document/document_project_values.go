@@ -3,10 +3,14 @@ package document
 import "time"
 
 type ProjectValues struct {
-	Name        string    `firestore:"name"`
-	Description string    `firestore:"description,omitempty"`
-	ChapterIds  []string  `firestore:"chapterIds,omitempty"`
-	UserId      string    `firestore:"userId"`
-	CreatedAt   time.Time `firestore:"createdAt"`
-	UpdatedAt   time.Time `firestore:"updatedAt"`
+	Name        string            `firestore:"name"`
+	Description string            `firestore:"description,omitempty"`
+	ChapterIds  []string          `firestore:"chapterIds,omitempty"`
+	UserId      string            `firestore:"userId"`
+	Members     map[string]string `firestore:"members,omitempty"`
+	Deleting    bool              `firestore:"deleting,omitempty"`
+	Importing   bool              `firestore:"importing,omitempty"`
+	Trashed     bool              `firestore:"trashed,omitempty"`
+	CreatedAt   time.Time         `firestore:"createdAt"`
+	UpdatedAt   time.Time         `firestore:"updatedAt"`
 }
@@ -0,0 +1,11 @@
+package document
+
+import "time"
+
+type ShareValues struct {
+	ProjectId string     `firestore:"projectId"`
+	ChapterId string     `firestore:"chapterId,omitempty"`
+	ExpiresAt *time.Time `firestore:"expiresAt,omitempty"`
+	UserId    string     `firestore:"userId"`
+	CreatedAt time.Time  `firestore:"createdAt"`
+}
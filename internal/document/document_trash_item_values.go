@@ -0,0 +1,15 @@
+package document
+
+import "time"
+
+type TrashItemValues struct {
+	Type      string    `firestore:"type"`
+	Name      string    `firestore:"name"`
+	ProjectId string    `firestore:"projectId"`
+	ChapterId string    `firestore:"chapterId,omitempty"`
+	SectionId string    `firestore:"sectionId,omitempty"`
+	Position  int       `firestore:"position"`
+	UserId    string    `firestore:"userId"`
+	DeletedAt time.Time `firestore:"deletedAt"`
+	Purging   bool      `firestore:"purging,omitempty"`
+}
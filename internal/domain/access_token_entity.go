@@ -0,0 +1,52 @@
+package domain
+
+type AccessTokenEntity struct {
+	id        AccessTokenIdObject
+	name      AccessTokenNameObject
+	scope     AccessTokenScopeObject
+	projectId *ProjectIdObject
+	expiresAt *ExpiresAtObject
+	createdAt CreatedAtObject
+}
+
+func NewAccessTokenEntity(
+	id AccessTokenIdObject,
+	name AccessTokenNameObject,
+	scope AccessTokenScopeObject,
+	projectId *ProjectIdObject,
+	expiresAt *ExpiresAtObject,
+	createdAt CreatedAtObject,
+) *AccessTokenEntity {
+	return &AccessTokenEntity{
+		id:        id,
+		name:      name,
+		scope:     scope,
+		projectId: projectId,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}
+}
+
+func (e *AccessTokenEntity) Id() *AccessTokenIdObject {
+	return &e.id
+}
+
+func (e *AccessTokenEntity) Name() *AccessTokenNameObject {
+	return &e.name
+}
+
+func (e *AccessTokenEntity) Scope() *AccessTokenScopeObject {
+	return &e.scope
+}
+
+func (e *AccessTokenEntity) ProjectId() *ProjectIdObject {
+	return e.projectId
+}
+
+func (e *AccessTokenEntity) ExpiresAt() *ExpiresAtObject {
+	return e.expiresAt
+}
+
+func (e *AccessTokenEntity) CreatedAt() *CreatedAtObject {
+	return &e.createdAt
+}
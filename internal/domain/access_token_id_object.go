@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type AccessTokenIdObject struct {
+	value string
+}
+
+func NewAccessTokenIdObject(accessTokenId string) (*AccessTokenIdObject, error) {
+	if accessTokenId == "" {
+		return nil, fmt.Errorf("access token id is required, but got '%v'", accessTokenId)
+	}
+	return &AccessTokenIdObject{value: accessTokenId}, nil
+}
+
+func (o *AccessTokenIdObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "fmt"
+
+type AccessTokenNameObject struct {
+	value string
+}
+
+func NewAccessTokenNameObject(name string) (*AccessTokenNameObject, error) {
+	if name == "" {
+		return nil, fmt.Errorf("access token name is required, but got '%v'", name)
+	}
+	if len(name) > 100 {
+		return nil, fmt.Errorf("access token name cannot be longer than 100 characters, but got '%v'", name)
+	}
+	return &AccessTokenNameObject{value: name}, nil
+}
+
+func (o *AccessTokenNameObject) Value() string {
+	return o.value
+}
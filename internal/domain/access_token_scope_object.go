@@ -0,0 +1,22 @@
+package domain
+
+import "fmt"
+
+type AccessTokenScopeObject struct {
+	value string
+}
+
+func NewAccessTokenScopeObject(scope string) (*AccessTokenScopeObject, error) {
+	if scope != "read" && scope != "write" {
+		return nil, fmt.Errorf("access token scope must be read or write, but got '%v'", scope)
+	}
+	return &AccessTokenScopeObject{value: scope}, nil
+}
+
+func (o *AccessTokenScopeObject) Value() string {
+	return o.value
+}
+
+func (o *AccessTokenScopeObject) CanWrite() bool {
+	return o.value == "write"
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessTokenSecretPrefix tells personal access tokens apart from JWTs in the Authorization header
+const AccessTokenSecretPrefix = "knpat_"
+
+type AccessTokenSecretObject struct {
+	value string
+}
+
+func NewAccessTokenSecretObject(secret string) (*AccessTokenSecretObject, error) {
+	if !strings.HasPrefix(secret, AccessTokenSecretPrefix) || len(secret) == len(AccessTokenSecretPrefix) {
+		return nil, fmt.Errorf("access token secret must start with '%v'", AccessTokenSecretPrefix)
+	}
+	return &AccessTokenSecretObject{value: secret}, nil
+}
+
+func (o *AccessTokenSecretObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,38 @@
+package domain
+
+type AccessTokenWithoutAutofieldEntity struct {
+	name      AccessTokenNameObject
+	scope     AccessTokenScopeObject
+	projectId *ProjectIdObject
+	expiresAt *ExpiresAtObject
+}
+
+func NewAccessTokenWithoutAutofieldEntity(
+	name AccessTokenNameObject,
+	scope AccessTokenScopeObject,
+	projectId *ProjectIdObject,
+	expiresAt *ExpiresAtObject,
+) *AccessTokenWithoutAutofieldEntity {
+	return &AccessTokenWithoutAutofieldEntity{
+		name:      name,
+		scope:     scope,
+		projectId: projectId,
+		expiresAt: expiresAt,
+	}
+}
+
+func (e *AccessTokenWithoutAutofieldEntity) Name() *AccessTokenNameObject {
+	return &e.name
+}
+
+func (e *AccessTokenWithoutAutofieldEntity) Scope() *AccessTokenScopeObject {
+	return &e.scope
+}
+
+func (e *AccessTokenWithoutAutofieldEntity) ProjectId() *ProjectIdObject {
+	return e.projectId
+}
+
+func (e *AccessTokenWithoutAutofieldEntity) ExpiresAt() *ExpiresAtObject {
+	return e.expiresAt
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+type DeletedAtObject struct {
+	value time.Time
+}
+
+func NewDeletedAtObject(deletedAt time.Time) (*DeletedAtObject, error) {
+	return &DeletedAtObject{value: deletedAt}, nil
+}
+
+func (o *DeletedAtObject) Value() time.Time {
+	return o.value
+}
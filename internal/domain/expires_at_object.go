@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+type ExpiresAtObject struct {
+	value time.Time
+}
+
+func NewExpiresAtObject(expiresAt time.Time) (*ExpiresAtObject, error) {
+	return &ExpiresAtObject{value: expiresAt}, nil
+}
+
+func (o *ExpiresAtObject) Value() time.Time {
+	return o.value
+}
@@ -1,6 +1,7 @@
 package domain
 
 type GraphChildEntity struct {
+	id          *GraphChildIdObject
 	name        GraphNameObject
 	relation    GraphRelationObject
 	description GraphDescriptionObject
@@ -8,12 +9,14 @@ type GraphChildEntity struct {
 }
 
 func NewGraphChildEntity(
+	id *GraphChildIdObject,
 	name GraphNameObject,
 	relation GraphRelationObject,
 	description GraphDescriptionObject,
 	children GraphChildrenEntity,
 ) *GraphChildEntity {
 	return &GraphChildEntity{
+		id:          id,
 		name:        name,
 		relation:    relation,
 		description: description,
@@ -21,6 +24,11 @@ func NewGraphChildEntity(
 	}
 }
 
+// Id returns nil for a child which has not been stored yet
+func (e *GraphChildEntity) Id() *GraphChildIdObject {
+	return e.id
+}
+
 func (e *GraphChildEntity) Name() *GraphNameObject {
 	return &e.name
 }
@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type GraphChildIdObject struct {
+	value string
+}
+
+func NewGraphChildIdObject(childId string) (*GraphChildIdObject, error) {
+	if childId == "" {
+		return nil, fmt.Errorf("graph child id is required, but got '%v'", childId)
+	}
+	return &GraphChildIdObject{value: childId}, nil
+}
+
+func (o *GraphChildIdObject) Value() string {
+	return o.value
+}
@@ -26,3 +26,145 @@ func (e *GraphChildrenEntity) Value() []GraphChildEntity {
 func (e *GraphChildrenEntity) Len() int {
 	return e.len
 }
+
+func (e *GraphChildrenEntity) AddChild(parentPath GraphPathObject, child GraphChildEntity) (*GraphChildrenEntity, error) {
+	return e.updateChildren(parentPath, func(children []GraphChildEntity) ([]GraphChildEntity, error) {
+		return append(children, child), nil
+	})
+}
+
+func (e *GraphChildrenEntity) RenameChild(path GraphPathObject, name GraphNameObject) (*GraphChildrenEntity, error) {
+	return e.updateChild(path, func(child GraphChildEntity) GraphChildEntity {
+		return *NewGraphChildEntity(child.id, name, child.relation, child.description, child.children)
+	})
+}
+
+func (e *GraphChildrenEntity) UpdateChild(
+	path GraphPathObject,
+	relation GraphRelationObject,
+	description GraphDescriptionObject,
+) (*GraphChildrenEntity, error) {
+	return e.updateChild(path, func(child GraphChildEntity) GraphChildEntity {
+		return *NewGraphChildEntity(child.id, child.name, relation, description, child.children)
+	})
+}
+
+func (e *GraphChildrenEntity) MoveChild(path GraphPathObject, parentPath GraphPathObject) (*GraphChildrenEntity, error) {
+	child, err := e.FindChild(path)
+	if err != nil {
+		return nil, err
+	}
+	if parentPath.hasPrefix(path) {
+		return nil, fmt.Errorf("graph child cannot be moved into itself, but got %v", parentPath.String())
+	}
+
+	removed, err := e.DeleteChild(path)
+	if err != nil {
+		return nil, err
+	}
+	return removed.AddChild(parentPath, *child)
+}
+
+func (e *GraphChildrenEntity) DeleteChild(path GraphPathObject) (*GraphChildrenEntity, error) {
+	if path.Len() == 0 {
+		return nil, fmt.Errorf("graph path is required, but got []")
+	}
+
+	names := path.Value()
+	last := names[len(names)-1]
+	parentPath := GraphPathObject{value: names[:len(names)-1]}
+	return e.updateChildren(parentPath, func(children []GraphChildEntity) ([]GraphChildEntity, error) {
+		for i, child := range children {
+			if child.name.Value() == last.Value() {
+				return append(children[:i:i], children[i+1:]...), nil
+			}
+		}
+		return nil, fmt.Errorf("graph child is not found at %v", path.String())
+	})
+}
+
+func (e *GraphChildrenEntity) FindChild(path GraphPathObject) (*GraphChildEntity, error) {
+	if path.Len() == 0 {
+		return nil, fmt.Errorf("graph path is required, but got []")
+	}
+
+	children := e
+	var found *GraphChildEntity
+	for _, name := range path.Value() {
+		found = nil
+		for i := range children.children {
+			if children.children[i].name.Value() == name.Value() {
+				found = &children.children[i]
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("graph child is not found at %v", path.String())
+		}
+		children = &found.children
+	}
+	return found, nil
+}
+
+func (e *GraphChildrenEntity) updateChild(
+	path GraphPathObject,
+	update func(child GraphChildEntity) GraphChildEntity,
+) (*GraphChildrenEntity, error) {
+	if path.Len() == 0 {
+		return nil, fmt.Errorf("graph path is required, but got []")
+	}
+
+	names := path.Value()
+	last := names[len(names)-1]
+	parentPath := GraphPathObject{value: names[:len(names)-1]}
+	return e.updateChildren(parentPath, func(children []GraphChildEntity) ([]GraphChildEntity, error) {
+		for i, child := range children {
+			if child.name.Value() == last.Value() {
+				updated := append([]GraphChildEntity{}, children...)
+				updated[i] = update(child)
+				return updated, nil
+			}
+		}
+		return nil, fmt.Errorf("graph child is not found at %v", path.String())
+	})
+}
+
+func (e *GraphChildrenEntity) updateChildren(
+	parentPath GraphPathObject,
+	update func(children []GraphChildEntity) ([]GraphChildEntity, error),
+) (*GraphChildrenEntity, error) {
+	if parentPath.Len() > 0 {
+		_, err := e.FindChild(parentPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.replaceChildren(parentPath.Value(), update)
+}
+
+func (e *GraphChildrenEntity) replaceChildren(
+	parentPath []GraphNameObject,
+	update func(children []GraphChildEntity) ([]GraphChildEntity, error),
+) (*GraphChildrenEntity, error) {
+	if len(parentPath) == 0 {
+		children, err := update(append([]GraphChildEntity{}, e.children...))
+		if err != nil {
+			return nil, err
+		}
+		return NewGraphChildrenEntity(children)
+	}
+
+	for i, child := range e.children {
+		if child.name.Value() != parentPath[0].Value() {
+			continue
+		}
+		grandchildren, err := child.children.replaceChildren(parentPath[1:], update)
+		if err != nil {
+			return nil, err
+		}
+		children := append([]GraphChildEntity{}, e.children...)
+		children[i] = *NewGraphChildEntity(child.id, child.name, child.relation, child.description, *grandchildren)
+		return NewGraphChildrenEntity(children)
+	}
+	return nil, fmt.Errorf("graph child is not found")
+}
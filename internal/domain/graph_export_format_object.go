@@ -0,0 +1,25 @@
+package domain
+
+import "fmt"
+
+type GraphExportFormatObject struct {
+	value string
+}
+
+var graphExportFormats = map[string]struct{}{
+	"cytoscape": {},
+	"dot":       {},
+	"graphml":   {},
+	"mermaid":   {},
+}
+
+func NewGraphExportFormatObject(format string) (*GraphExportFormatObject, error) {
+	if _, ok := graphExportFormats[format]; !ok {
+		return nil, fmt.Errorf("export format must be one of cytoscape, dot, graphml, mermaid, but got '%v'", format)
+	}
+	return &GraphExportFormatObject{value: format}, nil
+}
+
+func (o *GraphExportFormatObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "fmt"
+
+type GraphImportContentObject struct {
+	value string
+}
+
+func NewGraphImportContentObject(content string) (*GraphImportContentObject, error) {
+	contentLen := len(content)
+	if contentLen == 0 {
+		return nil, fmt.Errorf("import content is required, but got ''")
+	}
+	if contentLen > 1000000 {
+		return nil, fmt.Errorf("import content must be less than or equal to 1000000 bytes, but got %v bytes", contentLen)
+	}
+	return &GraphImportContentObject{value: content}, nil
+}
+
+func (o GraphImportContentObject) Value() string {
+	return o.value
+}
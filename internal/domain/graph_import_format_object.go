@@ -0,0 +1,25 @@
+package domain
+
+import "fmt"
+
+type GraphImportFormatObject struct {
+	value string
+}
+
+var graphImportFormats = map[string]struct{}{
+	"graphml":  {},
+	"markdown": {},
+	"mermaid":  {},
+	"opml":     {},
+}
+
+func NewGraphImportFormatObject(format string) (*GraphImportFormatObject, error) {
+	if _, ok := graphImportFormats[format]; !ok {
+		return nil, fmt.Errorf("import format must be one of graphml, markdown, mermaid, opml, but got '%v'", format)
+	}
+	return &GraphImportFormatObject{value: format}, nil
+}
+
+func (o *GraphImportFormatObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,18 @@
+package domain
+
+type GraphMatchEntity struct {
+	name    SectionNameObject
+	graphId *GraphIdObject
+}
+
+func NewGraphMatchEntity(name SectionNameObject, graphId *GraphIdObject) *GraphMatchEntity {
+	return &GraphMatchEntity{name: name, graphId: graphId}
+}
+
+func (e *GraphMatchEntity) Name() *SectionNameObject {
+	return &e.name
+}
+
+func (e *GraphMatchEntity) GraphId() *GraphIdObject {
+	return e.graphId
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "fmt"
+
+type GraphOrphanActionObject struct {
+	value string
+}
+
+var graphOrphanActions = map[string]struct{}{
+	"archive": {},
+	"delete":  {},
+}
+
+func NewGraphOrphanActionObject(action string) (*GraphOrphanActionObject, error) {
+	if _, ok := graphOrphanActions[action]; !ok {
+		return nil, fmt.Errorf("orphan action must be one of archive, delete, but got '%v'", action)
+	}
+	return &GraphOrphanActionObject{value: action}, nil
+}
+
+func (o *GraphOrphanActionObject) Value() string {
+	return o.value
+}
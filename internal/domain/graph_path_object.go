@@ -0,0 +1,51 @@
+package domain
+
+import "fmt"
+
+type GraphPathObject struct {
+	value []GraphNameObject
+}
+
+func NewGraphPathObject(path []string) (*GraphPathObject, error) {
+	if len(path) > 100 {
+		return nil, fmt.Errorf("graph path cannot be deeper than 100, but got %v", len(path))
+	}
+
+	names := make([]GraphNameObject, len(path))
+	for i, name := range path {
+		nameObject, err := NewGraphNameObject(name)
+		if err != nil {
+			return nil, fmt.Errorf("graph path is invalid at %v: %w", i, err)
+		}
+		names[i] = *nameObject
+	}
+	return &GraphPathObject{value: names}, nil
+}
+
+func (o *GraphPathObject) Value() []GraphNameObject {
+	return o.value
+}
+
+func (o *GraphPathObject) Len() int {
+	return len(o.value)
+}
+
+func (o *GraphPathObject) String() string {
+	names := make([]string, len(o.value))
+	for i, name := range o.value {
+		names[i] = name.Value()
+	}
+	return fmt.Sprintf("%q", names)
+}
+
+func (o *GraphPathObject) hasPrefix(prefix GraphPathObject) bool {
+	if len(prefix.value) > len(o.value) {
+		return false
+	}
+	for i, name := range prefix.value {
+		if o.value[i].Value() != name.Value() {
+			return false
+		}
+	}
+	return true
+}
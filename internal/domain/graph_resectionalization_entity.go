@@ -0,0 +1,21 @@
+package domain
+
+type GraphResectionalizationEntity struct {
+	matches []GraphMatchEntity
+	orphans []GraphEntity
+}
+
+func NewGraphResectionalizationEntity(
+	matches []GraphMatchEntity,
+	orphans []GraphEntity,
+) *GraphResectionalizationEntity {
+	return &GraphResectionalizationEntity{matches: matches, orphans: orphans}
+}
+
+func (e *GraphResectionalizationEntity) Matches() []GraphMatchEntity {
+	return e.matches
+}
+
+func (e *GraphResectionalizationEntity) Orphans() []GraphEntity {
+	return e.orphans
+}
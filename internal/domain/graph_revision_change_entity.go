@@ -0,0 +1,45 @@
+package domain
+
+type GraphRevisionChangeEntity struct {
+	changeType GraphRevisionChangeTypeObject
+	fromPath   []GraphNameObject
+	toPath     []GraphNameObject
+	fromValue  string
+	toValue    string
+}
+
+func NewGraphRevisionChangeEntity(
+	changeType GraphRevisionChangeTypeObject,
+	fromPath []GraphNameObject,
+	toPath []GraphNameObject,
+	fromValue string,
+	toValue string,
+) *GraphRevisionChangeEntity {
+	return &GraphRevisionChangeEntity{
+		changeType: changeType,
+		fromPath:   fromPath,
+		toPath:     toPath,
+		fromValue:  fromValue,
+		toValue:    toValue,
+	}
+}
+
+func (e *GraphRevisionChangeEntity) ChangeType() *GraphRevisionChangeTypeObject {
+	return &e.changeType
+}
+
+func (e *GraphRevisionChangeEntity) FromPath() []GraphNameObject {
+	return e.fromPath
+}
+
+func (e *GraphRevisionChangeEntity) ToPath() []GraphNameObject {
+	return e.toPath
+}
+
+func (e *GraphRevisionChangeEntity) FromValue() string {
+	return e.fromValue
+}
+
+func (e *GraphRevisionChangeEntity) ToValue() string {
+	return e.toValue
+}
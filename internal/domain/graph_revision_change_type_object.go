@@ -0,0 +1,28 @@
+package domain
+
+import "fmt"
+
+type GraphRevisionChangeTypeObject struct {
+	value string
+}
+
+var graphRevisionChangeTypes = map[string]struct{}{
+	"paragraph":   {},
+	"added":       {},
+	"removed":     {},
+	"renamed":     {},
+	"moved":       {},
+	"relation":    {},
+	"description": {},
+}
+
+func NewGraphRevisionChangeTypeObject(changeType string) (*GraphRevisionChangeTypeObject, error) {
+	if _, ok := graphRevisionChangeTypes[changeType]; !ok {
+		return nil, fmt.Errorf("graph revision change type is unknown, but got '%v'", changeType)
+	}
+	return &GraphRevisionChangeTypeObject{value: changeType}, nil
+}
+
+func (o *GraphRevisionChangeTypeObject) Value() string {
+	return o.value
+}
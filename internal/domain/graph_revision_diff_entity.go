@@ -0,0 +1,18 @@
+package domain
+
+type GraphRevisionDiffEntity struct {
+	changes []GraphRevisionChangeEntity
+	len     int
+}
+
+func NewGraphRevisionDiffEntity(changes []GraphRevisionChangeEntity) *GraphRevisionDiffEntity {
+	return &GraphRevisionDiffEntity{changes: changes, len: len(changes)}
+}
+
+func (e *GraphRevisionDiffEntity) Value() []GraphRevisionChangeEntity {
+	return e.changes
+}
+
+func (e *GraphRevisionDiffEntity) Len() int {
+	return e.len
+}
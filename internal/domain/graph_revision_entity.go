@@ -0,0 +1,45 @@
+package domain
+
+type GraphRevisionEntity struct {
+	id        GraphRevisionIdObject
+	paragraph GraphParagraphObject
+	children  GraphChildrenEntity
+	authorId  UserIdObject
+	createdAt CreatedAtObject
+}
+
+func NewGraphRevisionEntity(
+	id GraphRevisionIdObject,
+	paragraph GraphParagraphObject,
+	children GraphChildrenEntity,
+	authorId UserIdObject,
+	createdAt CreatedAtObject,
+) *GraphRevisionEntity {
+	return &GraphRevisionEntity{
+		id:        id,
+		paragraph: paragraph,
+		children:  children,
+		authorId:  authorId,
+		createdAt: createdAt,
+	}
+}
+
+func (e *GraphRevisionEntity) Id() *GraphRevisionIdObject {
+	return &e.id
+}
+
+func (e *GraphRevisionEntity) Paragraph() *GraphParagraphObject {
+	return &e.paragraph
+}
+
+func (e *GraphRevisionEntity) Children() *GraphChildrenEntity {
+	return &e.children
+}
+
+func (e *GraphRevisionEntity) AuthorId() *UserIdObject {
+	return &e.authorId
+}
+
+func (e *GraphRevisionEntity) CreatedAt() *CreatedAtObject {
+	return &e.createdAt
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type GraphRevisionIdObject struct {
+	value string
+}
+
+func NewGraphRevisionIdObject(revisionId string) (*GraphRevisionIdObject, error) {
+	if revisionId == "" {
+		return nil, fmt.Errorf("graph revision id is required, but got '%v'", revisionId)
+	}
+	return &GraphRevisionIdObject{value: revisionId}, nil
+}
+
+func (o *GraphRevisionIdObject) Value() string {
+	return o.value
+}
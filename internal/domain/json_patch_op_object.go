@@ -0,0 +1,27 @@
+package domain
+
+import "fmt"
+
+type JsonPatchOpObject struct {
+	value string
+}
+
+var jsonPatchOps = map[string]struct{}{
+	"add":     {},
+	"remove":  {},
+	"replace": {},
+	"move":    {},
+	"copy":    {},
+	"test":    {},
+}
+
+func NewJsonPatchOpObject(op string) (*JsonPatchOpObject, error) {
+	if _, ok := jsonPatchOps[op]; !ok {
+		return nil, fmt.Errorf("json patch op must be one of add, remove, replace, move, copy, test, but got '%v'", op)
+	}
+	return &JsonPatchOpObject{value: op}, nil
+}
+
+func (o *JsonPatchOpObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+)
+
+type JsonPatchOperationEntity struct {
+	op    JsonPatchOpObject
+	path  JsonPointerObject
+	from  *JsonPointerObject
+	value any
+}
+
+var jsonArrayIndexRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*)$`)
+
+func NewJsonPatchOperationEntity(
+	op JsonPatchOpObject,
+	path JsonPointerObject,
+	from *JsonPointerObject,
+	value any,
+	hasValue bool,
+) (*JsonPatchOperationEntity, error) {
+	switch op.Value() {
+	case "move", "copy":
+		if from == nil {
+			return nil, fmt.Errorf("json patch from is required for %v operation", op.Value())
+		}
+		if op.Value() == "move" && path.hasProperPrefix(*from) {
+			return nil, fmt.Errorf("json patch cannot move a value into its own child, but got from '%v' and path '%v'",
+				from.Value(), path.Value())
+		}
+	case "add", "replace", "test":
+		// null is a valid value, so only a missing value is rejected
+		if !hasValue {
+			return nil, fmt.Errorf("json patch value is required for %v operation", op.Value())
+		}
+	}
+
+	return &JsonPatchOperationEntity{op: op, path: path, from: from, value: value}, nil
+}
+
+func (e *JsonPatchOperationEntity) Op() *JsonPatchOpObject {
+	return &e.op
+}
+
+func (e *JsonPatchOperationEntity) Path() *JsonPointerObject {
+	return &e.path
+}
+
+func (e *JsonPatchOperationEntity) From() *JsonPointerObject {
+	return e.from
+}
+
+func (e *JsonPatchOperationEntity) Value() any {
+	return e.value
+}
+
+// Apply applies the operation to a document decoded by encoding/json into generic values.
+// Objects in the document may be modified in place.
+func (e *JsonPatchOperationEntity) Apply(document any) (any, error) {
+	switch e.op.Value() {
+	case "add":
+		return jsonAdd(document, e.path, jsonDeepCopy(e.value))
+	case "remove":
+		document, _, err := jsonRemove(document, e.path)
+		return document, err
+	case "replace":
+		return jsonReplace(document, e.path, jsonDeepCopy(e.value))
+	case "move":
+		document, value, err := jsonRemove(document, *e.from)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(document, e.path, value)
+	case "copy":
+		value, err := jsonGet(document, *e.from)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(document, e.path, jsonDeepCopy(value))
+	default:
+		value, err := jsonGet(document, e.path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, e.value) {
+			return nil, fmt.Errorf("json patch test failed, value at '%v' is not equal to the expected one", e.path.Value())
+		}
+		return document, nil
+	}
+}
+
+func jsonGet(document any, pointer JsonPointerObject) (any, error) {
+	value := document
+	for _, token := range pointer.tokens {
+		child, err := jsonChild(value, pointer, token)
+		if err != nil {
+			return nil, err
+		}
+		value = child
+	}
+	return value, nil
+}
+
+func jsonAdd(document any, pointer JsonPointerObject, value any) (any, error) {
+	if len(pointer.tokens) == 0 {
+		return value, nil
+	}
+	return jsonUpdate(document, pointer, pointer.tokens, func(parent any, token string) (any, error) {
+		switch parent := parent.(type) {
+		case map[string]any:
+			parent[token] = value
+			return parent, nil
+		case []any:
+			if token == "-" {
+				return append(parent, value), nil
+			}
+			index, ok := jsonArrayIndex(token, len(parent))
+			if !ok {
+				return nil, fmt.Errorf("json patch array index is out of range, but got '%v'", pointer.Value())
+			}
+			added := make([]any, 0, len(parent)+1)
+			added = append(added, parent[:index]...)
+			added = append(added, value)
+			return append(added, parent[index:]...), nil
+		}
+		return nil, jsonNotFoundError(pointer)
+	})
+}
+
+func jsonRemove(document any, pointer JsonPointerObject) (any, any, error) {
+	if len(pointer.tokens) == 0 {
+		return nil, nil, fmt.Errorf("json patch cannot remove the whole document")
+	}
+	var removed any
+	document, err := jsonUpdate(document, pointer, pointer.tokens, func(parent any, token string) (any, error) {
+		switch parent := parent.(type) {
+		case map[string]any:
+			value, ok := parent[token]
+			if !ok {
+				return nil, jsonNotFoundError(pointer)
+			}
+			removed = value
+			delete(parent, token)
+			return parent, nil
+		case []any:
+			index, ok := jsonArrayIndex(token, len(parent)-1)
+			if !ok {
+				return nil, jsonNotFoundError(pointer)
+			}
+			removed = parent[index]
+			return append(parent[:index:index], parent[index+1:]...), nil
+		}
+		return nil, jsonNotFoundError(pointer)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return document, removed, nil
+}
+
+func jsonReplace(document any, pointer JsonPointerObject, value any) (any, error) {
+	if len(pointer.tokens) == 0 {
+		return value, nil
+	}
+	return jsonUpdate(document, pointer, pointer.tokens, func(parent any, token string) (any, error) {
+		if _, err := jsonChild(parent, pointer, token); err != nil {
+			return nil, err
+		}
+		return jsonSetChild(parent, token, value), nil
+	})
+}
+
+// jsonUpdate replaces the parent of the value pointed by tokens with the result of update
+func jsonUpdate(
+	node any,
+	pointer JsonPointerObject,
+	tokens []string,
+	update func(parent any, token string) (any, error),
+) (any, error) {
+	if len(tokens) == 1 {
+		return update(node, tokens[0])
+	}
+
+	child, err := jsonChild(node, pointer, tokens[0])
+	if err != nil {
+		return nil, err
+	}
+	child, err = jsonUpdate(child, pointer, tokens[1:], update)
+	if err != nil {
+		return nil, err
+	}
+	return jsonSetChild(node, tokens[0], child), nil
+}
+
+func jsonChild(node any, pointer JsonPointerObject, token string) (any, error) {
+	switch node := node.(type) {
+	case map[string]any:
+		child, ok := node[token]
+		if !ok {
+			return nil, jsonNotFoundError(pointer)
+		}
+		return child, nil
+	case []any:
+		index, ok := jsonArrayIndex(token, len(node)-1)
+		if !ok {
+			return nil, jsonNotFoundError(pointer)
+		}
+		return node[index], nil
+	}
+	return nil, jsonNotFoundError(pointer)
+}
+
+// jsonSetChild sets the existing child of node, which is known to be an object or an array
+func jsonSetChild(node any, token string, child any) any {
+	switch node := node.(type) {
+	case map[string]any:
+		node[token] = child
+		return node
+	case []any:
+		index, _ := strconv.Atoi(token)
+		node[index] = child
+		return node
+	}
+	return node
+}
+
+func jsonArrayIndex(token string, max int) (int, bool) {
+	if !jsonArrayIndexRegexp.MatchString(token) {
+		return 0, false
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index > max {
+		return 0, false
+	}
+	return index, true
+}
+
+func jsonDeepCopy(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, child := range value {
+			copied[key] = jsonDeepCopy(child)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(value))
+		for i, child := range value {
+			copied[i] = jsonDeepCopy(child)
+		}
+		return copied
+	}
+	return value
+}
+
+func jsonNotFoundError(pointer JsonPointerObject) error {
+	return fmt.Errorf("json patch target does not exist, but got '%v'", pointer.Value())
+}
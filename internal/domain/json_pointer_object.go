@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type JsonPointerObject struct {
+	value  string
+	tokens []string
+}
+
+var jsonPointerInvalidEscapeRegexp = regexp.MustCompile(`~([^01]|$)`)
+
+func NewJsonPointerObject(pointer string) (*JsonPointerObject, error) {
+	if pointer == "" {
+		return &JsonPointerObject{value: pointer, tokens: []string{}}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("json pointer must be empty or start with '/', but got '%v'", pointer)
+	}
+	if jsonPointerInvalidEscapeRegexp.MatchString(pointer) {
+		return nil, fmt.Errorf("json pointer must escape '~' as '~0', but got '%v'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return &JsonPointerObject{value: pointer, tokens: tokens}, nil
+}
+
+func (o *JsonPointerObject) Value() string {
+	return o.value
+}
+
+func (o *JsonPointerObject) Tokens() []string {
+	return o.tokens
+}
+
+func (o *JsonPointerObject) hasProperPrefix(prefix JsonPointerObject) bool {
+	if len(prefix.tokens) >= len(o.tokens) {
+		return false
+	}
+	for i, token := range prefix.tokens {
+		if o.tokens[i] != token {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package domain
+
+type LinkEndpointEntity struct {
+	chapterId ChapterIdObject
+	sectionId SectionIdObject
+	nodeId    *GraphChildIdObject
+}
+
+func NewLinkEndpointEntity(
+	chapterId ChapterIdObject,
+	sectionId SectionIdObject,
+	nodeId *GraphChildIdObject,
+) *LinkEndpointEntity {
+	return &LinkEndpointEntity{
+		chapterId: chapterId,
+		sectionId: sectionId,
+		nodeId:    nodeId,
+	}
+}
+
+func (e *LinkEndpointEntity) ChapterId() *ChapterIdObject {
+	return &e.chapterId
+}
+
+func (e *LinkEndpointEntity) SectionId() *SectionIdObject {
+	return &e.sectionId
+}
+
+// NodeId returns nil for an endpoint which refers to the root of the graph
+func (e *LinkEndpointEntity) NodeId() *GraphChildIdObject {
+	return e.nodeId
+}
+
+func (e *LinkEndpointEntity) Equals(other *LinkEndpointEntity) bool {
+	if e.chapterId.Value() != other.chapterId.Value() || e.sectionId.Value() != other.sectionId.Value() {
+		return false
+	}
+	if e.nodeId == nil || other.nodeId == nil {
+		return e.nodeId == nil && other.nodeId == nil
+	}
+	return e.nodeId.Value() == other.nodeId.Value()
+}
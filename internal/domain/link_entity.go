@@ -0,0 +1,59 @@
+package domain
+
+type LinkEntity struct {
+	id          LinkIdObject
+	from        LinkEndpointEntity
+	to          LinkEndpointEntity
+	relation    GraphRelationObject
+	description GraphDescriptionObject
+	createdAt   CreatedAtObject
+	updatedAt   UpdatedAtObject
+}
+
+func NewLinkEntity(
+	id LinkIdObject,
+	from LinkEndpointEntity,
+	to LinkEndpointEntity,
+	relation GraphRelationObject,
+	description GraphDescriptionObject,
+	createdAt CreatedAtObject,
+	updatedAt UpdatedAtObject,
+) *LinkEntity {
+	return &LinkEntity{
+		id:          id,
+		from:        from,
+		to:          to,
+		relation:    relation,
+		description: description,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (e *LinkEntity) Id() *LinkIdObject {
+	return &e.id
+}
+
+func (e *LinkEntity) From() *LinkEndpointEntity {
+	return &e.from
+}
+
+func (e *LinkEntity) To() *LinkEndpointEntity {
+	return &e.to
+}
+
+func (e *LinkEntity) Relation() *GraphRelationObject {
+	return &e.relation
+}
+
+func (e *LinkEntity) Description() *GraphDescriptionObject {
+	return &e.description
+}
+
+func (e *LinkEntity) CreatedAt() *CreatedAtObject {
+	return &e.createdAt
+}
+
+func (e *LinkEntity) UpdatedAt() *UpdatedAtObject {
+	return &e.updatedAt
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type LinkIdObject struct {
+	value string
+}
+
+func NewLinkIdObject(linkId string) (*LinkIdObject, error) {
+	if linkId == "" {
+		return nil, fmt.Errorf("link id is required, but got '%v'", linkId)
+	}
+	return &LinkIdObject{value: linkId}, nil
+}
+
+func (o *LinkIdObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "errors"
+
+type LinkWithoutAutofieldEntity struct {
+	from        LinkEndpointEntity
+	to          LinkEndpointEntity
+	relation    GraphRelationObject
+	description GraphDescriptionObject
+}
+
+func NewLinkWithoutAutofieldEntity(
+	from LinkEndpointEntity,
+	to LinkEndpointEntity,
+	relation GraphRelationObject,
+	description GraphDescriptionObject,
+) (*LinkWithoutAutofieldEntity, error) {
+	if from.Equals(&to) {
+		return nil, errors.New("link cannot connect a node to itself")
+	}
+	return &LinkWithoutAutofieldEntity{
+		from:        from,
+		to:          to,
+		relation:    relation,
+		description: description,
+	}, nil
+}
+
+func (e *LinkWithoutAutofieldEntity) From() *LinkEndpointEntity {
+	return &e.from
+}
+
+func (e *LinkWithoutAutofieldEntity) To() *LinkEndpointEntity {
+	return &e.to
+}
+
+func (e *LinkWithoutAutofieldEntity) Relation() *GraphRelationObject {
+	return &e.relation
+}
+
+func (e *LinkWithoutAutofieldEntity) Description() *GraphDescriptionObject {
+	return &e.description
+}
@@ -0,0 +1,13 @@
+package domain
+
+type PaperRevisionDiffObject struct {
+	value string
+}
+
+func NewPaperRevisionDiffObject(diff string) (*PaperRevisionDiffObject, error) {
+	return &PaperRevisionDiffObject{value: diff}, nil
+}
+
+func (o *PaperRevisionDiffObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,38 @@
+package domain
+
+type PaperRevisionEntity struct {
+	id        PaperRevisionIdObject
+	content   PaperContentObject
+	authorId  UserIdObject
+	createdAt CreatedAtObject
+}
+
+func NewPaperRevisionEntity(
+	id PaperRevisionIdObject,
+	content PaperContentObject,
+	authorId UserIdObject,
+	createdAt CreatedAtObject,
+) *PaperRevisionEntity {
+	return &PaperRevisionEntity{
+		id:        id,
+		content:   content,
+		authorId:  authorId,
+		createdAt: createdAt,
+	}
+}
+
+func (e *PaperRevisionEntity) Id() *PaperRevisionIdObject {
+	return &e.id
+}
+
+func (e *PaperRevisionEntity) Content() *PaperContentObject {
+	return &e.content
+}
+
+func (e *PaperRevisionEntity) AuthorId() *UserIdObject {
+	return &e.authorId
+}
+
+func (e *PaperRevisionEntity) CreatedAt() *CreatedAtObject {
+	return &e.createdAt
+}
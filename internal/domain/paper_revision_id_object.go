@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type PaperRevisionIdObject struct {
+	value string
+}
+
+func NewPaperRevisionIdObject(revisionId string) (*PaperRevisionIdObject, error) {
+	if revisionId == "" {
+		return nil, fmt.Errorf("paper revision id is required, but got '%v'", revisionId)
+	}
+	return &PaperRevisionIdObject{value: revisionId}, nil
+}
+
+func (o *PaperRevisionIdObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "fmt"
+
+type ProjectArchiveChapterEntity struct {
+	id       ChapterIdObject
+	name     ChapterNameObject
+	paper    PaperContentObject
+	sections []ProjectArchiveSectionEntity
+}
+
+func NewProjectArchiveChapterEntity(
+	id ChapterIdObject,
+	name ChapterNameObject,
+	paper PaperContentObject,
+	sections []ProjectArchiveSectionEntity,
+) (*ProjectArchiveChapterEntity, error) {
+	ids := make(map[string]struct{}, len(sections))
+	for _, section := range sections {
+		if _, ok := ids[section.Id().Value()]; ok {
+			return nil, fmt.Errorf("section ids must be unique, but got '%v' duplicated", section.Id().Value())
+		}
+		ids[section.Id().Value()] = struct{}{}
+	}
+
+	return &ProjectArchiveChapterEntity{
+		id:       id,
+		name:     name,
+		paper:    paper,
+		sections: sections,
+	}, nil
+}
+
+// Id returns the chapter id in the archive, which is replaced with a new one on import
+func (e *ProjectArchiveChapterEntity) Id() *ChapterIdObject {
+	return &e.id
+}
+
+func (e *ProjectArchiveChapterEntity) Name() *ChapterNameObject {
+	return &e.name
+}
+
+func (e *ProjectArchiveChapterEntity) Paper() *PaperContentObject {
+	return &e.paper
+}
+
+func (e *ProjectArchiveChapterEntity) Sections() []ProjectArchiveSectionEntity {
+	return e.sections
+}
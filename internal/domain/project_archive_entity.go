@@ -0,0 +1,69 @@
+package domain
+
+import "fmt"
+
+type ProjectArchiveEntity struct {
+	project  ProjectWithoutAutofieldEntity
+	chapters []ProjectArchiveChapterEntity
+	links    []LinkWithoutAutofieldEntity
+}
+
+func NewProjectArchiveEntity(
+	project ProjectWithoutAutofieldEntity,
+	chapters []ProjectArchiveChapterEntity,
+	links []LinkWithoutAutofieldEntity,
+) (*ProjectArchiveEntity, error) {
+	endpoints := make(map[string]map[string]map[string]struct{}, len(chapters))
+	for _, chapter := range chapters {
+		if _, ok := endpoints[chapter.Id().Value()]; ok {
+			return nil, fmt.Errorf("chapter ids must be unique, but got '%v' duplicated", chapter.Id().Value())
+		}
+		sections := make(map[string]map[string]struct{}, len(chapter.Sections()))
+		for _, section := range chapter.Sections() {
+			nodeIds := map[string]struct{}{}
+			collectArchiveNodeIds(section.Children(), nodeIds)
+			sections[section.Id().Value()] = nodeIds
+		}
+		endpoints[chapter.Id().Value()] = sections
+	}
+
+	for i, link := range links {
+		for _, endpoint := range []*LinkEndpointEntity{link.From(), link.To()} {
+			nodeIds, ok := endpoints[endpoint.ChapterId().Value()][endpoint.SectionId().Value()]
+			if !ok {
+				return nil, fmt.Errorf("link endpoint does not exist in archive at %v", i)
+			}
+			if endpoint.NodeId() == nil {
+				continue
+			}
+			if _, ok := nodeIds[endpoint.NodeId().Value()]; !ok {
+				return nil, fmt.Errorf("link endpoint does not exist in archive at %v", i)
+			}
+		}
+	}
+
+	return &ProjectArchiveEntity{project: project, chapters: chapters, links: links}, nil
+}
+
+func collectArchiveNodeIds(children *GraphChildrenEntity, nodeIds map[string]struct{}) {
+	for _, child := range children.Value() {
+		if child.Id() != nil {
+			nodeIds[child.Id().Value()] = struct{}{}
+		}
+		collectArchiveNodeIds(child.Children(), nodeIds)
+	}
+}
+
+func (e *ProjectArchiveEntity) Project() *ProjectWithoutAutofieldEntity {
+	return &e.project
+}
+
+// Chapters returns chapters in the order of their numbers
+func (e *ProjectArchiveEntity) Chapters() []ProjectArchiveChapterEntity {
+	return e.chapters
+}
+
+// Links returns links whose endpoints refer to ids in the archive
+func (e *ProjectArchiveEntity) Links() []LinkWithoutAutofieldEntity {
+	return e.links
+}
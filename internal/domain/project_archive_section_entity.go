@@ -0,0 +1,39 @@
+package domain
+
+type ProjectArchiveSectionEntity struct {
+	id        SectionIdObject
+	name      SectionNameObject
+	paragraph GraphParagraphObject
+	children  GraphChildrenEntity
+}
+
+func NewProjectArchiveSectionEntity(
+	id SectionIdObject,
+	name SectionNameObject,
+	paragraph GraphParagraphObject,
+	children GraphChildrenEntity,
+) *ProjectArchiveSectionEntity {
+	return &ProjectArchiveSectionEntity{
+		id:        id,
+		name:      name,
+		paragraph: paragraph,
+		children:  children,
+	}
+}
+
+// Id returns the section id in the archive, which is replaced with a new one on import
+func (e *ProjectArchiveSectionEntity) Id() *SectionIdObject {
+	return &e.id
+}
+
+func (e *ProjectArchiveSectionEntity) Name() *SectionNameObject {
+	return &e.name
+}
+
+func (e *ProjectArchiveSectionEntity) Paragraph() *GraphParagraphObject {
+	return &e.paragraph
+}
+
+func (e *ProjectArchiveSectionEntity) Children() *GraphChildrenEntity {
+	return &e.children
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "fmt"
+
+type ProjectCompileFormatObject struct {
+	value string
+}
+
+var projectCompileFormats = map[string]struct{}{
+	"epub":     {},
+	"html":     {},
+	"markdown": {},
+}
+
+func NewProjectCompileFormatObject(format string) (*ProjectCompileFormatObject, error) {
+	if _, ok := projectCompileFormats[format]; !ok {
+		return nil, fmt.Errorf("compile format must be one of epub, html, markdown, but got '%v'", format)
+	}
+	return &ProjectCompileFormatObject{value: format}, nil
+}
+
+func (o *ProjectCompileFormatObject) Value() string {
+	return o.value
+}
@@ -4,6 +4,7 @@ type ProjectEntity struct {
 	id          ProjectIdObject
 	name        ProjectNameObject
 	description ProjectDescriptionObject
+	role        ProjectRoleObject
 	createdAt   CreatedAtObject
 	updatedAt   UpdatedAtObject
 }
@@ -12,6 +13,7 @@ func NewProjectEntity(
 	id ProjectIdObject,
 	name ProjectNameObject,
 	description ProjectDescriptionObject,
+	role ProjectRoleObject,
 	createdAt CreatedAtObject,
 	updatedAt UpdatedAtObject,
 ) *ProjectEntity {
@@ -19,6 +21,7 @@ func NewProjectEntity(
 		id:          id,
 		name:        name,
 		description: description,
+		role:        role,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 	}
@@ -36,6 +39,10 @@ func (e *ProjectEntity) Description() *ProjectDescriptionObject {
 	return &e.description
 }
 
+func (e *ProjectEntity) Role() *ProjectRoleObject {
+	return &e.role
+}
+
 func (e *ProjectEntity) CreatedAt() *CreatedAtObject {
 	return &e.createdAt
 }
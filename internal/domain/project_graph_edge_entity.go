@@ -0,0 +1,46 @@
+package domain
+
+type ProjectGraphEdgeEntity struct {
+	source      GraphNameObject
+	target      GraphNameObject
+	relation    GraphRelationObject
+	description GraphDescriptionObject
+	occurrences []ProjectGraphOccurrenceEntity
+}
+
+func NewProjectGraphEdgeEntity(
+	source GraphNameObject,
+	target GraphNameObject,
+	relation GraphRelationObject,
+	description GraphDescriptionObject,
+	occurrences []ProjectGraphOccurrenceEntity,
+) *ProjectGraphEdgeEntity {
+	return &ProjectGraphEdgeEntity{
+		source:      source,
+		target:      target,
+		relation:    relation,
+		description: description,
+		occurrences: occurrences,
+	}
+}
+
+func (e *ProjectGraphEdgeEntity) Source() *GraphNameObject {
+	return &e.source
+}
+
+func (e *ProjectGraphEdgeEntity) Target() *GraphNameObject {
+	return &e.target
+}
+
+func (e *ProjectGraphEdgeEntity) Relation() *GraphRelationObject {
+	return &e.relation
+}
+
+func (e *ProjectGraphEdgeEntity) Description() *GraphDescriptionObject {
+	return &e.description
+}
+
+// Occurrences returns the child nodes which define the edge to their parents
+func (e *ProjectGraphEdgeEntity) Occurrences() []ProjectGraphOccurrenceEntity {
+	return e.occurrences
+}
@@ -0,0 +1,18 @@
+package domain
+
+type ProjectGraphEntity struct {
+	nodes []ProjectGraphNodeEntity
+	edges []ProjectGraphEdgeEntity
+}
+
+func NewProjectGraphEntity(nodes []ProjectGraphNodeEntity, edges []ProjectGraphEdgeEntity) *ProjectGraphEntity {
+	return &ProjectGraphEntity{nodes: nodes, edges: edges}
+}
+
+func (e *ProjectGraphEntity) Nodes() []ProjectGraphNodeEntity {
+	return e.nodes
+}
+
+func (e *ProjectGraphEntity) Edges() []ProjectGraphEdgeEntity {
+	return e.edges
+}
@@ -0,0 +1,21 @@
+package domain
+
+type ProjectGraphNodeEntity struct {
+	name        GraphNameObject
+	occurrences []ProjectGraphOccurrenceEntity
+}
+
+func NewProjectGraphNodeEntity(
+	name GraphNameObject,
+	occurrences []ProjectGraphOccurrenceEntity,
+) *ProjectGraphNodeEntity {
+	return &ProjectGraphNodeEntity{name: name, occurrences: occurrences}
+}
+
+func (e *ProjectGraphNodeEntity) Name() *GraphNameObject {
+	return &e.name
+}
+
+func (e *ProjectGraphNodeEntity) Occurrences() []ProjectGraphOccurrenceEntity {
+	return e.occurrences
+}
@@ -0,0 +1,32 @@
+package domain
+
+type ProjectGraphOccurrenceEntity struct {
+	chapterId ChapterIdObject
+	sectionId SectionIdObject
+	nodeId    *GraphChildIdObject
+}
+
+func NewProjectGraphOccurrenceEntity(
+	chapterId ChapterIdObject,
+	sectionId SectionIdObject,
+	nodeId *GraphChildIdObject,
+) *ProjectGraphOccurrenceEntity {
+	return &ProjectGraphOccurrenceEntity{
+		chapterId: chapterId,
+		sectionId: sectionId,
+		nodeId:    nodeId,
+	}
+}
+
+func (e *ProjectGraphOccurrenceEntity) ChapterId() *ChapterIdObject {
+	return &e.chapterId
+}
+
+func (e *ProjectGraphOccurrenceEntity) SectionId() *SectionIdObject {
+	return &e.sectionId
+}
+
+// NodeId returns nil for an occurrence as the root of the graph
+func (e *ProjectGraphOccurrenceEntity) NodeId() *GraphChildIdObject {
+	return e.nodeId
+}
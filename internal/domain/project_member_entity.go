@@ -0,0 +1,18 @@
+package domain
+
+type ProjectMemberEntity struct {
+	userId UserIdObject
+	role   ProjectRoleObject
+}
+
+func NewProjectMemberEntity(userId UserIdObject, role ProjectRoleObject) *ProjectMemberEntity {
+	return &ProjectMemberEntity{userId: userId, role: role}
+}
+
+func (e *ProjectMemberEntity) UserId() *UserIdObject {
+	return &e.userId
+}
+
+func (e *ProjectMemberEntity) Role() *ProjectRoleObject {
+	return &e.role
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "fmt"
+
+type ProjectRoleObject struct {
+	value string
+}
+
+var projectRoles = map[string]struct{}{
+	"owner":  {},
+	"editor": {},
+	"viewer": {},
+}
+
+func NewProjectRoleObject(role string) (*ProjectRoleObject, error) {
+	if _, ok := projectRoles[role]; !ok {
+		return nil, fmt.Errorf("project role must be owner, editor or viewer, but got '%v'", role)
+	}
+	return &ProjectRoleObject{value: role}, nil
+}
+
+// NewProjectMemberRoleObject accepts only the roles which can be given to a member,
+// since a project has exactly one owner
+func NewProjectMemberRoleObject(role string) (*ProjectRoleObject, error) {
+	if role != "editor" && role != "viewer" {
+		return nil, fmt.Errorf("project member role must be editor or viewer, but got '%v'", role)
+	}
+	return &ProjectRoleObject{value: role}, nil
+}
+
+func (o *ProjectRoleObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,70 @@
+package domain
+
+type SearchHitEntity struct {
+	kind      string
+	projectId ProjectIdObject
+	chapterId *ChapterIdObject
+	sectionId *SectionIdObject
+	nodeId    *GraphChildIdObject
+	title     string
+	snippet   SearchSnippetObject
+	score     float64
+}
+
+func NewSearchHitEntity(
+	kind string,
+	projectId ProjectIdObject,
+	chapterId *ChapterIdObject,
+	sectionId *SectionIdObject,
+	nodeId *GraphChildIdObject,
+	title string,
+	snippet SearchSnippetObject,
+	score float64,
+) *SearchHitEntity {
+	return &SearchHitEntity{
+		kind:      kind,
+		projectId: projectId,
+		chapterId: chapterId,
+		sectionId: sectionId,
+		nodeId:    nodeId,
+		title:     title,
+		snippet:   snippet,
+		score:     score,
+	}
+}
+
+// Kind returns one of project, chapter, section, node and paper
+func (e *SearchHitEntity) Kind() string {
+	return e.kind
+}
+
+func (e *SearchHitEntity) ProjectId() *ProjectIdObject {
+	return &e.projectId
+}
+
+// ChapterId returns nil for a hit of project
+func (e *SearchHitEntity) ChapterId() *ChapterIdObject {
+	return e.chapterId
+}
+
+// SectionId returns nil for a hit of project, chapter or paper
+func (e *SearchHitEntity) SectionId() *SectionIdObject {
+	return e.sectionId
+}
+
+// NodeId returns nil unless the hit is a graph node
+func (e *SearchHitEntity) NodeId() *GraphChildIdObject {
+	return e.nodeId
+}
+
+func (e *SearchHitEntity) Title() string {
+	return e.title
+}
+
+func (e *SearchHitEntity) Snippet() *SearchSnippetObject {
+	return &e.snippet
+}
+
+func (e *SearchHitEntity) Score() float64 {
+	return e.score
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type SearchLimitObject struct {
+	value int
+}
+
+func NewSearchLimitObject(limit int) (*SearchLimitObject, error) {
+	if limit < 1 || limit > 100 {
+		return nil, fmt.Errorf("search limit must be between 1 and 100, but got %v", limit)
+	}
+	return &SearchLimitObject{value: limit}, nil
+}
+
+func (o *SearchLimitObject) Value() int {
+	return o.value
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SearchQueryObject struct {
+	value string
+}
+
+func NewSearchQueryObject(query string) (*SearchQueryObject, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("search query is required, but got '%v'", query)
+	}
+	if len(query) > 200 {
+		return nil, fmt.Errorf("search query cannot be longer than 200 characters, but got '%v'", query)
+	}
+	return &SearchQueryObject{value: query}, nil
+}
+
+func (o *SearchQueryObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,36 @@
+package domain
+
+type SearchSnippetObject struct {
+	text       string
+	highlights []SearchHighlightObject
+}
+
+// SearchHighlightObject is a range in the snippet text, counted in Unicode code points
+type SearchHighlightObject struct {
+	offset int
+	length int
+}
+
+func NewSearchSnippetObject(text string, highlights []SearchHighlightObject) *SearchSnippetObject {
+	return &SearchSnippetObject{text: text, highlights: highlights}
+}
+
+func NewSearchHighlightObject(offset int, length int) *SearchHighlightObject {
+	return &SearchHighlightObject{offset: offset, length: length}
+}
+
+func (o *SearchSnippetObject) Text() string {
+	return o.text
+}
+
+func (o *SearchSnippetObject) Highlights() []SearchHighlightObject {
+	return o.highlights
+}
+
+func (o *SearchHighlightObject) Offset() int {
+	return o.offset
+}
+
+func (o *SearchHighlightObject) Length() int {
+	return o.length
+}
@@ -0,0 +1,38 @@
+package domain
+
+type ShareEntity struct {
+	token     ShareTokenObject
+	chapterId *ChapterIdObject
+	expiresAt *ExpiresAtObject
+	createdAt CreatedAtObject
+}
+
+func NewShareEntity(
+	token ShareTokenObject,
+	chapterId *ChapterIdObject,
+	expiresAt *ExpiresAtObject,
+	createdAt CreatedAtObject,
+) *ShareEntity {
+	return &ShareEntity{
+		token:     token,
+		chapterId: chapterId,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}
+}
+
+func (e *ShareEntity) Token() *ShareTokenObject {
+	return &e.token
+}
+
+func (e *ShareEntity) ChapterId() *ChapterIdObject {
+	return e.chapterId
+}
+
+func (e *ShareEntity) ExpiresAt() *ExpiresAtObject {
+	return e.expiresAt
+}
+
+func (e *ShareEntity) CreatedAt() *CreatedAtObject {
+	return &e.createdAt
+}
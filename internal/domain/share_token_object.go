@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type ShareTokenObject struct {
+	value string
+}
+
+func NewShareTokenObject(token string) (*ShareTokenObject, error) {
+	if token == "" {
+		return nil, fmt.Errorf("share token is required, but got '%v'", token)
+	}
+	return &ShareTokenObject{value: token}, nil
+}
+
+func (o *ShareTokenObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,24 @@
+package domain
+
+type ShareWithoutAutofieldEntity struct {
+	chapterId *ChapterIdObject
+	expiresAt *ExpiresAtObject
+}
+
+func NewShareWithoutAutofieldEntity(
+	chapterId *ChapterIdObject,
+	expiresAt *ExpiresAtObject,
+) *ShareWithoutAutofieldEntity {
+	return &ShareWithoutAutofieldEntity{
+		chapterId: chapterId,
+		expiresAt: expiresAt,
+	}
+}
+
+func (e *ShareWithoutAutofieldEntity) ChapterId() *ChapterIdObject {
+	return e.chapterId
+}
+
+func (e *ShareWithoutAutofieldEntity) ExpiresAt() *ExpiresAtObject {
+	return e.expiresAt
+}
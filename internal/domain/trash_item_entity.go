@@ -0,0 +1,59 @@
+package domain
+
+type TrashItemEntity struct {
+	id        TrashItemIdObject
+	itemType  TrashItemTypeObject
+	name      TrashItemNameObject
+	projectId ProjectIdObject
+	chapterId *ChapterIdObject
+	sectionId *SectionIdObject
+	deletedAt DeletedAtObject
+}
+
+func NewTrashItemEntity(
+	id TrashItemIdObject,
+	itemType TrashItemTypeObject,
+	name TrashItemNameObject,
+	projectId ProjectIdObject,
+	chapterId *ChapterIdObject,
+	sectionId *SectionIdObject,
+	deletedAt DeletedAtObject,
+) *TrashItemEntity {
+	return &TrashItemEntity{
+		id:        id,
+		itemType:  itemType,
+		name:      name,
+		projectId: projectId,
+		chapterId: chapterId,
+		sectionId: sectionId,
+		deletedAt: deletedAt,
+	}
+}
+
+func (e *TrashItemEntity) Id() *TrashItemIdObject {
+	return &e.id
+}
+
+func (e *TrashItemEntity) Type() *TrashItemTypeObject {
+	return &e.itemType
+}
+
+func (e *TrashItemEntity) Name() *TrashItemNameObject {
+	return &e.name
+}
+
+func (e *TrashItemEntity) ProjectId() *ProjectIdObject {
+	return &e.projectId
+}
+
+func (e *TrashItemEntity) ChapterId() *ChapterIdObject {
+	return e.chapterId
+}
+
+func (e *TrashItemEntity) SectionId() *SectionIdObject {
+	return e.sectionId
+}
+
+func (e *TrashItemEntity) DeletedAt() *DeletedAtObject {
+	return &e.deletedAt
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "fmt"
+
+type TrashItemIdObject struct {
+	value string
+}
+
+func NewTrashItemIdObject(itemId string) (*TrashItemIdObject, error) {
+	if itemId == "" {
+		return nil, fmt.Errorf("trash item id is required, but got '%v'", itemId)
+	}
+	return &TrashItemIdObject{value: itemId}, nil
+}
+
+func (o *TrashItemIdObject) Value() string {
+	return o.value
+}
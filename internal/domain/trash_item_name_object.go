@@ -0,0 +1,21 @@
+package domain
+
+import "fmt"
+
+type TrashItemNameObject struct {
+	value string
+}
+
+func NewTrashItemNameObject(name string) (*TrashItemNameObject, error) {
+	if name == "" {
+		return nil, fmt.Errorf("trash item name is required, but got '%v'", name)
+	}
+	if len(name) > 100 {
+		return nil, fmt.Errorf("trash item name cannot be longer than 100 characters, but got '%v'", name)
+	}
+	return &TrashItemNameObject{value: name}, nil
+}
+
+func (o *TrashItemNameObject) Value() string {
+	return o.value
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "fmt"
+
+type TrashItemTypeObject struct {
+	value string
+}
+
+var trashItemTypes = map[string]struct{}{
+	"project": {},
+	"chapter": {},
+	"section": {},
+}
+
+func NewTrashItemTypeObject(itemType string) (*TrashItemTypeObject, error) {
+	if _, ok := trashItemTypes[itemType]; !ok {
+		return nil, fmt.Errorf("trash item type is unknown, but got '%v'", itemType)
+	}
+	return &TrashItemTypeObject{value: itemType}, nil
+}
+
+func (o *TrashItemTypeObject) Value() string {
+	return o.value
+}
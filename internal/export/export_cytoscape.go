@@ -0,0 +1,68 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type cytoscapeDocument struct {
+	Elements cytoscapeElements `json:"elements"`
+}
+
+type cytoscapeElements struct {
+	Nodes []cytoscapeNode `json:"nodes"`
+	Edges []cytoscapeEdge `json:"edges"`
+}
+
+type cytoscapeNode struct {
+	Data cytoscapeNodeData `json:"data"`
+}
+
+type cytoscapeNodeData struct {
+	Id    string `json:"id"`
+	Label string `json:"label"`
+}
+
+type cytoscapeEdge struct {
+	Data cytoscapeEdgeData `json:"data"`
+}
+
+type cytoscapeEdgeData struct {
+	Id          string `json:"id"`
+	Source      string `json:"source"`
+	Target      string `json:"target"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+}
+
+// Cytoscape renders elements which can be passed to cytoscape.js as they are
+func Cytoscape(graph Graph) (string, error) {
+	document := cytoscapeDocument{
+		Elements: cytoscapeElements{
+			Nodes: make([]cytoscapeNode, len(graph.Nodes)),
+			Edges: make([]cytoscapeEdge, len(graph.Edges)),
+		},
+	}
+	for i, node := range graph.Nodes {
+		document.Elements.Nodes[i] = cytoscapeNode{Data: cytoscapeNodeData{Id: node.Id, Label: node.Label}}
+	}
+	for i, edge := range graph.Edges {
+		document.Elements.Edges[i] = cytoscapeEdge{Data: cytoscapeEdgeData{
+			Id:          edge.Id,
+			Source:      edge.Source,
+			Target:      edge.Target,
+			Label:       edge.Label,
+			Description: edge.Description,
+		}}
+	}
+
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return "", fmt.Errorf("failed to render cytoscape json: %w", err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,25 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func DOT(graph Graph) string {
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, "  %s [label=\"%s\"];\n", node.Id, dotEscaper.Replace(node.Label))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{fmt.Sprintf("label=\"%s\"", dotEscaper.Replace(edge.Label))}
+		if edge.Description != "" {
+			attributes = append(attributes, fmt.Sprintf("description=\"%s\"", dotEscaper.Replace(edge.Description)))
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", edge.Source, edge.Target, strings.Join(attributes, ", "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,113 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+)
+
+// Graph is a flat, format-independent form of a knowledge graph
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+type Node struct {
+	Id    string
+	Label string
+}
+
+// Edge points from a parent node to its child. Label holds the relation of the child
+type Edge struct {
+	Id          string
+	Source      string
+	Target      string
+	Label       string
+	Description string
+}
+
+func FromGraph(graph domain.GraphEntity) *Graph {
+	return FromGraphs([]domain.GraphEntity{graph})
+}
+
+// FromGraphs keeps the graph of each section as a separate tree
+func FromGraphs(graphs []domain.GraphEntity) *Graph {
+	g := &Graph{Nodes: []Node{}, Edges: []Edge{}}
+	for _, graph := range graphs {
+		root := g.addNode(graph.Name().Value())
+		g.addChildren(root, *graph.Children())
+	}
+	return g
+}
+
+// FromProjectGraph keeps the nodes and edges merged by name
+func FromProjectGraph(graph domain.ProjectGraphEntity) *Graph {
+	g := &Graph{Nodes: []Node{}, Edges: []Edge{}}
+
+	ids := make(map[string]string)
+	for _, node := range graph.Nodes() {
+		ids[node.Name().Value()] = g.addNode(node.Name().Value())
+	}
+	for _, edge := range graph.Edges() {
+		g.addEdge(
+			ids[edge.Source().Value()],
+			ids[edge.Target().Value()],
+			edge.Relation().Value(),
+			edge.Description().Value(),
+		)
+	}
+	return g
+}
+
+func Render(graph Graph, format string) (string, error) {
+	switch format {
+	case "cytoscape":
+		return Cytoscape(graph)
+	case "dot":
+		return DOT(graph), nil
+	case "graphml":
+		return GraphML(graph)
+	case "mermaid":
+		return Mermaid(graph), nil
+	}
+	return "", fmt.Errorf("unsupported export format: %v", format)
+}
+
+func MediaType(format string) string {
+	switch format {
+	case "cytoscape":
+		return "application/json"
+	case "dot":
+		return "text/vnd.graphviz"
+	case "graphml":
+		return "application/xml"
+	case "mermaid":
+		return "text/vnd.mermaid"
+	}
+	return "text/plain"
+}
+
+func (g *Graph) addChildren(parent string, children domain.GraphChildrenEntity) {
+	for _, child := range children.Value() {
+		id := g.addNode(child.Name().Value())
+		g.addEdge(parent, id, child.Relation().Value(), child.Description().Value())
+		g.addChildren(id, *child.Children())
+	}
+}
+
+func (g *Graph) addNode(label string) string {
+	id := fmt.Sprintf("n%d", len(g.Nodes))
+	g.Nodes = append(g.Nodes, Node{Id: id, Label: label})
+	return id
+}
+
+func (g *Graph) addEdge(source string, target string, label string, description string) {
+	id := fmt.Sprintf("e%d", len(g.Edges))
+	g.Edges = append(g.Edges, Edge{
+		Id:          id,
+		Source:      source,
+		Target:      target,
+		Label:       label,
+		Description: description,
+	})
+}
@@ -0,0 +1,85 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	Id       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Id     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func GraphML(graph Graph) (string, error) {
+	document := graphMLDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{Id: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{Id: "relation", For: "edge", AttrName: "relation", AttrType: "string"},
+			{Id: "description", For: "edge", AttrName: "description", AttrType: "string"},
+		},
+		Graph: graphMLGraph{
+			Id:          "G",
+			EdgeDefault: "directed",
+			Nodes:       make([]graphMLNode, len(graph.Nodes)),
+			Edges:       make([]graphMLEdge, len(graph.Edges)),
+		},
+	}
+	for i, node := range graph.Nodes {
+		document.Graph.Nodes[i] = graphMLNode{
+			Id:   node.Id,
+			Data: []graphMLData{{Key: "label", Value: node.Label}},
+		}
+	}
+	for i, edge := range graph.Edges {
+		data := []graphMLData{{Key: "relation", Value: edge.Label}}
+		if edge.Description != "" {
+			data = append(data, graphMLData{Key: "description", Value: edge.Description})
+		}
+		document.Graph.Edges[i] = graphMLEdge{
+			Id:     edge.Id,
+			Source: edge.Source,
+			Target: edge.Target,
+			Data:   data,
+		}
+	}
+
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to render graphml: %w", err)
+	}
+	return xml.Header + string(content) + "\n", nil
+}
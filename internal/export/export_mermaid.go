@@ -0,0 +1,37 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+var mermaidEscaper = strings.NewReplacer(
+	`"`, "#quot;",
+	"&", "#amp;",
+	"<", "#lt;",
+	">", "#gt;",
+	"\n", "<br>",
+)
+
+var mermaidCommentEscaper = strings.NewReplacer("\n", " ")
+
+// Mermaid renders a flowchart. Since Mermaid has no edge attributes,
+// descriptions are kept as comments following the edges
+func Mermaid(graph Graph) string {
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", node.Id, mermaidEscaper.Replace(node.Label))
+	}
+	for _, edge := range graph.Edges {
+		if edge.Label == "" {
+			fmt.Fprintf(&b, "  %s --> %s\n", edge.Source, edge.Target)
+		} else {
+			fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", edge.Source, mermaidEscaper.Replace(edge.Label), edge.Target)
+		}
+		if edge.Description != "" {
+			fmt.Fprintf(&b, "  %%%% %s -> %s: %s\n", edge.Source, edge.Target, mermaidCommentEscaper.Replace(edge.Description))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,163 @@
+package export_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/export"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var extensions = map[string]string{
+	"cytoscape": "json",
+	"dot":       "dot",
+	"graphml":   "graphml",
+	"mermaid":   "mmd",
+}
+
+func TestRenderGolden(t *testing.T) {
+	tt := []struct {
+		name  string
+		graph *export.Graph
+	}{
+		{
+			name:  "section",
+			graph: export.FromGraph(*newSectionGraph(t, "2000000000000001", "Section \"One\"")),
+		},
+		{
+			name: "chapter",
+			graph: export.FromGraphs([]domain.GraphEntity{
+				*newSectionGraph(t, "2000000000000001", "Section \"One\""),
+				*newGraph(t, "2000000000000002", "Section Two", []domain.GraphChildEntity{}),
+			}),
+		},
+		{
+			name:  "project",
+			graph: export.FromProjectGraph(*newProjectGraph(t)),
+		},
+		{
+			name:  "empty",
+			graph: export.FromGraphs([]domain.GraphEntity{}),
+		},
+	}
+
+	for _, tc := range tt {
+		for format, extension := range extensions {
+			t.Run(tc.name+"."+format, func(t *testing.T) {
+				content, err := export.Render(*tc.graph, format)
+				assert.NoError(t, err)
+
+				path := filepath.Join("testdata", tc.name+"."+extension)
+				if *update {
+					assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+				}
+
+				golden, err := os.ReadFile(path)
+				assert.NoError(t, err)
+				assert.Equal(t, string(golden), content)
+			})
+		}
+	}
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	content, err := export.Render(export.Graph{}, "svg")
+	assert.EqualError(t, err, "unsupported export format: svg")
+	assert.Empty(t, content)
+}
+
+func TestMediaType(t *testing.T) {
+	assert.Equal(t, "application/json", export.MediaType("cytoscape"))
+	assert.Equal(t, "text/vnd.graphviz", export.MediaType("dot"))
+	assert.Equal(t, "application/xml", export.MediaType("graphml"))
+	assert.Equal(t, "text/vnd.mermaid", export.MediaType("mermaid"))
+}
+
+func newSectionGraph(t *testing.T, id string, name string) *domain.GraphEntity {
+	return newGraph(t, id, name, []domain.GraphChildEntity{
+		*newGraphChild(t, "Concept <A> & B", "part of", "line one\nline \"two\"", []domain.GraphChildEntity{
+			*newGraphChild(t, "Detail", "", "", []domain.GraphChildEntity{}),
+		}),
+		*newGraphChild(t, "Another Concept", "example of", "", []domain.GraphChildEntity{}),
+	})
+}
+
+func newGraph(t *testing.T, id string, name string, children []domain.GraphChildEntity) *domain.GraphEntity {
+	graphId, err := domain.NewGraphIdObject(id)
+	assert.NoError(t, err)
+	graphName, err := domain.NewGraphNameObject(name)
+	assert.NoError(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("paragraph")
+	assert.NoError(t, err)
+	graphChildren, err := domain.NewGraphChildrenEntity(children)
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	return domain.NewGraphEntity(*graphId, *graphName, *paragraph, *graphChildren, *createdAt, *updatedAt)
+}
+
+func newGraphChild(
+	t *testing.T,
+	name string,
+	relation string,
+	description string,
+	children []domain.GraphChildEntity,
+) *domain.GraphChildEntity {
+	childName, err := domain.NewGraphNameObject(name)
+	assert.NoError(t, err)
+	childRelation, err := domain.NewGraphRelationObject(relation)
+	assert.NoError(t, err)
+	childDescription, err := domain.NewGraphDescriptionObject(description)
+	assert.NoError(t, err)
+	childChildren, err := domain.NewGraphChildrenEntity(children)
+	assert.NoError(t, err)
+
+	return domain.NewGraphChildEntity(nil, *childName, *childRelation, *childDescription, *childChildren)
+}
+
+func newProjectGraph(t *testing.T) *domain.ProjectGraphEntity {
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.NoError(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.NoError(t, err)
+	section, err := domain.NewGraphNameObject("Section")
+	assert.NoError(t, err)
+	concept, err := domain.NewGraphNameObject("Concept")
+	assert.NoError(t, err)
+	detail, err := domain.NewGraphNameObject("Detail")
+	assert.NoError(t, err)
+	partOf, err := domain.NewGraphRelationObject("part of")
+	assert.NoError(t, err)
+	exampleOf, err := domain.NewGraphRelationObject("example of")
+	assert.NoError(t, err)
+	description, err := domain.NewGraphDescriptionObject("merged from two chapters")
+	assert.NoError(t, err)
+	noDescription, err := domain.NewGraphDescriptionObject("")
+	assert.NoError(t, err)
+
+	occurrences := []domain.ProjectGraphOccurrenceEntity{
+		*domain.NewProjectGraphOccurrenceEntity(*chapterId, *sectionId, nil),
+	}
+
+	return domain.NewProjectGraphEntity(
+		[]domain.ProjectGraphNodeEntity{
+			*domain.NewProjectGraphNodeEntity(*section, occurrences),
+			*domain.NewProjectGraphNodeEntity(*concept, occurrences),
+			*domain.NewProjectGraphNodeEntity(*detail, occurrences),
+		},
+		[]domain.ProjectGraphEdgeEntity{
+			*domain.NewProjectGraphEdgeEntity(*section, *concept, *partOf, *description, occurrences),
+			*domain.NewProjectGraphEdgeEntity(*concept, *detail, *exampleOf, *noDescription, occurrences),
+			*domain.NewProjectGraphEdgeEntity(*section, *detail, *partOf, *noDescription, occurrences),
+		},
+	)
+}
@@ -0,0 +1,123 @@
+package importer
+
+import "fmt"
+
+// graph is a general directed graph read from GraphML or Mermaid before it is cut into trees
+type graph struct {
+	nodes   []graphNode
+	indices map[string]int
+	edges   []graphEdge
+}
+
+type graphNode struct {
+	id       string
+	label    string
+	location string
+}
+
+type graphEdge struct {
+	source      string
+	target      string
+	relation    string
+	description string
+	location    string
+}
+
+func newGraph() *graph {
+	return &graph{nodes: []graphNode{}, indices: make(map[string]int), edges: []graphEdge{}}
+}
+
+// addNode registers the node on its first appearance. A label given later fills only the missing one
+func (g *graph) addNode(id string, label string, location string) {
+	if index, ok := g.indices[id]; ok {
+		if g.nodes[index].label == "" {
+			g.nodes[index].label = label
+		}
+		return
+	}
+	g.indices[id] = len(g.nodes)
+	g.nodes = append(g.nodes, graphNode{id: id, label: label, location: location})
+}
+
+// addEdge registers the edge. Nodes which have not been declared are registered with the location of the edge
+func (g *graph) addEdge(edge graphEdge) {
+	g.addNode(edge.source, "", edge.location)
+	g.addNode(edge.target, "", edge.location)
+	g.edges = append(g.edges, edge)
+}
+
+// trees cuts the graph into a forest rooted at the nodes without incoming edges.
+// Edges closing a cycle or reaching a node for the second time are dropped with warnings
+func (g *graph) trees() ([]Node, []Warning) {
+	warnings := []Warning{}
+
+	outgoing := make(map[string][]graphEdge, len(g.nodes))
+	incoming := make(map[string]int, len(g.nodes))
+	for _, edge := range g.edges {
+		outgoing[edge.source] = append(outgoing[edge.source], edge)
+		incoming[edge.target]++
+	}
+
+	visited := make(map[string]bool, len(g.nodes))
+	onPath := make(map[string]bool, len(g.nodes))
+
+	var walk func(node graphNode, edge *graphEdge) Node
+	walk = func(node graphNode, edge *graphEdge) Node {
+		visited[node.id] = true
+		onPath[node.id] = true
+
+		result := Node{Name: g.label(node), Location: node.location, Children: []Node{}}
+		if edge != nil {
+			result.Relation = edge.relation
+			result.Description = edge.description
+		}
+
+		for _, out := range outgoing[node.id] {
+			if onPath[out.target] {
+				warnings = append(warnings, Warning{
+					Location: out.location,
+					Message:  fmt.Sprintf("edge from '%v' to '%v' is dropped to break a cycle", out.source, out.target),
+				})
+				continue
+			}
+			if visited[out.target] {
+				warnings = append(warnings, Warning{
+					Location: out.location,
+					Message:  fmt.Sprintf("edge from '%v' to '%v' is dropped since the node already has a parent", out.source, out.target),
+				})
+				continue
+			}
+			child := g.nodes[g.indices[out.target]]
+			result.Children = append(result.Children, walk(child, &out))
+		}
+
+		onPath[node.id] = false
+		return result
+	}
+
+	roots := []Node{}
+	for _, node := range g.nodes {
+		if incoming[node.id] == 0 {
+			roots = append(roots, walk(node, nil))
+		}
+	}
+	for _, node := range g.nodes {
+		if visited[node.id] {
+			continue
+		}
+		warnings = append(warnings, Warning{
+			Location: node.location,
+			Message:  fmt.Sprintf("node '%v' is only reachable through a cycle and is placed at the top level", node.id),
+		})
+		roots = append(roots, walk(node, nil))
+	}
+
+	return roots, warnings
+}
+
+func (g *graph) label(node graphNode) string {
+	if node.label == "" {
+		return node.id
+	}
+	return node.label
+}
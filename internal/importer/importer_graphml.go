@@ -0,0 +1,94 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+type graphMLSource struct {
+	Keys  []graphMLSourceKey `xml:"key"`
+	Graph graphMLSourceGraph `xml:"graph"`
+}
+
+type graphMLSourceKey struct {
+	Id       string `xml:"id,attr"`
+	AttrName string `xml:"attr.name,attr"`
+}
+
+type graphMLSourceGraph struct {
+	Nodes []graphMLSourceNode `xml:"node"`
+	Edges []graphMLSourceEdge `xml:"edge"`
+}
+
+type graphMLSourceNode struct {
+	Id   string              `xml:"id,attr"`
+	Data []graphMLSourceData `xml:"data"`
+}
+
+type graphMLSourceEdge struct {
+	Source string              `xml:"source,attr"`
+	Target string              `xml:"target,attr"`
+	Data   []graphMLSourceData `xml:"data"`
+}
+
+type graphMLSourceData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// importGraphML reads nodes labeled by the label (or name) key
+// and edges labeled by the relation (or label) and description keys
+func importGraphML(content string) ([]Node, []Warning, error) {
+	var source graphMLSource
+	if err := xml.Unmarshal([]byte(content), &source); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse graphml: %w", err)
+	}
+
+	names := make(map[string]string, len(source.Keys))
+	for _, key := range source.Keys {
+		name := key.AttrName
+		if name == "" {
+			name = key.Id
+		}
+		names[key.Id] = strings.ToLower(name)
+	}
+	values := func(data []graphMLSourceData) map[string]string {
+		result := make(map[string]string, len(data))
+		for _, d := range data {
+			name, ok := names[d.Key]
+			if !ok {
+				name = strings.ToLower(d.Key)
+			}
+			result[name] = d.Value
+		}
+		return result
+	}
+
+	g := newGraph()
+	for _, node := range source.Graph.Nodes {
+		data := values(node.Data)
+		label := data["label"]
+		if label == "" {
+			label = data["name"]
+		}
+		g.addNode(node.Id, normalizeText(label), fmt.Sprintf("node %s", node.Id))
+	}
+	for _, edge := range source.Graph.Edges {
+		data := values(edge.Data)
+		relation, ok := data["relation"]
+		if !ok {
+			relation = data["label"]
+		}
+		g.addEdge(graphEdge{
+			source:      edge.Source,
+			target:      edge.Target,
+			relation:    normalizeText(relation),
+			description: strings.TrimSpace(data["description"]),
+			location:    fmt.Sprintf("edge %s -> %s", edge.Source, edge.Target),
+		})
+	}
+
+	nodes, warnings := g.trees()
+	return nodes, warnings, nil
+}
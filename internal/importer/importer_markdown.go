@@ -0,0 +1,69 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var markdownItemPattern = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(.*)$`)
+
+type outlineItem struct {
+	node     Node
+	indent   int
+	children []*outlineItem
+}
+
+// importMarkdown reads an indented list. Indented lines which are not list items
+// are joined into the description of the item above them
+func importMarkdown(content string) ([]Node, []Warning, error) {
+	warnings := []Warning{}
+	roots := []*outlineItem{}
+	stack := []*outlineItem{}
+
+	for i, line := range strings.Split(content, "\n") {
+		location := fmt.Sprintf("line %d", i+1)
+		line = strings.TrimRight(strings.ReplaceAll(line, "\t", "    "), " \r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		match := markdownItemPattern.FindStringSubmatch(line)
+		if match == nil {
+			indent := len(line) - len(strings.TrimLeft(line, " "))
+			if len(stack) > 0 && indent > stack[len(stack)-1].indent {
+				last := stack[len(stack)-1]
+				last.node.Description = normalizeText(last.node.Description + " " + line)
+				continue
+			}
+			warnings = append(warnings, Warning{Location: location, Message: "line is not a list item and is ignored"})
+			continue
+		}
+
+		item := &outlineItem{
+			node:   Node{Name: normalizeText(match[2]), Location: location},
+			indent: len(match[1]),
+		}
+		for len(stack) > 0 && stack[len(stack)-1].indent >= item.indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, item)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, item)
+		}
+		stack = append(stack, item)
+	}
+
+	return outlineItemsToNodes(roots), warnings, nil
+}
+
+func outlineItemsToNodes(items []*outlineItem) []Node {
+	nodes := make([]Node, len(items))
+	for i, item := range items {
+		nodes[i] = item.node
+		nodes[i].Children = outlineItemsToNodes(item.children)
+	}
+	return nodes
+}
@@ -0,0 +1,166 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	mermaidHeaderPattern      = regexp.MustCompile(`^(flowchart|graph)\b`)
+	mermaidSkipPattern        = regexp.MustCompile(`^(subgraph|end|classDef|class|style|linkStyle|click|direction)\b`)
+	mermaidDescriptionPattern = regexp.MustCompile(`^%%\s*([A-Za-z0-9_]+)\s*->\s*([A-Za-z0-9_]+)\s*:\s*(.*)$`)
+	mermaidIdPattern          = regexp.MustCompile(`^[A-Za-z0-9_]+`)
+	mermaidEdgePattern        = regexp.MustCompile(
+		`^\s*(?:--\s+(.+?)\s+-->|==\s+(.+?)\s+==>|-\.\s+(.+?)\s+\.->|-->|---|-\.->|==>|===)(?:\s*\|([^|]*)\|)?\s*`)
+)
+
+var mermaidShapeClosers = map[rune]rune{'[': ']', '(': ')', '{': '}', '>': ']', '/': '/', '\\': '\\'}
+
+var mermaidUnescaper = strings.NewReplacer(
+	"#quot;", `"`,
+	"#amp;", "&",
+	"#lt;", "<",
+	"#gt;", ">",
+	"<br>", " ",
+	"<br/>", " ",
+)
+
+type mermaidNodeRef struct {
+	id    string
+	label string
+}
+
+// importMermaid reads flowchart statements such as A["Label"] -->|relation| B.
+// Comments in the form of %% A -> B: description give descriptions to edges
+func importMermaid(content string) ([]Node, []Warning, error) {
+	warnings := []Warning{}
+	descriptions := make(map[[2]string]string)
+	g := newGraph()
+
+	for i, line := range strings.Split(content, "\n") {
+		location := fmt.Sprintf("line %d", i+1)
+		line = strings.TrimSuffix(strings.TrimSpace(line), ";")
+		if line == "" || mermaidHeaderPattern.MatchString(line) || mermaidSkipPattern.MatchString(line) {
+			continue
+		}
+		if strings.HasPrefix(line, "%%") {
+			if match := mermaidDescriptionPattern.FindStringSubmatch(line); match != nil {
+				descriptions[[2]string{match[1], match[2]}] = strings.TrimSpace(match[3])
+			}
+			continue
+		}
+
+		refs, relations, ok := parseMermaidStatement(line)
+		if !ok {
+			warnings = append(warnings, Warning{Location: location, Message: "statement is not supported and is ignored"})
+			continue
+		}
+		for _, ref := range refs {
+			g.addNode(ref.id, ref.label, location)
+		}
+		for j, relation := range relations {
+			g.addEdge(graphEdge{
+				source:   refs[j].id,
+				target:   refs[j+1].id,
+				relation: relation,
+				location: location,
+			})
+		}
+	}
+
+	for i, edge := range g.edges {
+		g.edges[i].description = descriptions[[2]string{edge.source, edge.target}]
+	}
+
+	nodes, treeWarnings := g.trees()
+	return nodes, append(warnings, treeWarnings...), nil
+}
+
+func parseMermaidStatement(statement string) ([]mermaidNodeRef, []string, bool) {
+	ref, rest, ok := parseMermaidNodeRef(statement)
+	if !ok {
+		return nil, nil, false
+	}
+
+	refs := []mermaidNodeRef{ref}
+	relations := []string{}
+	for strings.TrimSpace(rest) != "" {
+		match := mermaidEdgePattern.FindStringSubmatchIndex(rest)
+		if match == nil {
+			return nil, nil, false
+		}
+		relation := ""
+		for group := 1; group <= 4; group++ {
+			if match[2*group] >= 0 {
+				relation = mermaidText(rest[match[2*group]:match[2*group+1]])
+			}
+		}
+
+		ref, rest, ok = parseMermaidNodeRef(rest[match[1]:])
+		if !ok {
+			return nil, nil, false
+		}
+		refs = append(refs, ref)
+		relations = append(relations, relation)
+	}
+
+	return refs, relations, true
+}
+
+func parseMermaidNodeRef(text string) (mermaidNodeRef, string, bool) {
+	text = strings.TrimLeft(text, " ")
+	id := mermaidIdPattern.FindString(text)
+	if id == "" {
+		return mermaidNodeRef{}, "", false
+	}
+	rest := text[len(id):]
+
+	opener := ""
+	for _, r := range rest {
+		if _, ok := mermaidShapeClosers[r]; !ok || len(opener) >= 2 {
+			break
+		}
+		opener += string(r)
+	}
+	if opener == "" {
+		return mermaidNodeRef{id: id}, rest, true
+	}
+
+	closer := ""
+	for _, r := range opener {
+		closer = string(mermaidShapeClosers[r]) + closer
+	}
+	body := rest[len(opener):]
+
+	end := -1
+	if strings.HasPrefix(body, `"`) {
+		if quote := strings.Index(body[1:], `"`); quote >= 0 {
+			end = strings.Index(body[quote+2:], closer)
+			if end >= 0 {
+				end += quote + 2
+			}
+		}
+	}
+	if end < 0 {
+		end = strings.Index(body, closer)
+	}
+	if end < 0 {
+		closer = string(mermaidShapeClosers[rune(opener[0])])
+		end = strings.Index(body, closer)
+	}
+	if end < 0 {
+		return mermaidNodeRef{}, "", false
+	}
+
+	label := strings.Trim(body[:end], `/\`)
+	return mermaidNodeRef{id: id, label: mermaidText(label)}, body[end+len(closer):], true
+}
+
+func mermaidText(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) >= 2 && strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) {
+		text = text[1 : len(text)-1]
+	}
+	return normalizeText(mermaidUnescaper.Replace(text))
+}
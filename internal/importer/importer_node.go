@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node is a child of a section graph read from an external format.
+// Location points to the part of the source the node came from
+type Node struct {
+	Name        string
+	Relation    string
+	Description string
+	Location    string
+	Children    []Node
+}
+
+// MaxNodes is the maximum number of nodes in content to import
+const MaxNodes = 10000
+
+// Warning reports a repair applied to the source while importing
+type Warning struct {
+	Location string
+	Message  string
+}
+
+// Import reads content in the given format into a forest of nodes.
+// Cycles and nodes with several parents are cut, and duplicated sibling names are renamed,
+// so that every level of the result satisfies the constraints of the graph children
+func Import(format string, content string) ([]Node, []Warning, error) {
+	var nodes []Node
+	var warnings []Warning
+	var err error
+
+	switch format {
+	case "graphml":
+		nodes, warnings, err = importGraphML(content)
+	case "markdown":
+		nodes, warnings, err = importMarkdown(content)
+	case "mermaid":
+		nodes, warnings, err = importMermaid(content)
+	case "opml":
+		nodes, warnings, err = importOPML(content)
+	default:
+		return nil, nil, fmt.Errorf("unsupported import format: %v", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, nil, fmt.Errorf("content has no nodes to import")
+	}
+	if countNodes(nodes) > MaxNodes {
+		return nil, nil, fmt.Errorf("content has more than %d nodes to import", MaxNodes)
+	}
+
+	nodes, renameWarnings := renameDuplicatedSiblings(nodes)
+	return nodes, append(warnings, renameWarnings...), nil
+}
+
+func renameDuplicatedSiblings(nodes []Node) ([]Node, []Warning) {
+	warnings := []Warning{}
+
+	names := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		names[node.Name] = struct{}{}
+	}
+
+	// suffixes holds the next suffix to try for each duplicated name,
+	// so that the names taken by earlier duplicates are not scanned again
+	suffixes := make(map[string]int)
+	seen := make(map[string]struct{}, len(nodes))
+	renamed := make([]Node, len(nodes))
+	for i, node := range nodes {
+		if _, ok := seen[node.Name]; ok {
+			name := node.Name
+			n := max(suffixes[node.Name], 2)
+			for ; ; n++ {
+				name = fmt.Sprintf("%s (%d)", node.Name, n)
+				if _, ok := names[name]; !ok {
+					break
+				}
+			}
+			suffixes[node.Name] = n + 1
+			warnings = append(warnings, Warning{
+				Location: node.Location,
+				Message:  fmt.Sprintf("duplicated sibling name '%v' is renamed to '%v'", node.Name, name),
+			})
+			node.Name = name
+			names[name] = struct{}{}
+		}
+		seen[node.Name] = struct{}{}
+
+		children, childWarnings := renameDuplicatedSiblings(node.Children)
+		node.Children = children
+		warnings = append(warnings, childWarnings...)
+		renamed[i] = node
+	}
+
+	return renamed, warnings
+}
+
+func countNodes(nodes []Node) int {
+	count := len(nodes)
+	for _, node := range nodes {
+		count += countNodes(node.Children)
+	}
+	return count
+}
+
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// importOPML reads outline elements. The text attribute is the name,
+// and the relation and _note (or description) attributes are kept as they are
+func importOPML(content string) ([]Node, []Warning, error) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+
+	roots := []*outlineItem{}
+	stack := []*outlineItem{}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse opml: %w", err)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			if element.Name.Local != "outline" {
+				continue
+			}
+			line, _ := decoder.InputPos()
+			item := &outlineItem{node: Node{Location: fmt.Sprintf("line %d", line)}}
+			for _, attr := range element.Attr {
+				switch attr.Name.Local {
+				case "text":
+					item.node.Name = normalizeText(attr.Value)
+				case "title":
+					if item.node.Name == "" {
+						item.node.Name = normalizeText(attr.Value)
+					}
+				case "relation":
+					item.node.Relation = normalizeText(attr.Value)
+				case "_note", "description":
+					item.node.Description = strings.TrimSpace(attr.Value)
+				}
+			}
+
+			if len(stack) == 0 {
+				roots = append(roots, item)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, item)
+			}
+			stack = append(stack, item)
+		case xml.EndElement:
+			if element.Name.Local == "outline" && len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+
+	return outlineItemsToNodes(roots), []Warning{}, nil
+}
@@ -0,0 +1,279 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportMarkdown(t *testing.T) {
+	content := "# Title\n" +
+		"\n" +
+		"- Background\n" +
+		"  This is background part.\n" +
+		"    - IT in Education\n" +
+		"\t- Literature\n" +
+		"1. Motivation\n"
+
+	nodes, warnings, err := importer.Import("markdown", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:        "Background",
+			Description: "This is background part.",
+			Location:    "line 3",
+			Children: []importer.Node{
+				{Name: "IT in Education", Location: "line 5", Children: []importer.Node{}},
+				{Name: "Literature", Location: "line 6", Children: []importer.Node{}},
+			},
+		},
+		{Name: "Motivation", Location: "line 7", Children: []importer.Node{}},
+	}, nodes)
+	assert.Equal(t, []importer.Warning{
+		{Location: "line 1", Message: "line is not a list item and is ignored"},
+	}, warnings)
+}
+
+func TestImportOPML(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Outline</title></head>
+  <body>
+    <outline text="Background" relation="part of" _note="This is background part.">
+      <outline text="IT in Education" relation="one of"/>
+    </outline>
+    <outline title="Motivation"/>
+  </body>
+</opml>
+`
+
+	nodes, warnings, err := importer.Import("opml", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:        "Background",
+			Relation:    "part of",
+			Description: "This is background part.",
+			Location:    "line 5",
+			Children: []importer.Node{
+				{Name: "IT in Education", Relation: "one of", Location: "line 6", Children: []importer.Node{}},
+			},
+		},
+		{Name: "Motivation", Location: "line 8", Children: []importer.Node{}},
+	}, nodes)
+	assert.Empty(t, warnings)
+}
+
+func TestImportGraphML(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="label" attr.type="string"></key>
+  <key id="d1" for="edge" attr.name="relation" attr.type="string"></key>
+  <key id="d2" for="edge" attr.name="description" attr.type="string"></key>
+  <graph id="G" edgedefault="directed">
+    <node id="n0"><data key="d0">Concept &lt;A&gt; &amp; B</data></node>
+    <node id="n1"><data key="d0">Detail</data></node>
+    <node id="n2"><data key="d0">Another</data></node>
+    <edge source="n0" target="n1">
+      <data key="d1">part of</data>
+      <data key="d2">line one&#xA;line two</data>
+    </edge>
+    <edge source="n1" target="n2"><data key="d1">example of</data></edge>
+    <edge source="n2" target="n0"><data key="d1">back</data></edge>
+    <edge source="n3" target="n1"></edge>
+  </graph>
+</graphml>
+`
+
+	nodes, warnings, err := importer.Import("graphml", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:     "n3",
+			Location: "edge n3 -> n1",
+			Children: []importer.Node{
+				{
+					Name:     "Detail",
+					Location: "node n1",
+					Children: []importer.Node{
+						{
+							Name:     "Another",
+							Relation: "example of",
+							Location: "node n2",
+							Children: []importer.Node{
+								{
+									Name:        "Concept <A> & B",
+									Relation:    "back",
+									Location:    "node n0",
+									Children:    []importer.Node{},
+									Description: "",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nodes)
+	assert.Equal(t, []importer.Warning{
+		{Location: "edge n0 -> n1", Message: "edge from 'n0' to 'n1' is dropped to break a cycle"},
+	}, warnings)
+}
+
+func TestImportMermaid(t *testing.T) {
+	content := "flowchart TD\n" +
+		"  n0[\"Section #quot;One#quot;\"]\n" +
+		"  n1[\"Concept #lt;A#gt; #amp; B\"]\n" +
+		"  n2[\"Detail\"]\n" +
+		"  n0 -->|\"part of\"| n1\n" +
+		"  %% n0 -> n1: line one line \"two\"\n" +
+		"  n1 --> n2\n" +
+		"  n0 -- example of --> n3(Another Concept) --- n2\n" +
+		"  style n0 fill:#f9f\n" +
+		"  n0 & n1 --> n4\n"
+
+	nodes, warnings, err := importer.Import("mermaid", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:     "Section \"One\"",
+			Location: "line 2",
+			Children: []importer.Node{
+				{
+					Name:        "Concept <A> & B",
+					Relation:    "part of",
+					Description: "line one line \"two\"",
+					Location:    "line 3",
+					Children: []importer.Node{
+						{Name: "Detail", Location: "line 4", Children: []importer.Node{}},
+					},
+				},
+				{Name: "Another Concept", Relation: "example of", Location: "line 8", Children: []importer.Node{}},
+			},
+		},
+	}, nodes)
+	assert.Equal(t, []importer.Warning{
+		{Location: "line 10", Message: "statement is not supported and is ignored"},
+		{Location: "line 8", Message: "edge from 'n3' to 'n2' is dropped since the node already has a parent"},
+	}, warnings)
+}
+
+func TestImportRenameDuplicatedSiblings(t *testing.T) {
+	content := "- Concept\n" +
+		"  - Child\n" +
+		"  - Child\n" +
+		"- Concept\n" +
+		"- Concept (2)\n"
+
+	nodes, warnings, err := importer.Import("markdown", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:     "Concept",
+			Location: "line 1",
+			Children: []importer.Node{
+				{Name: "Child", Location: "line 2", Children: []importer.Node{}},
+				{Name: "Child (2)", Location: "line 3", Children: []importer.Node{}},
+			},
+		},
+		{Name: "Concept (3)", Location: "line 4", Children: []importer.Node{}},
+		{Name: "Concept (2)", Location: "line 5", Children: []importer.Node{}},
+	}, nodes)
+	assert.Equal(t, []importer.Warning{
+		{Location: "line 3", Message: "duplicated sibling name 'Child' is renamed to 'Child (2)'"},
+		{Location: "line 4", Message: "duplicated sibling name 'Concept' is renamed to 'Concept (3)'"},
+	}, warnings)
+}
+
+func TestImportRenameManyDuplicatedSiblings(t *testing.T) {
+	content := "- Concept (3)\n" + strings.Repeat("- Concept\n", 4)
+
+	nodes, warnings, err := importer.Import("markdown", content)
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	assert.Equal(t, []string{"Concept (3)", "Concept", "Concept (2)", "Concept (4)", "Concept (5)"}, names)
+	assert.Len(t, warnings, 3)
+}
+
+func TestImportCycleWithoutEntry(t *testing.T) {
+	content := "flowchart LR\n" +
+		"  A --> B\n" +
+		"  B --> A\n"
+
+	nodes, warnings, err := importer.Import("mermaid", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []importer.Node{
+		{
+			Name:     "A",
+			Location: "line 2",
+			Children: []importer.Node{
+				{Name: "B", Location: "line 2", Children: []importer.Node{}},
+			},
+		},
+	}, nodes)
+	assert.Equal(t, []importer.Warning{
+		{Location: "line 2", Message: "node 'A' is only reachable through a cycle and is placed at the top level"},
+		{Location: "line 3", Message: "edge from 'B' to 'A' is dropped to break a cycle"},
+	}, warnings)
+}
+
+func TestImportError(t *testing.T) {
+	tt := []struct {
+		name          string
+		format        string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "should return error when format is not supported",
+			format:        "svg",
+			content:       "<svg/>",
+			expectedError: "unsupported import format: svg",
+		},
+		{
+			name:          "should return error when content has no nodes",
+			format:        "markdown",
+			content:       "# Title only\n",
+			expectedError: "content has no nodes to import",
+		},
+		{
+			name:          "should return error when content has too many nodes",
+			format:        "markdown",
+			content:       strings.Repeat("- Concept\n", importer.MaxNodes+1),
+			expectedError: "content has more than 10000 nodes to import",
+		},
+		{
+			name:          "should return error when opml is malformed",
+			format:        "opml",
+			content:       "<opml><body><outline text=\"A\"></body></opml>",
+			expectedError: "failed to parse opml: XML syntax error on line 1: element <outline> closed by </body>",
+		},
+		{
+			name:          "should return error when graphml is malformed",
+			format:        "graphml",
+			content:       "<graphml><graph>",
+			expectedError: "failed to parse graphml: XML syntax error on line 1: unexpected EOF",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			nodes, warnings, err := importer.Import(tc.format, tc.content)
+			assert.EqualError(t, err, tc.expectedError)
+			assert.Nil(t, nodes)
+			assert.Nil(t, warnings)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+// accessTokenRoutes are the routes to manage personal access tokens,
+// which cannot be called with a personal access token itself
+var accessTokenRoutes = []string{"/api/tokens/", "/api/v2/tokens/"}
+
+// projectlessRoutes are the routes which are not about a single project,
+// which cannot be called with a personal access token limited to a project
+var projectlessRoutes = []string{
+	"/projects/list",
+	"/projects/create",
+	"/projects/import",
+	"/trash/list",
+	"/trash/restore",
+}
+
+// jsonPatchRoutes are the routes which accept JSON Patch requests,
+// whose project is carried in the query
+var jsonPatchRoutes = []string{"/projects/update", "/graphs/update"}
+
+const jsonPatchContentType = "application/json-patch+json"
+
+// AccessToken authenticates requests which carry a personal access token
+// and passes the other requests on to next, which is expected to validate a JWT.
+// The owner of the token is stored in the context in the same way as a JWT subject,
+// so that Subject works for both.
+func AccessToken(tokens service.AccessTokenService, next gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, ok := bearerAccessToken(c.Request)
+		if !ok {
+			next(c)
+			return
+		}
+
+		secret, err := domain.NewAccessTokenSecretObject(value)
+		if err != nil {
+			abortUnauthorized(c)
+			return
+		}
+
+		userId, accessToken, sErr := tokens.AuthenticateAccessToken(*secret)
+		if sErr != nil && sErr.Code() == service.NotFoundError {
+			log.Printf("Encountered error while validating access token: %v", sErr)
+			abortUnauthorized(c)
+			return
+		}
+		if sErr != nil {
+			log.Printf("Encountered error while validating access token: %v", sErr)
+			abortVerificationFailure(c)
+			return
+		}
+
+		allowed, err := accessTokenAllows(c, *accessToken)
+		if err != nil {
+			abortReadFailure(c, err)
+			return
+		}
+		if !allowed {
+			abortUnauthorized(c)
+			return
+		}
+
+		claims := &validator.ValidatedClaims{
+			RegisteredClaims: validator.RegisteredClaims{Subject: userId.Value()},
+			CustomClaims:     &CustomClaims{Sub: userId.Value()},
+		}
+		ctx := context.WithValue(c.Request.Context(), jwtmiddleware.ContextKey{}, claims)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+func bearerAccessToken(r *http.Request) (string, bool) {
+	fields := strings.Fields(r.Header.Get("Authorization"))
+	if len(fields) != 2 || !strings.EqualFold(fields[0], "bearer") {
+		return "", false
+	}
+	if !strings.HasPrefix(fields[1], domain.AccessTokenSecretPrefix) {
+		return "", false
+	}
+	return fields[1], true
+}
+
+// accessTokenAllows reports whether the scope of accessToken covers the request.
+// Every route which changes data is a POST route, so a read-only token is limited to GET.
+func accessTokenAllows(c *gin.Context, accessToken domain.AccessTokenEntity) (bool, error) {
+	for _, route := range accessTokenRoutes {
+		if strings.HasPrefix(c.Request.URL.Path, route) {
+			return false, nil
+		}
+	}
+	if c.Request.Method != http.MethodGet && !accessToken.Scope().CanWrite() {
+		return false, nil
+	}
+	if accessToken.ProjectId() == nil {
+		return true, nil
+	}
+	route := apiRoute(c.Request.URL.Path)
+	if slices.Contains(projectlessRoutes, route) {
+		return false, nil
+	}
+
+	projectId, err := requestProjectId(c, route)
+	if err != nil {
+		return false, err
+	}
+	return projectId == accessToken.ProjectId().Value(), nil
+}
+
+// apiRoute returns the route of path without the /api or /api/v2 prefix.
+func apiRoute(path string) string {
+	for _, prefix := range []string{"/api/v2", "/api"} {
+		if route, ok := strings.CutPrefix(path, prefix); ok && strings.HasPrefix(route, "/") {
+			return route
+		}
+	}
+	return path
+}
+
+// requestProjectId finds the project of a request to route where its handler binds it:
+// in the query for GET and JSON Patch requests, and in the JSON body for the other requests.
+// An empty project ID is returned when the project is unknown, including in bodies too large to scan,
+// or when the query and the body have different projects.
+func requestProjectId(c *gin.Context, route string) (string, error) {
+	queryProjectId := c.Request.URL.Query().Get("projectId")
+	if c.Request.Method == http.MethodGet {
+		return queryProjectId, nil
+	}
+	if c.ContentType() == jsonPatchContentType && slices.Contains(jsonPatchRoutes, route) {
+		return queryProjectId, nil
+	}
+	if !isJsonRequest(c) {
+		return "", nil
+	}
+
+	member, err := readJsonMember(c, "project")
+	if err != nil || member == nil {
+		return "", err
+	}
+
+	var project struct {
+		Id string `json:"id"`
+	}
+	if json.Unmarshal(member, &project) != nil {
+		return "", nil
+	}
+	if queryProjectId != "" && queryProjectId != project.Id {
+		return "", nil
+	}
+	return project.Id, nil
+}
+
+func abortUnauthorized(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusUnauthorized, openapi.ApplicationErrorResponse{
+		Message: string(AuthorizationError),
+	})
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+)
+
+// maxJsonScanBytes bounds how much of a JSON body middlewares read before handlers.
+// Handlers still receive the whole body, however large it is.
+const maxJsonScanBytes = 4 << 20
+
+// isJsonRequest reports whether handlers bind the body of c as JSON.
+// Handlers bind the body with ShouldBindJSON, which accepts a missing content type too.
+func isJsonRequest(c *gin.Context) bool {
+	contentType := c.ContentType()
+	return contentType == "" || contentType == gin.MIMEJSON
+}
+
+// readJsonMember reads the top-level member key of the JSON body of c
+// and restores the body so that handlers can still bind it.
+// The last member wins when key is repeated, as it does in encoding/json.
+// Nil is returned when the member is missing, when the body is not a JSON object,
+// when the body is longer than maxJsonScanBytes, and for bodies of other content types, such as archives.
+func readJsonMember(c *gin.Context, key string) (json.RawMessage, error) {
+	if c.Request.Body == nil || !isJsonRequest(c) {
+		return nil, nil
+	}
+
+	body := c.Request.Body
+	var scanned bytes.Buffer
+	limited := &io.LimitedReader{R: body, N: maxJsonScanBytes}
+	member, err := scanJsonMember(io.TeeReader(limited, &scanned), key)
+	c.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(&scanned, body), body}
+
+	if limited.N <= 0 {
+		return nil, nil
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func scanJsonMember(r io.Reader, key string) (json.RawMessage, error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token != json.Delim('{') {
+		return nil, nil
+	}
+
+	var member json.RawMessage
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if token == key {
+			member = value
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func abortReadFailure(c *gin.Context, err error) {
+	log.Printf("Encountered error while reading request body: %v", err)
+	c.AbortWithStatusJSON(http.StatusBadRequest, openapi.ApplicationErrorResponse{
+		Message: string(InvalidRequestError),
+	})
+}
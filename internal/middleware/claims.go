@@ -2,16 +2,38 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
+// CustomClaims holds the user ID of a JWT in Sub,
+// which is read from the claim configured by userIdClaim.
 type CustomClaims struct {
-	Sub string `json:"sub"`
+	Sub         string `json:"sub"`
+	userIdClaim string
+}
+
+func (claims *CustomClaims) UnmarshalJSON(data []byte) error {
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	userId, _ := values[claims.claimName()].(string)
+	claims.Sub = userId
+	return nil
 }
 
 func (claims *CustomClaims) Validate(ctx context.Context) error {
 	if claims.Sub == "" {
-		return fmt.Errorf("sub is required")
+		return fmt.Errorf("%v is required", claims.claimName())
 	}
 	return nil
 }
+
+func (claims *CustomClaims) claimName() string {
+	if claims.userIdClaim == "" {
+		return defaultJWTUserIdClaim
+	}
+	return claims.userIdClaim
+}
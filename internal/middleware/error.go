@@ -8,6 +8,7 @@ type ErrorCode string
 
 const (
 	AuthorizationError        ErrorCode = "authorization error"
+	InvalidRequestError       ErrorCode = "invalid request format"
 	VerificationFailurepPanic ErrorCode = "verification failure"
 )
 
@@ -1,9 +1,14 @@
 package middleware
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
@@ -13,39 +18,126 @@ import (
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
 )
 
-type Auth0JwTConfig struct {
-	Domain   string
-	Audience string
+const (
+	defaultJWTAlgorithm   = "RS256"
+	defaultJWTUserIdClaim = "sub"
+)
+
+// OIDCConfig configures validation of JWTs issued by an OpenID Connect provider
+// such as Auth0, Keycloak, Google or Azure AD.
+type OIDCConfig struct {
+	// IssuerURL is compared with the iss claim, e.g. https://example.auth0.com/
+	IssuerURL string
+	// JWKSURL overrides the key set found by OpenID Connect discovery of IssuerURL
+	JWKSURL string
+	// Audiences are the accepted values of the aud claim
+	Audiences []string
+	// Algorithms are the accepted signing algorithms. Defaults to RS256
+	Algorithms []string
+	// UserIdClaim is the claim which holds the user ID. Defaults to sub
+	UserIdClaim string
 }
 
-func Auth0JWT(config Auth0JwTConfig) gin.HandlerFunc {
-	issuerURL, err := url.Parse("https://" + config.Domain + "/")
+// DevJWTConfig configures validation of HS256 JWTs signed with a local secret.
+// It is meant for running and testing the server offline, never for production.
+type DevJWTConfig struct {
+	Secret      string
+	Issuer      string
+	Audiences   []string
+	UserIdClaim string
+}
+
+func OIDCJWT(config OIDCConfig) gin.HandlerFunc {
+	issuerURL, err := url.Parse(config.IssuerURL)
 	if err != nil {
 		log.Fatalf("Failed to parse the issuer url: %v", err)
 	}
 
-	provider := jwks.NewCachingProvider(issuerURL, 5*time.Minute)
+	var providerOpts []any
+	if config.JWKSURL != "" {
+		jwksURL, err := url.Parse(config.JWKSURL)
+		if err != nil {
+			log.Fatalf("Failed to parse the jwks url: %v", err)
+		}
+		providerOpts = append(providerOpts, jwks.WithCustomJWKSURI(jwksURL))
+	}
+
+	provider := jwks.NewCachingProvider(issuerURL, 5*time.Minute, providerOpts...)
+
+	algorithms := config.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = []string{defaultJWTAlgorithm}
+	}
+
+	validators := map[string]*validator.Validator{}
+	for _, algorithm := range algorithms {
+		validators[algorithm] = newJWTValidator(
+			provider.KeyFunc, algorithm, config.IssuerURL, config.Audiences, config.UserIdClaim)
+	}
+
+	return jwtHandler(validators)
+}
+
+func DevJWT(config DevJWTConfig) gin.HandlerFunc {
+	if config.Secret == "" {
+		log.Fatalf("Failed to set up the jwt validator: secret is required in dev mode")
+	}
+
+	keyFunc := func(ctx context.Context) (any, error) {
+		return []byte(config.Secret), nil
+	}
+
+	return jwtHandler(map[string]*validator.Validator{
+		string(validator.HS256): newJWTValidator(
+			keyFunc, string(validator.HS256), config.Issuer, config.Audiences, config.UserIdClaim),
+	})
+}
+
+func newJWTValidator(
+	keyFunc func(context.Context) (any, error),
+	algorithm string,
+	issuer string,
+	audiences []string,
+	userIdClaim string,
+) *validator.Validator {
+	if userIdClaim == "" {
+		userIdClaim = defaultJWTUserIdClaim
+	}
 
 	jwtValidator, err := validator.New(
-		provider.KeyFunc,
-		validator.RS256,
-		issuerURL.String(),
-		[]string{config.Audience},
+		keyFunc,
+		validator.SignatureAlgorithm(algorithm),
+		issuer,
+		audiences,
 		validator.WithCustomClaims(func() validator.CustomClaims {
-			return &CustomClaims{}
+			return &CustomClaims{userIdClaim: userIdClaim}
 		}),
 		validator.WithAllowedClockSkew(time.Minute),
 	)
 	if err != nil {
 		log.Fatalf("Failed to set up the jwt validator: %v", err)
 	}
+	return jwtValidator
+}
+
+// jwtHandler validates a JWT with the validator of the algorithm in its header,
+// since each validator accepts exactly one algorithm.
+func jwtHandler(validators map[string]*validator.Validator) gin.HandlerFunc {
+	validateToken := func(ctx context.Context, token string) (any, error) {
+		algorithm := jwtAlgorithm(token)
+		jwtValidator, ok := validators[algorithm]
+		if !ok {
+			return nil, fmt.Errorf("signing algorithm %q is not allowed", algorithm)
+		}
+		return jwtValidator.ValidateToken(ctx, token)
+	}
 
 	errorHandler := func(w http.ResponseWriter, r *http.Request, err error) {
 		log.Printf("Encountered error while validating JWT: %v", err)
 	}
 
 	middleware := jwtmiddleware.New(
-		jwtValidator.ValidateToken,
+		validateToken,
 		jwtmiddleware.WithErrorHandler(errorHandler),
 	)
 
@@ -67,3 +159,23 @@ func Auth0JWT(config Auth0JwTConfig) gin.HandlerFunc {
 		}
 	}
 }
+
+func jwtAlgorithm(token string) string {
+	header, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return ""
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return ""
+	}
+
+	var values struct {
+		Alg string `json:"alg"`
+	}
+	if json.Unmarshal(decoded, &values) != nil {
+		return ""
+	}
+	return values.Alg
+}
@@ -0,0 +1,163 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	devJWTSecret   = "DEV_JWT_SECRET"
+	devJWTIssuer   = "http://localhost:8080/"
+	devJWTAudience = "knodeledge-api"
+)
+
+func TestDevJWT(t *testing.T) {
+	now := time.Now()
+
+	tt := []struct {
+		name         string
+		userIdClaim  string
+		header       map[string]any
+		claims       map[string]any
+		secret       string
+		expectedCode int
+	}{
+		{
+			name:   "should accept token signed with secret",
+			header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": devJWTAudience,
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:        "should read user id from configured claim",
+			userIdClaim: "email",
+			header:      map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss":   devJWTIssuer,
+				"aud":   devJWTAudience,
+				"sub":   "another-subject",
+				"email": testutil.ModifyOnlyUserId(),
+				"exp":   now.Add(time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:        "should reject token without configured claim",
+			userIdClaim: "email",
+			header:      map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": devJWTAudience,
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject token signed with another secret",
+			header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": devJWTAudience,
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			secret:       "ANOTHER_SECRET",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject token with other algorithm",
+			header: map[string]any{"alg": "HS512", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": devJWTAudience,
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject token of other audience",
+			header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": "another-api",
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject expired token",
+			header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			claims: map[string]any{
+				"iss": devJWTIssuer,
+				"aud": devJWTAudience,
+				"sub": testutil.ModifyOnlyUserId(),
+				"exp": now.Add(-time.Hour).Unix(),
+			},
+			secret:       devJWTSecret,
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(middleware.DevJWT(middleware.DevJWTConfig{
+				Secret:      devJWTSecret,
+				Issuer:      devJWTIssuer,
+				Audiences:   []string{devJWTAudience},
+				UserIdClaim: tc.userIdClaim,
+			}), middleware.Subject())
+
+			router.GET("/api/projects/list", func(c *gin.Context) {
+				assert.Equal(t, testutil.ModifyOnlyUserId(), middleware.GetSubject(c))
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/api/projects/list", nil)
+			req.Header.Set("Authorization", "Bearer "+signJWT(t, tc.header, tc.claims, tc.secret))
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+		})
+	}
+}
+
+func signJWT(t *testing.T, header map[string]any, claims map[string]any, secret string) string {
+	encodedHeader, err := json.Marshal(header)
+	assert.NoError(t, err)
+	encodedClaims, err := json.Marshal(claims)
+	assert.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(encodedHeader) + "." +
+		base64.RawURLEncoding.EncodeToString(encodedClaims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
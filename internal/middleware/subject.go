@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+)
+
+// SubjectKey is the key of the authenticated user ID in the gin context
+const SubjectKey = "subject"
+
+// Subject stores the user ID authenticated by the JWT or access token middleware in the gin context,
+// so that handlers take the user from the token rather than from the request.
+func Subject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := c.Request.Context().Value(jwtmiddleware.ContextKey{}).(*validator.ValidatedClaims)
+		if !ok {
+			log.Printf("Encountered error while resolving subject: failed to get JWT claims from context")
+			abortVerificationFailure(c)
+			return
+		}
+
+		customClaims, ok := claims.CustomClaims.(*CustomClaims)
+		if !ok {
+			log.Printf("Encountered error while resolving subject: failed to get custom claims from JWT claims")
+			abortVerificationFailure(c)
+			return
+		}
+
+		c.Set(SubjectKey, customClaims.Sub)
+		c.Next()
+	}
+}
+
+// GetSubject returns the user ID stored by Subject
+func GetSubject(c *gin.Context) string {
+	return c.GetString(SubjectKey)
+}
+
+// DeprecatedUserId rejects v1 requests whose user ID does not match the subject.
+// The user ID is deprecated in v1 requests and may be omitted.
+func DeprecatedUserId() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userId, err := requestUserId(c)
+		if err != nil {
+			abortReadFailure(c, err)
+			return
+		}
+		if userId != "" && userId != GetSubject(c) {
+			abortUnauthorized(c)
+			return
+		}
+		c.Next()
+	}
+}
+
+// requestUserId finds the user of a request in the query or the JSON body.
+func requestUserId(c *gin.Context) (string, error) {
+	if userId := c.Request.URL.Query().Get("userId"); userId != "" {
+		return userId, nil
+	}
+	if c.Request.Method == http.MethodGet {
+		return "", nil
+	}
+
+	// The user is only checked in the first maxJsonScanBytes of the body,
+	// so that v1 requests of any size still reach handlers, which take the user from the subject.
+	member, err := readJsonMember(c, "user")
+	if err != nil || member == nil {
+		return "", err
+	}
+
+	var user struct {
+		Id string `json:"id"`
+	}
+	if json.Unmarshal(member, &user) != nil {
+		return "", nil
+	}
+	return user.Id, nil
+}
+
+func abortVerificationFailure(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusInternalServerError, openapi.ApplicationErrorResponse{
+		Message: string(VerificationFailurepPanic),
+	})
+}
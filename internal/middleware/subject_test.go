@@ -0,0 +1,141 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kumachan-mis/knodeledge-api/internal/middleware"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubjectWithoutClaims(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.Subject())
+	router.GET("/api/projects/list", func(c *gin.Context) {
+		t.Error("handler should not be called without claims")
+	})
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/projects/list", nil)
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.JSONEq(t, `{"message": "verification failure"}`, recorder.Body.String())
+}
+
+func TestDeprecatedUserId(t *testing.T) {
+	tt := []struct {
+		name         string
+		method       string
+		path         string
+		contentType  string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "should accept query without user id",
+			method:       "GET",
+			path:         "/api/projects/list",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should accept query with user id of subject",
+			method:       "GET",
+			path:         "/api/projects/list?userId=" + testutil.ModifyOnlyUserId(),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should reject query with user id of another user",
+			method:       "GET",
+			path:         "/api/projects/list?userId=" + testutil.ReadOnlyUserId(),
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "should accept body without user",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"project": {"name": "Project"}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should accept body with user of subject",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"user": {"id": "` + testutil.ModifyOnlyUserId() + `"}, "project": {"name": "Project"}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should reject body with user of another user",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"user": {"id": "` + testutil.ReadOnlyUserId() + `"}, "project": {"name": "Project"}}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "should leave invalid body to handler",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"user": `,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should leave body of other content type to handler",
+			method:       "POST",
+			path:         "/api/projects/import",
+			contentType:  "application/zip",
+			body:         `{"user": {"id": "` + testutil.ReadOnlyUserId() + `"}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should pass large body to handler",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"project": {"name": "` + strings.Repeat("a", 4<<20) + `"}, "user": {"id": "` + testutil.ReadOnlyUserId() + `"}}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "should reject last user in body when user is repeated",
+			method:       "POST",
+			path:         "/api/projects/create",
+			body:         `{"user": {"id": "` + testutil.ModifyOnlyUserId() + `"}, "user": {"id": "` + testutil.ReadOnlyUserId() + `"}}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set(middleware.SubjectKey, testutil.ModifyOnlyUserId())
+			}, middleware.DeprecatedUserId())
+
+			handler := func(c *gin.Context) {
+				body, err := io.ReadAll(c.Request.Body)
+				assert.NoError(t, err)
+				c.String(http.StatusOK, "%s", body)
+			}
+			router.GET("/api/projects/list", handler)
+			router.POST("/api/projects/create", handler)
+			router.POST("/api/projects/import", handler)
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			if tc.expectedCode == http.StatusOK {
+				assert.Equal(t, tc.body, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type AccessTokensAPI interface {
+
+	// AccessTokensCreate Post /api/tokens/create
+	// Create new personal access token
+	AccessTokensCreate(c *gin.Context)
+
+	// AccessTokensList Get /api/tokens/list
+	// List personal access tokens of user
+	AccessTokensList(c *gin.Context)
+
+	// AccessTokensRevoke Post /api/tokens/revoke
+	// Revoke personal access token
+	AccessTokensRevoke(c *gin.Context)
+
+	// V2AccessTokensCreate Post /api/v2/tokens/create
+	// Create new personal access token
+	V2AccessTokensCreate(c *gin.Context)
+
+	// V2AccessTokensList Get /api/v2/tokens/list
+	// List personal access tokens of user
+	V2AccessTokensList(c *gin.Context)
+
+	// V2AccessTokensRevoke Post /api/v2/tokens/revoke
+	// Revoke personal access token
+	V2AccessTokensRevoke(c *gin.Context)
+}
@@ -30,4 +30,20 @@ type ChaptersAPI interface {
 	// ChaptersUpdate Post /api/chapters/update
 	// Update chapter
 	ChaptersUpdate(c *gin.Context)
+
+	// V2ChaptersCreate Post /api/v2/chapters/create
+	// Create new Chapter
+	V2ChaptersCreate(c *gin.Context)
+
+	// V2ChaptersDelete Post /api/v2/chapters/delete
+	// Delete chapter
+	V2ChaptersDelete(c *gin.Context)
+
+	// V2ChaptersList Get /api/v2/chapters/list
+	// Get list of chapters for a project
+	V2ChaptersList(c *gin.Context)
+
+	// V2ChaptersUpdate Post /api/v2/chapters/update
+	// Update chapter
+	V2ChaptersUpdate(c *gin.Context)
 }
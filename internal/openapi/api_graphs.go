@@ -19,10 +19,62 @@ type GraphsAPI interface {
 	// Delete graph
 	GraphsDelete(c *gin.Context)
 
+	// GraphsExport Get /api/graphs/export
+	// Export graph of section, chapter or project
+	GraphsExport(c *gin.Context)
+
 	// GraphsFind Get /api/graphs/find
 	// Find graph
 	GraphsFind(c *gin.Context)
 
+	// GraphsImport Post /api/graphs/import
+	// Import children of graph from outline or graph document
+	GraphsImport(c *gin.Context)
+
+	// GraphsNodesAdd Post /api/graphs/nodes/add
+	// Add child node to graph
+	GraphsNodesAdd(c *gin.Context)
+
+	// GraphsNodesDelete Post /api/graphs/nodes/delete
+	// Delete subtree of graph
+	GraphsNodesDelete(c *gin.Context)
+
+	// GraphsNodesMove Post /api/graphs/nodes/move
+	// Move subtree of graph to new parent
+	GraphsNodesMove(c *gin.Context)
+
+	// GraphsNodesRename Post /api/graphs/nodes/rename
+	// Rename node of graph
+	GraphsNodesRename(c *gin.Context)
+
+	// GraphsNodesUpdate Post /api/graphs/nodes/update
+	// Update relation and description of node of graph
+	GraphsNodesUpdate(c *gin.Context)
+
+	// GraphsResectionalize Post /api/graphs/resectionalize
+	// Resectionalize into graphs
+	GraphsResectionalize(c *gin.Context)
+
+	// GraphsResectionalizePreview Post /api/graphs/resectionalize/preview
+	// Preview resectionalization of graphs
+	GraphsResectionalizePreview(c *gin.Context)
+
+	// GraphsRevisionsDiff Get /api/graphs/revisions/diff
+	// Diff graph revisions
+	GraphsRevisionsDiff(c *gin.Context)
+
+	// GraphsRevisionsFind Get /api/graphs/revisions/find
+	// Find graph revision
+	GraphsRevisionsFind(c *gin.Context)
+
+	// GraphsRevisionsList Get /api/graphs/revisions/list
+	// List graph revisions
+	GraphsRevisionsList(c *gin.Context)
+
+	// GraphsRevisionsRestore Post /api/graphs/revisions/restore
+	// Restore graph revision
+	GraphsRevisionsRestore(c *gin.Context)
+
 	// GraphsSectionalize Post /api/graphs/sectionalize
 	// Sectionalize into graphs
 	GraphsSectionalize(c *gin.Context)
@@ -30,4 +82,72 @@ type GraphsAPI interface {
 	// GraphsUpdate Post /api/graphs/update
 	// Update graph
 	GraphsUpdate(c *gin.Context)
+
+	// V2GraphsDelete Post /api/v2/graphs/delete
+	// Delete graph
+	V2GraphsDelete(c *gin.Context)
+
+	// V2GraphsExport Get /api/v2/graphs/export
+	// Export graph of section, chapter or project
+	V2GraphsExport(c *gin.Context)
+
+	// V2GraphsFind Get /api/v2/graphs/find
+	// Find graph
+	V2GraphsFind(c *gin.Context)
+
+	// V2GraphsImport Post /api/v2/graphs/import
+	// Import children of graph from outline or graph document
+	V2GraphsImport(c *gin.Context)
+
+	// V2GraphsNodesAdd Post /api/v2/graphs/nodes/add
+	// Add child node to graph
+	V2GraphsNodesAdd(c *gin.Context)
+
+	// V2GraphsNodesDelete Post /api/v2/graphs/nodes/delete
+	// Delete subtree of graph
+	V2GraphsNodesDelete(c *gin.Context)
+
+	// V2GraphsNodesMove Post /api/v2/graphs/nodes/move
+	// Move subtree of graph to new parent
+	V2GraphsNodesMove(c *gin.Context)
+
+	// V2GraphsNodesRename Post /api/v2/graphs/nodes/rename
+	// Rename node of graph
+	V2GraphsNodesRename(c *gin.Context)
+
+	// V2GraphsNodesUpdate Post /api/v2/graphs/nodes/update
+	// Update relation and description of node of graph
+	V2GraphsNodesUpdate(c *gin.Context)
+
+	// V2GraphsResectionalize Post /api/v2/graphs/resectionalize
+	// Resectionalize into graphs
+	V2GraphsResectionalize(c *gin.Context)
+
+	// V2GraphsResectionalizePreview Post /api/v2/graphs/resectionalize/preview
+	// Preview resectionalization of graphs
+	V2GraphsResectionalizePreview(c *gin.Context)
+
+	// V2GraphsRevisionsDiff Get /api/v2/graphs/revisions/diff
+	// Diff graph revisions
+	V2GraphsRevisionsDiff(c *gin.Context)
+
+	// V2GraphsRevisionsFind Get /api/v2/graphs/revisions/find
+	// Find graph revision
+	V2GraphsRevisionsFind(c *gin.Context)
+
+	// V2GraphsRevisionsList Get /api/v2/graphs/revisions/list
+	// List graph revisions
+	V2GraphsRevisionsList(c *gin.Context)
+
+	// V2GraphsRevisionsRestore Post /api/v2/graphs/revisions/restore
+	// Restore graph revision
+	V2GraphsRevisionsRestore(c *gin.Context)
+
+	// V2GraphsSectionalize Post /api/v2/graphs/sectionalize
+	// Sectionalize into graphs
+	V2GraphsSectionalize(c *gin.Context)
+
+	// V2GraphsUpdate Post /api/v2/graphs/update
+	// Update graph
+	V2GraphsUpdate(c *gin.Context)
 }
@@ -0,0 +1,49 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type LinksAPI interface {
+
+	// LinksCreate Post /api/links/create
+	// Create new link between graph nodes
+	LinksCreate(c *gin.Context)
+
+	// LinksDelete Post /api/links/delete
+	// Delete link
+	LinksDelete(c *gin.Context)
+
+	// LinksList Get /api/links/list
+	// List links of project
+	LinksList(c *gin.Context)
+
+	// LinksUpdate Post /api/links/update
+	// Update link
+	LinksUpdate(c *gin.Context)
+
+	// V2LinksCreate Post /api/v2/links/create
+	// Create new link between graph nodes
+	V2LinksCreate(c *gin.Context)
+
+	// V2LinksDelete Post /api/v2/links/delete
+	// Delete link
+	V2LinksDelete(c *gin.Context)
+
+	// V2LinksList Get /api/v2/links/list
+	// List links of project
+	V2LinksList(c *gin.Context)
+
+	// V2LinksUpdate Post /api/v2/links/update
+	// Update link
+	V2LinksUpdate(c *gin.Context)
+}
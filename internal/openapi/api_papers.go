@@ -19,7 +19,55 @@ type PapersAPI interface {
 	// Find paper
 	PapersFind(c *gin.Context)
 
+	// PapersRevisionsDiff Get /api/papers/revisions/diff
+	// Diff paper revisions
+	PapersRevisionsDiff(c *gin.Context)
+
+	// PapersRevisionsFind Get /api/papers/revisions/find
+	// Find paper revision
+	PapersRevisionsFind(c *gin.Context)
+
+	// PapersRevisionsList Get /api/papers/revisions/list
+	// List paper revisions
+	PapersRevisionsList(c *gin.Context)
+
+	// PapersRevisionsRestore Post /api/papers/revisions/restore
+	// Restore paper revision
+	PapersRevisionsRestore(c *gin.Context)
+
+	// PapersSectionalize Post /api/papers/sectionalize
+	// Sectionalize paper into graphs
+	PapersSectionalize(c *gin.Context)
+
 	// PapersUpdate Post /api/papers/update
 	// Update paper
 	PapersUpdate(c *gin.Context)
+
+	// V2PapersFind Get /api/v2/papers/find
+	// Find paper
+	V2PapersFind(c *gin.Context)
+
+	// V2PapersRevisionsDiff Get /api/v2/papers/revisions/diff
+	// Diff paper revisions
+	V2PapersRevisionsDiff(c *gin.Context)
+
+	// V2PapersRevisionsFind Get /api/v2/papers/revisions/find
+	// Find paper revision
+	V2PapersRevisionsFind(c *gin.Context)
+
+	// V2PapersRevisionsList Get /api/v2/papers/revisions/list
+	// List paper revisions
+	V2PapersRevisionsList(c *gin.Context)
+
+	// V2PapersRevisionsRestore Post /api/v2/papers/revisions/restore
+	// Restore paper revision
+	V2PapersRevisionsRestore(c *gin.Context)
+
+	// V2PapersSectionalize Post /api/v2/papers/sectionalize
+	// Sectionalize paper into graphs
+	V2PapersSectionalize(c *gin.Context)
+
+	// V2PapersUpdate Post /api/v2/papers/update
+	// Update paper
+	V2PapersUpdate(c *gin.Context)
 }
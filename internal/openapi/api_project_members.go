@@ -0,0 +1,41 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectMembersAPI interface {
+
+	// ProjectMembersInvite Post /api/projects/members/invite
+	// Invite user to project
+	ProjectMembersInvite(c *gin.Context)
+
+	// ProjectMembersList Get /api/projects/members/list
+	// List members of project
+	ProjectMembersList(c *gin.Context)
+
+	// ProjectMembersRemove Post /api/projects/members/remove
+	// Remove member from project
+	ProjectMembersRemove(c *gin.Context)
+
+	// V2ProjectMembersInvite Post /api/v2/projects/members/invite
+	// Invite user to project
+	V2ProjectMembersInvite(c *gin.Context)
+
+	// V2ProjectMembersList Get /api/v2/projects/members/list
+	// List members of project
+	V2ProjectMembersList(c *gin.Context)
+
+	// V2ProjectMembersRemove Post /api/v2/projects/members/remove
+	// Remove member from project
+	V2ProjectMembersRemove(c *gin.Context)
+}
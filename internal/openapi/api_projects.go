@@ -15,6 +15,10 @@ import (
 
 type ProjectsAPI interface {
 
+	// ProjectsCompile Get /api/projects/compile
+	// Compile project into single document
+	ProjectsCompile(c *gin.Context)
+
 	// ProjectsCreate Post /api/projects/create
 	// Create new project
 	ProjectsCreate(c *gin.Context)
@@ -23,10 +27,22 @@ type ProjectsAPI interface {
 	// Delete project
 	ProjectsDelete(c *gin.Context)
 
+	// ProjectsExport Get /api/projects/export
+	// Export project as archive
+	ProjectsExport(c *gin.Context)
+
 	// ProjectsFind Get /api/projects/find
 	// Find project
 	ProjectsFind(c *gin.Context)
 
+	// ProjectsGraph Get /api/projects/graph
+	// Get knowledge graph of project
+	ProjectsGraph(c *gin.Context)
+
+	// ProjectsImport Post /api/projects/import
+	// Import project from archive
+	ProjectsImport(c *gin.Context)
+
 	// ProjectsList Get /api/projects/list
 	// Get list of projects
 	ProjectsList(c *gin.Context)
@@ -34,4 +50,40 @@ type ProjectsAPI interface {
 	// ProjectsUpdate Post /api/projects/update
 	// Update project
 	ProjectsUpdate(c *gin.Context)
+
+	// V2ProjectsCompile Get /api/v2/projects/compile
+	// Compile project into single document
+	V2ProjectsCompile(c *gin.Context)
+
+	// V2ProjectsCreate Post /api/v2/projects/create
+	// Create new project
+	V2ProjectsCreate(c *gin.Context)
+
+	// V2ProjectsDelete Post /api/v2/projects/delete
+	// Delete project
+	V2ProjectsDelete(c *gin.Context)
+
+	// V2ProjectsExport Get /api/v2/projects/export
+	// Export project as archive
+	V2ProjectsExport(c *gin.Context)
+
+	// V2ProjectsFind Get /api/v2/projects/find
+	// Find project
+	V2ProjectsFind(c *gin.Context)
+
+	// V2ProjectsGraph Get /api/v2/projects/graph
+	// Get knowledge graph of project
+	V2ProjectsGraph(c *gin.Context)
+
+	// V2ProjectsImport Post /api/v2/projects/import
+	// Import project from archive
+	V2ProjectsImport(c *gin.Context)
+
+	// V2ProjectsList Get /api/v2/projects/list
+	// Get list of projects
+	V2ProjectsList(c *gin.Context)
+
+	// V2ProjectsUpdate Post /api/v2/projects/update
+	// Update project
+	V2ProjectsUpdate(c *gin.Context)
 }
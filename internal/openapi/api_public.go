@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type PublicAPI interface {
+
+	// PublicGraphsFind Get /api/public/graphs/find
+	// Find graph of shared project without authentication
+	PublicGraphsFind(c *gin.Context)
+
+	// PublicPapersFind Get /api/public/papers/find
+	// Find paper of shared project without authentication
+	PublicPapersFind(c *gin.Context)
+
+	// PublicProjectsFind Get /api/public/projects/find
+	// Find shared project and its chapters without authentication
+	PublicProjectsFind(c *gin.Context)
+}
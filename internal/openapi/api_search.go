@@ -0,0 +1,25 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SearchAPI interface {
+
+	// Search Get /api/search
+	// Search projects, chapters, sections, graph nodes and papers
+	Search(c *gin.Context)
+
+	// V2Search Get /api/v2/search
+	// Search projects, chapters, sections, graph nodes and papers
+	V2Search(c *gin.Context)
+}
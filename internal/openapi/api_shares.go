@@ -0,0 +1,41 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SharesAPI interface {
+
+	// SharesCreate Post /api/shares/create
+	// Create new share link of project or chapter
+	SharesCreate(c *gin.Context)
+
+	// SharesList Get /api/shares/list
+	// List share links of project
+	SharesList(c *gin.Context)
+
+	// SharesRevoke Post /api/shares/revoke
+	// Revoke share link
+	SharesRevoke(c *gin.Context)
+
+	// V2SharesCreate Post /api/v2/shares/create
+	// Create new share link of project or chapter
+	V2SharesCreate(c *gin.Context)
+
+	// V2SharesList Get /api/v2/shares/list
+	// List share links of project
+	V2SharesList(c *gin.Context)
+
+	// V2SharesRevoke Post /api/v2/shares/revoke
+	// Revoke share link
+	V2SharesRevoke(c *gin.Context)
+}
@@ -0,0 +1,33 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type TrashAPI interface {
+
+	// TrashList Get /api/trash/list
+	// List trash items
+	TrashList(c *gin.Context)
+
+	// TrashRestore Post /api/trash/restore
+	// Restore trash item
+	TrashRestore(c *gin.Context)
+
+	// V2TrashList Get /api/v2/trash/list
+	// List trash items
+	V2TrashList(c *gin.Context)
+
+	// V2TrashRestore Post /api/v2/trash/restore
+	// Restore trash item
+	V2TrashRestore(c *gin.Context)
+}
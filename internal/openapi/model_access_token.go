@@ -0,0 +1,36 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// AccessToken - Personal access token object
+type AccessToken struct {
+
+	// Auto-generated access token ID
+	Id string `json:"id"`
+
+	// Name of the access token
+	Name string `json:"name"`
+
+	// Scope of the access token. Either read or write
+	Scope string `json:"scope"`
+
+	// Auto-generated project ID. Absent when the access token is not limited to a project
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Expiration time of the access token. Absent when the access token never expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Created time of the access token
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenCreateErrorResponse - Error Response Body for Access Token Create API
+type AccessTokenCreateErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	User UserOnlyIdError `json:"user,omitempty"`
+
+	AccessToken AccessTokenWithoutAutofieldError `json:"accessToken,omitempty"`
+}
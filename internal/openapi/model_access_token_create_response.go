@@ -0,0 +1,18 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenCreateResponse - Response Body for Access Token Create API
+type AccessTokenCreateResponse struct {
+	AccessToken AccessToken `json:"accessToken"`
+
+	// Plaintext secret of the access token. Returned only once on creation
+	Secret string `json:"secret"`
+}
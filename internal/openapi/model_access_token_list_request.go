@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenListRequest - Request Paramemters for Access Token List API
+type AccessTokenListRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenListResponse - Response Body for Access Token List API
+type AccessTokenListResponse struct {
+	AccessTokens []AccessToken `json:"accessTokens"`
+}
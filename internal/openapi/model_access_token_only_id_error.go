@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenOnlyIdError - Error Message for AccessTokenOnlyId object
+type AccessTokenOnlyIdError struct {
+
+	// Error message for access token ID
+	Id string `json:"id,omitempty"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenRevokeErrorResponse - Error Response Body for Access Token Revoke API
+type AccessTokenRevokeErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	User UserOnlyIdError `json:"user,omitempty"`
+
+	AccessToken AccessTokenOnlyIdError `json:"accessToken,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenRevokeRequest - Request Body for Access Token Revoke API
+type AccessTokenRevokeRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	AccessToken AccessTokenOnlyId `json:"accessToken"`
+}
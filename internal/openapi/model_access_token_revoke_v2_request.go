@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenRevokeV2Request - Request Body for Access Token Revoke API v2
+type AccessTokenRevokeV2Request struct {
+	AccessToken AccessTokenOnlyId `json:"accessToken"`
+}
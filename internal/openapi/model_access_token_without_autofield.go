@@ -0,0 +1,30 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// AccessTokenWithoutAutofield - Personal access token object without auto-generated fields
+type AccessTokenWithoutAutofield struct {
+
+	// Name of the access token
+	Name string `json:"name"`
+
+	// Scope of the access token. Either read or write
+	Scope string `json:"scope"`
+
+	// Auto-generated project ID. Omit to allow access to all projects
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Expiration time of the access token. Omit to create access token without expiration
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
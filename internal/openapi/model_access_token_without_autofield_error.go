@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// AccessTokenWithoutAutofieldError - Error Message for AccessTokenWithoutAutofield object
+type AccessTokenWithoutAutofieldError struct {
+
+	// Error message for access token name
+	Name string `json:"name,omitempty"`
+
+	// Error message for access token scope
+	Scope string `json:"scope,omitempty"`
+
+	// Error message for project ID
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Error message for expiration time
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
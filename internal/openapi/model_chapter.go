@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // Chapter - Chapter object
 type Chapter struct {
 
@@ -20,4 +24,7 @@ type Chapter struct {
 
 	// Chapter number
 	Number int32 `json:"number"`
+
+	// Last updated time of the chapter. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
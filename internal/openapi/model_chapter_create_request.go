@@ -11,7 +11,7 @@ package openapi
 
 // ChapterCreateRequest - Request Body for Chapter Create API
 type ChapterCreateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
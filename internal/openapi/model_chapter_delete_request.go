@@ -11,7 +11,7 @@ package openapi
 
 // ChapterDeleteRequest - Request Body for Chapter Delete API
 type ChapterDeleteRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ChapterDeleteV2Request - Request Body for Chapter Delete API v2
+type ChapterDeleteV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+}
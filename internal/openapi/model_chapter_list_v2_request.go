@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ChapterListV2Request - Request Paramemters for Chapter List API v2
+type ChapterListV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ChapterUpdateConflictResponse - Conflict Response Body for Chapter Update API
+type ChapterUpdateConflictResponse struct {
+
+	// Error message when the chapter has been updated since the client last fetched it
+	Message string `json:"message"`
+
+	Chapter ChapterWithSections `json:"chapter"`
+}
@@ -11,7 +11,7 @@ package openapi
 
 // ChapterUpdateRequest - Request Body for Chapter Update API
 type ChapterUpdateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
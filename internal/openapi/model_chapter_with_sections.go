@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // ChapterWithSections - ChapterWithSections object
 type ChapterWithSections struct {
 
@@ -22,4 +26,7 @@ type ChapterWithSections struct {
 	Number int32 `json:"number"`
 
 	Sections []SectionOfChapter `json:"sections"`
+
+	// Last updated time of the chapter. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
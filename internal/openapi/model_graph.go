@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // Graph - Graph object
 type Graph struct {
 
@@ -22,4 +26,7 @@ type Graph struct {
 	Paragraph string `json:"paragraph"`
 
 	Children []GraphChild `json:"children"`
+
+	// Last updated time of the graph. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
@@ -12,6 +12,9 @@ package openapi
 // GraphChild - GraphChild object
 type GraphChild struct {
 
+	// Auto-generated immutable ID of the child node. Omit it for a new node and echo it back for an existing one
+	Id string `json:"id,omitempty"`
+
 	// Child node name of the graph
 	Name string `json:"name"`
 
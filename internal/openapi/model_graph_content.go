@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // GraphContent - Graph object with only content fields
 type GraphContent struct {
 
@@ -19,4 +23,7 @@ type GraphContent struct {
 	Paragraph string `json:"paragraph"`
 
 	Children []GraphChild `json:"children"`
+
+	// Last updated time of the graph. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
@@ -11,7 +11,7 @@ package openapi
 
 // GraphDeleteRequest - Request Body for Graph Delete API
 type GraphDeleteRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
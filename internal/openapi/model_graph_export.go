@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphExport - Graph rendered in an external format
+type GraphExport struct {
+
+	// Export format
+	Format string `json:"format"`
+
+	// Media type of the content
+	MediaType string `json:"mediaType"`
+
+	// Rendered graph
+	Content string `json:"content"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphExportRequest - Request Parameters for Graph Export API. Omit sectionId to export the whole chapter, and omit both chapterId and sectionId to export the whole project
+type GraphExportRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId,omitempty" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId,omitempty" form:"sectionId"`
+
+	// Export format. One of cytoscape, dot, graphml, mermaid
+	Format string `json:"format" form:"format"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphExportV2Request - Request Parameters for Graph Export API. Omit sectionId to export the whole chapter, and omit both chapterId and sectionId to export the whole project v2
+type GraphExportV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId,omitempty" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId,omitempty" form:"sectionId"`
+
+	// Export format. One of cytoscape, dot, graphml, mermaid
+	Format string `json:"format" form:"format"`
+}
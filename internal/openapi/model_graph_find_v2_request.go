@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphFindV2Request - Request Parameters for Graph Find API v2
+type GraphFindV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId" form:"sectionId"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportNodeError - Error Message for node of source document
+type GraphImportNodeError struct {
+
+	// Location of node in source document
+	Location string `json:"location"`
+
+	// Error message for overall of children of node
+	Message string `json:"message,omitempty"`
+
+	// Error message for node name
+	Name string `json:"name,omitempty"`
+
+	// Error message for node relation
+	Relation string `json:"relation,omitempty"`
+
+	// Error message for node description
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportRequest - Request Body for Graph Import API
+type GraphImportRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	Source GraphImportSource `json:"source"`
+}
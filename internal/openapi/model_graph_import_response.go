@@ -0,0 +1,18 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportResponse - Response Body for Graph Import API
+type GraphImportResponse struct {
+	Graph Graph `json:"graph"`
+
+	// Warnings about source document repaired on import
+	Warnings []GraphImportWarning `json:"warnings"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportSource - Source document to import into children of graph
+type GraphImportSource struct {
+
+	// Import format. One of graphml, markdown, mermaid, opml
+	Format string `json:"format"`
+
+	// Content of source document
+	Content string `json:"content"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportSourceError - Error Message for GraphImportSource object
+type GraphImportSourceError struct {
+
+	// Error message for import format
+	Format string `json:"format,omitempty"`
+
+	// Error message for content of source document
+	Content string `json:"content,omitempty"`
+
+	Nodes []GraphImportNodeError `json:"nodes,omitempty"`
+}
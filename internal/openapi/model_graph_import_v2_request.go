@@ -0,0 +1,21 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportV2Request - Request Body for Graph Import API v2
+type GraphImportV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	Source GraphImportSource `json:"source"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphImportWarning - Warning about source document repaired on import
+type GraphImportWarning struct {
+
+	// Location in source document
+	Location string `json:"location"`
+
+	// Warning message
+	Message string `json:"message"`
+}
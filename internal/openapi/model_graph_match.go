@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphMatch - Existing graph matched to a section
+type GraphMatch struct {
+
+	// Section name
+	Name string `json:"name"`
+
+	// ID of the existing graph kept for the section. Absent when a new graph will be created
+	GraphId string `json:"graphId,omitempty"`
+}
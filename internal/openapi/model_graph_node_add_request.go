@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeAddRequest - Request Body for Graph Node Add API
+type GraphNodeAddRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	// Names of the nodes from the root of the graph to the parent node. Empty for the root
+	ParentPath []string `json:"parentPath"`
+
+	Child GraphChild `json:"child"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeAddResponse - Response Body for Graph Node Add API
+type GraphNodeAddResponse struct {
+	Graph Graph `json:"graph"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeAddV2Request - Request Body for Graph Node Add API v2
+type GraphNodeAddV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	// Names of the nodes from the root of the graph to the parent node. Empty for the root
+	ParentPath []string `json:"parentPath"`
+
+	Child GraphChild `json:"child"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeDeleteResponse - Response Body for Graph Node Delete API
+type GraphNodeDeleteResponse struct {
+	Graph Graph `json:"graph"`
+}
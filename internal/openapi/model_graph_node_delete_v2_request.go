@@ -0,0 +1,22 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeDeleteV2Request - Request Body for Graph Node Delete API v2
+type GraphNodeDeleteV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	// Names of the nodes from the root of the graph to the target node
+	Path []string `json:"path"`
+}
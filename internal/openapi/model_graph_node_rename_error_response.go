@@ -0,0 +1,31 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeRenameErrorResponse - Error Response Body for Graph Node Rename API
+type GraphNodeRenameErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	User UserOnlyIdError `json:"user,omitempty"`
+
+	Project ProjectOnlyIdError `json:"project,omitempty"`
+
+	Chapter ChapterOnlyIdError `json:"chapter,omitempty"`
+
+	Section SectionOnlyIdError `json:"section,omitempty"`
+
+	// Error message for path
+	Path string `json:"path,omitempty"`
+
+	// Error message for name
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,27 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphNodeRenameRequest - Request Body for Graph Node Rename API
+type GraphNodeRenameRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Section SectionOnlyId `json:"section"`
+
+	// Names of the nodes from the root of the graph to the target node
+	Path []string `json:"path"`
+
+	// New name of the node
+	Name string `json:"name"`
+}
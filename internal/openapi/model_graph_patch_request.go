@@ -0,0 +1,28 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphPatchRequest - Request Parameters and Body for Graph Update API with JSON Patch
+type GraphPatchRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId" form:"sectionId"`
+
+	Patch []JsonPatchOperation `json:"patch"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphPatchV2Request - Request Parameters and Body for Graph Update API v2 with JSON Patch
+type GraphPatchV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId" form:"sectionId"`
+
+	Patch []JsonPatchOperation `json:"patch"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphResectionalizeErrorResponse - Error Response Body for Graph Resectionalize API
+type GraphResectionalizeErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	User UserOnlyIdError `json:"user,omitempty"`
+
+	Project ProjectOnlyIdError `json:"project,omitempty"`
+
+	Chapter ChapterOnlyIdError `json:"chapter,omitempty"`
+
+	Sections SectionWithoutAutofieldListError `json:"sections,omitempty"`
+
+	// Error message for orphan action
+	OrphanAction string `json:"orphanAction,omitempty"`
+}
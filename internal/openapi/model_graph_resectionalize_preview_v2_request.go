@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphResectionalizePreviewV2Request - Request Body for Graph Resectionalize Preview API v2
+type GraphResectionalizePreviewV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Sections []SectionWithoutAutofield `json:"sections"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphResectionalizeRequest - Request Body for Graph Resectionalize API
+type GraphResectionalizeRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Sections []SectionWithoutAutofield `json:"sections"`
+
+	// What to do with graphs matched to no section
+	OrphanAction string `json:"orphanAction"`
+}
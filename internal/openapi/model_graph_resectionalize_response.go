@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphResectionalizeResponse - Response Body for Graph Resectionalize API
+type GraphResectionalizeResponse struct {
+	Graphs []Graph `json:"graphs"`
+
+	Orphans []Graph `json:"orphans"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphResectionalizeV2Request - Request Body for Graph Resectionalize API v2
+type GraphResectionalizeV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Chapter ChapterOnlyId `json:"chapter"`
+
+	Sections []SectionWithoutAutofield `json:"sections"`
+
+	// What to do with graphs matched to no section
+	OrphanAction string `json:"orphanAction"`
+}
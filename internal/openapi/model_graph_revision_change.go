@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionChange - Structural change of a graph node between two revisions
+type GraphRevisionChange struct {
+
+	// Kind of the change
+	Type string `json:"type"`
+
+	// Names from the root to the node in the old revision. Absent for added nodes
+	FromPath []string `json:"fromPath,omitempty"`
+
+	// Names from the root to the node in the new revision. Absent for removed nodes
+	ToPath []string `json:"toPath,omitempty"`
+
+	// Paragraph, relation or description in the old revision
+	FromValue string `json:"fromValue,omitempty"`
+
+	// Paragraph, relation or description in the new revision
+	ToValue string `json:"toValue,omitempty"`
+}
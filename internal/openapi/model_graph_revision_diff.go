@@ -0,0 +1,22 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionDiff - Structural diff between two graph revisions
+type GraphRevisionDiff struct {
+
+	// Graph revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId"`
+
+	// Graph revision ID of the new side
+	ToRevisionId string `json:"toRevisionId"`
+
+	Changes []GraphRevisionChange `json:"changes"`
+}
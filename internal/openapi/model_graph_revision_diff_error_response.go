@@ -0,0 +1,35 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionDiffErrorResponse - Error Response Body for Graph Revision Diff API
+type GraphRevisionDiffErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	// Error message for user ID
+	UserId string `json:"userId,omitempty"`
+
+	// Error message for project ID
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Error message for chapter ID
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Error message for section ID
+	SectionId string `json:"sectionId,omitempty"`
+
+	// Error message for graph revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId,omitempty"`
+
+	// Error message for graph revision ID of the new side
+	ToRevisionId string `json:"toRevisionId,omitempty"`
+}
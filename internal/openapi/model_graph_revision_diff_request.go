@@ -0,0 +1,32 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionDiffRequest - Request Parameters for Graph Revision Diff API
+type GraphRevisionDiffRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId" form:"sectionId"`
+
+	// Auto-generated graph revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId" form:"fromRevisionId"`
+
+	// Auto-generated graph revision ID of the new side
+	ToRevisionId string `json:"toRevisionId" form:"toRevisionId"`
+}
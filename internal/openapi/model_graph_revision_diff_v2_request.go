@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionDiffV2Request - Request Parameters for Graph Revision Diff API v2
+type GraphRevisionDiffV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId" form:"sectionId"`
+
+	// Auto-generated graph revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId" form:"fromRevisionId"`
+
+	// Auto-generated graph revision ID of the new side
+	ToRevisionId string `json:"toRevisionId" form:"toRevisionId"`
+}
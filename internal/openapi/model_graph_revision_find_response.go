@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphRevisionFindResponse - Response Body for Graph Revision Find API
+type GraphRevisionFindResponse struct {
+	Revision GraphRevision `json:"revision"`
+}
@@ -0,0 +1,27 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// GraphRevisionSummary - Graph revision object without content
+type GraphRevisionSummary struct {
+
+	// Auto-generated graph revision ID
+	Id string `json:"id"`
+
+	// User ID of the author of the revision
+	AuthorId string `json:"authorId"`
+
+	// Created time of the revision
+	CreatedAt time.Time `json:"createdAt"`
+}
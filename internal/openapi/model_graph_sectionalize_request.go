@@ -11,7 +11,7 @@ package openapi
 
 // GraphSectionalizeRequest - Request Body for Graph Sectionalize API
 type GraphSectionalizeRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
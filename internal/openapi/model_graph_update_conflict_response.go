@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// GraphUpdateConflictResponse - Conflict Response Body for Graph Update API
+type GraphUpdateConflictResponse struct {
+
+	// Error message when the graph has been updated since the client last fetched it
+	Message string `json:"message"`
+
+	Graph Graph `json:"graph"`
+}
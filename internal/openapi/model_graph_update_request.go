@@ -11,7 +11,7 @@ package openapi
 
 // GraphUpdateRequest - Request Body for Graph Update API
 type GraphUpdateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
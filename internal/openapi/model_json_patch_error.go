@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// JsonPatchError - Error Message for JsonPatchOperation list
+type JsonPatchError struct {
+
+	// Error message for overall of patch
+	Message string `json:"message,omitempty"`
+
+	Items []JsonPatchOperationError `json:"items,omitempty"`
+}
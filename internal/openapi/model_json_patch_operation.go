@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// JsonPatchOperation - JSON Patch operation object (RFC 6902)
+type JsonPatchOperation struct {
+
+	// Operation type
+	Op string `json:"op"`
+
+	// JSON Pointer to the target location
+	Path string `json:"path"`
+
+	// JSON Pointer to the source location. Required for move and copy operations
+	From string `json:"from,omitempty"`
+
+	// Value to add, replace or test. Required for add, replace and test operations, where null is also a value
+	Value interface{} `json:"value,omitempty"`
+}
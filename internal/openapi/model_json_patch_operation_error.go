@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// JsonPatchOperationError - Error Message for JsonPatchOperation object
+type JsonPatchOperationError struct {
+
+	// Error message for overall of operation
+	Message string `json:"message,omitempty"`
+
+	// Error message for operation type
+	Op string `json:"op,omitempty"`
+
+	// Error message for target location
+	Path string `json:"path,omitempty"`
+
+	// Error message for source location
+	From string `json:"from,omitempty"`
+}
@@ -0,0 +1,34 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// Link - Link object
+type Link struct {
+
+	// Auto-generated link ID
+	Id string `json:"id"`
+
+	From LinkEndpoint `json:"from"`
+
+	To LinkEndpoint `json:"to"`
+
+	// Link relation
+	Relation string `json:"relation"`
+
+	// Link description
+	Description string `json:"description"`
+
+	// Last updated time of the link
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkDeleteV2Request - Request Body for Link Delete API v2
+type LinkDeleteV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Link LinkOnlyId `json:"link"`
+}
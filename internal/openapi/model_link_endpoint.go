@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkEndpoint - Node referred by a link. Omit nodeId to refer to the root of the graph
+type LinkEndpoint struct {
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId"`
+
+	// Auto-generated immutable ID of the child node
+	NodeId string `json:"nodeId,omitempty"`
+}
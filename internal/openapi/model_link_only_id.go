@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkOnlyId - Link object with only ID
+type LinkOnlyId struct {
+
+	// Auto-generated link ID
+	Id string `json:"id"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkUpdateV2Request - Request Body for Link Update API v2
+type LinkUpdateV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Link Link `json:"link"`
+}
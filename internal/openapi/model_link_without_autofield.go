@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkWithoutAutofield - Link object without auto-generated fields
+type LinkWithoutAutofield struct {
+	From LinkEndpoint `json:"from"`
+
+	To LinkEndpoint `json:"to"`
+
+	// Link relation
+	Relation string `json:"relation"`
+
+	// Link description
+	Description string `json:"description"`
+}
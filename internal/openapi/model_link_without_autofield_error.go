@@ -0,0 +1,27 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// LinkWithoutAutofieldError - Error Message for LinkWithoutAutofield object
+type LinkWithoutAutofieldError struct {
+
+	// Error message for overall of link
+	Message string `json:"message,omitempty"`
+
+	From LinkEndpointError `json:"from,omitempty"`
+
+	To LinkEndpointError `json:"to,omitempty"`
+
+	// Error message for link relation
+	Relation string `json:"relation,omitempty"`
+
+	// Error message for link description
+	Description string `json:"description,omitempty"`
+}
@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // Paper - Paper object
 type Paper struct {
 
@@ -17,4 +21,7 @@ type Paper struct {
 
 	// Paper content
 	Content string `json:"content"`
+
+	// Last updated time of the paper. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
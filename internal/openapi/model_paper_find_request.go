@@ -12,8 +12,8 @@ package openapi
 // PaperFindRequest - Request Parameters for Paper Find API
 type PaperFindRequest struct {
 
-	// User ID
-	UserId string `json:"userId" form:"userId"`
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
 
 	// Auto-generated project ID
 	ProjectId string `json:"projectId" form:"projectId"`
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperOnlyIdError - Error Message for PaperOnlyId object
+type PaperOnlyIdError struct {
+
+	// Error message for paper ID
+	Id string `json:"id,omitempty"`
+}
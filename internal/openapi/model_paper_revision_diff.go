@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionDiff - Line-based unified diff between two paper revisions
+type PaperRevisionDiff struct {
+
+	// Paper revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId"`
+
+	// Paper revision ID of the new side
+	ToRevisionId string `json:"toRevisionId"`
+
+	// Unified diff of the paper content. Empty when the contents are identical
+	UnifiedDiff string `json:"unifiedDiff"`
+}
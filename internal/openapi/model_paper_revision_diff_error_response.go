@@ -0,0 +1,32 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionDiffErrorResponse - Error Response Body for Paper Revision Diff API
+type PaperRevisionDiffErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	// Error message for user ID
+	UserId string `json:"userId,omitempty"`
+
+	// Error message for project ID
+	ProjectId string `json:"projectId,omitempty"`
+
+	// Error message for paper ID
+	PaperId string `json:"paperId,omitempty"`
+
+	// Error message for paper revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId,omitempty"`
+
+	// Error message for paper revision ID of the new side
+	ToRevisionId string `json:"toRevisionId,omitempty"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionDiffRequest - Request Parameters for Paper Revision Diff API
+type PaperRevisionDiffRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated paper ID
+	PaperId string `json:"paperId" form:"paperId"`
+
+	// Auto-generated paper revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId" form:"fromRevisionId"`
+
+	// Auto-generated paper revision ID of the new side
+	ToRevisionId string `json:"toRevisionId" form:"toRevisionId"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionDiffV2Request - Request Parameters for Paper Revision Diff API v2
+type PaperRevisionDiffV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated paper ID
+	PaperId string `json:"paperId" form:"paperId"`
+
+	// Auto-generated paper revision ID of the old side
+	FromRevisionId string `json:"fromRevisionId" form:"fromRevisionId"`
+
+	// Auto-generated paper revision ID of the new side
+	ToRevisionId string `json:"toRevisionId" form:"toRevisionId"`
+}
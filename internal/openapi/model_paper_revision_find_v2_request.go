@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionFindV2Request - Request Parameters for Paper Revision Find API v2
+type PaperRevisionFindV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Auto-generated paper ID
+	PaperId string `json:"paperId" form:"paperId"`
+
+	// Auto-generated paper revision ID
+	RevisionId string `json:"revisionId" form:"revisionId"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionOnlyId - Paper revision object with only ID
+type PaperRevisionOnlyId struct {
+
+	// Auto-generated paper revision ID
+	Id string `json:"id"`
+}
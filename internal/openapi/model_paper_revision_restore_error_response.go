@@ -0,0 +1,25 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionRestoreErrorResponse - Error Response Body for Paper Revision Restore API
+type PaperRevisionRestoreErrorResponse struct {
+
+	// Error message when request body format is invalid
+	Message string `json:"message"`
+
+	User UserOnlyIdError `json:"user,omitempty"`
+
+	Project ProjectOnlyIdError `json:"project,omitempty"`
+
+	Paper PaperOnlyIdError `json:"paper,omitempty"`
+
+	Revision PaperRevisionOnlyIdError `json:"revision,omitempty"`
+}
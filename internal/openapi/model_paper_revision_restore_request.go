@@ -0,0 +1,21 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionRestoreRequest - Request Body for Paper Revision Restore API
+type PaperRevisionRestoreRequest struct {
+	User UserOnlyId `json:"user,omitempty"`
+
+	Project ProjectOnlyId `json:"project"`
+
+	Paper PaperOnlyId `json:"paper"`
+
+	Revision PaperRevisionOnlyId `json:"revision"`
+}
@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperRevisionRestoreV2Request - Request Body for Paper Revision Restore API v2
+type PaperRevisionRestoreV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Paper PaperOnlyId `json:"paper"`
+
+	Revision PaperRevisionOnlyId `json:"revision"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperSectionalizeV2Request - Request Body for Paper Sectionalize API v2
+type PaperSectionalizeV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Paper PaperOnlyId `json:"paper"`
+}
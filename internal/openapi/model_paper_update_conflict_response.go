@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PaperUpdateConflictResponse - Conflict Response Body for Paper Update API
+type PaperUpdateConflictResponse struct {
+
+	// Error message when the paper has been updated since the client last fetched it
+	Message string `json:"message"`
+
+	Paper Paper `json:"paper"`
+}
@@ -11,7 +11,7 @@ package openapi
 
 // PaperUpdateRequest - Request Body for Paper Update API
 type PaperUpdateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 
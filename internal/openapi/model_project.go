@@ -9,6 +9,10 @@
 
 package openapi
 
+import (
+	"time"
+)
+
 // Project - Project object
 type Project struct {
 
@@ -20,4 +24,10 @@ type Project struct {
 
 	// Project description
 	Description string `json:"description,omitempty"`
+
+	// Role of the user in the project
+	Role string `json:"role,omitempty"`
+
+	// Last updated time of the project. Send the value last seen to reject stale updates
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
 }
@@ -0,0 +1,28 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectArchiveChapterError - Error Message for chapter in project archive
+type ProjectArchiveChapterError struct {
+
+	// Error message for overall of chapter
+	Message string `json:"message,omitempty"`
+
+	// Error message for chapter ID
+	Id string `json:"id,omitempty"`
+
+	// Error message for chapter name
+	Name string `json:"name,omitempty"`
+
+	// Error message for paper content
+	Paper string `json:"paper,omitempty"`
+
+	Sections []ProjectArchiveSectionError `json:"sections,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectArchiveError - Error Message for project archive
+type ProjectArchiveError struct {
+
+	// Error message for overall of archive
+	Message string `json:"message,omitempty"`
+
+	Project ProjectWithoutAutofieldError `json:"project,omitempty"`
+
+	Chapters []ProjectArchiveChapterError `json:"chapters,omitempty"`
+
+	Links []LinkWithoutAutofieldError `json:"links,omitempty"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectArchiveSectionError - Error Message for section in project archive
+type ProjectArchiveSectionError struct {
+
+	// Error message for section ID
+	Id string `json:"id,omitempty"`
+
+	// Error message for section name
+	Name string `json:"name,omitempty"`
+
+	// Error message for graph paragraph
+	Paragraph string `json:"paragraph,omitempty"`
+
+	Children GraphChildrenError `json:"children,omitempty"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectCompileRequest - Request Parameters for Project Compile API
+type ProjectCompileRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Compile format. One of epub, html, markdown
+	Format string `json:"format" form:"format"`
+
+	// Whether to append graph of each section as outline
+	IncludeGraphs bool `json:"includeGraphs,omitempty" form:"includeGraphs"`
+}
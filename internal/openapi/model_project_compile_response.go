@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectCompileResponse - Response for Project Compile API. Content is sent as body of the media type
+type ProjectCompileResponse struct {
+
+	// Suggested file name of compiled document
+	FileName string `json:"fileName"`
+
+	// Media type of compiled document
+	MediaType string `json:"mediaType"`
+
+	// Compiled document
+	Content []byte `json:"content"`
+}
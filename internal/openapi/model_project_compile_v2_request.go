@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectCompileV2Request - Request Parameters for Project Compile API v2
+type ProjectCompileV2Request struct {
+
+	// Auto-generated project ID
+	ProjectId string `json:"projectId" form:"projectId"`
+
+	// Compile format. One of epub, html, markdown
+	Format string `json:"format" form:"format"`
+
+	// Whether to append graph of each section as outline
+	IncludeGraphs bool `json:"includeGraphs,omitempty" form:"includeGraphs"`
+}
@@ -11,7 +11,7 @@ package openapi
 
 // ProjectCreateRequest - Request Body for Project Create API
 type ProjectCreateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectWithoutAutofield `json:"project"`
 }
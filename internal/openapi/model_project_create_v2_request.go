@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectCreateV2Request - Request Body for Project Create API v2
+type ProjectCreateV2Request struct {
+	Project ProjectWithoutAutofield `json:"project"`
+}
@@ -11,7 +11,7 @@ package openapi
 
 // ProjectDeleteRequest - Request Body for Project Delete API
 type ProjectDeleteRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project ProjectOnlyId `json:"project"`
 }
@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectDeleteV2Request - Request Body for Project Delete API v2
+type ProjectDeleteV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+}
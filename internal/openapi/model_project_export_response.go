@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectExportResponse - Response for Project Export API. Archive is sent as application/zip body
+type ProjectExportResponse struct {
+
+	// Suggested file name of archive
+	FileName string `json:"fileName"`
+
+	// Zip archive of project
+	Archive []byte `json:"archive"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectGraph - Knowledge graph of the whole project merged across chapters and sections
+type ProjectGraph struct {
+
+	// Concepts in the project
+	Nodes []ProjectGraphNode `json:"nodes"`
+
+	// Relations between concepts in the project
+	Edges []ProjectGraphEdge `json:"edges"`
+}
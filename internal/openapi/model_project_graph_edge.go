@@ -0,0 +1,29 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectGraphEdge - Relation merged from parent-child pairs with the same names and relation
+type ProjectGraphEdge struct {
+
+	// Name of the parent concept
+	Source string `json:"source"`
+
+	// Name of the child concept
+	Target string `json:"target"`
+
+	// Relation of the child to the parent
+	Relation string `json:"relation"`
+
+	// Description of the relation
+	Description string `json:"description"`
+
+	// Child nodes merged into the relation
+	Occurrences []ProjectGraphOccurrence `json:"occurrences"`
+}
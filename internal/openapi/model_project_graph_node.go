@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectGraphNode - Concept merged from graph nodes with the same name
+type ProjectGraphNode struct {
+
+	// Name of the concept
+	Name string `json:"name"`
+
+	// Graph nodes merged into the concept
+	Occurrences []ProjectGraphOccurrence `json:"occurrences"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectGraphOccurrence - Graph node where a concept appears. nodeId is omitted for the root of the graph
+type ProjectGraphOccurrence struct {
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId"`
+
+	// Auto-generated section ID
+	SectionId string `json:"sectionId"`
+
+	// Auto-generated immutable ID of the child node
+	NodeId string `json:"nodeId,omitempty"`
+}
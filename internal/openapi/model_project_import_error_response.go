@@ -0,0 +1,22 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectImportErrorResponse - Error Response Body for Project Import API
+type ProjectImportErrorResponse struct {
+
+	// Error message when request format is invalid
+	Message string `json:"message"`
+
+	// Error message for user ID
+	UserId string `json:"userId,omitempty"`
+
+	Archive ProjectArchiveError `json:"archive,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectImportV2Request - Request for Project Import API v2. Archive is sent as application/zip body
+type ProjectImportV2Request struct {
+
+	// Zip archive of project
+	Archive []byte `json:"archive"`
+}
@@ -12,6 +12,6 @@ package openapi
 // ProjectListRequest - Request Parameters for Project List API
 type ProjectListRequest struct {
 
-	// User ID
-	UserId string `json:"userId" form:"userId"`
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectMember - Member of project
+type ProjectMember struct {
+
+	// User ID of the member
+	Id string `json:"id"`
+
+	// Role of the member. One of owner, editor or viewer
+	Role string `json:"role"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectMemberError - Error Message for ProjectMember object
+type ProjectMemberError struct {
+
+	// Error message for user ID of the member
+	Id string `json:"id,omitempty"`
+
+	// Error message for role of the member
+	Role string `json:"role,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectMemberInviteV2Request - Request Body for Project Member Invite API v2
+type ProjectMemberInviteV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Member ProjectMember `json:"member"`
+}
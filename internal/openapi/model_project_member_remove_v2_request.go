@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectMemberRemoveV2Request - Request Body for Project Member Remove API v2
+type ProjectMemberRemoveV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Member UserOnlyId `json:"member"`
+}
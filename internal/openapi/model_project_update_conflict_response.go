@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ProjectUpdateConflictResponse - Conflict Response Body for Project Update API
+type ProjectUpdateConflictResponse struct {
+
+	// Error message when the project has been updated since the client last fetched it
+	Message string `json:"message"`
+
+	Project Project `json:"project"`
+}
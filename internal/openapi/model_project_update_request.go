@@ -11,7 +11,7 @@ package openapi
 
 // ProjectUpdateRequest - Request Body for Project Update API
 type ProjectUpdateRequest struct {
-	User UserOnlyId `json:"user"`
+	User UserOnlyId `json:"user,omitempty"`
 
 	Project Project `json:"project"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PublicPaperFindRequest - Request Paramemters for Public Paper Find API
+type PublicPaperFindRequest struct {
+
+	// Auto-generated secret token of the share
+	Token string `json:"token" form:"token"`
+
+	// Auto-generated chapter ID
+	ChapterId string `json:"chapterId" form:"chapterId"`
+}
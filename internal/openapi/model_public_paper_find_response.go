@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PublicPaperFindResponse - Response Body for Public Paper Find API
+type PublicPaperFindResponse struct {
+	Paper Paper `json:"paper"`
+}
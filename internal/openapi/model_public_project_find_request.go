@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PublicProjectFindRequest - Request Paramemters for Public Project Find API
+type PublicProjectFindRequest struct {
+
+	// Auto-generated secret token of the share
+	Token string `json:"token" form:"token"`
+}
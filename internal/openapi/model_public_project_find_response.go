@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// PublicProjectFindResponse - Response Body for Public Project Find API
+type PublicProjectFindResponse struct {
+	Project Project `json:"project"`
+
+	Chapters []ChapterWithSections `json:"chapters"`
+}
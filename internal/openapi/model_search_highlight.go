@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SearchHighlight - Range of a match in the snippet text, counted in Unicode code points
+type SearchHighlight struct {
+
+	// Start of the match
+	Offset int32 `json:"offset"`
+
+	// Length of the match
+	Length int32 `json:"length"`
+}
@@ -0,0 +1,37 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SearchHit - Search hit object
+type SearchHit struct {
+
+	// Kind of the hit. One of project, chapter, section, node, paper
+	Kind string `json:"kind"`
+
+	// Auto-generated project ID of the hit or its parent
+	ProjectId string `json:"projectId"`
+
+	// Auto-generated chapter ID of the hit or its parent. Absent for projects
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Auto-generated section ID of the hit or its parent. Present only for sections and nodes
+	SectionId string `json:"sectionId,omitempty"`
+
+	// Auto-generated graph child ID of the hit. Present only for nodes
+	NodeId string `json:"nodeId,omitempty"`
+
+	// Title of the hit. The chapter name for papers
+	Title string `json:"title"`
+
+	Snippet SearchSnippet `json:"snippet"`
+
+	// Relevance score of the hit. Higher is more relevant
+	Score float64 `json:"score"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SearchRequest - Request Parameters for Search API
+type SearchRequest struct {
+
+	// Deprecated user ID. The user is taken from the access token, and requests under /api/v2 do not have it
+	UserId string `json:"userId,omitempty" form:"userId"`
+
+	// Search query. All words in the query have to match
+	Query string `json:"query" form:"query"`
+
+	// Auto-generated project ID to search within. Searches all projects when omitted
+	ProjectId string `json:"projectId,omitempty" form:"projectId"`
+
+	// Maximum number of hits. Defaults to 20
+	Limit int32 `json:"limit,omitempty" form:"limit"`
+}
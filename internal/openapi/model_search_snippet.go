@@ -0,0 +1,19 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SearchSnippet - Part of the hit text around the matches
+type SearchSnippet struct {
+
+	// Snippet text
+	Text string `json:"text"`
+
+	Highlights []SearchHighlight `json:"highlights"`
+}
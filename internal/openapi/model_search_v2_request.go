@@ -0,0 +1,23 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// SearchV2Request - Request Parameters for Search API v2
+type SearchV2Request struct {
+
+	// Search query. All words in the query have to match
+	Query string `json:"query" form:"query"`
+
+	// Auto-generated project ID to search within. Searches all projects when omitted
+	ProjectId string `json:"projectId,omitempty" form:"projectId"`
+
+	// Maximum number of hits. Defaults to 20
+	Limit int32 `json:"limit,omitempty" form:"limit"`
+}
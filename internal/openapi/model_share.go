@@ -0,0 +1,30 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// Share - Share object
+type Share struct {
+
+	// Auto-generated secret token of the share
+	Token string `json:"token"`
+
+	// Auto-generated chapter ID. Absent when the whole project is shared
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Expiration time of the share. Absent when the share never expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Created time of the share
+	CreatedAt time.Time `json:"createdAt"`
+}
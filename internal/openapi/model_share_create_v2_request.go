@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ShareCreateV2Request - Request Body for Share Create API v2
+type ShareCreateV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Share ShareWithoutAutofield `json:"share"`
+}
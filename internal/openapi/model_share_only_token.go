@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ShareOnlyToken - Share object with only token
+type ShareOnlyToken struct {
+
+	// Auto-generated secret token of the share
+	Token string `json:"token"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ShareOnlyTokenError - Error Message for ShareOnlyToken object
+type ShareOnlyTokenError struct {
+
+	// Error message for share token
+	Token string `json:"token,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ShareRevokeV2Request - Request Body for Share Revoke API v2
+type ShareRevokeV2Request struct {
+	Project ProjectOnlyId `json:"project"`
+
+	Share ShareOnlyToken `json:"share"`
+}
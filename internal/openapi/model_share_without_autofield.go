@@ -0,0 +1,24 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// ShareWithoutAutofield - Share object without auto-generated fields
+type ShareWithoutAutofield struct {
+
+	// Auto-generated chapter ID. Omit to share the whole project
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Expiration time of the share. Omit to share without expiration
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// ShareWithoutAutofieldError - Error Message for ShareWithoutAutofield object
+type ShareWithoutAutofieldError struct {
+
+	// Error message for chapter ID
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Error message for expiration time
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// TrashItem - Trash item object
+type TrashItem struct {
+
+	// Auto-generated trash item ID
+	Id string `json:"id"`
+
+	// Kind of the deleted item
+	Type string `json:"type"`
+
+	// Name of the deleted item
+	Name string `json:"name"`
+
+	// Auto-generated project ID of the deleted item or its parent
+	ProjectId string `json:"projectId"`
+
+	// Auto-generated chapter ID of the deleted item or its parent. Absent for projects
+	ChapterId string `json:"chapterId,omitempty"`
+
+	// Auto-generated section ID of the deleted item. Present only for sections
+	SectionId string `json:"sectionId,omitempty"`
+
+	// Deleted time of the item
+	DeletedAt time.Time `json:"deletedAt"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// TrashItemOnlyId - Trash item object with only ID
+type TrashItemOnlyId struct {
+
+	// Auto-generated trash item ID
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Web API of kNODEledge
+ *
+ * App to Create Graphically-Summarized Notes in Three Steps
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// TrashItemRestoreV2Request - Request Body for Trash Item Restore API v2
+type TrashItemRestoreV2Request struct {
+	Item TrashItemOnlyId `json:"item"`
+}
@@ -0,0 +1,12 @@
+package record
+
+import "time"
+
+type AccessTokenEntry struct {
+	Name      string
+	Scope     string
+	ProjectId string
+	ExpiresAt *time.Time
+	UserId    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,11 @@
+package record
+
+import "time"
+
+type AccessTokenWithoutAutofieldEntry struct {
+	Name      string
+	Scope     string
+	ProjectId string
+	TokenHash string
+	ExpiresAt *time.Time
+}
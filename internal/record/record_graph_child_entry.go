@@ -1,6 +1,7 @@
 package record
 
 type GraphChildEntry struct {
+	Id          string
 	Name        string
 	Relation    string
 	Description string
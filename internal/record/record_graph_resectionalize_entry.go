@@ -0,0 +1,7 @@
+package record
+
+type GraphResectionalizeEntry struct {
+	Id        string
+	Name      string
+	Paragraph string
+}
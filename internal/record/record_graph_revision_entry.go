@@ -0,0 +1,11 @@
+package record
+
+import "time"
+
+type GraphRevisionEntry struct {
+	Paragraph string
+	Children  []GraphChildEntry
+	AuthorId  string
+	UserId    string
+	CreatedAt time.Time
+}
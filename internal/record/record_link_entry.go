@@ -0,0 +1,19 @@
+package record
+
+import "time"
+
+type LinkEndpointEntry struct {
+	ChapterId string
+	SectionId string
+	NodeId    string
+}
+
+type LinkEntry struct {
+	From        LinkEndpointEntry
+	To          LinkEndpointEntry
+	Relation    string
+	Description string
+	UserId      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
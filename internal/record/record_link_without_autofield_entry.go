@@ -0,0 +1,8 @@
+package record
+
+type LinkWithoutAutofieldEntry struct {
+	From        LinkEndpointEntry
+	To          LinkEndpointEntry
+	Relation    string
+	Description string
+}
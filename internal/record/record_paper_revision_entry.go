@@ -0,0 +1,10 @@
+package record
+
+import "time"
+
+type PaperRevisionEntry struct {
+	Content   string
+	AuthorId  string
+	UserId    string
+	CreatedAt time.Time
+}
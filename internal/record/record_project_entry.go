@@ -6,6 +6,7 @@ type ProjectEntry struct {
 	Name        string
 	Description string
 	UserId      string
+	Members     map[string]string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -0,0 +1,11 @@
+package record
+
+import "time"
+
+type ShareEntry struct {
+	ProjectId string
+	ChapterId string
+	ExpiresAt *time.Time
+	UserId    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,8 @@
+package record
+
+import "time"
+
+type ShareWithoutAutofieldEntry struct {
+	ChapterId string
+	ExpiresAt *time.Time
+}
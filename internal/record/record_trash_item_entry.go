@@ -0,0 +1,14 @@
+package record
+
+import "time"
+
+type TrashItemEntry struct {
+	Type      string
+	Name      string
+	ProjectId string
+	ChapterId string
+	SectionId string
+	Position  int
+	UserId    string
+	DeletedAt time.Time
+}
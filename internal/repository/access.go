@@ -0,0 +1,68 @@
+package repository
+
+import "github.com/kumachan-mis/knodeledge-api/internal/document"
+
+const (
+	ProjectRoleOwner  = "owner"
+	ProjectRoleEditor = "editor"
+	ProjectRoleViewer = "viewer"
+)
+
+// projectAccess is the access level which an operation requires on a project.
+// Viewers can read, editors can also write, and only the owner can trash the project
+// or manage its members.
+type projectAccess int
+
+const (
+	readAccess projectAccess = iota
+	writeAccess
+	ownerAccess
+)
+
+// projectRole returns the role of the user in the project, or empty string
+// when the user is neither the owner nor a member
+func projectRole(ownerId string, members map[string]string, userId string) string {
+	if ownerId == userId {
+		return ProjectRoleOwner
+	}
+	return members[userId]
+}
+
+func roleHasAccess(role string, access projectAccess) bool {
+	switch role {
+	case ProjectRoleOwner:
+		return true
+	case ProjectRoleEditor:
+		return access <= writeAccess
+	case ProjectRoleViewer:
+		return access == readAccess
+	default:
+		return false
+	}
+}
+
+func canAccessProject(values document.ProjectValues, userId string, access projectAccess) bool {
+	return roleHasAccess(projectRole(values.UserId, values.Members, userId), access)
+}
+
+func isMemberRole(role string) bool {
+	return role == ProjectRoleEditor || role == ProjectRoleViewer
+}
+
+// canRemoveProjectMember reports whether the user can remove the member from the project.
+// The owner can remove anyone, and members can remove themselves.
+func canRemoveProjectMember(ownerId string, members map[string]string, userId string, memberId string) bool {
+	return ownerId == userId || (userId == memberId && isMemberRole(members[memberId]))
+}
+
+func membersToEntry(members map[string]string) map[string]string {
+	if len(members) == 0 {
+		return nil
+	}
+
+	entry := make(map[string]string, len(members))
+	for memberId, role := range members {
+		entry[memberId] = role
+	}
+	return entry
+}
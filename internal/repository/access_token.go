@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"cloud.google.com/go/firestore"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const AccessTokenCollection = "accessTokens"
+
+type AccessTokenRepository interface {
+	FetchAccessTokens(
+		userId string,
+	) (map[string]record.AccessTokenEntry, *Error)
+	FetchAccessTokenByHash(
+		tokenHash string,
+	) (string, *record.AccessTokenEntry, *Error)
+	InsertAccessToken(
+		userId string,
+		entry record.AccessTokenWithoutAutofieldEntry,
+	) (string, *record.AccessTokenEntry, *Error)
+	DeleteAccessToken(
+		userId string,
+		accessTokenId string,
+	) *Error
+}
+
+type accessTokenRepository struct {
+	client            firestore.Client
+	chapterRepository chapterRepository
+}
+
+func NewAccessTokenRepository(client firestore.Client) AccessTokenRepository {
+	return accessTokenRepository{
+		client:            client,
+		chapterRepository: chapterRepository{client: client},
+	}
+}
+
+func (r accessTokenRepository) FetchAccessTokens(
+	userId string,
+) (map[string]record.AccessTokenEntry, *Error) {
+	iter := r.client.Collection(AccessTokenCollection).
+		Where("userId", "==", userId).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.AccessTokenEntry)
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch access tokens: %w", err)
+		}
+
+		var values document.AccessTokenValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r accessTokenRepository) FetchAccessTokenByHash(
+	tokenHash string,
+) (string, *record.AccessTokenEntry, *Error) {
+	snapshots, err := r.client.Collection(AccessTokenCollection).
+		Where("tokenHash", "==", tokenHash).
+		Limit(1).
+		Documents(db.FirestoreContext()).
+		GetAll()
+	if err != nil {
+		return "", nil, Errorf(ReadFailurePanic, "failed to fetch access token: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return "", nil, Errorf(NotFoundError, "failed to fetch access token")
+	}
+
+	var values document.AccessTokenValues
+	err = snapshots[0].DataTo(&values)
+	if err != nil {
+		return "", nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return snapshots[0].Ref.ID, r.valuesToEntry(values), nil
+}
+
+func (r accessTokenRepository) InsertAccessToken(
+	userId string,
+	entry record.AccessTokenWithoutAutofieldEntry,
+) (string, *record.AccessTokenEntry, *Error) {
+	if entry.ProjectId != "" {
+		_, rErr := r.chapterRepository.projectValues(userId, entry.ProjectId, readAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	}
+
+	ref := r.client.Collection(AccessTokenCollection).
+		Doc(newId())
+
+	values := map[string]any{
+		"name":      entry.Name,
+		"scope":     entry.Scope,
+		"tokenHash": entry.TokenHash,
+		"userId":    userId,
+		"createdAt": firestore.ServerTimestamp,
+	}
+	if entry.ProjectId != "" {
+		values["projectId"] = entry.ProjectId
+	}
+	if entry.ExpiresAt != nil {
+		values["expiresAt"] = *entry.ExpiresAt
+	}
+
+	_, err := ref.Create(db.FirestoreContext(), values)
+	if err != nil {
+		return "", nil, Errorf(WriteFailurePanic, "failed to insert access token: %w", err)
+	}
+
+	created, rErr := r.fetchAccessToken(ref.ID)
+	if rErr != nil {
+		return "", nil, Errorf(ReadFailurePanic, "failed to fetch inserted access token: %w", rErr.Unwrap())
+	}
+
+	return ref.ID, created, nil
+}
+
+func (r accessTokenRepository) DeleteAccessToken(
+	userId string,
+	accessTokenId string,
+) *Error {
+	entry, rErr := r.fetchAccessToken(accessTokenId)
+	if rErr != nil {
+		return rErr
+	}
+
+	if entry.UserId != userId {
+		return Errorf(NotFoundError, "failed to fetch access token")
+	}
+
+	_, err := r.client.Collection(AccessTokenCollection).
+		Doc(accessTokenId).
+		Delete(db.FirestoreContext())
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to delete access token: %w", err)
+	}
+
+	return nil
+}
+
+func (r accessTokenRepository) fetchAccessToken(
+	accessTokenId string,
+) (*record.AccessTokenEntry, *Error) {
+	snapshot, err := r.client.Collection(AccessTokenCollection).
+		Doc(accessTokenId).
+		Get(db.FirestoreContext())
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch access token")
+	}
+
+	var values document.AccessTokenValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.valuesToEntry(values), nil
+}
+
+func (r accessTokenRepository) valuesToEntry(
+	values document.AccessTokenValues,
+) *record.AccessTokenEntry {
+	return &record.AccessTokenEntry{
+		Name:      values.Name,
+		Scope:     values.Scope,
+		ProjectId: values.ProjectId,
+		ExpiresAt: values.ExpiresAt,
+		UserId:    values.UserId,
+		CreatedAt: values.CreatedAt,
+	}
+}
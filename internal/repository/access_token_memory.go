@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryAccessTokenRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryAccessTokenRepository(store *MemoryStore) AccessTokenRepository {
+	return memoryAccessTokenRepository{store: store}
+}
+
+func (r memoryAccessTokenRepository) FetchAccessTokens(
+	userId string,
+) (map[string]record.AccessTokenEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.AccessTokenEntry)
+	for id, values := range r.store.accessTokens {
+		if values.UserId == userId {
+			entries[id] = *r.valuesToEntry(values)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r memoryAccessTokenRepository) FetchAccessTokenByHash(
+	tokenHash string,
+) (string, *record.AccessTokenEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for id, values := range r.store.accessTokens {
+		if values.TokenHash == tokenHash {
+			return id, r.valuesToEntry(values), nil
+		}
+	}
+
+	return "", nil, Errorf(NotFoundError, "failed to fetch access token")
+}
+
+func (r memoryAccessTokenRepository) InsertAccessToken(
+	userId string,
+	entry record.AccessTokenWithoutAutofieldEntry,
+) (string, *record.AccessTokenEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if entry.ProjectId != "" {
+		_, rErr := r.store.project(userId, entry.ProjectId, readAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	}
+
+	id := newId()
+	values := document.AccessTokenValues{
+		Name:      entry.Name,
+		Scope:     entry.Scope,
+		ProjectId: entry.ProjectId,
+		TokenHash: entry.TokenHash,
+		ExpiresAt: entry.ExpiresAt,
+		UserId:    userId,
+		CreatedAt: currentTime(),
+	}
+	r.store.accessTokens[id] = values
+
+	return id, r.valuesToEntry(values), nil
+}
+
+func (r memoryAccessTokenRepository) DeleteAccessToken(
+	userId string,
+	accessTokenId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	values, ok := r.store.accessTokens[accessTokenId]
+	if !ok || values.UserId != userId {
+		return Errorf(NotFoundError, "failed to fetch access token")
+	}
+
+	delete(r.store.accessTokens, accessTokenId)
+	return nil
+}
+
+func (r memoryAccessTokenRepository) valuesToEntry(
+	values document.AccessTokenValues,
+) *record.AccessTokenEntry {
+	return &record.AccessTokenEntry{
+		Name:      values.Name,
+		Scope:     values.Scope,
+		ProjectId: values.ProjectId,
+		ExpiresAt: values.ExpiresAt,
+		UserId:    values.UserId,
+		CreatedAt: values.CreatedAt,
+	}
+}
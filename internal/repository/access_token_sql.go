@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const sqlAccessTokenColumns = "id, name, scope, project_id, expires_at, user_id, created_at"
+
+type sqlAccessTokenRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLAccessTokenRepository(database db.SQLDatabase) AccessTokenRepository {
+	return sqlAccessTokenRepository{database: database}
+}
+
+func (r sqlAccessTokenRepository) FetchAccessTokens(
+	userId string,
+) (map[string]record.AccessTokenEntry, *Error) {
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlAccessTokenColumns+" FROM access_tokens WHERE user_id = ?"), userId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.AccessTokenEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch access tokens: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlAccessTokenRepository) FetchAccessTokenByHash(
+	tokenHash string,
+) (string, *record.AccessTokenEntry, *Error) {
+	id, entry, err := r.scanEntry(r.database.DB.QueryRow(r.database.Rebind(
+		"SELECT "+sqlAccessTokenColumns+" FROM access_tokens WHERE token_hash = ?"), tokenHash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil, Errorf(NotFoundError, "failed to fetch access token")
+	}
+	if err != nil {
+		return "", nil, Errorf(ReadFailurePanic, "failed to fetch access token: %w", err)
+	}
+	return id, entry, nil
+}
+
+func (r sqlAccessTokenRepository) InsertAccessToken(
+	userId string,
+	entry record.AccessTokenWithoutAutofieldEntry,
+) (string, *record.AccessTokenEntry, *Error) {
+	id := newId()
+	now := currentTime()
+
+	var expiresAt sql.NullTime
+	if entry.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: entry.ExpiresAt.UTC(), Valid: true}
+	}
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		if entry.ProjectId != "" {
+			rErr := sqlCheckProject(r.database, tx, userId, entry.ProjectId, readAccess)
+			if rErr != nil {
+				return rErr
+			}
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO access_tokens ("+sqlAccessTokenColumns+", token_hash) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"),
+			id, entry.Name, entry.Scope, entry.ProjectId, expiresAt, userId, now, entry.TokenHash)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert access token: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return id, &record.AccessTokenEntry{
+		Name:      entry.Name,
+		Scope:     entry.Scope,
+		ProjectId: entry.ProjectId,
+		ExpiresAt: entry.ExpiresAt,
+		UserId:    userId,
+		CreatedAt: now,
+	}, nil
+}
+
+func (r sqlAccessTokenRepository) DeleteAccessToken(
+	userId string,
+	accessTokenId string,
+) *Error {
+	result, err := r.database.DB.Exec(r.database.Rebind("DELETE FROM access_tokens WHERE user_id = ? AND id = ?"),
+		userId, accessTokenId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to delete access token: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to delete access token: %w", err)
+	}
+	if affected == 0 {
+		return Errorf(NotFoundError, "failed to fetch access token")
+	}
+	return nil
+}
+
+func (r sqlAccessTokenRepository) scanEntry(
+	row interface{ Scan(dest ...any) error },
+) (string, *record.AccessTokenEntry, error) {
+	var id string
+	var expiresAt sql.NullTime
+	var entry record.AccessTokenEntry
+	err := row.Scan(&id, &entry.Name, &entry.Scope, &entry.ProjectId, &expiresAt, &entry.UserId, &entry.CreatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if expiresAt.Valid {
+		value := expiresAt.Time.UTC()
+		entry.ExpiresAt = &value
+	}
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return id, &entry, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const (
+	idCharset   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	idLength    = 20
+	tokenLength = 40
+)
+
+func newId() string {
+	return randomString(idLength)
+}
+
+// newToken returns an id which is long enough to be used as a bearer secret
+func newToken() string {
+	return randomString(tokenLength)
+}
+
+func randomString(length int) string {
+	random := make([]byte, length)
+	_, _ = rand.Read(random)
+
+	id := make([]byte, length)
+	for i, b := range random {
+		id[i] = idCharset[int(b)%len(idCharset)]
+	}
+	return string(id)
+}
+
+func currentTime() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// assignGraphChildIds keeps the ids of children which already exist in current and
+// assigns new ids to the others. When the same id appears more than once, only the
+// first occurrence keeps it.
+func assignGraphChildIds(current []record.GraphChildEntry, children []record.GraphChildEntry) []record.GraphChildEntry {
+	available := map[string]bool{}
+	collectGraphChildIds(current, available)
+	return assignAvailableGraphChildIds(children, available)
+}
+
+func collectGraphChildIds(children []record.GraphChildEntry, ids map[string]bool) {
+	for _, child := range children {
+		if child.Id != "" {
+			ids[child.Id] = true
+		}
+		collectGraphChildIds(child.Children, ids)
+	}
+}
+
+func assignAvailableGraphChildIds(children []record.GraphChildEntry, available map[string]bool) []record.GraphChildEntry {
+	entries := make([]record.GraphChildEntry, len(children))
+	for i, child := range children {
+		id := child.Id
+		if !available[id] {
+			id = newId()
+		}
+		available[id] = false
+
+		entries[i] = record.GraphChildEntry{
+			Id:          id,
+			Name:        child.Name,
+			Relation:    child.Relation,
+			Description: child.Description,
+			Children:    assignAvailableGraphChildIds(child.Children, available),
+		}
+	}
+	return entries
+}
+
+func graphChildIdsMissing(children []record.GraphChildEntry) bool {
+	for _, child := range children {
+		if child.Id == "" || graphChildIdsMissing(child.Children) {
+			return true
+		}
+	}
+	return false
+}
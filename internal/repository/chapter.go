@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -34,6 +35,7 @@ type ChapterRepository interface {
 		projectId string,
 		chapterId string,
 		entry record.ChapterWithoutAutofieldEntry,
+		expectedUpdatedAt *time.Time,
 	) (*record.ChapterEntry, *Error)
 	UpdateChapterSections(
 		userId string,
@@ -41,7 +43,7 @@ type ChapterRepository interface {
 		chapterId string,
 		entries []record.SectionWithoutAutofieldEntry,
 	) ([]record.SectionEntry, *Error)
-	DeleteChapter(
+	TrashChapter(
 		userId string,
 		projectId string,
 		chapterId string,
@@ -60,7 +62,7 @@ func (r chapterRepository) FetchChapters(
 	userId string,
 	projectId string,
 ) (map[string]record.ChapterEntry, *Error) {
-	projectValues, rErr := r.projectValues(userId, projectId)
+	projectValues, rErr := r.projectValues(userId, projectId, readAccess)
 	if rErr != nil {
 		return nil, rErr
 	}
@@ -89,6 +91,10 @@ func (r chapterRepository) FetchChapters(
 			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
 		}
 
+		if values.Trashed {
+			continue
+		}
+
 		number, ok := chapterNumbers[snapshot.Ref.ID]
 		if !ok {
 			err = errors.New("document.ProjectValues.chapterIds have insufficient elements")
@@ -110,7 +116,16 @@ func (r chapterRepository) FetchChapter(
 	projectId string,
 	chapterId string,
 ) (*record.ChapterEntry, *Error) {
-	projectValues, rErr := r.projectValues(userId, projectId)
+	return r.fetchChapter(userId, projectId, chapterId, readAccess)
+}
+
+func (r chapterRepository) fetchChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+	access projectAccess,
+) (*record.ChapterEntry, *Error) {
+	projectValues, rErr := r.projectValues(userId, projectId, access)
 	if rErr != nil {
 		return nil, rErr
 	}
@@ -134,9 +149,6 @@ func (r chapterRepository) FetchChapter(
 	if err != nil && ok {
 		err := errors.New("document.ProjectValues.chapterIds have excessive elements")
 		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
-	} else if err == nil && !ok {
-		err := errors.New("document.ProjectValues.chapterIds have insufficient elements")
-		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
 	}
 
 	var values document.ChapterValues
@@ -145,6 +157,13 @@ func (r chapterRepository) FetchChapter(
 		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
 	}
 
+	if !ok && values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch chapter")
+	} else if !ok {
+		err := errors.New("document.ProjectValues.chapterIds have insufficient elements")
+		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+	}
+
 	return r.valuesToEntry(values, number, userId), nil
 }
 
@@ -153,45 +172,63 @@ func (r chapterRepository) InsertChapter(
 	projectId string,
 	entry record.ChapterWithoutAutofieldEntry,
 ) (string, *record.ChapterEntry, *Error) {
-	projectValues, rErr := r.projectValues(userId, projectId)
-	if rErr != nil {
-		return "", nil, rErr
-	}
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+	ref := projectRef.Collection(ChapterCollection).
+		NewDoc()
+	paperRef := projectRef.Collection(PaperCollection).
+		Doc(ref.ID)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		projectValues, rErr := r.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
 
-	if entry.Number > len(projectValues.ChapterIds)+1 {
-		return "", nil, Errorf(InvalidArgumentError, "chapter number is too large")
-	}
+		if entry.Number > len(projectValues.ChapterIds)+1 {
+			return Errorf(InvalidArgumentError, "chapter number is too large")
+		}
 
-	ref, _, err := r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Collection(ChapterCollection).
-		Add(db.FirestoreContext(), map[string]any{
+		err := tx.Create(ref, map[string]any{
 			"name":      entry.Name,
 			"sections":  []map[string]any{},
 			"createdAt": firestore.ServerTimestamp,
 			"updatedAt": firestore.ServerTimestamp,
 		})
-	if err != nil {
-		return "", nil, Errorf(WriteFailurePanic, "failed to insert chapter: %w", err)
-	}
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert chapter: %w", err)
+		}
 
-	updatedChapterIds := make([]string, len(projectValues.ChapterIds)+1)
-	copy(updatedChapterIds[:entry.Number-1], projectValues.ChapterIds[:entry.Number-1])
-	updatedChapterIds[entry.Number-1] = ref.ID
-	copy(updatedChapterIds[entry.Number:], projectValues.ChapterIds[entry.Number-1:])
+		err = tx.Create(paperRef, map[string]any{
+			"content":   "",
+			"createdAt": firestore.ServerTimestamp,
+			"updatedAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert paper: %w", err)
+		}
 
-	_, err = r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Update(db.FirestoreContext(), []firestore.Update{
+		updatedChapterIds := make([]string, len(projectValues.ChapterIds)+1)
+		copy(updatedChapterIds[:entry.Number-1], projectValues.ChapterIds[:entry.Number-1])
+		updatedChapterIds[entry.Number-1] = ref.ID
+		copy(updatedChapterIds[entry.Number:], projectValues.ChapterIds[entry.Number-1:])
+
+		err = tx.Update(projectRef, []firestore.Update{
 			{Path: "chapterIds", Value: updatedChapterIds},
 		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return "", nil, Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+		return "", nil, transactionError(err)
 	}
 
 	snapshot, err := ref.Get(db.FirestoreContext())
 	if err != nil {
-		return "", nil, Errorf(WriteFailurePanic, "failed to fetch inserted chapter: %w", err)
+		return "", nil, Errorf(ReadFailurePanic, "failed to fetch inserted chapter: %w", err)
 	}
 
 	var values document.ChapterValues
@@ -208,59 +245,97 @@ func (r chapterRepository) UpdateChapter(
 	projectId string,
 	chapterId string,
 	entry record.ChapterWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
 ) (*record.ChapterEntry, *Error) {
-	projectValues, rErr := r.projectValues(userId, projectId)
-	if rErr != nil {
-		return nil, rErr
-	}
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+	ref := projectRef.Collection(ChapterCollection).
+		Doc(chapterId)
 
-	if entry.Number > len(projectValues.ChapterIds) {
-		return nil, Errorf(InvalidArgumentError, "chapter number is too large")
-	}
+	var current *record.ChapterEntry
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		projectValues, rErr := r.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
 
-	_, err := r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Collection(ChapterCollection).
-		Doc(chapterId).
-		Update(db.FirestoreContext(), []firestore.Update{
+		if entry.Number > len(projectValues.ChapterIds) {
+			return Errorf(InvalidArgumentError, "chapter number is too large")
+		}
+
+		snapshotToBeUpdated, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to update chapter")
+		}
+
+		var valuesToBeUpdated document.ChapterValues
+		err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if valuesToBeUpdated.Trashed {
+			return Errorf(NotFoundError, "failed to update chapter")
+		}
+
+		if expectedUpdatedAt != nil && !valuesToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+			number := 0
+			for i, id := range projectValues.ChapterIds {
+				if id == chapterId {
+					number = i + 1
+					break
+				}
+			}
+			current = r.valuesToEntry(valuesToBeUpdated, number, userId)
+			return Errorf(ConflictError, "chapter has been updated since it was fetched")
+		}
+
+		err = tx.Update(ref, []firestore.Update{
 			{Path: "name", Value: entry.Name},
 			{Path: "updatedAt", Value: firestore.ServerTimestamp},
 		})
-	if err != nil {
-		return nil, Errorf(NotFoundError, "failed to update chapter")
-	}
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter: %w", err)
+		}
 
-	chapterIdsWithoutUpdated := []string{}
-	updatedNumber := 0
-	for i, id := range projectValues.ChapterIds {
-		if id == chapterId {
-			updatedNumber = i + 1
-			continue
+		chapterIdsWithoutUpdated := []string{}
+		updatedNumber := 0
+		for i, id := range projectValues.ChapterIds {
+			if id == chapterId {
+				updatedNumber = i + 1
+				continue
+			}
+			chapterIdsWithoutUpdated = append(chapterIdsWithoutUpdated, id)
+		}
+
+		if updatedNumber == entry.Number {
+			return nil
+		}
+
+		if updatedNumber == 0 {
+			err := errors.New("document.ProjectValues.chapterIds have insufficient elements")
+			return Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
 		}
-		chapterIdsWithoutUpdated = append(chapterIdsWithoutUpdated, id)
-	}
 
-	if updatedNumber != entry.Number {
 		updatedChapterIds := make([]string, len(projectValues.ChapterIds))
 		copy(updatedChapterIds[:entry.Number-1], chapterIdsWithoutUpdated[:entry.Number-1])
 		updatedChapterIds[entry.Number-1] = chapterId
 		copy(updatedChapterIds[entry.Number:], chapterIdsWithoutUpdated[entry.Number-1:])
 
-		_, err = r.client.Collection(ProjectCollection).
-			Doc(projectId).
-			Update(db.FirestoreContext(), []firestore.Update{
-				{Path: "chapterIds", Value: updatedChapterIds},
-			})
+		err = tx.Update(projectRef, []firestore.Update{
+			{Path: "chapterIds", Value: updatedChapterIds},
+		})
 		if err != nil {
-			return nil, Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+			return Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return current, transactionError(err)
 	}
 
-	snapshot, err := r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Collection(ChapterCollection).
-		Doc(chapterId).
-		Get(db.FirestoreContext())
+	snapshot, err := ref.Get(db.FirestoreContext())
 	if err != nil {
 		return nil, Errorf(ReadFailurePanic, "failed to fetch updated chapter: %w", err)
 	}
@@ -280,11 +355,22 @@ func (r chapterRepository) UpdateChapterSections(
 	chapterId string,
 	entries []record.SectionWithoutAutofieldEntry,
 ) ([]record.SectionEntry, *Error) {
-	_, rErr := r.projectValues(userId, projectId)
+	projectValues, rErr := r.projectValues(userId, projectId, writeAccess)
 	if rErr != nil {
 		return nil, rErr
 	}
 
+	ok := false
+	for _, id := range projectValues.ChapterIds {
+		if id == chapterId {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return nil, Errorf(NotFoundError, "failed to update sections of chapter")
+	}
+
 	sections := make([]map[string]any, len(entries))
 	for i, sectionEntry := range entries {
 		sections[i] = map[string]any{
@@ -328,52 +414,95 @@ func (r chapterRepository) UpdateChapterSections(
 	return sectionEntries, nil
 }
 
-func (r chapterRepository) DeleteChapter(
+func (r chapterRepository) TrashChapter(
 	userId string,
 	projectId string,
 	chapterId string,
 ) *Error {
-	projectValues, rErr := r.projectValues(userId, projectId)
-	if rErr != nil {
-		return rErr
-	}
-
-	ref := r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Collection(ChapterCollection).
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+	ref := projectRef.Collection(ChapterCollection).
 		Doc(chapterId)
+	trashRef := r.client.Collection(TrashCollection).
+		NewDoc()
 
-	if _, err := ref.Get(db.FirestoreContext()); err != nil {
-		return Errorf(NotFoundError, "failed to fetch chapter")
-	}
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		projectValues, rErr := r.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
 
-	_, err := ref.Delete(db.FirestoreContext())
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch chapter")
+		}
 
-	if err != nil {
-		return Errorf(WriteFailurePanic, "failed to delete chapter: %w", err)
-	}
+		var values document.ChapterValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
 
-	updatedChapterIds := []string{}
-	for _, id := range projectValues.ChapterIds {
-		if id == chapterId {
-			continue
+		if values.Trashed {
+			return Errorf(NotFoundError, "failed to fetch chapter")
 		}
-		updatedChapterIds = append(updatedChapterIds, id)
-	}
 
-	_, err = r.client.Collection(ProjectCollection).
-		Doc(projectId).
-		Update(db.FirestoreContext(), []firestore.Update{
+		position := -1
+		updatedChapterIds := []string{}
+		for i, id := range projectValues.ChapterIds {
+			if id == chapterId {
+				position = i
+				continue
+			}
+			updatedChapterIds = append(updatedChapterIds, id)
+		}
+
+		if position < 0 {
+			err := errors.New("document.ProjectValues.chapterIds have insufficient elements")
+			return Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "trashed", Value: true},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to move chapter to trash: %w", err)
+		}
+
+		err = tx.Update(projectRef, []firestore.Update{
 			{Path: "chapterIds", Value: updatedChapterIds},
 		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+		}
+
+		err = tx.Create(trashRef, map[string]any{
+			"type":      TrashItemTypeChapter,
+			"name":      values.Name,
+			"projectId": projectId,
+			"chapterId": chapterId,
+			"position":  position,
+			"userId":    userId,
+			"deletedAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert trash item: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+		return transactionError(err)
 	}
 
 	return nil
 }
 
-func (r chapterRepository) projectValues(userId string, projectId string) (*document.ProjectValues, *Error) {
+func (r chapterRepository) projectValues(
+	userId string,
+	projectId string,
+	access projectAccess,
+) (*document.ProjectValues, *Error) {
 	ref := r.client.Collection(ProjectCollection).
 		Doc(projectId)
 
@@ -388,7 +517,37 @@ func (r chapterRepository) projectValues(userId string, projectId string) (*docu
 		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
 	}
 
-	if projectValues.UserId != userId {
+	if !canAccessProject(projectValues, userId, access) || projectValues.Deleting || projectValues.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	if projectValues.ChapterIds == nil {
+		projectValues.ChapterIds = []string{}
+	}
+	return &projectValues, nil
+}
+
+func (r chapterRepository) projectValuesInTransaction(
+	tx *firestore.Transaction,
+	userId string,
+	projectId string,
+	access projectAccess,
+) (*document.ProjectValues, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+
+	snapshot, err := tx.Get(ref)
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	var projectValues document.ProjectValues
+	err = snapshot.DataTo(&projectValues)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if !canAccessProject(projectValues, userId, access) || projectValues.Deleting || projectValues.Trashed {
 		return nil, Errorf(NotFoundError, "failed to fetch project")
 	}
 
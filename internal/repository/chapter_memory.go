@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryChapterRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryChapterRepository(store *MemoryStore) ChapterRepository {
+	return memoryChapterRepository{store: store}
+}
+
+func (r memoryChapterRepository) FetchChapters(
+	userId string,
+	projectId string,
+) (map[string]record.ChapterEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, rErr := r.store.project(userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entries := make(map[string]record.ChapterEntry)
+	for i, chapterId := range project.values.ChapterIds {
+		chapter := project.chapters[chapterId]
+		entries[chapterId] = *r.valuesToEntry(chapter.values, i+1, userId)
+	}
+
+	return entries, nil
+}
+
+func (r memoryChapterRepository) FetchChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+) (*record.ChapterEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, number, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return r.valuesToEntry(chapter.values, number, userId), nil
+}
+
+func (r memoryChapterRepository) InsertChapter(
+	userId string,
+	projectId string,
+	entry record.ChapterWithoutAutofieldEntry,
+) (string, *record.ChapterEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	chapterIds := project.values.ChapterIds
+	if entry.Number > len(chapterIds)+1 {
+		return "", nil, Errorf(InvalidArgumentError, "chapter number is too large")
+	}
+
+	now := currentTime()
+	id := newId()
+	chapter := &memoryChapter{
+		values: document.ChapterValues{
+			Name:      entry.Name,
+			Sections:  []document.SectionValues{},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		graphs:         make(map[string]document.GraphValues),
+		graphRevisions: make(map[string]map[string]document.GraphRevisionValues),
+	}
+	project.chapters[id] = chapter
+	project.papers[id] = document.PaperValues{
+		Content:   "",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	updatedChapterIds := make([]string, len(chapterIds)+1)
+	copy(updatedChapterIds[:entry.Number-1], chapterIds[:entry.Number-1])
+	updatedChapterIds[entry.Number-1] = id
+	copy(updatedChapterIds[entry.Number:], chapterIds[entry.Number-1:])
+	project.values.ChapterIds = updatedChapterIds
+
+	return id, r.valuesToEntry(chapter.values, entry.Number, userId), nil
+}
+
+func (r memoryChapterRepository) UpdateChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.ChapterWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.ChapterEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	chapterIds := project.values.ChapterIds
+	if entry.Number > len(chapterIds) {
+		return nil, Errorf(InvalidArgumentError, "chapter number is too large")
+	}
+
+	chapter, ok := project.chapters[chapterId]
+	if !ok || chapter.values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to update chapter")
+	}
+
+	if expectedUpdatedAt != nil && !chapter.values.UpdatedAt.Equal(*expectedUpdatedAt) {
+		number := 0
+		for i, id := range chapterIds {
+			if id == chapterId {
+				number = i + 1
+				break
+			}
+		}
+		return r.valuesToEntry(chapter.values, number, userId),
+			Errorf(ConflictError, "chapter has been updated since it was fetched")
+	}
+
+	chapter.values.Name = entry.Name
+	chapter.values.UpdatedAt = currentTime()
+
+	chapterIdsWithoutUpdated := []string{}
+	for _, id := range chapterIds {
+		if id == chapterId {
+			continue
+		}
+		chapterIdsWithoutUpdated = append(chapterIdsWithoutUpdated, id)
+	}
+
+	updatedChapterIds := make([]string, len(chapterIds))
+	copy(updatedChapterIds[:entry.Number-1], chapterIdsWithoutUpdated[:entry.Number-1])
+	updatedChapterIds[entry.Number-1] = chapterId
+	copy(updatedChapterIds[entry.Number:], chapterIdsWithoutUpdated[entry.Number-1:])
+	project.values.ChapterIds = updatedChapterIds
+
+	return r.valuesToEntry(chapter.values, entry.Number, userId), nil
+}
+
+func (r memoryChapterRepository) UpdateChapterSections(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.SectionWithoutAutofieldEntry,
+) ([]record.SectionEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	chapter, ok := project.chapters[chapterId]
+	if !ok || chapter.values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to update sections of chapter")
+	}
+
+	sections := make([]document.SectionValues, len(entries))
+	for i, sectionEntry := range entries {
+		sections[i] = document.SectionValues{
+			Id:   sectionEntry.Id,
+			Name: sectionEntry.Name,
+		}
+	}
+	chapter.values.Sections = sections
+	chapter.values.UpdatedAt = currentTime()
+
+	return r.valuesToEntry(chapter.values, 0, userId).Sections, nil
+}
+
+func (r memoryChapterRepository) TrashChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, chapter, number, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	updatedChapterIds := []string{}
+	for _, id := range project.values.ChapterIds {
+		if id == chapterId {
+			continue
+		}
+		updatedChapterIds = append(updatedChapterIds, id)
+	}
+	project.values.ChapterIds = updatedChapterIds
+	chapter.values.Trashed = true
+
+	r.store.insertTrashItem(document.TrashItemValues{
+		Type:      TrashItemTypeChapter,
+		Name:      chapter.values.Name,
+		ProjectId: projectId,
+		ChapterId: chapterId,
+		Position:  number - 1,
+		UserId:    userId,
+	})
+
+	return nil
+}
+
+func (r memoryChapterRepository) valuesToEntry(
+	values document.ChapterValues,
+	number int,
+	userId string,
+) *record.ChapterEntry {
+	sections := make([]record.SectionEntry, len(values.Sections))
+	for i, sectionValues := range values.Sections {
+		sections[i] = record.SectionEntry{
+			Id:        sectionValues.Id,
+			Name:      sectionValues.Name,
+			UserId:    userId,
+			CreatedAt: values.CreatedAt,
+			UpdatedAt: values.UpdatedAt,
+		}
+	}
+
+	return &record.ChapterEntry{
+		Name:      values.Name,
+		Number:    number,
+		Sections:  sections,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+		UpdatedAt: values.UpdatedAt,
+	}
+}
@@ -0,0 +1,401 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type sqlChapterRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLChapterRepository(database db.SQLDatabase) ChapterRepository {
+	return sqlChapterRepository{database: database}
+}
+
+func (r sqlChapterRepository) FetchChapters(
+	userId string,
+	projectId string,
+) (map[string]record.ChapterEntry, *Error) {
+	rErr := sqlCheckProject(r.database, r.database.DB, userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	sections, rErr := r.sections(r.database.DB,
+		"SELECT id FROM chapters WHERE project_id = ?", projectId)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT id, name, number, created_at, updated_at FROM chapters WHERE project_id = ? AND trashed = FALSE "+
+			"ORDER BY number"),
+		projectId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch chapters: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.ChapterEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows, userId)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entry.Sections = r.sectionEntries(sections[id], *entry)
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch chapters: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlChapterRepository) FetchChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+) (*record.ChapterEntry, *Error) {
+	rErr := sqlCheckProject(r.database, r.database.DB, userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return r.fetchChapter(r.database.DB, userId, projectId, chapterId)
+}
+
+func (r sqlChapterRepository) InsertChapter(
+	userId string,
+	projectId string,
+	entry record.ChapterWithoutAutofieldEntry,
+) (string, *record.ChapterEntry, *Error) {
+	id := newId()
+	now := currentTime()
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		count, rErr := r.countChapters(tx, projectId)
+		if rErr != nil {
+			return rErr
+		}
+
+		if entry.Number > count+1 {
+			return Errorf(InvalidArgumentError, "chapter number is too large")
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"UPDATE chapters SET number = number + 1 WHERE project_id = ? AND trashed = FALSE AND number >= ?"),
+			projectId, entry.Number)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter numbers: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"INSERT INTO chapters (id, project_id, name, number, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"),
+			id, projectId, entry.Name, entry.Number, now, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert chapter: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"INSERT INTO papers (chapter_id, content, created_at, updated_at) VALUES (?, ?, ?, ?)"),
+			id, "", now, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert paper: %w", err)
+		}
+
+		return nil
+	})
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return id, &record.ChapterEntry{
+		Name:      entry.Name,
+		Number:    entry.Number,
+		Sections:  []record.SectionEntry{},
+		UserId:    userId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (r sqlChapterRepository) UpdateChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.ChapterWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.ChapterEntry, *Error) {
+	var current *record.ChapterEntry
+	var updated *record.ChapterEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		count, rErr := r.countChapters(tx, projectId)
+		if rErr != nil {
+			return rErr
+		}
+
+		if entry.Number > count {
+			return Errorf(InvalidArgumentError, "chapter number is too large")
+		}
+
+		var number int
+		err := tx.QueryRow(r.database.Rebind(
+			"SELECT number FROM chapters WHERE project_id = ? AND id = ? AND trashed = FALSE"),
+			projectId, chapterId).Scan(&number)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Errorf(NotFoundError, "failed to update chapter")
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch chapter: %w", err)
+		}
+
+		if expectedUpdatedAt != nil {
+			entryToBeUpdated, rErr := r.fetchChapter(tx, userId, projectId, chapterId)
+			if rErr != nil {
+				return rErr
+			}
+
+			if !entryToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+				current = entryToBeUpdated
+				return Errorf(ConflictError, "chapter has been updated since it was fetched")
+			}
+		}
+
+		var shift string
+		if entry.Number < number {
+			shift = "UPDATE chapters SET number = number + 1 " +
+				"WHERE project_id = ? AND trashed = FALSE AND number >= ? AND number < ?"
+		} else {
+			shift = "UPDATE chapters SET number = number - 1 " +
+				"WHERE project_id = ? AND trashed = FALSE AND number <= ? AND number > ?"
+		}
+		_, err = tx.Exec(r.database.Rebind(shift), projectId, entry.Number, number)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter numbers: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"UPDATE chapters SET name = ?, number = ?, updated_at = ? WHERE id = ?"),
+			entry.Name, entry.Number, currentTime(), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter: %w", err)
+		}
+
+		updated, rErr = r.fetchChapter(tx, userId, projectId, chapterId)
+		return rErr
+	})
+	if rErr != nil {
+		return current, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlChapterRepository) UpdateChapterSections(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.SectionWithoutAutofieldEntry,
+) ([]record.SectionEntry, *Error) {
+	var updated *record.ChapterEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		result, err := tx.Exec(r.database.Rebind(
+			"UPDATE chapters SET updated_at = ? WHERE project_id = ? AND id = ? AND trashed = FALSE"),
+			currentTime(), projectId, chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter: %w", err)
+		}
+		if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+			return Errorf(NotFoundError, "failed to update sections of chapter")
+		}
+
+		_, err = tx.Exec(r.database.Rebind("DELETE FROM sections WHERE chapter_id = ?"), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		for i, section := range entries {
+			_, err = tx.Exec(r.database.Rebind(
+				"INSERT INTO sections (chapter_id, id, name, position) VALUES (?, ?, ?, ?)"),
+				chapterId, section.Id, section.Name, i)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+			}
+		}
+
+		updated, rErr = r.fetchChapter(tx, userId, projectId, chapterId)
+		return rErr
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return updated.Sections, nil
+}
+
+func (r sqlChapterRepository) TrashChapter(
+	userId string,
+	projectId string,
+	chapterId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		var name string
+		var number int
+		err := tx.QueryRow(r.database.Rebind(
+			"SELECT name, number FROM chapters WHERE project_id = ? AND id = ? AND trashed = FALSE"),
+			projectId, chapterId).Scan(&name, &number)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Errorf(NotFoundError, "failed to fetch chapter")
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch chapter: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET trashed = TRUE WHERE id = ?"), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to move chapter to trash: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"UPDATE chapters SET number = number - 1 WHERE project_id = ? AND trashed = FALSE AND number > ?"),
+			projectId, number)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update chapter numbers: %w", err)
+		}
+
+		return sqlInsertTrashItem(r.database, tx, record.TrashItemEntry{
+			Type:      TrashItemTypeChapter,
+			Name:      name,
+			ProjectId: projectId,
+			ChapterId: chapterId,
+			Position:  number - 1,
+			UserId:    userId,
+		})
+	})
+}
+
+func (r sqlChapterRepository) fetchChapter(
+	q sqlQuerier,
+	userId string,
+	projectId string,
+	chapterId string,
+) (*record.ChapterEntry, *Error) {
+	_, entry, err := r.scanEntry(q.QueryRow(r.database.Rebind(
+		"SELECT id, name, number, created_at, updated_at FROM chapters WHERE project_id = ? AND id = ? AND trashed = FALSE"),
+		projectId, chapterId), userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch chapter")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch chapter: %w", err)
+	}
+
+	sections, rErr := r.sections(q, "?", chapterId)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entry.Sections = r.sectionEntries(sections[chapterId], *entry)
+	return entry, nil
+}
+
+func (r sqlChapterRepository) countChapters(q sqlQuerier, projectId string) (int, *Error) {
+	var count int
+	err := q.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM chapters WHERE project_id = ? AND trashed = FALSE"),
+		projectId).Scan(&count)
+	if err != nil {
+		return 0, Errorf(ReadFailurePanic, "failed to count chapters: %w", err)
+	}
+	return count, nil
+}
+
+func (r sqlChapterRepository) sections(
+	q sqlQuerier,
+	chapterCondition string,
+	args ...any,
+) (map[string][]record.SectionWithoutAutofieldEntry, *Error) {
+	rows, err := q.Query(r.database.Rebind(
+		"SELECT chapter_id, id, name FROM sections WHERE chapter_id IN ("+chapterCondition+") "+
+			"ORDER BY chapter_id, position"), args...)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+	defer rows.Close()
+
+	sections := make(map[string][]record.SectionWithoutAutofieldEntry)
+	for rows.Next() {
+		var chapterId string
+		var section record.SectionWithoutAutofieldEntry
+		if err := rows.Scan(&chapterId, &section.Id, &section.Name); err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		sections[chapterId] = append(sections[chapterId], section)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+	return sections, nil
+}
+
+func (r sqlChapterRepository) sectionEntries(
+	sections []record.SectionWithoutAutofieldEntry,
+	chapter record.ChapterEntry,
+) []record.SectionEntry {
+	entries := make([]record.SectionEntry, len(sections))
+	for i, section := range sections {
+		entries[i] = record.SectionEntry{
+			Id:        section.Id,
+			Name:      section.Name,
+			UserId:    chapter.UserId,
+			CreatedAt: chapter.CreatedAt,
+			UpdatedAt: chapter.UpdatedAt,
+		}
+	}
+	return entries
+}
+
+func (r sqlChapterRepository) scanEntry(
+	row interface{ Scan(dest ...any) error },
+	userId string,
+) (string, *record.ChapterEntry, error) {
+	var id string
+	var createdAt, updatedAt time.Time
+	entry := record.ChapterEntry{UserId: userId}
+	err := row.Scan(&id, &entry.Name, &entry.Number, &createdAt, &updatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.CreatedAt = createdAt.UTC()
+	entry.UpdatedAt = updatedAt.UTC()
+	return id, &entry, nil
+}
@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -376,6 +377,86 @@ func TestInsertChapterValidEntry(t *testing.T) {
 	}, chapters)
 }
 
+func TestInsertChapterInsertsPaper(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewChapterRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	ppr := repository.NewPaperRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Insert Chapter with Paper",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := r.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	paper, rErr := ppr.FetchPaper(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+	assert.Equal(t, "", paper.Content)
+	assert.Equal(t, userId, paper.UserId)
+}
+
+func TestInsertChapterConcurrentWriters(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewChapterRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Insert Chapters Concurrently",
+	})
+	assert.Nil(t, rErr)
+
+	const writers = 8
+
+	var wg sync.WaitGroup
+	ids := make([]string, writers)
+	rErrs := make([]*repository.Error, writers)
+	for i := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids[i], _, rErrs[i] = r.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+				Name:   fmt.Sprintf("Chapter %d", i),
+				Number: 1,
+			})
+		}()
+	}
+	wg.Wait()
+
+	insertedIds := []string{}
+	for i, rErr := range rErrs {
+		if rErr != nil {
+			assert.Equal(t, repository.WriteFailurePanic, rErr.Code())
+			continue
+		}
+		insertedIds = append(insertedIds, ids[i])
+	}
+	assert.NotEmpty(t, insertedIds)
+
+	chapters, rErr := r.FetchChapters(userId, projectId)
+
+	assert.Nil(t, rErr)
+
+	assert.Len(t, chapters, len(insertedIds))
+	numbers := make(map[int]struct{}, len(chapters))
+	for _, id := range insertedIds {
+		chapter, ok := chapters[id]
+		assert.True(t, ok)
+		numbers[chapter.Number] = struct{}{}
+	}
+	for number := 1; number <= len(insertedIds); number++ {
+		assert.Contains(t, numbers, number)
+	}
+}
+
 func TestInsertChapterNotFound(t *testing.T) {
 	tt := []struct {
 		name          string
@@ -466,7 +547,7 @@ func TestUpdateChapterValidEntry(t *testing.T) {
 		Number: 1,
 	}
 
-	updatedChapter, rErr := r.UpdateChapter(userId, projectId, "CHAPTER_TWO", entry)
+	updatedChapter, rErr := r.UpdateChapter(userId, projectId, "CHAPTER_TWO", entry, nil)
 	now := time.Now()
 
 	assert.Nil(t, rErr)
@@ -521,7 +602,7 @@ func TestUpdateChapterValidEntry(t *testing.T) {
 		Number: 2,
 	}
 
-	updatedChapter, rErr = r.UpdateChapter(userId, projectId, "CHAPTER_TWO", entry)
+	updatedChapter, rErr = r.UpdateChapter(userId, projectId, "CHAPTER_TWO", entry, nil)
 
 	assert.Nil(t, rErr)
 
@@ -614,7 +695,7 @@ func TestUpdateChapterNotFound(t *testing.T) {
 				record.ChapterWithoutAutofieldEntry{
 					Name:   "Chapter One",
 					Number: 1,
-				})
+				}, nil)
 
 			assert.NotNil(t, rErr)
 
@@ -652,7 +733,7 @@ func TestUpdateChapterInvalidArgument(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewChapterRepository(*client)
 
-			updatedChapter, rErr := r.UpdateChapter(tc.userId, tc.projectId, tc.chapterId, tc.entry)
+			updatedChapter, rErr := r.UpdateChapter(tc.userId, tc.projectId, tc.chapterId, tc.entry, nil)
 
 			assert.NotNil(t, rErr)
 
@@ -693,7 +774,7 @@ func TestUpdateChapterInvalidDocument(t *testing.T) {
 				record.ChapterWithoutAutofieldEntry{
 					Name:   "Updated Chapter",
 					Number: 1,
-				})
+				}, nil)
 
 			assert.NotNil(t, rErr)
 
@@ -883,7 +964,7 @@ func TestUpdateChapterSectionsInvalidDocument(t *testing.T) {
 	}
 }
 
-func TestDeleteChapterValidEntry(t *testing.T) {
+func TestTrashChapterValidEntry(t *testing.T) {
 	client := db.FirestoreClient()
 	r := repository.NewChapterRepository(*client)
 
@@ -891,13 +972,143 @@ func TestDeleteChapterValidEntry(t *testing.T) {
 	projectId := "PROJECT_WITHOUT_DESCRIPTION_TO_DELETE_FROM_REPOSITORY"
 	chapterId := "CHAPTER_TWO"
 
-	rErr := r.DeleteChapter(userId, projectId, chapterId)
+	rErr := r.TrashChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+}
+
+func TestTrashChapterKeepsPaperAndGraphs(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewChapterRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Trash Chapter with Paper and Graphs",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := r.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	sectionIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{
+			Name:      "Introduction",
+			Paragraph: "This is the introduction of the paper.",
+			Children:  []record.GraphChildEntry{},
+		},
+	})
+	assert.Nil(t, rErr)
+
+	rErr = r.TrashChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+	projectRef := client.Collection(repository.ProjectCollection).Doc(projectId)
+
+	_, err := projectRef.Collection(repository.PaperCollection).Doc(chapterId).Get(db.FirestoreContext())
+	assert.Nil(t, err)
+
+	_, err = projectRef.Collection(repository.ChapterCollection).
+		Doc(chapterId).
+		Collection(repository.GraphCollection).
+		Doc(sectionIds[0]).
+		Get(db.FirestoreContext())
+	assert.Nil(t, err)
+
+	chapters, rErr := r.FetchChapters(userId, projectId)
+
+	assert.Nil(t, rErr)
+
+	assert.Empty(t, chapters)
+
+	chapter, rErr := r.FetchChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, chapter)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch chapter", rErr.Error())
+}
+
+func TestChapterConcurrentWriters(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewChapterRepository(*client)
+	pr := repository.NewProjectRepository(*client)
 
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Modify Chapters Concurrently",
+	})
 	assert.Nil(t, rErr)
 
+	const initialChapters = 4
+
+	initialIds := make([]string, initialChapters)
+	for i := range initialChapters {
+		initialIds[i], _, rErr = r.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   fmt.Sprintf("Initial Chapter %d", i),
+			Number: i + 1,
+		})
+		assert.Nil(t, rErr)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	inserted := 0
+	deleted := 0
+
+	for i := range initialChapters {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, _, rErr := r.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+				Name:   fmt.Sprintf("Inserted Chapter %d", i),
+				Number: 1,
+			})
+			if rErr == nil {
+				mu.Lock()
+				inserted++
+				mu.Unlock()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			rErr := r.TrashChapter(userId, projectId, initialIds[i])
+			if rErr == nil {
+				mu.Lock()
+				deleted++
+				mu.Unlock()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = r.UpdateChapter(userId, projectId, initialIds[(i+1)%initialChapters], record.ChapterWithoutAutofieldEntry{
+				Name:   fmt.Sprintf("Updated Chapter %d", i),
+				Number: 1,
+			}, nil)
+		}()
+	}
+	wg.Wait()
+
+	chapters, rErr := r.FetchChapters(userId, projectId)
+
+	assert.Nil(t, rErr)
+
+	assert.Len(t, chapters, initialChapters+inserted-deleted)
+	numbers := make(map[int]struct{}, len(chapters))
+	for _, chapter := range chapters {
+		numbers[chapter.Number] = struct{}{}
+	}
+	for number := 1; number <= len(chapters); number++ {
+		assert.Contains(t, numbers, number)
+	}
 }
 
-func TestDeleteChapterNotFound(t *testing.T) {
+func TestTrashChapterNotFound(t *testing.T) {
 	tt := []struct {
 		name          string
 		userId        string
@@ -933,7 +1144,7 @@ func TestDeleteChapterNotFound(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewChapterRepository(*client)
 
-			rErr := r.DeleteChapter(tc.userId, tc.projectId, tc.chapterId)
+			rErr := r.TrashChapter(tc.userId, tc.projectId, tc.chapterId)
 
 			assert.NotNil(t, rErr)
 
@@ -943,7 +1154,7 @@ func TestDeleteChapterNotFound(t *testing.T) {
 	}
 }
 
-func TestDeleteChapterInvalidDocument(t *testing.T) {
+func TestTrashChapterInvalidDocument(t *testing.T) {
 	tt := []struct {
 		name          string
 		userId        string
@@ -966,7 +1177,7 @@ func TestDeleteChapterInvalidDocument(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewChapterRepository(*client)
 
-			rErr := r.DeleteChapter(tc.userId, tc.projectId, tc.chapterId)
+			rErr := r.TrashChapter(tc.userId, tc.projectId, tc.chapterId)
 
 			assert.NotNil(t, rErr)
 
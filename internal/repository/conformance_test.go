@@ -0,0 +1,24 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository/repositorytest"
+)
+
+func TestFirestoreConformance(t *testing.T) {
+	repositorytest.RunConformance(t, func(t *testing.T) repositorytest.Repositories {
+		client := db.FirestoreClient()
+		return repositorytest.Repositories{
+			Project:     repository.NewProjectRepository(*client),
+			Chapter:     repository.NewChapterRepository(*client),
+			Paper:       repository.NewPaperRepository(*client),
+			Graph:       repository.NewGraphRepository(*client),
+			Link:        repository.NewLinkRepository(*client),
+			Share:       repository.NewShareRepository(*client),
+			AccessToken: repository.NewAccessTokenRepository(*client),
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -9,6 +10,7 @@ type ErrorCode string
 const (
 	InvalidArgumentError ErrorCode = "invalid argument"
 	NotFoundError        ErrorCode = "not found"
+	ConflictError        ErrorCode = "conflict"
 	ReadFailurePanic     ErrorCode = "read failure"
 	WriteFailurePanic    ErrorCode = "write failure"
 )
@@ -33,3 +35,11 @@ func (e Error) Unwrap() error {
 func (e Error) Code() ErrorCode {
 	return e.code
 }
+
+func transactionError(err error) *Error {
+	var rErr *Error
+	if errors.As(err, &rErr) {
+		return rErr
+	}
+	return Errorf(WriteFailurePanic, "failed to run transaction: %w", err)
+}
@@ -1,18 +1,33 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
 	"github.com/kumachan-mis/knodeledge-api/internal/document"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
 
 const GraphCollection = "graphs"
 
+const GraphRevisionCollection = "revisions"
+
+const MigrationCollection = "migrations"
+
+const graphChildIdsMigration = "graphChildIds"
+
+const (
+	GraphOrphanActionArchive = "archive"
+	GraphOrphanActionDelete  = "delete"
+)
+
 type GraphRepository interface {
 	GraphExists(
 		userId string,
@@ -25,6 +40,11 @@ type GraphRepository interface {
 		chapterId string,
 		sectionId string,
 	) (*record.GraphEntry, *Error)
+	FetchGraphs(
+		userId string,
+		projectId string,
+		chapterId string,
+	) ([]string, []record.GraphEntry, *Error)
 	InsertGraphs(
 		userId string,
 		projectId string,
@@ -37,23 +57,59 @@ type GraphRepository interface {
 		chapterId string,
 		sectionId string,
 		entry record.GraphContentEntry,
+		expectedUpdatedAt *time.Time,
+	) (*record.GraphEntry, *Error)
+	UpdateGraphChildren(
+		userId string,
+		projectId string,
+		chapterId string,
+		sectionId string,
+		update func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error),
 	) (*record.GraphEntry, *Error)
-	DeleteGraph(
+	ResectionalizeGraphs(
+		userId string,
+		projectId string,
+		chapterId string,
+		entries []record.GraphResectionalizeEntry,
+		orphanIds []string,
+		orphanAction string,
+	) ([]string, []record.GraphEntry, *Error)
+	TrashGraph(
+		userId string,
+		projectId string,
+		chapterId string,
+		sectionId string,
+	) *Error
+	FetchGraphRevisions(
+		userId string,
+		projectId string,
+		chapterId string,
+		sectionId string,
+	) (map[string]record.GraphRevisionEntry, *Error)
+	FetchGraphRevision(
+		userId string,
+		projectId string,
+		chapterId string,
+		sectionId string,
+		revisionId string,
+	) (*record.GraphRevisionEntry, *Error)
+	DeleteGraphRevisions(
 		userId string,
 		projectId string,
 		chapterId string,
 		sectionId string,
+		revisionIds []string,
 	) *Error
+	AssignMissingGraphChildIds() (int, *Error)
 }
 
 type graphRepository struct {
 	client            firestore.Client
-	chapterRepository ChapterRepository
+	chapterRepository chapterRepository
 }
 
 func NewGraphRepository(client firestore.Client) GraphRepository {
-	chapterRepository := NewChapterRepository(client)
-	return graphRepository{client: client, chapterRepository: chapterRepository}
+	return graphRepository{client: client, chapterRepository: chapterRepository{client: client}}
 }
 
 func (r graphRepository) GraphExists(
@@ -66,20 +122,34 @@ func (r graphRepository) GraphExists(
 		return false, rErr
 	}
 
-	snapshots, err := r.client.Collection(ProjectCollection).
+	iter := r.client.Collection(ProjectCollection).
 		Doc(projectId).
 		Collection(ChapterCollection).
 		Doc(chapterId).
 		Collection(GraphCollection).
-		Limit(1).
-		Documents(db.FirestoreContext()).
-		GetAll()
+		Documents(db.FirestoreContext())
 
-	if err != nil {
-		return false, Errorf(ReadFailurePanic, "failed to fetch graphs: %w", err)
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return false, Errorf(ReadFailurePanic, "failed to fetch graphs: %w", err)
+		}
+
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return false, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !values.Trashed {
+			return true, nil
+		}
 	}
 
-	return len(snapshots) > 0, nil
+	return false, nil
 }
 
 func (r graphRepository) FetchGraph(
@@ -116,9 +186,6 @@ func (r graphRepository) FetchGraph(
 	if err != nil && section != nil {
 		err := errors.New("document.ChapterValues.sections have excessive elements")
 		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
-	} else if err == nil && section == nil {
-		err := errors.New("document.ChapterValues.sections have insufficient elements")
-		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
 	}
 
 	var values document.GraphValues
@@ -127,16 +194,22 @@ func (r graphRepository) FetchGraph(
 		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %v", err)
 	}
 
+	if section == nil && values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	} else if section == nil {
+		err := errors.New("document.ChapterValues.sections have insufficient elements")
+		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+	}
+
 	return r.valuesToEntry(values, section.Name, userId), nil
 }
 
-func (r graphRepository) InsertGraphs(
+func (r graphRepository) FetchGraphs(
 	userId string,
 	projectId string,
 	chapterId string,
-	entries []record.GraphWithoutAutofieldEntry,
 ) ([]string, []record.GraphEntry, *Error) {
-	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	chapter, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
 	if rErr != nil {
 		return nil, nil, rErr
 	}
@@ -147,29 +220,99 @@ func (r graphRepository) InsertGraphs(
 		Doc(chapterId).
 		Collection(GraphCollection)
 
-	bw := r.client.BulkWriter(db.FirestoreContext())
+	ids := make([]string, len(chapter.Sections))
+	docRefs := make([]*firestore.DocumentRef, len(chapter.Sections))
+	for i, section := range chapter.Sections {
+		ids[i] = section.Id
+		docRefs[i] = collRef.Doc(section.Id)
+	}
+
+	snapshots, err := r.client.GetAll(db.FirestoreContext(), docRefs)
+	if err != nil {
+		return nil, nil, Errorf(ReadFailurePanic, "failed to fetch graphs: %v", err)
+	}
+
+	entries := make([]record.GraphEntry, len(snapshots))
+	for i, snapshot := range snapshots {
+		if !snapshot.Exists() {
+			err := errors.New("document.ChapterValues.sections have excessive elements")
+			return nil, nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+		}
+
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %v", err)
+		}
+
+		entries[i] = *r.valuesToEntry(values, chapter.Sections[i].Name, userId)
+	}
+
+	return ids, entries, nil
+}
+
+func (r graphRepository) InsertGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphWithoutAutofieldEntry,
+) ([]string, []record.GraphEntry, *Error) {
+	chapterRef := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId)
+	collRef := chapterRef.Collection(GraphCollection)
 
 	ids := make([]string, len(entries))
 	docRefs := make([]*firestore.DocumentRef, len(entries))
+	sections := make([]map[string]any, len(entries))
 	for i, entry := range entries {
 		docRef := collRef.NewDoc()
+		ids[i] = docRef.ID
+		docRefs[i] = docRef
+		sections[i] = map[string]any{
+			"id":   docRef.ID,
+			"name": entry.Name,
+		}
+	}
 
-		_, err := bw.Create(docRef, map[string]any{
-			"paragraph": entry.Paragraph,
-			"children":  r.childrenEntryToValues(entry.Children),
-			"createdAt": firestore.ServerTimestamp,
-			"updatedAt": firestore.ServerTimestamp,
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		chapterValues, rErr := r.chapterValuesInTransaction(tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		if len(chapterValues.Sections) > 0 {
+			return Errorf(InvalidArgumentError, "graph already exists")
+		}
+
+		for i, entry := range entries {
+			children := assignGraphChildIds(nil, entry.Children)
+			err := tx.Create(docRefs[i], map[string]any{
+				"paragraph": entry.Paragraph,
+				"children":  r.childrenEntryToValues(children),
+				"createdAt": firestore.ServerTimestamp,
+				"updatedAt": firestore.ServerTimestamp,
+			})
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to insert graph: %w", err)
+			}
+		}
+
+		err := tx.Update(chapterRef, []firestore.Update{
+			{Path: "sections", Value: sections},
+			{Path: "updatedAt", Value: firestore.ServerTimestamp},
 		})
 		if err != nil {
-			return nil, nil, Errorf(WriteFailurePanic, "failed to insert graph: %v", err)
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
 		}
 
-		ids[i] = docRef.ID
-		docRefs[i] = docRef
+		return nil
+	})
+	if err != nil {
+		return nil, nil, transactionError(err)
 	}
 
-	bw.End()
-
 	snapshots, err := r.client.GetAll(db.FirestoreContext(), docRefs)
 	if err != nil {
 		return nil, nil, Errorf(ReadFailurePanic, "failed to fetch created graphs: %v", err)
@@ -196,8 +339,9 @@ func (r graphRepository) UpdateGraphContent(
 	chapterId string,
 	sectionId string,
 	entry record.GraphContentEntry,
+	expectedUpdatedAt *time.Time,
 ) (*record.GraphEntry, *Error) {
-	chapter, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	chapter, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
 	if rErr != nil {
 		return nil, rErr
 	}
@@ -220,14 +364,60 @@ func (r graphRepository) UpdateGraphContent(
 		Collection(GraphCollection).
 		Doc(sectionId)
 
-	_, err := ref.Set(db.FirestoreContext(), map[string]any{
-		"paragraph": entry.Paragraph,
-		"children":  r.childrenEntryToValues(entry.Children),
-		"updatedAt": firestore.ServerTimestamp,
-	}, firestore.MergeAll)
+	var current *record.GraphEntry
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshotToBeUpdated, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		var valuesToBeUpdated document.GraphValues
+		err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if expectedUpdatedAt != nil && !valuesToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+			current = r.valuesToEntry(valuesToBeUpdated, section.Name, userId)
+			return Errorf(ConflictError, "graph has been updated since it was fetched")
+		}
+
+		children := assignGraphChildIds(r.childrenValuesToEntry(valuesToBeUpdated.Children), entry.Children)
+		linkRefs, rErr := nodeLinkRefsInTransaction(tx, r.client.Collection(ProjectCollection).Doc(projectId), chapterId, sectionId, children)
+		if rErr != nil {
+			return rErr
+		}
+
+		err = tx.Set(ref, map[string]any{
+			"paragraph": entry.Paragraph,
+			"children":  r.childrenEntryToValues(children),
+			"updatedAt": firestore.ServerTimestamp,
+		}, firestore.MergeAll)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+		}
+
+		err = tx.Create(ref.Collection(GraphRevisionCollection).NewDoc(), map[string]any{
+			"paragraph": entry.Paragraph,
+			"children":  r.childrenEntryToValues(children),
+			"authorId":  userId,
+			"createdAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+		}
+
+		for _, linkRef := range linkRefs {
+			err := tx.Delete(linkRef)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+			}
+		}
 
+		return nil
+	})
 	if err != nil {
-		return nil, Errorf(WriteFailurePanic, "failed to update graph: %v", err)
+		return current, transactionError(err)
 	}
 
 	snapshot, err := ref.Get(db.FirestoreContext())
@@ -244,15 +434,27 @@ func (r graphRepository) UpdateGraphContent(
 	return r.valuesToEntry(values, section.Name, userId), nil
 }
 
-func (r graphRepository) DeleteGraph(
+func (r graphRepository) UpdateGraphChildren(
 	userId string,
 	projectId string,
 	chapterId string,
 	sectionId string,
-) *Error {
-	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	update func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error),
+) (*record.GraphEntry, *Error) {
+	chapter, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
 	if rErr != nil {
-		return rErr
+		return nil, rErr
+	}
+
+	var section *record.SectionEntry
+	for _, s := range chapter.Sections {
+		if s.Id == sectionId {
+			section = &s
+			break
+		}
+	}
+	if section == nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
 	}
 
 	ref := r.client.Collection(ProjectCollection).
@@ -262,19 +464,645 @@ func (r graphRepository) DeleteGraph(
 		Collection(GraphCollection).
 		Doc(sectionId)
 
-	if _, err := ref.Get(db.FirestoreContext()); err != nil {
-		return Errorf(NotFoundError, "failed to fetch graph")
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshotToBeUpdated, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		var valuesToBeUpdated document.GraphValues
+		err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		currentChildren := r.childrenValuesToEntry(valuesToBeUpdated.Children)
+		children, err := update(currentChildren)
+		if err != nil {
+			return Errorf(InvalidArgumentError, "failed to update graph children: %w", err)
+		}
+		children = assignGraphChildIds(currentChildren, children)
+		linkRefs, rErr := nodeLinkRefsInTransaction(tx, r.client.Collection(ProjectCollection).Doc(projectId), chapterId, sectionId, children)
+		if rErr != nil {
+			return rErr
+		}
+
+		err = tx.Set(ref, map[string]any{
+			"children":  r.childrenEntryToValues(children),
+			"updatedAt": firestore.ServerTimestamp,
+		}, firestore.MergeAll)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+		}
+
+		err = tx.Create(ref.Collection(GraphRevisionCollection).NewDoc(), map[string]any{
+			"paragraph": valuesToBeUpdated.Paragraph,
+			"children":  r.childrenEntryToValues(children),
+			"authorId":  userId,
+			"createdAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+		}
+
+		for _, linkRef := range linkRefs {
+			err := tx.Delete(linkRef)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	snapshot, err := ref.Get(db.FirestoreContext())
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch updated graph: %w", err)
+	}
+
+	var values document.GraphValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.valuesToEntry(values, section.Name, userId), nil
+}
+
+func (r graphRepository) ResectionalizeGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphResectionalizeEntry,
+	orphanIds []string,
+	orphanAction string,
+) ([]string, []record.GraphEntry, *Error) {
+	chapterRef := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId)
+	collRef := chapterRef.Collection(GraphCollection)
+
+	ids := make([]string, len(entries))
+	docRefs := make([]*firestore.DocumentRef, len(entries))
+	sections := make([]map[string]any, len(entries))
+	for i, entry := range entries {
+		docRef := collRef.NewDoc()
+		if entry.Id != "" {
+			docRef = collRef.Doc(entry.Id)
+		}
+		ids[i] = docRef.ID
+		docRefs[i] = docRef
+		sections[i] = map[string]any{
+			"id":   docRef.ID,
+			"name": entry.Name,
+		}
 	}
 
-	_, err := ref.Delete(db.FirestoreContext())
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		chapterValues, rErr := r.chapterValuesInTransaction(tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		currentIds := make([]string, len(chapterValues.Sections))
+		for i, section := range chapterValues.Sections {
+			currentIds[i] = section.Id
+		}
+		if !sameGraphIds(currentIds, entries, orphanIds) {
+			return Errorf(ConflictError, "graphs have been updated since they were fetched")
+		}
+
+		currentValues := make(map[string]document.GraphValues)
+		for i, entry := range entries {
+			if entry.Id == "" {
+				continue
+			}
+
+			snapshot, err := tx.Get(docRefs[i])
+			if err != nil {
+				err := errors.New("document.ChapterValues.sections have excessive elements")
+				return Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+			}
 
+			var values document.GraphValues
+			err = snapshot.DataTo(&values)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+			}
+			currentValues[entry.Id] = values
+		}
+
+		var orphanRefs []*firestore.DocumentRef
+		if orphanAction == GraphOrphanActionDelete {
+			for _, orphanId := range orphanIds {
+				refs, rErr := r.graphRefsInTransaction(tx, collRef.Doc(orphanId))
+				if rErr != nil {
+					return rErr
+				}
+				orphanRefs = append(orphanRefs, refs...)
+
+				linkRefs, rErr := linkRefsInTransaction(tx, chapterRef.Parent.Parent, chapterId, orphanId)
+				if rErr != nil {
+					return rErr
+				}
+				orphanRefs = append(orphanRefs, linkRefs...)
+			}
+		}
+
+		for i, entry := range entries {
+			if entry.Id == "" {
+				err := tx.Create(docRefs[i], map[string]any{
+					"paragraph": entry.Paragraph,
+					"children":  []document.GraphChildValues{},
+					"createdAt": firestore.ServerTimestamp,
+					"updatedAt": firestore.ServerTimestamp,
+				})
+				if err != nil {
+					return Errorf(WriteFailurePanic, "failed to insert graph: %w", err)
+				}
+				continue
+			}
+
+			values := currentValues[entry.Id]
+			if values.Paragraph == entry.Paragraph {
+				continue
+			}
+
+			err := tx.Update(docRefs[i], []firestore.Update{
+				{Path: "paragraph", Value: entry.Paragraph},
+				{Path: "updatedAt", Value: firestore.ServerTimestamp},
+			})
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+			}
+
+			err = tx.Create(docRefs[i].Collection(GraphRevisionCollection).NewDoc(), map[string]any{
+				"paragraph": entry.Paragraph,
+				"children":  values.Children,
+				"authorId":  userId,
+				"createdAt": firestore.ServerTimestamp,
+			})
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+			}
+		}
+
+		for _, ref := range orphanRefs {
+			err := tx.Delete(ref)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete document: %w", err)
+			}
+		}
+
+		if orphanAction == GraphOrphanActionArchive {
+			for position, section := range chapterValues.Sections {
+				if !slices.Contains(orphanIds, section.Id) {
+					continue
+				}
+
+				err := tx.Update(collRef.Doc(section.Id), []firestore.Update{
+					{Path: "trashed", Value: true},
+				})
+				if err != nil {
+					return Errorf(WriteFailurePanic, "failed to move graph to trash: %w", err)
+				}
+
+				err = tx.Create(r.client.Collection(TrashCollection).NewDoc(), map[string]any{
+					"type":      TrashItemTypeSection,
+					"name":      section.Name,
+					"projectId": projectId,
+					"chapterId": chapterId,
+					"sectionId": section.Id,
+					"position":  position,
+					"userId":    userId,
+					"deletedAt": firestore.ServerTimestamp,
+				})
+				if err != nil {
+					return Errorf(WriteFailurePanic, "failed to insert trash item: %w", err)
+				}
+			}
+		}
+
+		err := tx.Update(chapterRef, []firestore.Update{
+			{Path: "sections", Value: sections},
+			{Path: "updatedAt", Value: firestore.ServerTimestamp},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, transactionError(err)
+	}
+
+	snapshots, err := r.client.GetAll(db.FirestoreContext(), docRefs)
 	if err != nil {
-		return Errorf(WriteFailurePanic, "failed to delete graph: %v", err)
+		return nil, nil, Errorf(ReadFailurePanic, "failed to fetch resectionalized graphs: %v", err)
+	}
+
+	res := make([]record.GraphEntry, len(snapshots))
+	for i, snapshot := range snapshots {
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %v", err)
+		}
+
+		res[i] = *r.valuesToEntry(values, entries[i].Name, userId)
+	}
+
+	return ids, res, nil
+}
+
+func (r graphRepository) TrashGraph(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) *Error {
+	chapterRef := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId)
+	ref := chapterRef.Collection(GraphCollection).
+		Doc(sectionId)
+	trashRef := r.client.Collection(TrashCollection).
+		NewDoc()
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		chapterValues, rErr := r.chapterValuesInTransaction(tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if values.Trashed {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		position := -1
+		name := ""
+		sections := []map[string]any{}
+		for i, section := range chapterValues.Sections {
+			if section.Id == sectionId {
+				position = i
+				name = section.Name
+				continue
+			}
+			sections = append(sections, map[string]any{
+				"id":   section.Id,
+				"name": section.Name,
+			})
+		}
+
+		if position < 0 {
+			err := errors.New("document.ChapterValues.sections have insufficient elements")
+			return Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "trashed", Value: true},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to move graph to trash: %w", err)
+		}
+
+		err = tx.Update(chapterRef, []firestore.Update{
+			{Path: "sections", Value: sections},
+			{Path: "updatedAt", Value: firestore.ServerTimestamp},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		err = tx.Create(trashRef, map[string]any{
+			"type":      TrashItemTypeSection,
+			"name":      name,
+			"projectId": projectId,
+			"chapterId": chapterId,
+			"sectionId": sectionId,
+			"position":  position,
+			"userId":    userId,
+			"deletedAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert trash item: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
 	}
 
 	return nil
 }
 
+func (r graphRepository) FetchGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) (map[string]record.GraphRevisionEntry, *Error) {
+	rErr := r.checkSection(userId, projectId, chapterId, sectionId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	iter := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId).
+		Collection(GraphCollection).
+		Doc(sectionId).
+		Collection(GraphRevisionCollection).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.GraphRevisionEntry)
+
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch graph revisions: %w", err)
+		}
+
+		var values document.GraphRevisionValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		entries[snapshot.Ref.ID] = *r.revisionValuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r graphRepository) FetchGraphRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionId string,
+) (*record.GraphRevisionEntry, *Error) {
+	rErr := r.checkSection(userId, projectId, chapterId, sectionId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	snapshot, err := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId).
+		Collection(GraphCollection).
+		Doc(sectionId).
+		Collection(GraphRevisionCollection).
+		Doc(revisionId).
+		Get(db.FirestoreContext())
+
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph revision")
+	}
+
+	var values document.GraphRevisionValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.revisionValuesToEntry(values, userId), nil
+}
+
+func (r graphRepository) DeleteGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionIds []string,
+) *Error {
+	rErr := r.checkSection(userId, projectId, chapterId, sectionId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	collRef := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(ChapterCollection).
+		Doc(chapterId).
+		Collection(GraphCollection).
+		Doc(sectionId).
+		Collection(GraphRevisionCollection)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		for _, revisionId := range revisionIds {
+			err := tx.Delete(collRef.Doc(revisionId))
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete graph revision: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+// AssignMissingGraphChildIds scans all graphs only once, and records a migration marker when finished
+// so that later starts skip the scan. Graphs written since then always have child ids.
+func (r graphRepository) AssignMissingGraphChildIds() (int, *Error) {
+	markerRef := r.client.Collection(MigrationCollection).
+		Doc(graphChildIdsMigration)
+
+	marker, err := markerRef.Get(db.FirestoreContext())
+	if err != nil && marker == nil {
+		return 0, Errorf(ReadFailurePanic, "failed to fetch migration: %w", err)
+	}
+	if marker.Exists() {
+		return 0, nil
+	}
+
+	iter := r.client.CollectionGroup(GraphCollection).Documents(db.FirestoreContext())
+
+	count := 0
+	for {
+		snapshot, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return count, Errorf(ReadFailurePanic, "failed to fetch graphs: %w", err)
+		}
+
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return count, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !graphChildIdsMissing(r.childrenValuesToEntry(values.Children)) {
+			continue
+		}
+
+		err = r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+			snapshotToBeUpdated, err := tx.Get(snapshot.Ref)
+			if err != nil {
+				return Errorf(NotFoundError, "failed to fetch graph")
+			}
+
+			var valuesToBeUpdated document.GraphValues
+			err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+			}
+
+			children := r.childrenValuesToEntry(valuesToBeUpdated.Children)
+			err = tx.Update(snapshot.Ref, []firestore.Update{
+				{Path: "children", Value: r.childrenEntryToValues(assignGraphChildIds(children, children))},
+			})
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			rErr := transactionError(err)
+			if rErr.Code() == NotFoundError {
+				continue
+			}
+			return count, rErr
+		}
+		count++
+	}
+
+	_, err = markerRef.Set(db.FirestoreContext(), map[string]any{
+		"completedAt": firestore.ServerTimestamp,
+	})
+	if err != nil {
+		return count, Errorf(WriteFailurePanic, "failed to record migration: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r graphRepository) checkSection(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	access projectAccess,
+) *Error {
+	chapter, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, access)
+	if rErr != nil {
+		return rErr
+	}
+
+	for _, section := range chapter.Sections {
+		if section.Id == sectionId {
+			return nil
+		}
+	}
+	return Errorf(NotFoundError, "failed to fetch graph")
+}
+
+func (r graphRepository) chapterValuesInTransaction(
+	tx *firestore.Transaction,
+	userId string,
+	projectId string,
+	chapterId string,
+	access projectAccess,
+) (*document.ChapterValues, *Error) {
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+
+	projectSnapshot, err := tx.Get(projectRef)
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	var projectValues document.ProjectValues
+	err = projectSnapshot.DataTo(&projectValues)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if !canAccessProject(projectValues, userId, access) || projectValues.Deleting || projectValues.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	ok := false
+	for _, id := range projectValues.ChapterIds {
+		if id == chapterId {
+			ok = true
+			break
+		}
+	}
+
+	snapshot, err := tx.Get(projectRef.Collection(ChapterCollection).Doc(chapterId))
+
+	if err != nil && !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch chapter")
+	}
+
+	if err != nil && ok {
+		err := errors.New("document.ProjectValues.chapterIds have excessive elements")
+		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+	}
+
+	var values document.ChapterValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if !ok && values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch chapter")
+	} else if !ok {
+		err := errors.New("document.ProjectValues.chapterIds have insufficient elements")
+		return nil, Errorf(ReadFailurePanic, "failed to convert values to entry: %w", err)
+	}
+
+	return &values, nil
+}
+
+func (r graphRepository) graphRefsInTransaction(
+	tx *firestore.Transaction,
+	graphRef *firestore.DocumentRef,
+) ([]*firestore.DocumentRef, *Error) {
+	refs := []*firestore.DocumentRef{}
+	if _, err := tx.Get(graphRef); err == nil {
+		refs = append(refs, graphRef)
+	}
+
+	revisionRefs, err := tx.DocumentRefs(graphRef.Collection(GraphRevisionCollection)).GetAll()
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph revisions: %w", err)
+	}
+
+	return append(refs, revisionRefs...), nil
+}
+
 func (r graphRepository) valuesToEntry(
 	values document.GraphValues,
 	name string,
@@ -290,12 +1118,26 @@ func (r graphRepository) valuesToEntry(
 	}
 }
 
+func (r graphRepository) revisionValuesToEntry(
+	values document.GraphRevisionValues,
+	userId string,
+) *record.GraphRevisionEntry {
+	return &record.GraphRevisionEntry{
+		Paragraph: values.Paragraph,
+		Children:  r.childrenValuesToEntry(values.Children),
+		AuthorId:  values.AuthorId,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+	}
+}
+
 func (r graphRepository) childrenValuesToEntry(
 	values []document.GraphChildValues,
 ) []record.GraphChildEntry {
 	entries := make([]record.GraphChildEntry, len(values))
 	for i, value := range values {
 		entries[i] = record.GraphChildEntry{
+			Id:          value.Id,
 			Name:        value.Name,
 			Relation:    value.Relation,
 			Description: value.Descrition,
@@ -311,6 +1153,7 @@ func (r graphRepository) childrenEntryToValues(
 	values := make([]document.GraphChildValues, len(children))
 	for i, child := range children {
 		values[i] = document.GraphChildValues{
+			Id:         child.Id,
 			Name:       child.Name,
 			Relation:   child.Relation,
 			Descrition: child.Description,
@@ -319,3 +1162,17 @@ func (r graphRepository) childrenEntryToValues(
 	}
 	return values
 }
+
+func sameGraphIds(currentIds []string, entries []record.GraphResectionalizeEntry, orphanIds []string) bool {
+	expectedIds := slices.Clone(orphanIds)
+	for _, entry := range entries {
+		if entry.Id != "" {
+			expectedIds = append(expectedIds, entry.Id)
+		}
+	}
+
+	sortedCurrentIds := slices.Clone(currentIds)
+	slices.Sort(sortedCurrentIds)
+	slices.Sort(expectedIds)
+	return slices.Equal(sortedCurrentIds, expectedIds)
+}
@@ -0,0 +1,517 @@
+package repository
+
+import (
+	"slices"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryGraphRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryGraphRepository(store *MemoryStore) GraphRepository {
+	return memoryGraphRepository{store: store}
+}
+
+func (r memoryGraphRepository) GraphExists(
+	userId string,
+	projectId string,
+	chapterId string,
+) (bool, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return false, rErr
+	}
+
+	for _, values := range chapter.graphs {
+		if !values.Trashed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r memoryGraphRepository) FetchGraph(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) (*record.GraphEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	section := r.section(chapter, sectionId)
+	values, ok := chapter.graphs[sectionId]
+	if section == nil || !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	return r.valuesToEntry(values, section.Name, userId), nil
+}
+
+func (r memoryGraphRepository) FetchGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+) ([]string, []record.GraphEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	ids := make([]string, len(chapter.values.Sections))
+	entries := make([]record.GraphEntry, len(chapter.values.Sections))
+	for i, section := range chapter.values.Sections {
+		ids[i] = section.Id
+		entries[i] = *r.valuesToEntry(chapter.graphs[section.Id], section.Name, userId)
+	}
+
+	return ids, entries, nil
+}
+
+func (r memoryGraphRepository) InsertGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphWithoutAutofieldEntry,
+) ([]string, []record.GraphEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	if len(chapter.values.Sections) > 0 {
+		return nil, nil, Errorf(InvalidArgumentError, "graph already exists")
+	}
+
+	now := currentTime()
+	ids := make([]string, len(entries))
+	res := make([]record.GraphEntry, len(entries))
+	sections := make([]document.SectionValues, len(entries))
+	for i, entry := range entries {
+		id := newId()
+		values := document.GraphValues{
+			Paragraph: entry.Paragraph,
+			Children:  r.childrenEntryToValues(assignGraphChildIds(nil, entry.Children)),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		chapter.graphs[id] = values
+
+		ids[i] = id
+		res[i] = *r.valuesToEntry(values, entry.Name, userId)
+		sections[i] = document.SectionValues{Id: id, Name: entry.Name}
+	}
+
+	chapter.values.Sections = sections
+	chapter.values.UpdatedAt = now
+
+	return ids, res, nil
+}
+
+func (r memoryGraphRepository) UpdateGraphContent(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	entry record.GraphContentEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.GraphEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	section := r.section(chapter, sectionId)
+	if section == nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	values := chapter.graphs[sectionId]
+	if expectedUpdatedAt != nil && !values.UpdatedAt.Equal(*expectedUpdatedAt) {
+		return r.valuesToEntry(values, section.Name, userId),
+			Errorf(ConflictError, "graph has been updated since it was fetched")
+	}
+
+	children := assignGraphChildIds(r.childrenValuesToEntry(values.Children), entry.Children)
+	values.Paragraph = entry.Paragraph
+	values.Children = r.childrenEntryToValues(children)
+	values.UpdatedAt = currentTime()
+	chapter.graphs[sectionId] = values
+	project.deleteNodeLinks(chapterId, sectionId, children)
+
+	if chapter.graphRevisions[sectionId] == nil {
+		chapter.graphRevisions[sectionId] = make(map[string]document.GraphRevisionValues)
+	}
+	chapter.graphRevisions[sectionId][newId()] = document.GraphRevisionValues{
+		Paragraph: entry.Paragraph,
+		Children:  r.childrenEntryToValues(children),
+		AuthorId:  userId,
+		CreatedAt: values.UpdatedAt,
+	}
+
+	return r.valuesToEntry(values, section.Name, userId), nil
+}
+
+func (r memoryGraphRepository) UpdateGraphChildren(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	update func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error),
+) (*record.GraphEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	section := r.section(chapter, sectionId)
+	if section == nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	values := chapter.graphs[sectionId]
+	currentChildren := r.childrenValuesToEntry(values.Children)
+	children, err := update(currentChildren)
+	if err != nil {
+		return nil, Errorf(InvalidArgumentError, "failed to update graph children: %w", err)
+	}
+	children = assignGraphChildIds(currentChildren, children)
+
+	values.Children = r.childrenEntryToValues(children)
+	values.UpdatedAt = currentTime()
+	chapter.graphs[sectionId] = values
+	project.deleteNodeLinks(chapterId, sectionId, children)
+
+	if chapter.graphRevisions[sectionId] == nil {
+		chapter.graphRevisions[sectionId] = make(map[string]document.GraphRevisionValues)
+	}
+	chapter.graphRevisions[sectionId][newId()] = document.GraphRevisionValues{
+		Paragraph: values.Paragraph,
+		Children:  r.childrenEntryToValues(children),
+		AuthorId:  userId,
+		CreatedAt: values.UpdatedAt,
+	}
+
+	return r.valuesToEntry(values, section.Name, userId), nil
+}
+
+func (r memoryGraphRepository) ResectionalizeGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphResectionalizeEntry,
+	orphanIds []string,
+	orphanAction string,
+) ([]string, []record.GraphEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	currentIds := make([]string, len(chapter.values.Sections))
+	for i, section := range chapter.values.Sections {
+		currentIds[i] = section.Id
+	}
+	if !sameGraphIds(currentIds, entries, orphanIds) {
+		return nil, nil, Errorf(ConflictError, "graphs have been updated since they were fetched")
+	}
+
+	now := currentTime()
+	ids := make([]string, len(entries))
+	res := make([]record.GraphEntry, len(entries))
+	sections := make([]document.SectionValues, len(entries))
+	for i, entry := range entries {
+		id := entry.Id
+		values := chapter.graphs[id]
+		if id == "" {
+			id = newId()
+			values = document.GraphValues{
+				Paragraph: entry.Paragraph,
+				Children:  []document.GraphChildValues{},
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+		} else if values.Paragraph != entry.Paragraph {
+			values.Paragraph = entry.Paragraph
+			values.UpdatedAt = now
+
+			if chapter.graphRevisions[id] == nil {
+				chapter.graphRevisions[id] = make(map[string]document.GraphRevisionValues)
+			}
+			chapter.graphRevisions[id][newId()] = document.GraphRevisionValues{
+				Paragraph: values.Paragraph,
+				Children:  values.Children,
+				AuthorId:  userId,
+				CreatedAt: now,
+			}
+		}
+		chapter.graphs[id] = values
+
+		ids[i] = id
+		res[i] = *r.valuesToEntry(values, entry.Name, userId)
+		sections[i] = document.SectionValues{Id: id, Name: entry.Name}
+	}
+
+	for position, section := range chapter.values.Sections {
+		if !slices.Contains(orphanIds, section.Id) {
+			continue
+		}
+
+		if orphanAction == GraphOrphanActionDelete {
+			delete(chapter.graphs, section.Id)
+			delete(chapter.graphRevisions, section.Id)
+			project.deleteLinks(chapterId, section.Id)
+			continue
+		}
+
+		values := chapter.graphs[section.Id]
+		values.Trashed = true
+		chapter.graphs[section.Id] = values
+
+		r.store.insertTrashItem(document.TrashItemValues{
+			Type:      TrashItemTypeSection,
+			Name:      section.Name,
+			ProjectId: projectId,
+			ChapterId: chapterId,
+			SectionId: section.Id,
+			Position:  position,
+			UserId:    userId,
+		})
+	}
+
+	chapter.values.Sections = sections
+	chapter.values.UpdatedAt = now
+
+	return ids, res, nil
+}
+
+func (r memoryGraphRepository) TrashGraph(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	values, ok := chapter.graphs[sectionId]
+	if !ok || values.Trashed {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	position := 0
+	name := ""
+	sections := []document.SectionValues{}
+	for i, section := range chapter.values.Sections {
+		if section.Id == sectionId {
+			position = i
+			name = section.Name
+			continue
+		}
+		sections = append(sections, section)
+	}
+
+	values.Trashed = true
+	chapter.graphs[sectionId] = values
+	chapter.values.Sections = sections
+	chapter.values.UpdatedAt = currentTime()
+
+	r.store.insertTrashItem(document.TrashItemValues{
+		Type:      TrashItemTypeSection,
+		Name:      name,
+		ProjectId: projectId,
+		ChapterId: chapterId,
+		SectionId: sectionId,
+		Position:  position,
+		UserId:    userId,
+	})
+
+	return nil
+}
+
+func (r memoryGraphRepository) FetchGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) (map[string]record.GraphRevisionEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	if r.section(chapter, sectionId) == nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	entries := make(map[string]record.GraphRevisionEntry)
+	for id, values := range chapter.graphRevisions[sectionId] {
+		entries[id] = *r.revisionValuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r memoryGraphRepository) FetchGraphRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionId string,
+) (*record.GraphRevisionEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	if r.section(chapter, sectionId) == nil {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	values, ok := chapter.graphRevisions[sectionId][revisionId]
+	if !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch graph revision")
+	}
+
+	return r.revisionValuesToEntry(values, userId), nil
+}
+
+func (r memoryGraphRepository) DeleteGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionIds []string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, chapter, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	if r.section(chapter, sectionId) == nil {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	for _, revisionId := range revisionIds {
+		delete(chapter.graphRevisions[sectionId], revisionId)
+	}
+	return nil
+}
+
+// AssignMissingGraphChildIds has nothing to do since every child stored here has an id
+func (r memoryGraphRepository) AssignMissingGraphChildIds() (int, *Error) {
+	return 0, nil
+}
+
+func (r memoryGraphRepository) section(
+	chapter *memoryChapter,
+	sectionId string,
+) *document.SectionValues {
+	for _, section := range chapter.values.Sections {
+		if section.Id == sectionId {
+			return &section
+		}
+	}
+	return nil
+}
+
+func (r memoryGraphRepository) valuesToEntry(
+	values document.GraphValues,
+	name string,
+	userId string,
+) *record.GraphEntry {
+	return &record.GraphEntry{
+		Name:      name,
+		Paragraph: values.Paragraph,
+		Children:  r.childrenValuesToEntry(values.Children),
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+		UpdatedAt: values.UpdatedAt,
+	}
+}
+
+func (r memoryGraphRepository) revisionValuesToEntry(
+	values document.GraphRevisionValues,
+	userId string,
+) *record.GraphRevisionEntry {
+	return &record.GraphRevisionEntry{
+		Paragraph: values.Paragraph,
+		Children:  r.childrenValuesToEntry(values.Children),
+		AuthorId:  values.AuthorId,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+	}
+}
+
+func (r memoryGraphRepository) childrenValuesToEntry(
+	values []document.GraphChildValues,
+) []record.GraphChildEntry {
+	entries := make([]record.GraphChildEntry, len(values))
+	for i, value := range values {
+		entries[i] = record.GraphChildEntry{
+			Id:          value.Id,
+			Name:        value.Name,
+			Relation:    value.Relation,
+			Description: value.Descrition,
+			Children:    r.childrenValuesToEntry(value.Children),
+		}
+	}
+	return entries
+}
+
+func (r memoryGraphRepository) childrenEntryToValues(
+	children []record.GraphChildEntry,
+) []document.GraphChildValues {
+	values := make([]document.GraphChildValues, len(children))
+	for i, child := range children {
+		values[i] = document.GraphChildValues{
+			Id:         child.Id,
+			Name:       child.Name,
+			Relation:   child.Relation,
+			Descrition: child.Description,
+			Children:   r.childrenEntryToValues(child.Children),
+		}
+	}
+	return values
+}
@@ -0,0 +1,809 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type sqlGraphRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLGraphRepository(database db.SQLDatabase) GraphRepository {
+	return sqlGraphRepository{database: database}
+}
+
+func (r sqlGraphRepository) GraphExists(
+	userId string,
+	projectId string,
+	chapterId string,
+) (bool, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return false, rErr
+	}
+
+	var count int
+	err := r.database.DB.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ?"), chapterId).
+		Scan(&count)
+	if err != nil {
+		return false, Errorf(ReadFailurePanic, "failed to fetch graphs: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (r sqlGraphRepository) FetchGraph(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) (*record.GraphEntry, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return r.fetchGraph(r.database.DB, userId, chapterId, sectionId)
+}
+
+func (r sqlGraphRepository) FetchGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+) ([]string, []record.GraphEntry, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	ids, rErr := r.fetchSectionIds(r.database.DB, chapterId)
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	entries := make([]record.GraphEntry, len(ids))
+	for i, id := range ids {
+		entry, rErr := r.fetchGraph(r.database.DB, userId, chapterId, id)
+		if rErr != nil {
+			return nil, nil, rErr
+		}
+		entries[i] = *entry
+	}
+
+	return ids, entries, nil
+}
+
+func (r sqlGraphRepository) InsertGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphWithoutAutofieldEntry,
+) ([]string, []record.GraphEntry, *Error) {
+	ids := make([]string, len(entries))
+	res := make([]record.GraphEntry, len(entries))
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		var count int
+		err := tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ?"), chapterId).
+			Scan(&count)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+		}
+
+		if count > 0 {
+			return Errorf(InvalidArgumentError, "graph already exists")
+		}
+
+		now := currentTime()
+		for i, entry := range entries {
+			id := newId()
+
+			_, err = tx.Exec(r.database.Rebind(
+				"INSERT INTO graphs (chapter_id, id, paragraph, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"),
+				chapterId, id, entry.Paragraph, now, now)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to insert graph: %w", err)
+			}
+
+			_, err = tx.Exec(r.database.Rebind(
+				"INSERT INTO sections (chapter_id, id, name, position) VALUES (?, ?, ?, ?)"),
+				chapterId, id, entry.Name, i)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+			}
+
+			children := assignGraphChildIds(nil, entry.Children)
+			rErr := r.insertChildren(tx, chapterId, id, nil, children)
+			if rErr != nil {
+				return rErr
+			}
+
+			ids[i] = id
+			res[i] = record.GraphEntry{
+				Name:      entry.Name,
+				Paragraph: entry.Paragraph,
+				Children:  r.copyChildren(children),
+				UserId:    userId,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+		}
+
+		_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET updated_at = ? WHERE id = ?"), now, chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		return nil
+	})
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	return ids, res, nil
+}
+
+func (r sqlGraphRepository) UpdateGraphContent(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	entry record.GraphContentEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.GraphEntry, *Error) {
+	var current *record.GraphEntry
+	var updated *record.GraphEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		var count int
+		err := tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ? AND id = ?"),
+			chapterId, sectionId).Scan(&count)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+		}
+
+		if count == 0 {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		if expectedUpdatedAt != nil {
+			entryToBeUpdated, rErr := r.fetchGraph(tx, userId, chapterId, sectionId)
+			if rErr != nil {
+				return rErr
+			}
+
+			if !entryToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+				current = entryToBeUpdated
+				return Errorf(ConflictError, "graph has been updated since it was fetched")
+			}
+		}
+
+		rErr = r.writeGraphContent(tx, userId, chapterId, sectionId, entry)
+		if rErr != nil {
+			return rErr
+		}
+
+		updated, rErr = r.fetchGraph(tx, userId, chapterId, sectionId)
+		return rErr
+	})
+	if rErr != nil {
+		return current, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlGraphRepository) UpdateGraphChildren(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	update func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error),
+) (*record.GraphEntry, *Error) {
+	var updated *record.GraphEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		var count int
+		err := tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ? AND id = ?"),
+			chapterId, sectionId).Scan(&count)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+		}
+
+		if count == 0 {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		entryToBeUpdated, rErr := r.fetchGraph(tx, userId, chapterId, sectionId)
+		if rErr != nil {
+			return rErr
+		}
+
+		children, err := update(entryToBeUpdated.Children)
+		if err != nil {
+			return Errorf(InvalidArgumentError, "failed to update graph children: %w", err)
+		}
+
+		rErr = r.writeGraphContent(tx, userId, chapterId, sectionId, record.GraphContentEntry{
+			Paragraph: entryToBeUpdated.Paragraph,
+			Children:  children,
+		})
+		if rErr != nil {
+			return rErr
+		}
+
+		updated, rErr = r.fetchGraph(tx, userId, chapterId, sectionId)
+		return rErr
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlGraphRepository) ResectionalizeGraphs(
+	userId string,
+	projectId string,
+	chapterId string,
+	entries []record.GraphResectionalizeEntry,
+	orphanIds []string,
+	orphanAction string,
+) ([]string, []record.GraphEntry, *Error) {
+	ids := make([]string, len(entries))
+	res := make([]record.GraphEntry, len(entries))
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		currentIds, rErr := r.fetchSectionIds(tx, chapterId)
+		if rErr != nil {
+			return rErr
+		}
+		if !sameGraphIds(currentIds, entries, orphanIds) {
+			return Errorf(ConflictError, "graphs have been updated since they were fetched")
+		}
+
+		orphanNames := make(map[string]string)
+		for _, orphanId := range orphanIds {
+			current, rErr := r.fetchGraph(tx, userId, chapterId, orphanId)
+			if rErr != nil {
+				return rErr
+			}
+			orphanNames[orphanId] = current.Name
+		}
+
+		now := currentTime()
+		for i, entry := range entries {
+			id := entry.Id
+			if id == "" {
+				id = newId()
+				_, err := tx.Exec(r.database.Rebind(
+					"INSERT INTO graphs (chapter_id, id, paragraph, created_at, updated_at) VALUES (?, ?, ?, ?, ?)"),
+					chapterId, id, entry.Paragraph, now, now)
+				if err != nil {
+					return Errorf(WriteFailurePanic, "failed to insert graph: %w", err)
+				}
+			} else {
+				rErr := r.updateParagraph(tx, userId, chapterId, id, entry.Paragraph, now)
+				if rErr != nil {
+					return rErr
+				}
+			}
+			ids[i] = id
+		}
+
+		for position, sectionId := range currentIds {
+			if !slices.Contains(orphanIds, sectionId) {
+				continue
+			}
+
+			if orphanAction == GraphOrphanActionDelete {
+				_, rErr := sqlDeleteGraph(r.database, tx, chapterId, sectionId)
+				if rErr != nil {
+					return rErr
+				}
+				_, rErr = sqlDeleteLinks(r.database, tx, chapterId, sectionId)
+				if rErr != nil {
+					return rErr
+				}
+				continue
+			}
+
+			rErr := sqlInsertTrashItem(r.database, tx, record.TrashItemEntry{
+				Type:      TrashItemTypeSection,
+				Name:      orphanNames[sectionId],
+				ProjectId: projectId,
+				ChapterId: chapterId,
+				SectionId: sectionId,
+				Position:  position,
+				UserId:    userId,
+			})
+			if rErr != nil {
+				return rErr
+			}
+		}
+
+		_, err := tx.Exec(r.database.Rebind("DELETE FROM sections WHERE chapter_id = ?"), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		for i, entry := range entries {
+			_, err = tx.Exec(r.database.Rebind(
+				"INSERT INTO sections (chapter_id, id, name, position) VALUES (?, ?, ?, ?)"),
+				chapterId, ids[i], entry.Name, i)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+			}
+		}
+
+		_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET updated_at = ? WHERE id = ?"), now, chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		for i := range entries {
+			entry, rErr := r.fetchGraph(tx, userId, chapterId, ids[i])
+			if rErr != nil {
+				return rErr
+			}
+			res[i] = *entry
+		}
+
+		return nil
+	})
+	if rErr != nil {
+		return nil, nil, rErr
+	}
+
+	return ids, res, nil
+}
+
+func (r sqlGraphRepository) TrashGraph(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		var name string
+		var position int
+		err := tx.QueryRow(r.database.Rebind("SELECT name, position FROM sections WHERE chapter_id = ? AND id = ?"),
+			chapterId, sectionId).Scan(&name, &position)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Errorf(NotFoundError, "failed to fetch graph")
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind("DELETE FROM sections WHERE chapter_id = ? AND id = ?"),
+			chapterId, sectionId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"UPDATE sections SET position = position - 1 WHERE chapter_id = ? AND position > ?"),
+			chapterId, position)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET updated_at = ? WHERE id = ?"), currentTime(), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+		}
+
+		return sqlInsertTrashItem(r.database, tx, record.TrashItemEntry{
+			Type:      TrashItemTypeSection,
+			Name:      name,
+			ProjectId: projectId,
+			ChapterId: chapterId,
+			SectionId: sectionId,
+			Position:  position,
+			UserId:    userId,
+		})
+	})
+}
+
+func (r sqlGraphRepository) FetchGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+) (map[string]record.GraphRevisionEntry, *Error) {
+	rErr := r.checkSection(r.database.DB, userId, projectId, chapterId, sectionId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT id, paragraph, children, author_id, created_at FROM graph_revisions "+
+			"WHERE chapter_id = ? AND graph_id = ?"), chapterId, sectionId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph revisions: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.GraphRevisionEntry)
+	for rows.Next() {
+		id, entry, err := r.scanRevision(rows, userId)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph revisions: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlGraphRepository) FetchGraphRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionId string,
+) (*record.GraphRevisionEntry, *Error) {
+	rErr := r.checkSection(r.database.DB, userId, projectId, chapterId, sectionId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	_, entry, err := r.scanRevision(r.database.DB.QueryRow(r.database.Rebind(
+		"SELECT id, paragraph, children, author_id, created_at FROM graph_revisions "+
+			"WHERE chapter_id = ? AND graph_id = ? AND id = ?"), chapterId, sectionId, revisionId), userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch graph revision")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph revision: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r sqlGraphRepository) DeleteGraphRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	revisionIds []string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := r.checkSection(tx, userId, projectId, chapterId, sectionId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		for _, revisionId := range revisionIds {
+			_, err := tx.Exec(r.database.Rebind(
+				"DELETE FROM graph_revisions WHERE chapter_id = ? AND graph_id = ? AND id = ?"),
+				chapterId, sectionId, revisionId)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete graph revision: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// AssignMissingGraphChildIds has nothing to do since every child stored here has an id
+func (r sqlGraphRepository) AssignMissingGraphChildIds() (int, *Error) {
+	return 0, nil
+}
+
+func (r sqlGraphRepository) checkSection(
+	q sqlQuerier,
+	userId string,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	access projectAccess,
+) *Error {
+	rErr := sqlCheckChapter(r.database, q, userId, projectId, chapterId, access)
+	if rErr != nil {
+		return rErr
+	}
+
+	var count int
+	err := q.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ? AND id = ?"),
+		chapterId, sectionId).Scan(&count)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+
+	if count == 0 {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+	return nil
+}
+
+func (r sqlGraphRepository) fetchSectionIds(q sqlQuerier, chapterId string) ([]string, *Error) {
+	rows, err := q.Query(r.database.Rebind("SELECT id FROM sections WHERE chapter_id = ? ORDER BY position"), chapterId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+	return ids, nil
+}
+
+func (r sqlGraphRepository) updateParagraph(
+	tx *sql.Tx,
+	userId string,
+	chapterId string,
+	graphId string,
+	paragraph string,
+	now time.Time,
+) *Error {
+	current, rErr := r.fetchGraph(tx, userId, chapterId, graphId)
+	if rErr != nil {
+		return rErr
+	}
+	if current.Paragraph == paragraph {
+		return nil
+	}
+
+	_, err := tx.Exec(r.database.Rebind("UPDATE graphs SET paragraph = ?, updated_at = ? WHERE chapter_id = ? AND id = ?"),
+		paragraph, now, chapterId, graphId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+	}
+
+	children, err := json.Marshal(current.Children)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+	}
+
+	_, err = tx.Exec(r.database.Rebind(
+		"INSERT INTO graph_revisions (id, chapter_id, graph_id, paragraph, children, author_id, created_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)"),
+		newId(), chapterId, graphId, paragraph, string(children), userId, now)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+	}
+	return nil
+}
+
+func (r sqlGraphRepository) writeGraphContent(
+	tx *sql.Tx,
+	userId string,
+	chapterId string,
+	sectionId string,
+	entry record.GraphContentEntry,
+) *Error {
+	now := currentTime()
+	_, err := tx.Exec(r.database.Rebind(
+		"INSERT INTO graphs (chapter_id, id, paragraph, created_at, updated_at) VALUES (?, ?, ?, ?, ?) "+
+			"ON CONFLICT (chapter_id, id) DO UPDATE SET paragraph = excluded.paragraph, updated_at = excluded.updated_at"),
+		chapterId, sectionId, entry.Paragraph, now, now)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+	}
+
+	currentChildren, rErr := r.fetchChildren(tx, chapterId, sectionId)
+	if rErr != nil {
+		return rErr
+	}
+	entryChildren := assignGraphChildIds(currentChildren, entry.Children)
+
+	_, err = tx.Exec(r.database.Rebind("DELETE FROM graph_children WHERE chapter_id = ? AND graph_id = ?"),
+		chapterId, sectionId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update graph: %w", err)
+	}
+
+	rErr = r.insertChildren(tx, chapterId, sectionId, nil, entryChildren)
+	if rErr != nil {
+		return rErr
+	}
+
+	rErr = sqlDeleteNodeLinks(r.database, tx, chapterId, sectionId)
+	if rErr != nil {
+		return rErr
+	}
+
+	children, err := json.Marshal(entryChildren)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+	}
+
+	_, err = tx.Exec(r.database.Rebind(
+		"INSERT INTO graph_revisions (id, chapter_id, graph_id, paragraph, children, author_id, created_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)"),
+		newId(), chapterId, sectionId, entry.Paragraph, string(children), userId, now)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to insert graph revision: %w", err)
+	}
+
+	return nil
+}
+
+func (r sqlGraphRepository) fetchGraph(
+	q sqlQuerier,
+	userId string,
+	chapterId string,
+	sectionId string,
+) (*record.GraphEntry, *Error) {
+	entry := record.GraphEntry{UserId: userId}
+	err := q.QueryRow(r.database.Rebind(
+		"SELECT s.name, g.paragraph, g.created_at, g.updated_at FROM graphs g "+
+			"JOIN sections s ON s.chapter_id = g.chapter_id AND s.id = g.id "+
+			"WHERE g.chapter_id = ? AND g.id = ?"), chapterId, sectionId).
+		Scan(&entry.Name, &entry.Paragraph, &entry.CreatedAt, &entry.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch graph")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph: %w", err)
+	}
+
+	children, rErr := r.fetchChildren(q, chapterId, sectionId)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entry.Children = children
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+	return &entry, nil
+}
+
+func (r sqlGraphRepository) scanRevision(
+	row interface{ Scan(dest ...any) error },
+	userId string,
+) (string, *record.GraphRevisionEntry, error) {
+	var id string
+	var children string
+	entry := record.GraphRevisionEntry{UserId: userId}
+	err := row.Scan(&id, &entry.Paragraph, &children, &entry.AuthorId, &entry.CreatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = json.Unmarshal([]byte(children), &entry.Children)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return id, &entry, nil
+}
+
+type sqlGraphChildRow struct {
+	id    string
+	entry record.GraphChildEntry
+}
+
+func (r sqlGraphRepository) fetchChildren(
+	q sqlQuerier,
+	chapterId string,
+	graphId string,
+) ([]record.GraphChildEntry, *Error) {
+	rows, err := q.Query(r.database.Rebind(
+		"SELECT id, parent_id, name, relation, description FROM graph_children "+
+			"WHERE chapter_id = ? AND graph_id = ? ORDER BY position"), chapterId, graphId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph children: %w", err)
+	}
+	defer rows.Close()
+
+	childrenOf := make(map[string][]sqlGraphChildRow)
+	for rows.Next() {
+		var row sqlGraphChildRow
+		var parentId sql.NullString
+		err := rows.Scan(&row.id, &parentId, &row.entry.Name, &row.entry.Relation, &row.entry.Description)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		row.entry.Id = row.id
+		childrenOf[parentId.String] = append(childrenOf[parentId.String], row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch graph children: %w", err)
+	}
+
+	return r.buildChildren(childrenOf, ""), nil
+}
+
+func (r sqlGraphRepository) buildChildren(
+	childrenOf map[string][]sqlGraphChildRow,
+	parentId string,
+) []record.GraphChildEntry {
+	rows := childrenOf[parentId]
+	entries := make([]record.GraphChildEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = row.entry
+		entries[i].Children = r.buildChildren(childrenOf, row.id)
+	}
+	return entries
+}
+
+func (r sqlGraphRepository) insertChildren(
+	tx *sql.Tx,
+	chapterId string,
+	graphId string,
+	parentId *string,
+	children []record.GraphChildEntry,
+) *Error {
+	for i, child := range children {
+		id := child.Id
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO graph_children (id, chapter_id, graph_id, parent_id, position, name, relation, description) "+
+				"VALUES (?, ?, ?, ?, ?, ?, ?, ?)"),
+			id, chapterId, graphId, parentId, i, child.Name, child.Relation, child.Description)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert graph children: %w", err)
+		}
+
+		rErr := r.insertChildren(tx, chapterId, graphId, &id, child.Children)
+		if rErr != nil {
+			return rErr
+		}
+	}
+	return nil
+}
+
+func (r sqlGraphRepository) copyChildren(
+	children []record.GraphChildEntry,
+) []record.GraphChildEntry {
+	entries := make([]record.GraphChildEntry, len(children))
+	for i, child := range children {
+		entries[i] = record.GraphChildEntry{
+			Id:          child.Id,
+			Name:        child.Name,
+			Relation:    child.Relation,
+			Description: child.Description,
+			Children:    r.copyChildren(child.Children),
+		}
+	}
+	return entries
+}
@@ -3,12 +3,14 @@ package repository_test
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository/repositorytest"
 	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -315,6 +317,103 @@ func TestInsertGraphsValidEntry(t *testing.T) {
 	assert.Equal(t, testutil.ModifyOnlyUserId(), createdEntry.UserId)
 	assert.Less(t, now.Sub(createdEntry.CreatedAt), time.Second)
 	assert.Less(t, now.Sub(createdEntry.UpdatedAt), time.Second)
+
+	cr := repository.NewChapterRepository(*client)
+	chapter, rErr := cr.FetchChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+	assert.Len(t, chapter.Sections, 2)
+	assert.Equal(t, ids[0], chapter.Sections[0].Id)
+	assert.Equal(t, "Introduction", chapter.Sections[0].Name)
+	assert.Equal(t, ids[1], chapter.Sections[1].Id)
+	assert.Equal(t, "What is note apps?", chapter.Sections[1].Name)
+}
+
+func TestInsertGraphsConcurrentWriters(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Insert Graphs Concurrently",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	const writers = 4
+
+	var wg sync.WaitGroup
+	ids := make([][]string, writers)
+	rErrs := make([]*repository.Error, writers)
+	for i := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids[i], _, rErrs[i] = r.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+				{
+					Name:      fmt.Sprintf("Section %d", i),
+					Paragraph: fmt.Sprintf("This is section %d.", i),
+					Children:  []record.GraphChildEntry{},
+				},
+			})
+		}()
+	}
+	wg.Wait()
+
+	insertedIds := []string{}
+	for i, rErr := range rErrs {
+		if rErr != nil {
+			assert.Contains(t, []repository.ErrorCode{repository.InvalidArgumentError, repository.WriteFailurePanic}, rErr.Code())
+			continue
+		}
+		insertedIds = append(insertedIds, ids[i]...)
+	}
+	assert.Len(t, insertedIds, 1)
+
+	chapter, rErr := cr.FetchChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+	assert.Len(t, chapter.Sections, 1)
+	assert.Equal(t, insertedIds[0], chapter.Sections[0].Id)
+
+	graph, rErr := r.FetchGraph(userId, projectId, chapterId, insertedIds[0])
+
+	assert.Nil(t, rErr)
+
+	assert.Equal(t, chapter.Sections[0].Name, graph.Name)
+}
+
+func TestInsertGraphsInvalidArgument(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId := "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_REPOSITORY"
+	chapterId := "CHAPTER_ONE"
+
+	ids, createdEntries, rErr := r.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{
+			Name:      "Introduction",
+			Paragraph: "This is the introduction of the paper.",
+			Children:  []record.GraphChildEntry{},
+		},
+	})
+
+	assert.NotNil(t, rErr)
+
+	assert.Equal(t, repository.InvalidArgumentError, rErr.Code())
+	assert.Equal(t, "invalid argument: graph already exists", rErr.Error())
+	assert.Nil(t, ids)
+	assert.Nil(t, createdEntries)
 }
 
 func TestInsertGraphNotFound(t *testing.T) {
@@ -417,14 +516,14 @@ func TestUpdateGraphContentValidEntry(t *testing.T) {
 	}
 
 	updatedEntry, rErr := r.UpdateGraphContent(userId, projectId, chapterId, sectionId,
-		record.GraphContentEntry{Paragraph: paragraph, Children: children})
+		record.GraphContentEntry{Paragraph: paragraph, Children: children}, nil)
 	now := time.Now()
 
 	assert.Nil(t, rErr)
 
 	assert.Equal(t, "Introduction", updatedEntry.Name)
 	assert.Equal(t, paragraph, updatedEntry.Paragraph)
-	assert.Equal(t, children, updatedEntry.Children)
+	repositorytest.AssertGraphChildren(t, children, updatedEntry.Children)
 	assert.Equal(t, testutil.ModifyOnlyUserId(), updatedEntry.UserId)
 	assert.Equal(t, testutil.Date(), updatedEntry.CreatedAt)
 	assert.Less(t, now.Sub(updatedEntry.UpdatedAt), time.Second)
@@ -481,7 +580,7 @@ func TestUpdateGraphContentNotFound(t *testing.T) {
 
 			entry, rErr := r.UpdateGraphContent(tc.userId, tc.projectId, tc.chapterId, tc.sectionId, record.GraphContentEntry{
 				Paragraph: "content",
-			})
+			}, nil)
 
 			assert.NotNil(t, rErr)
 
@@ -492,7 +591,228 @@ func TestUpdateGraphContentNotFound(t *testing.T) {
 	}
 }
 
-func TestDeleteGraphValidEntry(t *testing.T) {
+func TestFetchGraphsValidEntry(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Fetch Graphs",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	ids, entries, rErr := r.FetchGraphs(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+	assert.Empty(t, ids)
+	assert.Empty(t, entries)
+
+	insertedIds, insertedEntries, rErr := r.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "paragraph two", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	ids, entries, rErr = r.FetchGraphs(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+	assert.Equal(t, insertedIds, ids)
+	assert.Equal(t, insertedEntries, entries)
+
+	_, _, rErr = r.FetchGraphs(testutil.ReadOnlyUserId(), projectId, chapterId)
+
+	assert.NotNil(t, rErr)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch project", rErr.Error())
+}
+
+func TestUpdateGraphChildrenValidEntry(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Update Graph Children",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+	ids, _, rErr := r.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	child := record.GraphChildEntry{Name: "Child", Relation: "part of", Description: "", Children: []record.GraphChildEntry{}}
+	entry, rErr := r.UpdateGraphChildren(userId, projectId, chapterId, ids[0],
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return append(children, child), nil
+		})
+	now := time.Now()
+
+	assert.Nil(t, rErr)
+	assert.Equal(t, "Section One", entry.Name)
+	assert.Equal(t, "paragraph one", entry.Paragraph)
+	repositorytest.AssertGraphChildren(t, []record.GraphChildEntry{child}, entry.Children)
+	assert.Less(t, now.Sub(entry.UpdatedAt), time.Second)
+
+	revisions, rErr := r.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+
+	assert.Nil(t, rErr)
+	assert.Len(t, revisions, 1)
+
+	entry, rErr = r.UpdateGraphChildren(userId, projectId, chapterId, ids[0],
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return nil, fmt.Errorf("graph child is not found")
+		})
+
+	assert.Nil(t, entry)
+	assert.NotNil(t, rErr)
+	assert.Equal(t, repository.InvalidArgumentError, rErr.Code())
+	assert.Equal(t, "invalid argument: failed to update graph children: graph child is not found", rErr.Error())
+
+	entry, rErr = r.UpdateGraphChildren(userId, projectId, chapterId, "UNKNOWN_SECTION",
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return children, nil
+		})
+
+	assert.Nil(t, entry)
+	assert.NotNil(t, rErr)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch graph", rErr.Error())
+}
+
+func TestResectionalizeGraphsValidEntry(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	tr := repository.NewTrashRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Resectionalize Graphs",
+	})
+	assert.Nil(t, rErr)
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	children := []record.GraphChildEntry{
+		{Name: "Child", Relation: "part of", Description: "description", Children: []record.GraphChildEntry{}},
+	}
+	ids, _, rErr := r.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one", Children: children},
+		{Name: "Section Two", Paragraph: "paragraph two", Children: []record.GraphChildEntry{}},
+		{Name: "Section Three", Paragraph: "paragraph three", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	newIds, entries, rErr := r.ResectionalizeGraphs(userId, projectId, chapterId,
+		[]record.GraphResectionalizeEntry{
+			{Name: "Section Zero", Paragraph: "paragraph zero"},
+			{Id: ids[0], Name: "Section One", Paragraph: "updated paragraph one"},
+		},
+		[]string{ids[1], ids[2]},
+		repository.GraphOrphanActionArchive,
+	)
+	now := time.Now()
+
+	assert.Nil(t, rErr)
+	assert.Len(t, newIds, 2)
+	assert.NotEmpty(t, newIds[0])
+	assert.Equal(t, ids[0], newIds[1])
+
+	assert.Equal(t, "Section Zero", entries[0].Name)
+	assert.Equal(t, "paragraph zero", entries[0].Paragraph)
+	assert.Equal(t, []record.GraphChildEntry{}, entries[0].Children)
+	assert.Less(t, now.Sub(entries[0].CreatedAt), time.Second)
+	assert.Equal(t, "Section One", entries[1].Name)
+	assert.Equal(t, "updated paragraph one", entries[1].Paragraph)
+	repositorytest.AssertGraphChildren(t, children, entries[1].Children)
+	assert.Less(t, now.Sub(entries[1].UpdatedAt), time.Second)
+
+	chapter, rErr := cr.FetchChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+	assert.Len(t, chapter.Sections, 2)
+	assert.Equal(t, newIds[0], chapter.Sections[0].Id)
+	assert.Equal(t, newIds[1], chapter.Sections[1].Id)
+
+	revisions, rErr := r.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+
+	assert.Nil(t, rErr)
+	assert.Len(t, revisions, 1)
+
+	items, rErr := tr.FetchTrashItems(userId)
+
+	assert.Nil(t, rErr)
+
+	positions := make(map[string]int)
+	for _, item := range items {
+		if item.ChapterId == chapterId {
+			positions[item.SectionId] = item.Position
+		}
+	}
+	assert.Equal(t, map[string]int{ids[1]: 1, ids[2]: 2}, positions)
+
+	_, _, rErr = r.ResectionalizeGraphs(userId, projectId, chapterId,
+		[]record.GraphResectionalizeEntry{
+			{Id: newIds[1], Name: "Section One", Paragraph: "updated paragraph one"},
+		},
+		[]string{newIds[0]},
+		repository.GraphOrphanActionDelete,
+	)
+
+	assert.Nil(t, rErr)
+
+	_, err := client.Collection(repository.ProjectCollection).
+		Doc(projectId).
+		Collection(repository.ChapterCollection).
+		Doc(chapterId).
+		Collection(repository.GraphCollection).
+		Doc(newIds[0]).
+		Get(db.FirestoreContext())
+	assert.NotNil(t, err)
+}
+
+func TestResectionalizeGraphsConflict(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId := "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_REPOSITORY"
+	chapterId := "CHAPTER_ONE"
+
+	ids, entries, rErr := r.ResectionalizeGraphs(userId, projectId, chapterId,
+		[]record.GraphResectionalizeEntry{
+			{Id: "UNKNOWN_SECTION", Name: "Section", Paragraph: "paragraph"},
+		},
+		[]string{},
+		repository.GraphOrphanActionArchive,
+	)
+
+	assert.Nil(t, ids)
+	assert.Nil(t, entries)
+	assert.NotNil(t, rErr)
+	assert.Equal(t, repository.ConflictError, rErr.Code())
+	assert.Equal(t, "conflict: graphs have been updated since they were fetched", rErr.Error())
+}
+
+func TestTrashGraphValidEntry(t *testing.T) {
 	client := db.FirestoreClient()
 	r := repository.NewGraphRepository(*client)
 
@@ -501,13 +821,21 @@ func TestDeleteGraphValidEntry(t *testing.T) {
 	chapterId := "CHAPTER_ONE"
 	sectionId := "SECTION_TWO"
 
-	rErr := r.DeleteGraph(userId, projectId, chapterId, sectionId)
+	rErr := r.TrashGraph(userId, projectId, chapterId, sectionId)
 
 	assert.Nil(t, rErr)
 
+	cr := repository.NewChapterRepository(*client)
+	chapter, rErr := cr.FetchChapter(userId, projectId, chapterId)
+
+	assert.Nil(t, rErr)
+
+	for _, section := range chapter.Sections {
+		assert.NotEqual(t, sectionId, section.Id)
+	}
 }
 
-func TestDeleteGraphNotFound(t *testing.T) {
+func TestTrashGraphNotFound(t *testing.T) {
 	tt := []struct {
 		name          string
 		userId        string
@@ -555,7 +883,7 @@ func TestDeleteGraphNotFound(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewGraphRepository(*client)
 
-			rErr := r.DeleteGraph(tc.userId, tc.projectId, tc.chapterId, tc.sectionId)
+			rErr := r.TrashGraph(tc.userId, tc.projectId, tc.chapterId, tc.sectionId)
 
 			assert.NotNil(t, rErr)
 
@@ -0,0 +1,457 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const LinkCollection = "links"
+
+type LinkRepository interface {
+	FetchLinks(
+		userId string,
+		projectId string,
+	) (map[string]record.LinkEntry, *Error)
+	InsertLink(
+		userId string,
+		projectId string,
+		entry record.LinkWithoutAutofieldEntry,
+	) (string, *record.LinkEntry, *Error)
+	UpdateLink(
+		userId string,
+		projectId string,
+		linkId string,
+		entry record.LinkWithoutAutofieldEntry,
+	) (*record.LinkEntry, *Error)
+	DeleteLink(
+		userId string,
+		projectId string,
+		linkId string,
+	) *Error
+}
+
+type linkRepository struct {
+	client            firestore.Client
+	chapterRepository chapterRepository
+	graphRepository   graphRepository
+}
+
+func NewLinkRepository(client firestore.Client) LinkRepository {
+	return linkRepository{
+		client:            client,
+		chapterRepository: chapterRepository{client: client},
+		graphRepository:   graphRepository{client: client, chapterRepository: chapterRepository{client: client}},
+	}
+}
+
+func (r linkRepository) FetchLinks(
+	userId string,
+	projectId string,
+) (map[string]record.LinkEntry, *Error) {
+	projectValues, rErr := r.chapterRepository.projectValues(userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	iter := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(LinkCollection).
+		Documents(db.FirestoreContext())
+
+	linkValues := make(map[string]document.LinkValues)
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+		}
+
+		var values document.LinkValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		linkValues[snapshot.Ref.ID] = values
+	}
+
+	sectionIds, rErr := r.liveSectionIds(projectId, projectValues.ChapterIds, linkValues)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// links stay while an endpoint is in trash, so that restoring it brings them back
+	entries := make(map[string]record.LinkEntry)
+	for id, values := range linkValues {
+		if !slices.Contains(sectionIds[values.From.ChapterId], values.From.SectionId) ||
+			!slices.Contains(sectionIds[values.To.ChapterId], values.To.SectionId) {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r linkRepository) InsertLink(
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (string, *record.LinkEntry, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(LinkCollection).
+		NewDoc()
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		_, rErr := r.chapterRepository.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		rErr = r.checkEndpointsInTransaction(tx, userId, projectId, entry)
+		if rErr != nil {
+			return rErr
+		}
+
+		err := tx.Create(ref, map[string]any{
+			"from":        r.endpointEntryToValues(entry.From),
+			"to":          r.endpointEntryToValues(entry.To),
+			"relation":    entry.Relation,
+			"description": entry.Description,
+			"createdAt":   firestore.ServerTimestamp,
+			"updatedAt":   firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert link: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", nil, transactionError(err)
+	}
+
+	created, rErr := r.fetchEntry(ref, userId)
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return ref.ID, created, nil
+}
+
+func (r linkRepository) UpdateLink(
+	userId string,
+	projectId string,
+	linkId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (*record.LinkEntry, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(LinkCollection).
+		Doc(linkId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		_, rErr := r.chapterRepository.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch link")
+		}
+
+		rErr = r.checkEndpointsInTransaction(tx, userId, projectId, entry)
+		if rErr != nil {
+			return rErr
+		}
+
+		err = tx.Set(ref, map[string]any{
+			"from":        r.endpointEntryToValues(entry.From),
+			"to":          r.endpointEntryToValues(entry.To),
+			"relation":    entry.Relation,
+			"description": entry.Description,
+			"updatedAt":   firestore.ServerTimestamp,
+		}, firestore.MergeAll)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update link: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	return r.fetchEntry(ref, userId)
+}
+
+func (r linkRepository) DeleteLink(
+	userId string,
+	projectId string,
+	linkId string,
+) *Error {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(LinkCollection).
+		Doc(linkId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		_, rErr := r.chapterRepository.projectValuesInTransaction(tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch link")
+		}
+
+		err = tx.Delete(ref)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+func (r linkRepository) checkEndpointsInTransaction(
+	tx *firestore.Transaction,
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) *Error {
+	for _, endpoint := range []record.LinkEndpointEntry{entry.From, entry.To} {
+		chapterValues, rErr := r.graphRepository.chapterValuesInTransaction(tx, userId, projectId, endpoint.ChapterId, readAccess)
+		if rErr != nil && rErr.Code() == NotFoundError {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		if !slices.ContainsFunc(chapterValues.Sections, func(section document.SectionValues) bool {
+			return section.Id == endpoint.SectionId
+		}) {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+
+		if endpoint.NodeId == "" {
+			continue
+		}
+
+		snapshot, err := tx.Get(r.client.Collection(ProjectCollection).
+			Doc(projectId).
+			Collection(ChapterCollection).
+			Doc(endpoint.ChapterId).
+			Collection(GraphCollection).
+			Doc(endpoint.SectionId))
+		if err != nil {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+
+		var values document.GraphValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !containsGraphChildId(r.graphRepository.childrenValuesToEntry(values.Children), endpoint.NodeId) {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+	}
+
+	return nil
+}
+
+// liveSectionIds returns the IDs of the sections which are not in trash
+// for each chapter which is not in trash and has an endpoint of the links
+func (r linkRepository) liveSectionIds(
+	projectId string,
+	chapterIds []string,
+	linkValues map[string]document.LinkValues,
+) (map[string][]string, *Error) {
+	refs := []*firestore.DocumentRef{}
+	for _, values := range linkValues {
+		for _, chapterId := range []string{values.From.ChapterId, values.To.ChapterId} {
+			if !slices.Contains(chapterIds, chapterId) ||
+				slices.ContainsFunc(refs, func(ref *firestore.DocumentRef) bool { return ref.ID == chapterId }) {
+				continue
+			}
+			refs = append(refs, r.client.Collection(ProjectCollection).
+				Doc(projectId).
+				Collection(ChapterCollection).
+				Doc(chapterId))
+		}
+	}
+
+	sectionIds := make(map[string][]string)
+	if len(refs) == 0 {
+		return sectionIds, nil
+	}
+
+	snapshots, err := r.client.GetAll(db.FirestoreContext(), refs)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch chapters: %w", err)
+	}
+
+	for _, snapshot := range snapshots {
+		if !snapshot.Exists() {
+			continue
+		}
+
+		var values document.ChapterValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if values.Trashed {
+			continue
+		}
+		for _, section := range values.Sections {
+			sectionIds[snapshot.Ref.ID] = append(sectionIds[snapshot.Ref.ID], section.Id)
+		}
+	}
+	return sectionIds, nil
+}
+
+func (r linkRepository) fetchEntry(ref *firestore.DocumentRef, userId string) (*record.LinkEntry, *Error) {
+	snapshot, err := ref.Get(db.FirestoreContext())
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch link: %w", err)
+	}
+
+	var values document.LinkValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.valuesToEntry(values, userId), nil
+}
+
+func (r linkRepository) endpointEntryToValues(entry record.LinkEndpointEntry) document.LinkEndpointValues {
+	return document.LinkEndpointValues{
+		ChapterId: entry.ChapterId,
+		SectionId: entry.SectionId,
+		NodeId:    entry.NodeId,
+	}
+}
+
+func (r linkRepository) valuesToEntry(values document.LinkValues, userId string) *record.LinkEntry {
+	return &record.LinkEntry{
+		From: record.LinkEndpointEntry{
+			ChapterId: values.From.ChapterId,
+			SectionId: values.From.SectionId,
+			NodeId:    values.From.NodeId,
+		},
+		To: record.LinkEndpointEntry{
+			ChapterId: values.To.ChapterId,
+			SectionId: values.To.SectionId,
+			NodeId:    values.To.NodeId,
+		},
+		Relation:    values.Relation,
+		Description: values.Description,
+		UserId:      userId,
+		CreatedAt:   values.CreatedAt,
+		UpdatedAt:   values.UpdatedAt,
+	}
+}
+
+// linkRefsInTransaction returns the links which have an endpoint in the chapter,
+// or only in the section of it when sectionId is not empty
+func linkRefsInTransaction(
+	tx *firestore.Transaction,
+	projectRef *firestore.DocumentRef,
+	chapterId string,
+	sectionId string,
+) ([]*firestore.DocumentRef, *Error) {
+	refs := []*firestore.DocumentRef{}
+	for _, side := range []string{"from", "to"} {
+		query := projectRef.Collection(LinkCollection).
+			Where(side+".chapterId", "==", chapterId)
+		if sectionId != "" {
+			query = query.Where(side+".sectionId", "==", sectionId)
+		}
+
+		snapshots, err := tx.Documents(query).GetAll()
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+		}
+
+		for _, snapshot := range snapshots {
+			if !slices.ContainsFunc(refs, func(ref *firestore.DocumentRef) bool { return ref.ID == snapshot.Ref.ID }) {
+				refs = append(refs, snapshot.Ref)
+			}
+		}
+	}
+	return refs, nil
+}
+
+// nodeLinkRefsInTransaction returns the links which have an endpoint at a node of the section
+// which is no longer in children
+func nodeLinkRefsInTransaction(
+	tx *firestore.Transaction,
+	projectRef *firestore.DocumentRef,
+	chapterId string,
+	sectionId string,
+	children []record.GraphChildEntry,
+) ([]*firestore.DocumentRef, *Error) {
+	refs := []*firestore.DocumentRef{}
+	for _, side := range []string{"from", "to"} {
+		snapshots, err := tx.Documents(projectRef.Collection(LinkCollection).
+			Where(side+".chapterId", "==", chapterId).
+			Where(side+".sectionId", "==", sectionId)).
+			GetAll()
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+		}
+
+		for _, snapshot := range snapshots {
+			var values document.LinkValues
+			err := snapshot.DataTo(&values)
+			if err != nil {
+				return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+			}
+
+			endpoint := values.From
+			if side == "to" {
+				endpoint = values.To
+			}
+			if endpoint.NodeId == "" || containsGraphChildId(children, endpoint.NodeId) {
+				continue
+			}
+			if !slices.ContainsFunc(refs, func(ref *firestore.DocumentRef) bool { return ref.ID == snapshot.Ref.ID }) {
+				refs = append(refs, snapshot.Ref)
+			}
+		}
+	}
+	return refs, nil
+}
+
+func containsGraphChildId(children []record.GraphChildEntry, childId string) bool {
+	for _, child := range children {
+		if child.Id == childId || containsGraphChildId(child.Children, childId) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryLinkRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryLinkRepository(store *MemoryStore) LinkRepository {
+	return memoryLinkRepository{store: store}
+}
+
+func (r memoryLinkRepository) FetchLinks(
+	userId string,
+	projectId string,
+) (map[string]record.LinkEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, rErr := r.store.project(userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// links stay while an endpoint is in trash, so that restoring it brings them back
+	entries := make(map[string]record.LinkEntry)
+	for id, values := range project.links {
+		if !project.hasSection(values.From.ChapterId, values.From.SectionId) ||
+			!project.hasSection(values.To.ChapterId, values.To.SectionId) {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r memoryLinkRepository) InsertLink(
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (string, *record.LinkEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	rErr = r.checkEndpoints(userId, projectId, entry)
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	now := currentTime()
+	id := newId()
+	values := document.LinkValues{
+		From:        r.endpointEntryToValues(entry.From),
+		To:          r.endpointEntryToValues(entry.To),
+		Relation:    entry.Relation,
+		Description: entry.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	project.links[id] = values
+
+	return id, r.valuesToEntry(values, userId), nil
+}
+
+func (r memoryLinkRepository) UpdateLink(
+	userId string,
+	projectId string,
+	linkId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (*record.LinkEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	values, ok := project.links[linkId]
+	if !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch link")
+	}
+
+	rErr = r.checkEndpoints(userId, projectId, entry)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	values.From = r.endpointEntryToValues(entry.From)
+	values.To = r.endpointEntryToValues(entry.To)
+	values.Relation = entry.Relation
+	values.Description = entry.Description
+	values.UpdatedAt = currentTime()
+	project.links[linkId] = values
+
+	return r.valuesToEntry(values, userId), nil
+}
+
+func (r memoryLinkRepository) DeleteLink(
+	userId string,
+	projectId string,
+	linkId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.store.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	if _, ok := project.links[linkId]; !ok {
+		return Errorf(NotFoundError, "failed to fetch link")
+	}
+
+	delete(project.links, linkId)
+	return nil
+}
+
+func (r memoryLinkRepository) checkEndpoints(
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) *Error {
+	for _, endpoint := range []record.LinkEndpointEntry{entry.From, entry.To} {
+		_, chapter, _, rErr := r.store.chapter(userId, projectId, endpoint.ChapterId, readAccess)
+		if rErr != nil {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+
+		if !slices.ContainsFunc(chapter.values.Sections, func(section document.SectionValues) bool {
+			return section.Id == endpoint.SectionId
+		}) {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+
+		children := memoryGraphRepository{}.childrenValuesToEntry(chapter.graphs[endpoint.SectionId].Children)
+		if endpoint.NodeId != "" && !containsGraphChildId(children, endpoint.NodeId) {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+	}
+
+	return nil
+}
+
+func (r memoryLinkRepository) endpointEntryToValues(entry record.LinkEndpointEntry) document.LinkEndpointValues {
+	return document.LinkEndpointValues{
+		ChapterId: entry.ChapterId,
+		SectionId: entry.SectionId,
+		NodeId:    entry.NodeId,
+	}
+}
+
+func (r memoryLinkRepository) valuesToEntry(values document.LinkValues, userId string) *record.LinkEntry {
+	return &record.LinkEntry{
+		From: record.LinkEndpointEntry{
+			ChapterId: values.From.ChapterId,
+			SectionId: values.From.SectionId,
+			NodeId:    values.From.NodeId,
+		},
+		To: record.LinkEndpointEntry{
+			ChapterId: values.To.ChapterId,
+			SectionId: values.To.SectionId,
+			NodeId:    values.To.NodeId,
+		},
+		Relation:    values.Relation,
+		Description: values.Description,
+		UserId:      userId,
+		CreatedAt:   values.CreatedAt,
+		UpdatedAt:   values.UpdatedAt,
+	}
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const sqlLinkColumns = "id, from_chapter_id, from_section_id, from_node_id, to_chapter_id, to_section_id, to_node_id, " +
+	"relation, description, created_at, updated_at"
+
+type sqlLinkRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLLinkRepository(database db.SQLDatabase) LinkRepository {
+	return sqlLinkRepository{database: database}
+}
+
+func (r sqlLinkRepository) FetchLinks(
+	userId string,
+	projectId string,
+) (map[string]record.LinkEntry, *Error) {
+	rErr := sqlCheckProject(r.database, r.database.DB, userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	// links stay while an endpoint is in trash, so that restoring it brings them back
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlLinkColumns+" FROM links WHERE project_id = ? AND "+
+			sqlLiveSectionCondition("from_chapter_id", "from_section_id")+" AND "+
+			sqlLiveSectionCondition("to_chapter_id", "to_section_id")), projectId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.LinkEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows, userId)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlLinkRepository) InsertLink(
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (string, *record.LinkEntry, *Error) {
+	id := newId()
+	now := currentTime()
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		rErr = r.checkEndpoints(tx, userId, projectId, entry)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO links (id, project_id, from_chapter_id, from_section_id, from_node_id, "+
+				"to_chapter_id, to_section_id, to_node_id, relation, description, created_at, updated_at) "+
+				"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"),
+			id, projectId, entry.From.ChapterId, entry.From.SectionId, entry.From.NodeId,
+			entry.To.ChapterId, entry.To.SectionId, entry.To.NodeId, entry.Relation, entry.Description, now, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert link: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return id, &record.LinkEntry{
+		From:        entry.From,
+		To:          entry.To,
+		Relation:    entry.Relation,
+		Description: entry.Description,
+		UserId:      userId,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (r sqlLinkRepository) UpdateLink(
+	userId string,
+	projectId string,
+	linkId string,
+	entry record.LinkWithoutAutofieldEntry,
+) (*record.LinkEntry, *Error) {
+	var updated *record.LinkEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, current, err := r.scanEntry(tx.QueryRow(r.database.Rebind(
+			"SELECT "+sqlLinkColumns+" FROM links WHERE project_id = ? AND id = ?"), projectId, linkId), userId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return Errorf(NotFoundError, "failed to fetch link")
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch link: %w", err)
+		}
+
+		rErr = r.checkEndpoints(tx, userId, projectId, entry)
+		if rErr != nil {
+			return rErr
+		}
+
+		now := currentTime()
+		_, err = tx.Exec(r.database.Rebind(
+			"UPDATE links SET from_chapter_id = ?, from_section_id = ?, from_node_id = ?, "+
+				"to_chapter_id = ?, to_section_id = ?, to_node_id = ?, relation = ?, description = ?, updated_at = ? "+
+				"WHERE id = ?"),
+			entry.From.ChapterId, entry.From.SectionId, entry.From.NodeId,
+			entry.To.ChapterId, entry.To.SectionId, entry.To.NodeId, entry.Relation, entry.Description, now, linkId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update link: %w", err)
+		}
+
+		updated = &record.LinkEntry{
+			From:        entry.From,
+			To:          entry.To,
+			Relation:    entry.Relation,
+			Description: entry.Description,
+			UserId:      userId,
+			CreatedAt:   current.CreatedAt,
+			UpdatedAt:   now,
+		}
+		return nil
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlLinkRepository) DeleteLink(
+	userId string,
+	projectId string,
+	linkId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		result, err := tx.Exec(r.database.Rebind("DELETE FROM links WHERE project_id = ? AND id = ?"),
+			projectId, linkId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+		}
+
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+		}
+		if deleted == 0 {
+			return Errorf(NotFoundError, "failed to fetch link")
+		}
+		return nil
+	})
+}
+
+func (r sqlLinkRepository) checkEndpoints(
+	tx *sql.Tx,
+	userId string,
+	projectId string,
+	entry record.LinkWithoutAutofieldEntry,
+) *Error {
+	for _, endpoint := range []record.LinkEndpointEntry{entry.From, entry.To} {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, endpoint.ChapterId, readAccess)
+		if rErr != nil && rErr.Code() == NotFoundError {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		query := "SELECT COUNT(*) FROM sections WHERE chapter_id = ? AND id = ?"
+		args := []any{endpoint.ChapterId, endpoint.SectionId}
+		if endpoint.NodeId != "" {
+			query = "SELECT COUNT(*) FROM graph_children WHERE chapter_id = ? AND graph_id = ? AND id = ? " +
+				"AND graph_id IN (SELECT id FROM sections WHERE chapter_id = ?)"
+			args = []any{endpoint.ChapterId, endpoint.SectionId, endpoint.NodeId, endpoint.ChapterId}
+		}
+
+		var count int
+		err := tx.QueryRow(r.database.Rebind(query), args...).Scan(&count)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch link endpoint: %w", err)
+		}
+		if count == 0 {
+			return Errorf(InvalidArgumentError, "link endpoint does not exist")
+		}
+	}
+
+	return nil
+}
+
+func (r sqlLinkRepository) scanEntry(
+	row interface{ Scan(dest ...any) error },
+	userId string,
+) (string, *record.LinkEntry, error) {
+	var id string
+	entry := record.LinkEntry{UserId: userId}
+	err := row.Scan(&id,
+		&entry.From.ChapterId, &entry.From.SectionId, &entry.From.NodeId,
+		&entry.To.ChapterId, &entry.To.SectionId, &entry.To.NodeId,
+		&entry.Relation, &entry.Description, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+	return id, &entry, nil
+}
+
+// sqlLiveSectionCondition is the condition that the section of a link endpoint
+// is in the chapter and neither of them is in trash
+func sqlLiveSectionCondition(chapterColumn string, sectionColumn string) string {
+	return "EXISTS (SELECT 1 FROM sections JOIN chapters ON chapters.id = sections.chapter_id " +
+		"WHERE sections.chapter_id = links." + chapterColumn + " AND sections.id = links." + sectionColumn +
+		" AND chapters.trashed = FALSE)"
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type MemoryStore struct {
+	mu           sync.RWMutex
+	projects     map[string]*memoryProject
+	trash        map[string]document.TrashItemValues
+	shares       map[string]document.ShareValues
+	accessTokens map[string]document.AccessTokenValues
+}
+
+type memoryProject struct {
+	values         document.ProjectValues
+	chapters       map[string]*memoryChapter
+	papers         map[string]document.PaperValues
+	paperRevisions map[string]map[string]document.PaperRevisionValues
+	links          map[string]document.LinkValues
+}
+
+type memoryChapter struct {
+	values         document.ChapterValues
+	graphs         map[string]document.GraphValues
+	graphRevisions map[string]map[string]document.GraphRevisionValues
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		projects:     make(map[string]*memoryProject),
+		trash:        make(map[string]document.TrashItemValues),
+		shares:       make(map[string]document.ShareValues),
+		accessTokens: make(map[string]document.AccessTokenValues),
+	}
+}
+
+func (s *MemoryStore) project(userId string, projectId string, access projectAccess) (*memoryProject, *Error) {
+	project, ok := s.projects[projectId]
+	if !ok || !canAccessProject(project.values, userId, access) || project.values.Deleting || project.values.Trashed {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+	return project, nil
+}
+
+func (s *MemoryStore) chapter(
+	userId string,
+	projectId string,
+	chapterId string,
+	access projectAccess,
+) (*memoryProject, *memoryChapter, int, *Error) {
+	project, rErr := s.project(userId, projectId, access)
+	if rErr != nil {
+		return nil, nil, 0, rErr
+	}
+
+	number := 0
+	for i, id := range project.values.ChapterIds {
+		if id == chapterId {
+			number = i + 1
+			break
+		}
+	}
+
+	chapter, ok := project.chapters[chapterId]
+	if !ok || chapter.values.Trashed {
+		return nil, nil, 0, Errorf(NotFoundError, "failed to fetch chapter")
+	}
+
+	return project, chapter, number, nil
+}
+
+// deleteProject deletes the project together with the shares and access tokens scoped to it
+func (s *MemoryStore) deleteProject(projectId string) {
+	for id, values := range s.shares {
+		if values.ProjectId == projectId {
+			delete(s.shares, id)
+		}
+	}
+	for id, values := range s.accessTokens {
+		if values.ProjectId == projectId {
+			delete(s.accessTokens, id)
+		}
+	}
+	delete(s.projects, projectId)
+}
+
+func (s *MemoryStore) insertTrashItem(values document.TrashItemValues) {
+	values.DeletedAt = currentTime()
+	s.trash[newId()] = values
+}
+
+// deleteLinks removes the links which have an endpoint in the chapter,
+// or only in the section of it when sectionId is not empty, and returns the number of them
+func (p *memoryProject) deleteLinks(chapterId string, sectionId string) int {
+	count := 0
+	for id, values := range p.links {
+		for _, endpoint := range []document.LinkEndpointValues{values.From, values.To} {
+			if endpoint.ChapterId == chapterId && (sectionId == "" || endpoint.SectionId == sectionId) {
+				delete(p.links, id)
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// deleteNodeLinks removes the links which have an endpoint at a node of the section
+// which is no longer in children
+func (p *memoryProject) deleteNodeLinks(chapterId string, sectionId string, children []record.GraphChildEntry) {
+	for id, values := range p.links {
+		for _, endpoint := range []document.LinkEndpointValues{values.From, values.To} {
+			if endpoint.ChapterId == chapterId && endpoint.SectionId == sectionId &&
+				endpoint.NodeId != "" && !containsGraphChildId(children, endpoint.NodeId) {
+				delete(p.links, id)
+				break
+			}
+		}
+	}
+}
+
+// hasSection reports whether the section is in the chapter and neither of them is in trash
+func (p *memoryProject) hasSection(chapterId string, sectionId string) bool {
+	chapter, ok := p.chapters[chapterId]
+	if !ok || chapter.values.Trashed {
+		return false
+	}
+	return slices.ContainsFunc(chapter.values.Sections, func(section document.SectionValues) bool {
+		return section.Id == sectionId
+	})
+}
@@ -1,16 +1,22 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"cloud.google.com/go/firestore"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
 	"github.com/kumachan-mis/knodeledge-api/internal/document"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
 
 const PaperCollection = "papers"
 
+const PaperRevisionCollection = "revisions"
+
 type PaperRepository interface {
 	FetchPaper(
 		userId string,
@@ -28,22 +34,39 @@ type PaperRepository interface {
 		projectId string,
 		chapterId string,
 		entry record.PaperWithoutAutofieldEntry,
+		expectedUpdatedAt *time.Time,
 	) (*record.PaperEntry, *Error)
 	DeletePaper(
 		userId string,
 		projectId string,
 		chapterId string,
 	) *Error
+	FetchPaperRevisions(
+		userId string,
+		projectId string,
+		chapterId string,
+	) (map[string]record.PaperRevisionEntry, *Error)
+	FetchPaperRevision(
+		userId string,
+		projectId string,
+		chapterId string,
+		revisionId string,
+	) (*record.PaperRevisionEntry, *Error)
+	DeletePaperRevisions(
+		userId string,
+		projectId string,
+		chapterId string,
+		revisionIds []string,
+	) *Error
 }
 
 type paperRepository struct {
 	client            firestore.Client
-	chapterRepository ChapterRepository
+	chapterRepository chapterRepository
 }
 
 func NewPaperRepository(client firestore.Client) PaperRepository {
-	chapterRepository := NewChapterRepository(client)
-	return paperRepository{client: client, chapterRepository: chapterRepository}
+	return paperRepository{client: client, chapterRepository: chapterRepository{client: client}}
 }
 
 func (r paperRepository) FetchPaper(
@@ -81,7 +104,7 @@ func (r paperRepository) InsertPaper(
 	chapterId string,
 	entry record.PaperWithoutAutofieldEntry,
 ) (string, *record.PaperEntry, *Error) {
-	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	_, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
 	if rErr != nil {
 		return "", nil, rErr
 	}
@@ -120,8 +143,9 @@ func (r paperRepository) UpdatePaper(
 	projectId string,
 	chapterId string,
 	entry record.PaperWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
 ) (*record.PaperEntry, *Error) {
-	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	_, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
 	if rErr != nil {
 		return nil, rErr
 	}
@@ -131,13 +155,47 @@ func (r paperRepository) UpdatePaper(
 		Collection(PaperCollection).
 		Doc(chapterId)
 
-	_, err := ref.Set(db.FirestoreContext(), map[string]any{
-		"content":   entry.Content,
-		"updatedAt": firestore.ServerTimestamp,
-	}, firestore.MergeAll)
+	var current *record.PaperEntry
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		if expectedUpdatedAt != nil {
+			snapshotToBeUpdated, err := tx.Get(ref)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to fetch paper: %w", err)
+			}
+
+			var valuesToBeUpdated document.PaperValues
+			err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+			}
 
+			if !valuesToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+				current = r.valuesToEntry(valuesToBeUpdated, userId)
+				return Errorf(ConflictError, "paper has been updated since it was fetched")
+			}
+		}
+
+		err := tx.Set(ref, map[string]any{
+			"content":   entry.Content,
+			"updatedAt": firestore.ServerTimestamp,
+		}, firestore.MergeAll)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update paper: %w", err)
+		}
+
+		err = tx.Create(ref.Collection(PaperRevisionCollection).NewDoc(), map[string]any{
+			"content":   entry.Content,
+			"authorId":  userId,
+			"createdAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert paper revision: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, Errorf(WriteFailurePanic, "failed to update paper: %w", err)
+		return current, transactionError(err)
 	}
 
 	snapshot, err := ref.Get(db.FirestoreContext())
@@ -159,19 +217,143 @@ func (r paperRepository) DeletePaper(
 	projectId string,
 	chapterId string,
 ) *Error {
+	_, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(PaperCollection).
+		Doc(chapterId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		revisionRefs, err := tx.DocumentRefs(ref.Collection(PaperRevisionCollection)).GetAll()
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch paper revisions: %w", err)
+		}
+
+		for _, revisionRef := range revisionRefs {
+			err = tx.Delete(revisionRef)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete paper revision: %w", err)
+			}
+		}
+
+		err = tx.Delete(ref)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete paper: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+func (r paperRepository) FetchPaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+) (map[string]record.PaperRevisionEntry, *Error) {
 	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	iter := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(PaperCollection).
+		Doc(chapterId).
+		Collection(PaperRevisionCollection).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.PaperRevisionEntry)
+
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch paper revisions: %w", err)
+		}
+
+		var values document.PaperRevisionValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		entries[snapshot.Ref.ID] = *r.revisionValuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r paperRepository) FetchPaperRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionId string,
+) (*record.PaperRevisionEntry, *Error) {
+	_, rErr := r.chapterRepository.FetchChapter(userId, projectId, chapterId)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	snapshot, err := r.client.Collection(ProjectCollection).
+		Doc(projectId).
+		Collection(PaperCollection).
+		Doc(chapterId).
+		Collection(PaperRevisionCollection).
+		Doc(revisionId).
+		Get(db.FirestoreContext())
+
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch paper revision")
+	}
+
+	var values document.PaperRevisionValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.revisionValuesToEntry(values, userId), nil
+}
+
+func (r paperRepository) DeletePaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionIds []string,
+) *Error {
+	_, rErr := r.chapterRepository.fetchChapter(userId, projectId, chapterId, writeAccess)
 	if rErr != nil {
 		return rErr
 	}
 
-	_, err := r.client.Collection(ProjectCollection).
+	collRef := r.client.Collection(ProjectCollection).
 		Doc(projectId).
 		Collection(PaperCollection).
 		Doc(chapterId).
-		Delete(db.FirestoreContext())
+		Collection(PaperRevisionCollection)
 
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		for _, revisionId := range revisionIds {
+			err := tx.Delete(collRef.Doc(revisionId))
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete paper revision: %w", err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return Errorf(WriteFailurePanic, "failed to delete paper: %w", err)
+		return transactionError(err)
 	}
 
 	return nil
@@ -188,3 +370,15 @@ func (r paperRepository) valuesToEntry(
 		UpdatedAt: values.UpdatedAt,
 	}
 }
+
+func (r paperRepository) revisionValuesToEntry(
+	values document.PaperRevisionValues,
+	userId string,
+) *record.PaperRevisionEntry {
+	return &record.PaperRevisionEntry{
+		Content:   values.Content,
+		AuthorId:  values.AuthorId,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+	}
+}
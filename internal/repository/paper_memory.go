@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryPaperRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryPaperRepository(store *MemoryStore) PaperRepository {
+	return memoryPaperRepository{store: store}
+}
+
+func (r memoryPaperRepository) FetchPaper(
+	userId string,
+	projectId string,
+	chapterId string,
+) (*record.PaperEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	values, ok := project.papers[chapterId]
+	if !ok {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch paper: %w", errors.New("paper does not exist"))
+	}
+
+	return r.valuesToEntry(values, userId), nil
+}
+
+func (r memoryPaperRepository) InsertPaper(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.PaperWithoutAutofieldEntry,
+) (string, *record.PaperEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	now := currentTime()
+	values := document.PaperValues{
+		Content:   entry.Content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	project.papers[chapterId] = values
+
+	return chapterId, r.valuesToEntry(values, userId), nil
+}
+
+func (r memoryPaperRepository) UpdatePaper(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.PaperWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.PaperEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	values := project.papers[chapterId]
+	if expectedUpdatedAt != nil && !values.UpdatedAt.Equal(*expectedUpdatedAt) {
+		return r.valuesToEntry(values, userId), Errorf(ConflictError, "paper has been updated since it was fetched")
+	}
+
+	values.Content = entry.Content
+	values.UpdatedAt = currentTime()
+	project.papers[chapterId] = values
+
+	if project.paperRevisions[chapterId] == nil {
+		project.paperRevisions[chapterId] = make(map[string]document.PaperRevisionValues)
+	}
+	project.paperRevisions[chapterId][newId()] = document.PaperRevisionValues{
+		Content:   entry.Content,
+		AuthorId:  userId,
+		CreatedAt: values.UpdatedAt,
+	}
+
+	return r.valuesToEntry(values, userId), nil
+}
+
+func (r memoryPaperRepository) DeletePaper(
+	userId string,
+	projectId string,
+	chapterId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	delete(project.papers, chapterId)
+	delete(project.paperRevisions, chapterId)
+	return nil
+}
+
+func (r memoryPaperRepository) FetchPaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+) (map[string]record.PaperRevisionEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entries := make(map[string]record.PaperRevisionEntry)
+	for id, values := range project.paperRevisions[chapterId] {
+		entries[id] = *r.revisionValuesToEntry(values, userId)
+	}
+
+	return entries, nil
+}
+
+func (r memoryPaperRepository) FetchPaperRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionId string,
+) (*record.PaperRevisionEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	values, ok := project.paperRevisions[chapterId][revisionId]
+	if !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch paper revision")
+	}
+
+	return r.revisionValuesToEntry(values, userId), nil
+}
+
+func (r memoryPaperRepository) DeletePaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionIds []string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, _, _, rErr := r.store.chapter(userId, projectId, chapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	for _, revisionId := range revisionIds {
+		delete(project.paperRevisions[chapterId], revisionId)
+	}
+	return nil
+}
+
+func (r memoryPaperRepository) valuesToEntry(
+	values document.PaperValues,
+	userId string,
+) *record.PaperEntry {
+	return &record.PaperEntry{
+		Content:   values.Content,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+		UpdatedAt: values.UpdatedAt,
+	}
+}
+
+func (r memoryPaperRepository) revisionValuesToEntry(
+	values document.PaperRevisionValues,
+	userId string,
+) *record.PaperRevisionEntry {
+	return &record.PaperRevisionEntry{
+		Content:   values.Content,
+		AuthorId:  values.AuthorId,
+		UserId:    userId,
+		CreatedAt: values.CreatedAt,
+	}
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type sqlPaperRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLPaperRepository(database db.SQLDatabase) PaperRepository {
+	return sqlPaperRepository{database: database}
+}
+
+func (r sqlPaperRepository) FetchPaper(
+	userId string,
+	projectId string,
+	chapterId string,
+) (*record.PaperEntry, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entry, err := r.fetchPaper(r.database.DB, userId, chapterId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch paper: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r sqlPaperRepository) InsertPaper(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.PaperWithoutAutofieldEntry,
+) (string, *record.PaperEntry, *Error) {
+	var inserted *record.PaperEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		now := currentTime()
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO papers (chapter_id, content, created_at, updated_at) VALUES (?, ?, ?, ?) "+
+				"ON CONFLICT (chapter_id) DO UPDATE SET "+
+				"content = excluded.content, created_at = excluded.created_at, updated_at = excluded.updated_at"),
+			chapterId, entry.Content, now, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert paper: %w", err)
+		}
+
+		inserted, err = r.fetchPaper(tx, userId, chapterId)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch inserted paper: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return chapterId, inserted, nil
+}
+
+func (r sqlPaperRepository) UpdatePaper(
+	userId string,
+	projectId string,
+	chapterId string,
+	entry record.PaperWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.PaperEntry, *Error) {
+	var current *record.PaperEntry
+	var updated *record.PaperEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		if expectedUpdatedAt != nil {
+			entryToBeUpdated, err := r.fetchPaper(tx, userId, chapterId)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to fetch paper: %w", err)
+			}
+
+			if !entryToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+				current = entryToBeUpdated
+				return Errorf(ConflictError, "paper has been updated since it was fetched")
+			}
+		}
+
+		now := currentTime()
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO papers (chapter_id, content, created_at, updated_at) VALUES (?, ?, ?, ?) "+
+				"ON CONFLICT (chapter_id) DO UPDATE SET content = excluded.content, updated_at = excluded.updated_at"),
+			chapterId, entry.Content, now, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update paper: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind(
+			"INSERT INTO paper_revisions (id, chapter_id, content, author_id, created_at) VALUES (?, ?, ?, ?, ?)"),
+			newId(), chapterId, entry.Content, userId, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert paper revision: %w", err)
+		}
+
+		updated, err = r.fetchPaper(tx, userId, chapterId)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch updated paper: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return current, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlPaperRepository) DeletePaper(
+	userId string,
+	projectId string,
+	chapterId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind("DELETE FROM paper_revisions WHERE chapter_id = ?"), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete paper revisions: %w", err)
+		}
+
+		_, err = tx.Exec(r.database.Rebind("DELETE FROM papers WHERE chapter_id = ?"), chapterId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete paper: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r sqlPaperRepository) FetchPaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+) (map[string]record.PaperRevisionEntry, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT id, content, author_id, created_at FROM paper_revisions WHERE chapter_id = ?"), chapterId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch paper revisions: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.PaperRevisionEntry)
+	for rows.Next() {
+		id, entry, err := r.scanRevision(rows, userId)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch paper revisions: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlPaperRepository) FetchPaperRevision(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionId string,
+) (*record.PaperRevisionEntry, *Error) {
+	rErr := sqlCheckChapter(r.database, r.database.DB, userId, projectId, chapterId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	_, entry, err := r.scanRevision(r.database.DB.QueryRow(r.database.Rebind(
+		"SELECT id, content, author_id, created_at FROM paper_revisions WHERE chapter_id = ? AND id = ?"),
+		chapterId, revisionId), userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch paper revision")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch paper revision: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r sqlPaperRepository) DeletePaperRevisions(
+	userId string,
+	projectId string,
+	chapterId string,
+	revisionIds []string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckChapter(r.database, tx, userId, projectId, chapterId, writeAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		for _, revisionId := range revisionIds {
+			_, err := tx.Exec(r.database.Rebind("DELETE FROM paper_revisions WHERE chapter_id = ? AND id = ?"),
+				chapterId, revisionId)
+			if err != nil {
+				return Errorf(WriteFailurePanic, "failed to delete paper revision: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r sqlPaperRepository) fetchPaper(
+	q sqlQuerier,
+	userId string,
+	chapterId string,
+) (*record.PaperEntry, error) {
+	entry := record.PaperEntry{UserId: userId}
+	err := q.QueryRow(r.database.Rebind(
+		"SELECT content, created_at, updated_at FROM papers WHERE chapter_id = ?"), chapterId).
+		Scan(&entry.Content, &entry.CreatedAt, &entry.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("paper does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+	return &entry, nil
+}
+
+func (r sqlPaperRepository) scanRevision(
+	row interface{ Scan(dest ...any) error },
+	userId string,
+) (string, *record.PaperRevisionEntry, error) {
+	var id string
+	entry := record.PaperRevisionEntry{UserId: userId}
+	err := row.Scan(&id, &entry.Content, &entry.AuthorId, &entry.CreatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return id, &entry, nil
+}
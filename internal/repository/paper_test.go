@@ -217,7 +217,7 @@ func TestUpdatePaterValidEntry(t *testing.T) {
 
 	entry, err := r.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
 		Content: content,
-	})
+	}, nil)
 	now := time.Now()
 
 	assert.Nil(t, err)
@@ -266,7 +266,7 @@ func TestUpdatePaperNotFound(t *testing.T) {
 
 			entry, rErr := r.UpdatePaper(tc.userId, tc.projectId, tc.chapterId, record.PaperWithoutAutofieldEntry{
 				Content: "content",
-			})
+			}, nil)
 
 			assert.NotNil(t, rErr)
 
@@ -349,3 +349,127 @@ func TestDeletePaperNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchPaperRevisionsValidEntry(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewPaperRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId := "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_REPOSITORY"
+	chapterId := "CHAPTER_ONE"
+	content := "## Revision\nThis content is recorded as a revision.\n"
+
+	paper, rErr := r.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: content,
+	}, nil)
+	assert.Nil(t, rErr)
+
+	entries, rErr := r.FetchPaperRevisions(userId, projectId, chapterId)
+	assert.Nil(t, rErr)
+
+	var revisionId string
+	for id, entry := range entries {
+		if entry.CreatedAt.Equal(paper.UpdatedAt) {
+			revisionId = id
+		}
+	}
+	assert.NotEmpty(t, revisionId)
+
+	entry := entries[revisionId]
+	assert.Equal(t, content, entry.Content)
+	assert.Equal(t, userId, entry.AuthorId)
+	assert.Equal(t, userId, entry.UserId)
+
+	fetched, rErr := r.FetchPaperRevision(userId, projectId, chapterId, revisionId)
+	assert.Nil(t, rErr)
+	assert.Equal(t, entry, *fetched)
+}
+
+func TestFetchPaperRevisionsNotFound(t *testing.T) {
+	tt := []struct {
+		name          string
+		userId        string
+		projectId     string
+		chapterId     string
+		expectedError string
+	}{
+		{
+			name:          "should return error when project not found",
+			userId:        testutil.ReadOnlyUserId(),
+			projectId:     "UNKNOWN_PROJECT",
+			chapterId:     "CHAPTER_ONE",
+			expectedError: "failed to fetch project",
+		},
+		{
+			name:          "should return not found when user is not author of the project",
+			userId:        testutil.ModifyOnlyUserId(),
+			projectId:     "PROJECT_WITHOUT_DESCRIPTION",
+			chapterId:     "CHAPTER_ONE",
+			expectedError: "failed to fetch project",
+		},
+		{
+			name:          "should return error when chapter not found",
+			userId:        testutil.ReadOnlyUserId(),
+			projectId:     "PROJECT_WITHOUT_DESCRIPTION",
+			chapterId:     "UNKNOWN_CHAPTER",
+			expectedError: "failed to fetch chapter",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			client := db.FirestoreClient()
+			r := repository.NewPaperRepository(*client)
+
+			entries, rErr := r.FetchPaperRevisions(tc.userId, tc.projectId, tc.chapterId)
+
+			assert.NotNil(t, rErr)
+
+			assert.Nil(t, entries)
+			assert.Equal(t, repository.NotFoundError, rErr.Code())
+			assert.Equal(t, fmt.Sprintf("not found: %s", tc.expectedError), rErr.Error())
+		})
+	}
+}
+
+func TestFetchPaperRevisionNotFound(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewPaperRepository(*client)
+
+	entry, rErr := r.FetchPaperRevision(testutil.ReadOnlyUserId(), "PROJECT_WITHOUT_DESCRIPTION", "CHAPTER_ONE", "UNKNOWN_REVISION")
+
+	assert.NotNil(t, rErr)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch paper revision", rErr.Error())
+}
+
+func TestDeletePaperRevisionsValidEntry(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewPaperRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+	projectId := "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_REPOSITORY"
+	chapterId := "CHAPTER_ONE"
+
+	_, rErr := r.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Revision to be deleted",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	entries, rErr := r.FetchPaperRevisions(userId, projectId, chapterId)
+	assert.Nil(t, rErr)
+
+	revisionIds := []string{}
+	for id := range entries {
+		revisionIds = append(revisionIds, id)
+	}
+
+	rErr = r.DeletePaperRevisions(userId, projectId, chapterId, revisionIds)
+	assert.Nil(t, rErr)
+
+	entries, rErr = r.FetchPaperRevisions(userId, projectId, chapterId)
+	assert.Nil(t, rErr)
+	assert.Empty(t, entries)
+}
@@ -1,16 +1,23 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"cloud.google.com/go/firestore"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
 	"github.com/kumachan-mis/knodeledge-api/internal/document"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
 
 const ProjectCollection = "projects"
 
+const deleteBatchSize = 500
+
 type ProjectRepository interface {
 	FetchProjects(
 		userId string,
@@ -23,15 +30,43 @@ type ProjectRepository interface {
 		userId string,
 		entry record.ProjectWithoutAutofieldEntry,
 	) (string, *record.ProjectEntry, *Error)
+	InsertImportingProject(
+		userId string,
+		entry record.ProjectWithoutAutofieldEntry,
+	) (string, *record.ProjectEntry, *Error)
+	FinishImportingProject(
+		userId string,
+		projectId string,
+	) (*record.ProjectEntry, *Error)
 	UpdateProject(
 		userId string,
 		projectId string,
 		entry record.ProjectWithoutAutofieldEntry,
+		expectedUpdatedAt *time.Time,
 	) (*record.ProjectEntry, *Error)
-	DeleteProject(
+	TrashProject(
 		userId string,
 		projectId string,
 	) *Error
+	UpdateProjectMember(
+		userId string,
+		projectId string,
+		memberId string,
+		role string,
+	) (*record.ProjectEntry, *Error)
+	DeleteProjectMember(
+		userId string,
+		projectId string,
+		memberId string,
+	) (*record.ProjectEntry, *Error)
+	FetchDeletingProjects() (map[string]record.ProjectEntry, *Error)
+	FetchImportingProjects(
+		createdBefore time.Time,
+	) (map[string]record.ProjectEntry, *Error)
+	DeleteProject(
+		userId string,
+		projectId string,
+	) (int, *Error)
 }
 
 type projectRepository struct {
@@ -45,25 +80,36 @@ func NewProjectRepository(client firestore.Client) ProjectRepository {
 func (r projectRepository) FetchProjects(
 	userId string,
 ) (map[string]record.ProjectEntry, *Error) {
-	iter := r.client.Collection(ProjectCollection).
-		Where("userId", "==", userId).
-		Documents(db.FirestoreContext())
+	queries := []firestore.Query{
+		r.client.Collection(ProjectCollection).
+			Where("userId", "==", userId),
+		r.client.Collection(ProjectCollection).
+			WherePath(firestore.FieldPath{"members", userId}, "in", []any{ProjectRoleEditor, ProjectRoleViewer}),
+	}
 
 	entries := make(map[string]record.ProjectEntry)
 
-	for {
-		snapshot, err := iter.Next()
-		if err != nil {
-			break
-		}
+	for _, query := range queries {
+		iter := query.Documents(db.FirestoreContext())
 
-		var values document.ProjectValues
-		err = snapshot.DataTo(&values)
-		if err != nil {
-			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
-		}
+		for {
+			snapshot, err := iter.Next()
+			if err != nil {
+				break
+			}
 
-		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+			var values document.ProjectValues
+			err = snapshot.DataTo(&values)
+			if err != nil {
+				return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+			}
+
+			if values.Deleting || values.Importing || values.Trashed {
+				continue
+			}
+
+			entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+		}
 	}
 
 	return entries, nil
@@ -86,7 +132,7 @@ func (r projectRepository) FetchProject(
 		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
 	}
 
-	if values.UserId != userId {
+	if !canAccessProject(values, userId, readAccess) || values.Deleting || values.Importing || values.Trashed {
 		return nil, Errorf(NotFoundError, "failed to fetch project")
 	}
 
@@ -97,14 +143,68 @@ func (r projectRepository) InsertProject(
 	userId string,
 	entry record.ProjectWithoutAutofieldEntry,
 ) (string, *record.ProjectEntry, *Error) {
-	ref, _, err := r.client.Collection(ProjectCollection).
-		Add(db.FirestoreContext(), map[string]any{
-			"name":        entry.Name,
-			"description": entry.Description,
-			"userId":      userId,
-			"createdAt":   firestore.ServerTimestamp,
-			"updatedAt":   firestore.ServerTimestamp,
+	return r.insertProject(userId, entry, map[string]any{})
+}
+
+func (r projectRepository) InsertImportingProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+) (string, *record.ProjectEntry, *Error) {
+	return r.insertProject(userId, entry, map[string]any{"importing": true})
+}
+
+func (r projectRepository) FinishImportingProject(
+	userId string,
+	projectId string,
+) (*record.ProjectEntry, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if values.UserId != userId || values.Deleting || !values.Importing {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "importing", Value: firestore.Delete},
 		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to finish importing project: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	return r.fetchUpdatedEntry(ref)
+}
+
+func (r projectRepository) insertProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+	fields map[string]any,
+) (string, *record.ProjectEntry, *Error) {
+	fields["name"] = entry.Name
+	fields["description"] = entry.Description
+	fields["userId"] = userId
+	fields["createdAt"] = firestore.ServerTimestamp
+	fields["updatedAt"] = firestore.ServerTimestamp
+
+	ref, _, err := r.client.Collection(ProjectCollection).
+		Add(db.FirestoreContext(), fields)
 	if err != nil {
 		return "", nil, Errorf(WriteFailurePanic, "failed to insert project: %w", err)
 	}
@@ -127,32 +227,46 @@ func (r projectRepository) UpdateProject(
 	userId string,
 	projectId string,
 	entry record.ProjectWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
 ) (*record.ProjectEntry, *Error) {
 	ref := r.client.Collection(ProjectCollection).
 		Doc(projectId)
 
-	snapshotToBeUpdated, err := ref.Get(db.FirestoreContext())
-	if err != nil {
-		return nil, Errorf(NotFoundError, "failed to update project")
-	}
+	var current *record.ProjectEntry
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshotToBeUpdated, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to update project")
+		}
 
-	var valuesToBeUpdated document.ProjectValues
-	err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
-	if err != nil {
-		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
-	}
+		var valuesToBeUpdated document.ProjectValues
+		err = snapshotToBeUpdated.DataTo(&valuesToBeUpdated)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
 
-	if valuesToBeUpdated.UserId != userId {
-		return nil, Errorf(NotFoundError, "failed to update project")
-	}
+		if !canAccessProject(valuesToBeUpdated, userId, writeAccess) || valuesToBeUpdated.Deleting || valuesToBeUpdated.Importing || valuesToBeUpdated.Trashed {
+			return Errorf(NotFoundError, "failed to update project")
+		}
+
+		if expectedUpdatedAt != nil && !valuesToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+			current = r.valuesToEntry(valuesToBeUpdated)
+			return Errorf(ConflictError, "project has been updated since it was fetched")
+		}
 
-	_, err = ref.Update(db.FirestoreContext(), []firestore.Update{
-		{Path: "name", Value: entry.Name},
-		{Path: "description", Value: entry.Description},
-		{Path: "updatedAt", Value: firestore.ServerTimestamp},
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "name", Value: entry.Name},
+			{Path: "description", Value: entry.Description},
+			{Path: "updatedAt", Value: firestore.ServerTimestamp},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update project: %w", err)
+		}
+
+		return nil
 	})
 	if err != nil {
-		return nil, Errorf(WriteFailurePanic, "failed to update project: %w", err)
+		return current, transactionError(err)
 	}
 
 	snapshot, err := ref.Get(db.FirestoreContext())
@@ -169,31 +283,383 @@ func (r projectRepository) UpdateProject(
 	return r.valuesToEntry(values), nil
 }
 
-func (r projectRepository) DeleteProject(
+func (r projectRepository) TrashProject(
 	userId string,
 	projectId string,
 ) *Error {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+	trashRef := r.client.Collection(TrashCollection).
+		NewDoc()
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !canAccessProject(values, userId, ownerAccess) || values.Deleting || values.Importing || values.Trashed {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "trashed", Value: true},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to move project to trash: %w", err)
+		}
+
+		err = tx.Create(trashRef, map[string]any{
+			"type":      TrashItemTypeProject,
+			"name":      values.Name,
+			"projectId": projectId,
+			"position":  0,
+			"userId":    userId,
+			"deletedAt": firestore.ServerTimestamp,
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert trash item: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+func (r projectRepository) UpdateProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+	role string,
+) (*record.ProjectEntry, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !canAccessProject(values, userId, ownerAccess) || values.Deleting || values.Importing || values.Trashed {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		if memberId == values.UserId {
+			return Errorf(InvalidArgumentError, "project owner cannot be a member")
+		}
+		if !isMemberRole(role) {
+			return Errorf(InvalidArgumentError, "project member role must be editor or viewer")
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{FieldPath: firestore.FieldPath{"members", memberId}, Value: role},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update project member: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	return r.fetchUpdatedEntry(ref)
+}
+
+func (r projectRepository) DeleteProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+) (*record.ProjectEntry, *Error) {
 	ref := r.client.Collection(ProjectCollection).
 		Doc(projectId)
 
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !canRemoveProjectMember(values.UserId, values.Members, userId, memberId) || values.Deleting || values.Importing || values.Trashed {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		if _, ok := values.Members[memberId]; !ok {
+			return Errorf(NotFoundError, "failed to fetch project member")
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{FieldPath: firestore.FieldPath{"members", memberId}, Value: firestore.Delete},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete project member: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	return r.fetchUpdatedEntry(ref)
+}
+
+func (r projectRepository) FetchDeletingProjects() (map[string]record.ProjectEntry, *Error) {
+	iter := r.client.Collection(ProjectCollection).
+		Where("deleting", "==", true).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.ProjectEntry)
+
+	for {
+		snapshot, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r projectRepository) FetchImportingProjects(
+	createdBefore time.Time,
+) (map[string]record.ProjectEntry, *Error) {
+	iter := r.client.Collection(ProjectCollection).
+		Where("importing", "==", true).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.ProjectEntry)
+
+	for {
+		snapshot, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if !values.CreatedAt.Before(createdBefore) {
+			continue
+		}
+
+		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r projectRepository) DeleteProject(
+	userId string,
+	projectId string,
+) (int, *Error) {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(projectId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		snapshot, err := tx.Get(ref)
+		if err != nil {
+			return Errorf(NotFoundError, "failed to delete project")
+		}
+
+		var values document.ProjectValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if values.UserId != userId {
+			return Errorf(NotFoundError, "failed to delete project")
+		}
+
+		if values.Deleting {
+			return nil
+		}
+
+		err = tx.Update(ref, []firestore.Update{
+			{Path: "deleting", Value: true},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to mark project as deleting: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, transactionError(err)
+	}
+
+	count, rErr := r.deleteSubcollections(ref)
+	if rErr != nil {
+		return count, rErr
+	}
+
+	for _, collection := range []string{ShareCollection, AccessTokenCollection} {
+		rErr = r.deleteProjectReferences(collection, projectId)
+		if rErr != nil {
+			return count, rErr
+		}
+	}
+
+	_, err = ref.Delete(db.FirestoreContext())
+	if err != nil {
+		return count, Errorf(WriteFailurePanic, "failed to delete project: %w", err)
+	}
+
+	return count + 1, nil
+}
+
+func (r projectRepository) fetchUpdatedEntry(ref *firestore.DocumentRef) (*record.ProjectEntry, *Error) {
 	snapshot, err := ref.Get(db.FirestoreContext())
 	if err != nil {
-		return Errorf(NotFoundError, "failed to delete project")
+		return nil, Errorf(ReadFailurePanic, "failed to fetch updated project: %w", err)
 	}
 
 	var values document.ProjectValues
 	err = snapshot.DataTo(&values)
 	if err != nil {
-		return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.valuesToEntry(values), nil
+}
+
+func (r projectRepository) deleteSubcollections(ref *firestore.DocumentRef) (int, *Error) {
+	iter := ref.Collections(db.FirestoreContext())
+
+	count := 0
+	for {
+		collRef, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return count, Errorf(ReadFailurePanic, "failed to fetch subcollections: %w", err)
+		}
+
+		deleted, rErr := r.deleteCollection(collRef)
+		count += deleted
+		if rErr != nil {
+			return count, rErr
+		}
 	}
 
-	if values.UserId != userId {
-		return Errorf(NotFoundError, "failed to delete project")
+	return count, nil
+}
+
+func (r projectRepository) deleteCollection(collRef *firestore.CollectionRef) (int, *Error) {
+	count := 0
+	for {
+		iter := collRef.DocumentRefs(db.FirestoreContext())
+
+		docRefs := make([]*firestore.DocumentRef, 0, deleteBatchSize)
+		for len(docRefs) < deleteBatchSize {
+			docRef, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				return count, Errorf(ReadFailurePanic, "failed to fetch documents: %w", err)
+			}
+			docRefs = append(docRefs, docRef)
+		}
+
+		if len(docRefs) == 0 {
+			return count, nil
+		}
+
+		for _, docRef := range docRefs {
+			deleted, rErr := r.deleteSubcollections(docRef)
+			count += deleted
+			if rErr != nil {
+				return count, rErr
+			}
+		}
+
+		bw := r.client.BulkWriter(db.FirestoreContext())
+
+		jobs := make([]*firestore.BulkWriterJob, len(docRefs))
+		for i, docRef := range docRefs {
+			job, err := bw.Delete(docRef)
+			if err != nil {
+				bw.End()
+				return count, Errorf(WriteFailurePanic, "failed to delete document: %w", err)
+			}
+			jobs[i] = job
+		}
+
+		bw.End()
+
+		for _, job := range jobs {
+			if _, err := job.Results(); err != nil {
+				return count, Errorf(WriteFailurePanic, "failed to delete document: %w", err)
+			}
+			count++
+		}
 	}
+}
 
-	_, err = ref.Delete(db.FirestoreContext())
-	if err != nil {
-		return Errorf(WriteFailurePanic, "failed to delete project: %w", err)
+// deleteProjectReferences deletes the documents of the top-level collection which refer to the project,
+// such as shares and access tokens scoped to it
+func (r projectRepository) deleteProjectReferences(collection string, projectId string) *Error {
+	iter := r.client.Collection(collection).
+		Where("projectId", "==", projectId).
+		Documents(db.FirestoreContext())
+
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch %s: %w", collection, err)
+		}
+
+		_, err = snapshot.Ref.Delete(db.FirestoreContext())
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete %s: %w", collection, err)
+		}
 	}
 
 	return nil
@@ -206,6 +672,7 @@ func (r projectRepository) valuesToEntry(
 		Name:        values.Name,
 		Description: values.Description,
 		UserId:      values.UserId,
+		Members:     membersToEntry(values.Members),
 		CreatedAt:   values.CreatedAt,
 		UpdatedAt:   values.UpdatedAt,
 	}
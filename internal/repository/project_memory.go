@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryProjectRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryProjectRepository(store *MemoryStore) ProjectRepository {
+	return memoryProjectRepository{store: store}
+}
+
+func (r memoryProjectRepository) FetchProjects(
+	userId string,
+) (map[string]record.ProjectEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.ProjectEntry)
+	for id, project := range r.store.projects {
+		if !canAccessProject(project.values, userId, readAccess) || project.values.Deleting || project.values.Importing || project.values.Trashed {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(project.values)
+	}
+
+	return entries, nil
+}
+
+func (r memoryProjectRepository) FetchProject(
+	userId string,
+	projectId string,
+) (*record.ProjectEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	project, rErr := r.project(userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) InsertProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+) (string, *record.ProjectEntry, *Error) {
+	return r.insertProject(userId, entry, false)
+}
+
+func (r memoryProjectRepository) InsertImportingProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+) (string, *record.ProjectEntry, *Error) {
+	return r.insertProject(userId, entry, true)
+}
+
+func (r memoryProjectRepository) FinishImportingProject(
+	userId string,
+	projectId string,
+) (*record.ProjectEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, ok := r.store.projects[projectId]
+	if !ok || project.values.UserId != userId || project.values.Deleting || !project.values.Importing {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+	project.values.Importing = false
+
+	return r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) insertProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+	importing bool,
+) (string, *record.ProjectEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := currentTime()
+	id := newId()
+	project := &memoryProject{
+		values: document.ProjectValues{
+			Name:        entry.Name,
+			Description: entry.Description,
+			UserId:      userId,
+			Importing:   importing,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		chapters:       make(map[string]*memoryChapter),
+		papers:         make(map[string]document.PaperValues),
+		paperRevisions: make(map[string]map[string]document.PaperRevisionValues),
+		links:          make(map[string]document.LinkValues),
+	}
+	r.store.projects[id] = project
+
+	return id, r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) UpdateProject(
+	userId string,
+	projectId string,
+	entry record.ProjectWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.ProjectEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.project(userId, projectId, writeAccess)
+	if rErr != nil {
+		return nil, Errorf(NotFoundError, "failed to update project")
+	}
+
+	if expectedUpdatedAt != nil && !project.values.UpdatedAt.Equal(*expectedUpdatedAt) {
+		return r.valuesToEntry(project.values), Errorf(ConflictError, "project has been updated since it was fetched")
+	}
+
+	project.values.Name = entry.Name
+	project.values.Description = entry.Description
+	project.values.UpdatedAt = currentTime()
+
+	return r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) TrashProject(
+	userId string,
+	projectId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.project(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	project.values.Trashed = true
+	r.store.insertTrashItem(document.TrashItemValues{
+		Type:      TrashItemTypeProject,
+		Name:      project.values.Name,
+		ProjectId: projectId,
+		UserId:    userId,
+	})
+
+	return nil
+}
+
+func (r memoryProjectRepository) UpdateProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+	role string,
+) (*record.ProjectEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.project(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	if memberId == project.values.UserId {
+		return nil, Errorf(InvalidArgumentError, "project owner cannot be a member")
+	}
+	if !isMemberRole(role) {
+		return nil, Errorf(InvalidArgumentError, "project member role must be editor or viewer")
+	}
+
+	if project.values.Members == nil {
+		project.values.Members = make(map[string]string)
+	}
+	project.values.Members[memberId] = role
+
+	return r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) DeleteProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+) (*record.ProjectEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, rErr := r.project(userId, projectId, readAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	if !canRemoveProjectMember(project.values.UserId, project.values.Members, userId, memberId) {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+	if _, ok := project.values.Members[memberId]; !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch project member")
+	}
+	delete(project.values.Members, memberId)
+
+	return r.valuesToEntry(project.values), nil
+}
+
+func (r memoryProjectRepository) FetchDeletingProjects() (map[string]record.ProjectEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.ProjectEntry)
+	for id, project := range r.store.projects {
+		if !project.values.Deleting {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(project.values)
+	}
+
+	return entries, nil
+}
+
+func (r memoryProjectRepository) FetchImportingProjects(
+	createdBefore time.Time,
+) (map[string]record.ProjectEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.ProjectEntry)
+	for id, project := range r.store.projects {
+		if !project.values.Importing || !project.values.CreatedAt.Before(createdBefore) {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(project.values)
+	}
+
+	return entries, nil
+}
+
+func (r memoryProjectRepository) DeleteProject(
+	userId string,
+	projectId string,
+) (int, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	project, ok := r.store.projects[projectId]
+	if !ok || project.values.UserId != userId {
+		return 0, Errorf(NotFoundError, "failed to delete project")
+	}
+
+	count := 1 + len(project.papers) + len(project.links)
+	for _, revisions := range project.paperRevisions {
+		count += len(revisions)
+	}
+	for _, chapter := range project.chapters {
+		count += 1 + len(chapter.graphs)
+		for _, revisions := range chapter.graphRevisions {
+			count += len(revisions)
+		}
+	}
+
+	r.store.deleteProject(projectId)
+	return count, nil
+}
+
+// project fetches the project like the store does, but also hides projects still being imported,
+// which only the importer writes into until the import finishes
+func (r memoryProjectRepository) project(
+	userId string,
+	projectId string,
+	access projectAccess,
+) (*memoryProject, *Error) {
+	project, rErr := r.store.project(userId, projectId, access)
+	if rErr != nil {
+		return nil, rErr
+	}
+	if project.values.Importing {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+	return project, nil
+}
+
+func (r memoryProjectRepository) valuesToEntry(
+	values document.ProjectValues,
+) *record.ProjectEntry {
+	return &record.ProjectEntry{
+		Name:        values.Name,
+		Description: values.Description,
+		UserId:      values.UserId,
+		Members:     membersToEntry(values.Members),
+		CreatedAt:   values.CreatedAt,
+		UpdatedAt:   values.UpdatedAt,
+	}
+}
@@ -0,0 +1,396 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const sqlProjectColumns = "id, name, description, user_id, created_at, updated_at"
+
+type sqlProjectRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLProjectRepository(database db.SQLDatabase) ProjectRepository {
+	return sqlProjectRepository{database: database}
+}
+
+func (r sqlProjectRepository) FetchProjects(
+	userId string,
+) (map[string]record.ProjectEntry, *Error) {
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlProjectColumns+" FROM projects "+
+			"WHERE (user_id = ? OR id IN (SELECT project_id FROM project_members WHERE user_id = ?)) "+
+			"AND deleting = FALSE AND importing = FALSE AND trashed = FALSE"), userId, userId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+	}
+
+	entries, rErr := r.scanEntries(rows)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	for id, entry := range entries {
+		entry.Members, rErr = r.fetchMembers(r.database.DB, id)
+		if rErr != nil {
+			return nil, rErr
+		}
+		entries[id] = entry
+	}
+	return entries, nil
+}
+
+func (r sqlProjectRepository) FetchProject(
+	userId string,
+	projectId string,
+) (*record.ProjectEntry, *Error) {
+	return r.fetchEntry(r.database.DB, userId, projectId, readAccess)
+}
+
+func (r sqlProjectRepository) InsertProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+) (string, *record.ProjectEntry, *Error) {
+	return r.insertProject(userId, entry, false)
+}
+
+func (r sqlProjectRepository) InsertImportingProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+) (string, *record.ProjectEntry, *Error) {
+	return r.insertProject(userId, entry, true)
+}
+
+func (r sqlProjectRepository) FinishImportingProject(
+	userId string,
+	projectId string,
+) (*record.ProjectEntry, *Error) {
+	result, err := r.database.DB.Exec(r.database.Rebind(
+		"UPDATE projects SET importing = FALSE "+
+			"WHERE id = ? AND user_id = ? AND deleting = FALSE AND importing = TRUE"), projectId, userId)
+	if err != nil {
+		return nil, Errorf(WriteFailurePanic, "failed to finish importing project: %w", err)
+	}
+	if count, err := result.RowsAffected(); err != nil || count == 0 {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	return r.fetchEntry(r.database.DB, userId, projectId, readAccess)
+}
+
+func (r sqlProjectRepository) insertProject(
+	userId string,
+	entry record.ProjectWithoutAutofieldEntry,
+	importing bool,
+) (string, *record.ProjectEntry, *Error) {
+	id := newId()
+	now := currentTime()
+
+	_, err := r.database.DB.Exec(r.database.Rebind(
+		"INSERT INTO projects (id, name, description, user_id, deleting, importing, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, FALSE, ?, ?, ?)"),
+		id, entry.Name, entry.Description, userId, importing, now, now)
+	if err != nil {
+		return "", nil, Errorf(WriteFailurePanic, "failed to insert project: %w", err)
+	}
+
+	return id, &record.ProjectEntry{
+		Name:        entry.Name,
+		Description: entry.Description,
+		UserId:      userId,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (r sqlProjectRepository) UpdateProject(
+	userId string,
+	projectId string,
+	entry record.ProjectWithoutAutofieldEntry,
+	expectedUpdatedAt *time.Time,
+) (*record.ProjectEntry, *Error) {
+	var current *record.ProjectEntry
+	var updated *record.ProjectEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entryToBeUpdated, rErr := r.fetchEntry(tx, userId, projectId, writeAccess)
+		if rErr != nil && rErr.Code() == NotFoundError {
+			return Errorf(NotFoundError, "failed to update project")
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		if expectedUpdatedAt != nil && !entryToBeUpdated.UpdatedAt.Equal(*expectedUpdatedAt) {
+			current = entryToBeUpdated
+			return Errorf(ConflictError, "project has been updated since it was fetched")
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"UPDATE projects SET name = ?, description = ?, updated_at = ? WHERE id = ?"),
+			entry.Name, entry.Description, currentTime(), projectId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update project: %w", err)
+		}
+
+		_, updated, err = r.scanEntry(tx.QueryRow(r.database.Rebind(
+			"SELECT "+sqlProjectColumns+" FROM projects WHERE id = ?"), projectId))
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch updated project: %w", err)
+		}
+		updated.Members = entryToBeUpdated.Members
+		return nil
+	})
+	if rErr != nil {
+		return current, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlProjectRepository) TrashProject(
+	userId string,
+	projectId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entry, rErr := r.fetchEntry(tx, userId, projectId, ownerAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind("UPDATE projects SET trashed = TRUE WHERE id = ?"), projectId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to move project to trash: %w", err)
+		}
+
+		return sqlInsertTrashItem(r.database, tx, record.TrashItemEntry{
+			Type:      TrashItemTypeProject,
+			Name:      entry.Name,
+			ProjectId: projectId,
+			UserId:    userId,
+		})
+	})
+}
+
+func (r sqlProjectRepository) UpdateProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+	role string,
+) (*record.ProjectEntry, *Error) {
+	var updated *record.ProjectEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entry, rErr := r.fetchEntry(tx, userId, projectId, ownerAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		if memberId == entry.UserId {
+			return Errorf(InvalidArgumentError, "project owner cannot be a member")
+		}
+		if !isMemberRole(role) {
+			return Errorf(InvalidArgumentError, "project member role must be editor or viewer")
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO project_members (project_id, user_id, role) VALUES (?, ?, ?) "+
+				"ON CONFLICT (project_id, user_id) DO UPDATE SET role = excluded.role"),
+			projectId, memberId, role)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to update project member: %w", err)
+		}
+
+		if entry.Members == nil {
+			entry.Members = make(map[string]string)
+		}
+		entry.Members[memberId] = role
+		updated = entry
+		return nil
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlProjectRepository) DeleteProjectMember(
+	userId string,
+	projectId string,
+	memberId string,
+) (*record.ProjectEntry, *Error) {
+	var updated *record.ProjectEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entry, rErr := r.fetchEntry(tx, userId, projectId, readAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		if !canRemoveProjectMember(entry.UserId, entry.Members, userId, memberId) {
+			return Errorf(NotFoundError, "failed to fetch project")
+		}
+		if _, ok := entry.Members[memberId]; !ok {
+			return Errorf(NotFoundError, "failed to fetch project member")
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"DELETE FROM project_members WHERE project_id = ? AND user_id = ?"), projectId, memberId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete project member: %w", err)
+		}
+
+		delete(entry.Members, memberId)
+		entry.Members = membersToEntry(entry.Members)
+		updated = entry
+		return nil
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return updated, nil
+}
+
+func (r sqlProjectRepository) FetchDeletingProjects() (map[string]record.ProjectEntry, *Error) {
+	rows, err := r.database.DB.Query("SELECT " + sqlProjectColumns + " FROM projects WHERE deleting = TRUE")
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+	}
+
+	return r.scanEntries(rows)
+}
+
+func (r sqlProjectRepository) FetchImportingProjects(
+	createdBefore time.Time,
+) (map[string]record.ProjectEntry, *Error) {
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlProjectColumns+" FROM projects WHERE importing = TRUE AND created_at < ?"), createdBefore.UTC())
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+	}
+
+	return r.scanEntries(rows)
+}
+
+func (r sqlProjectRepository) DeleteProject(
+	userId string,
+	projectId string,
+) (int, *Error) {
+	count := 0
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		var ownerId string
+		err := tx.QueryRow(r.database.Rebind("SELECT user_id FROM projects WHERE id = ?"+r.database.ForUpdate()),
+			projectId).Scan(&ownerId)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && ownerId != userId) {
+			return Errorf(NotFoundError, "failed to delete project")
+		}
+		if err != nil {
+			return Errorf(ReadFailurePanic, "failed to fetch project: %w", err)
+		}
+
+		var rErr *Error
+		count, rErr = sqlDeleteProject(r.database, tx, projectId)
+		return rErr
+	})
+	if rErr != nil {
+		return 0, rErr
+	}
+
+	return count, nil
+}
+
+// fetchEntry fetches the project which the user can access with the role,
+// and locks it when the access is not read-only
+func (r sqlProjectRepository) fetchEntry(
+	q sqlQuerier,
+	userId string,
+	projectId string,
+	access projectAccess,
+) (*record.ProjectEntry, *Error) {
+	query := "SELECT " + sqlProjectColumns + " FROM projects WHERE id = ? AND deleting = FALSE AND importing = FALSE AND trashed = FALSE"
+	if access != readAccess {
+		query += r.database.ForUpdate()
+	}
+
+	_, entry, err := r.scanEntry(q.QueryRow(r.database.Rebind(query), projectId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch project: %w", err)
+	}
+
+	role, rErr := sqlProjectRole(r.database, q, entry.UserId, userId, projectId)
+	if rErr != nil {
+		return nil, rErr
+	}
+	if !roleHasAccess(role, access) {
+		return nil, Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	entry.Members, rErr = r.fetchMembers(q, projectId)
+	if rErr != nil {
+		return nil, rErr
+	}
+	return entry, nil
+}
+
+func (r sqlProjectRepository) fetchMembers(q sqlQuerier, projectId string) (map[string]string, *Error) {
+	rows, err := q.Query(r.database.Rebind("SELECT user_id, role FROM project_members WHERE project_id = ?"), projectId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch project members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make(map[string]string)
+	for rows.Next() {
+		var memberId, role string
+		if err := rows.Scan(&memberId, &role); err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		members[memberId] = role
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch project members: %w", err)
+	}
+	return membersToEntry(members), nil
+}
+
+func (r sqlProjectRepository) scanEntries(rows *sql.Rows) (map[string]record.ProjectEntry, *Error) {
+	defer rows.Close()
+
+	entries := make(map[string]record.ProjectEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch projects: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlProjectRepository) scanEntry(row interface{ Scan(dest ...any) error }) (string, *record.ProjectEntry, error) {
+	var id string
+	var entry record.ProjectEntry
+	err := row.Scan(&id, &entry.Name, &entry.Description, &entry.UserId, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+	return id, &entry, nil
+}
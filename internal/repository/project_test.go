@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"cloud.google.com/go/firestore"
 	"github.com/kumachan-mis/knodeledge-api/internal/db"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
@@ -281,7 +282,7 @@ func TestUpdateProjectValidEntry(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewProjectRepository(*client)
 
-			updatedProject, rErr := r.UpdateProject(tc.userId, tc.projectId, tc.project)
+			updatedProject, rErr := r.UpdateProject(tc.userId, tc.projectId, tc.project, nil)
 			now := time.Now()
 
 			assert.Nil(t, rErr)
@@ -324,7 +325,7 @@ func TestUpdateProjectNotFound(t *testing.T) {
 			project, rErr := r.UpdateProject(tc.userId, tc.projectId, record.ProjectWithoutAutofieldEntry{
 				Name:        "Updated Project",
 				Description: "This is updated project",
-			})
+			}, nil)
 
 			assert.NotNil(t, rErr)
 			assert.Equal(t, repository.NotFoundError, rErr.Code())
@@ -365,7 +366,7 @@ func TestUpdateProjectInvalidDocument(t *testing.T) {
 			project, rErr := r.UpdateProject(tc.userId, tc.projectId, record.ProjectWithoutAutofieldEntry{
 				Name:        "Updated Project",
 				Description: "This is updated project",
-			})
+			}, nil)
 
 			assert.NotNil(t, rErr)
 			assert.Equal(t, repository.ReadFailurePanic, rErr.Code())
@@ -375,6 +376,68 @@ func TestUpdateProjectInvalidDocument(t *testing.T) {
 	}
 }
 
+func TestTrashProjectValidDocument(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := r.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Trash",
+	})
+	assert.Nil(t, rErr)
+
+	rErr = r.TrashProject(userId, projectId)
+
+	assert.Nil(t, rErr)
+
+	entry, rErr := r.FetchProject(userId, projectId)
+
+	assert.Nil(t, entry)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch project", rErr.Error())
+
+	projects, rErr := r.FetchProjects(userId)
+
+	assert.Nil(t, rErr)
+	assert.NotContains(t, projects, projectId)
+
+	_, err := client.Collection(repository.ProjectCollection).Doc(projectId).Get(db.FirestoreContext())
+	assert.Nil(t, err)
+}
+
+func TestTrashProjectNotFound(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+	}{
+		{
+			name:      "should return error when project is not found",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "UNKNOWN_PROJECT",
+		},
+		{
+			name:      "should return error when user is not author of the project",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "PROJECT_WITHOUT_DESCRIPTION_TO_UPDATE_FROM_REPOSITORY",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			client := db.FirestoreClient()
+			r := repository.NewProjectRepository(*client)
+
+			rErr := r.TrashProject(tc.userId, tc.projectId)
+
+			assert.NotNil(t, rErr)
+			assert.Equal(t, repository.NotFoundError, rErr.Code())
+			assert.Equal(t, "not found: failed to fetch project", rErr.Error())
+		})
+	}
+}
+
 func TestDeleteProjectValidDocument(t *testing.T) {
 	client := db.FirestoreClient()
 	r := repository.NewProjectRepository(*client)
@@ -382,9 +445,111 @@ func TestDeleteProjectValidDocument(t *testing.T) {
 	userId := testutil.ModifyOnlyUserId()
 	projectId := "PROJECT_WITH_DESCRIPTION_TO_DELETE_FROM_REPOSITORY"
 
-	rErr := r.DeleteProject(userId, projectId)
+	count, rErr := r.DeleteProject(userId, projectId)
+
+	assert.Nil(t, rErr)
+	assert.Equal(t, 1, count)
+
+	entry, rErr := r.FetchProject(userId, projectId)
+
+	assert.NotNil(t, rErr)
+	assert.Nil(t, entry)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+}
+
+func TestDeleteProjectDeletesSubcollections(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := r.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Delete with Subcollections",
+	})
+	assert.Nil(t, rErr)
+
+	chapterIds := []string{}
+	for i := 1; i <= 2; i++ {
+		chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   fmt.Sprintf("Chapter %d", i),
+			Number: i,
+		})
+		assert.Nil(t, rErr)
+		chapterIds = append(chapterIds, chapterId)
+	}
+
+	_, _, rErr = gr.InsertGraphs(userId, projectId, chapterIds[0], []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one"},
+		{Name: "Section Two", Paragraph: "paragraph two"},
+	})
+	assert.Nil(t, rErr)
+
+	count, rErr := r.DeleteProject(userId, projectId)
+
+	assert.Nil(t, rErr)
+	// 1 project + 2 chapters + 2 papers + 2 graphs
+	assert.Equal(t, 7, count)
+
+	ref := client.Collection(repository.ProjectCollection).Doc(projectId)
+	for _, collection := range []string{repository.ChapterCollection, repository.PaperCollection} {
+		snapshots, err := ref.Collection(collection).Documents(db.FirestoreContext()).GetAll()
+		assert.Nil(t, err)
+		assert.Empty(t, snapshots)
+	}
+	for _, chapterId := range chapterIds {
+		snapshots, err := ref.Collection(repository.ChapterCollection).Doc(chapterId).
+			Collection(repository.GraphCollection).Documents(db.FirestoreContext()).GetAll()
+		assert.Nil(t, err)
+		assert.Empty(t, snapshots)
+	}
+}
+
+func TestDeleteProjectResumesInterruptedDeletion(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := r.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Resume Deletion",
+	})
+	assert.Nil(t, rErr)
+
+	_, _, rErr = cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	// simulate a deletion interrupted right after the project was marked
+	ref := client.Collection(repository.ProjectCollection).Doc(projectId)
+	_, err := ref.Update(db.FirestoreContext(), []firestore.Update{
+		{Path: "deleting", Value: true},
+	})
+	assert.Nil(t, err)
+
+	entry, rErr := r.FetchProject(userId, projectId)
+	assert.NotNil(t, rErr)
+	assert.Nil(t, entry)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+
+	entries, rErr := r.FetchDeletingProjects()
+	assert.Nil(t, rErr)
+	assert.Contains(t, entries, projectId)
+	assert.Equal(t, userId, entries[projectId].UserId)
+
+	count, rErr := r.DeleteProject(userId, projectId)
+
+	assert.Nil(t, rErr)
+	// 1 project + 1 chapter + 1 paper
+	assert.Equal(t, 3, count)
 
+	entries, rErr = r.FetchDeletingProjects()
 	assert.Nil(t, rErr)
+	assert.NotContains(t, entries, projectId)
 }
 
 func TestDeleteProjectNotFound(t *testing.T) {
@@ -413,9 +578,10 @@ func TestDeleteProjectNotFound(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewProjectRepository(*client)
 
-			rErr := r.DeleteProject(tc.userId, tc.projectId)
+			count, rErr := r.DeleteProject(tc.userId, tc.projectId)
 
 			assert.NotNil(t, rErr)
+			assert.Equal(t, 0, count)
 			assert.Equal(t, repository.NotFoundError, rErr.Code())
 			assert.Equal(t, fmt.Sprintf("not found: %v", tc.expectedError), rErr.Error())
 		})
@@ -450,9 +616,10 @@ func TestDeleteProjectInvalidDocument(t *testing.T) {
 			client := db.FirestoreClient()
 			r := repository.NewProjectRepository(*client)
 
-			rErr := r.DeleteProject(tc.userId, tc.projectId)
+			count, rErr := r.DeleteProject(tc.userId, tc.projectId)
 
 			assert.NotNil(t, rErr)
+			assert.Equal(t, 0, count)
 			assert.Equal(t, repository.ReadFailurePanic, rErr.Code())
 			assert.Equal(t, fmt.Sprintf("read failure: %v", tc.expectedError), rErr.Error())
 		})
@@ -0,0 +1,1884 @@
+package repositorytest
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Repositories struct {
+	Project     repository.ProjectRepository
+	Chapter     repository.ChapterRepository
+	Paper       repository.PaperRepository
+	Graph       repository.GraphRepository
+	Trash       repository.TrashRepository
+	Link        repository.LinkRepository
+	Share       repository.ShareRepository
+	AccessToken repository.AccessTokenRepository
+}
+
+type Factory func(t *testing.T) Repositories
+
+func RunConformance(t *testing.T, newRepositories Factory) {
+	t.Run("Project", func(t *testing.T) { testProject(t, newRepositories(t)) })
+	t.Run("ProjectNotFound", func(t *testing.T) { testProjectNotFound(t, newRepositories(t)) })
+	t.Run("ProjectMembers", func(t *testing.T) { testProjectMembers(t, newRepositories(t)) })
+	t.Run("ProjectMemberAccess", func(t *testing.T) { testProjectMemberAccess(t, newRepositories(t)) })
+	t.Run("ChapterOrdering", func(t *testing.T) { testChapterOrdering(t, newRepositories(t)) })
+	t.Run("ChapterInvalidArgument", func(t *testing.T) { testChapterInvalidArgument(t, newRepositories(t)) })
+	t.Run("ChapterNotFound", func(t *testing.T) { testChapterNotFound(t, newRepositories(t)) })
+	t.Run("ChapterSections", func(t *testing.T) { testChapterSections(t, newRepositories(t)) })
+	t.Run("Paper", func(t *testing.T) { testPaper(t, newRepositories(t)) })
+	t.Run("PaperRevisions", func(t *testing.T) { testPaperRevisions(t, newRepositories(t)) })
+	t.Run("Graph", func(t *testing.T) { testGraph(t, newRepositories(t)) })
+	t.Run("GraphNotFound", func(t *testing.T) { testGraphNotFound(t, newRepositories(t)) })
+	t.Run("GraphRevisions", func(t *testing.T) { testGraphRevisions(t, newRepositories(t)) })
+	t.Run("UpdateGraphChildren", func(t *testing.T) { testUpdateGraphChildren(t, newRepositories(t)) })
+	t.Run("GraphChildIds", func(t *testing.T) { testGraphChildIds(t, newRepositories(t)) })
+	t.Run("ResectionalizeGraphs", func(t *testing.T) { testResectionalizeGraphs(t, newRepositories(t)) })
+	t.Run("ResectionalizeGraphsConflict", func(t *testing.T) { testResectionalizeGraphsConflict(t, newRepositories(t)) })
+	t.Run("Link", func(t *testing.T) { testLink(t, newRepositories(t)) })
+	t.Run("LinkInvalidArgument", func(t *testing.T) { testLinkInvalidArgument(t, newRepositories(t)) })
+	t.Run("LinkNotFound", func(t *testing.T) { testLinkNotFound(t, newRepositories(t)) })
+	t.Run("LinkCleanup", func(t *testing.T) { testLinkCleanup(t, newRepositories(t)) })
+	t.Run("TrashChapter", func(t *testing.T) { testTrashChapter(t, newRepositories(t)) })
+	t.Run("DeleteProject", func(t *testing.T) { testDeleteProject(t, newRepositories(t)) })
+	t.Run("ImportingProject", func(t *testing.T) { testImportingProject(t, newRepositories(t)) })
+	t.Run("UpdateConflict", func(t *testing.T) { testUpdateConflict(t, newRepositories(t)) })
+	t.Run("TrashProject", func(t *testing.T) { testTrashProject(t, newRepositories(t)) })
+	t.Run("TrashRestorePosition", func(t *testing.T) { testTrashRestorePosition(t, newRepositories(t)) })
+	t.Run("TrashNotFound", func(t *testing.T) { testTrashNotFound(t, newRepositories(t)) })
+	t.Run("TrashPurge", func(t *testing.T) { testTrashPurge(t, newRepositories(t)) })
+	t.Run("Share", func(t *testing.T) { testShare(t, newRepositories(t)) })
+	t.Run("ShareNotFound", func(t *testing.T) { testShareNotFound(t, newRepositories(t)) })
+	t.Run("AccessToken", func(t *testing.T) { testAccessToken(t, newRepositories(t)) })
+	t.Run("AccessTokenNotFound", func(t *testing.T) { testAccessTokenNotFound(t, newRepositories(t)) })
+}
+
+var userCounter atomic.Int64
+
+func UserId() string {
+	return fmt.Sprintf("conformance|%019d%05d", time.Now().UnixNano(), userCounter.Add(1)%100000)
+}
+
+func testProject(t *testing.T, r Repositories) {
+	userId := UserId()
+
+	projectId, created, rErr := r.Project.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name:        "Project",
+		Description: "Description",
+	})
+	require.Nil(t, rErr)
+	assert.NotEmpty(t, projectId)
+	assert.Equal(t, "Project", created.Name)
+	assert.Equal(t, "Description", created.Description)
+	assert.Equal(t, userId, created.UserId)
+	assert.Less(t, time.Since(created.CreatedAt), time.Minute)
+	assert.Equal(t, created.CreatedAt, created.UpdatedAt)
+
+	fetched, rErr := r.Project.FetchProject(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Equal(t, *created, *fetched)
+
+	updated, rErr := r.Project.UpdateProject(userId, projectId, record.ProjectWithoutAutofieldEntry{
+		Name: "Updated Project",
+	}, nil)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated Project", updated.Name)
+	assert.Empty(t, updated.Description)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+	assert.False(t, updated.UpdatedAt.Before(created.UpdatedAt))
+
+	otherId, _, rErr := r.Project.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Other Project",
+	})
+	require.Nil(t, rErr)
+
+	_, _, rErr = r.Project.InsertProject(UserId()+"-other", record.ProjectWithoutAutofieldEntry{
+		Name: "Project of Other User",
+	})
+	require.Nil(t, rErr)
+
+	projects, rErr := r.Project.FetchProjects(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "Updated Project", projects[projectId].Name)
+	assert.Equal(t, "Other Project", projects[otherId].Name)
+}
+
+func testProjectNotFound(t *testing.T, r Repositories) {
+	userId := UserId()
+
+	projectId, _, rErr := r.Project.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project",
+	})
+	require.Nil(t, rErr)
+
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+	}{
+		{name: "unknown project", userId: userId, projectId: "UNKNOWN_PROJECT"},
+		{name: "other user", userId: userId + "-other", projectId: projectId},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, rErr := r.Project.FetchProject(tc.userId, tc.projectId)
+			assert.Nil(t, entry)
+			assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+			entry, rErr = r.Project.UpdateProject(tc.userId, tc.projectId, record.ProjectWithoutAutofieldEntry{
+				Name: "Updated Project",
+			}, nil)
+			assert.Nil(t, entry)
+			assertError(t, rErr, repository.NotFoundError, "failed to update project")
+
+			count, rErr := r.Project.DeleteProject(tc.userId, tc.projectId)
+			assert.Equal(t, 0, count)
+			assertError(t, rErr, repository.NotFoundError, "failed to delete project")
+
+			chapters, rErr := r.Chapter.FetchChapters(tc.userId, tc.projectId)
+			assert.Nil(t, chapters)
+			assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+		})
+	}
+}
+
+func testProjectMembers(t *testing.T, r Repositories) {
+	ownerId, projectId := insertProject(t, r)
+	editorId := UserId() + "-editor"
+	viewerId := UserId() + "-viewer"
+
+	entry, rErr := r.Project.UpdateProjectMember(ownerId, projectId, editorId, repository.ProjectRoleEditor)
+	require.Nil(t, rErr)
+	assert.Equal(t, ownerId, entry.UserId)
+	assert.Equal(t, map[string]string{editorId: repository.ProjectRoleEditor}, entry.Members)
+
+	entry, rErr = r.Project.UpdateProjectMember(ownerId, projectId, viewerId, repository.ProjectRoleEditor)
+	require.Nil(t, rErr)
+	entry, rErr = r.Project.UpdateProjectMember(ownerId, projectId, viewerId, repository.ProjectRoleViewer)
+	require.Nil(t, rErr)
+	assert.Equal(t, map[string]string{
+		editorId: repository.ProjectRoleEditor,
+		viewerId: repository.ProjectRoleViewer,
+	}, entry.Members)
+
+	for _, userId := range []string{editorId, viewerId} {
+		projects, rErr := r.Project.FetchProjects(userId)
+		require.Nil(t, rErr)
+		assert.Len(t, projects, 1)
+		assert.Equal(t, *entry, projects[projectId])
+
+		fetched, rErr := r.Project.FetchProject(userId, projectId)
+		require.Nil(t, rErr)
+		assert.Equal(t, *entry, *fetched)
+	}
+
+	_, rErr = r.Project.UpdateProjectMember(ownerId, projectId, ownerId, repository.ProjectRoleEditor)
+	assertError(t, rErr, repository.InvalidArgumentError, "project owner cannot be a member")
+	_, rErr = r.Project.UpdateProjectMember(ownerId, projectId, viewerId, repository.ProjectRoleOwner)
+	assertError(t, rErr, repository.InvalidArgumentError, "project member role must be editor or viewer")
+	_, rErr = r.Project.UpdateProjectMember(editorId, projectId, viewerId, repository.ProjectRoleEditor)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	_, rErr = r.Project.DeleteProjectMember(editorId, projectId, viewerId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	_, rErr = r.Project.DeleteProjectMember(ownerId, projectId, UserId()+"-stranger")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project member")
+
+	entry, rErr = r.Project.DeleteProjectMember(viewerId, projectId, viewerId)
+	require.Nil(t, rErr)
+	assert.Equal(t, map[string]string{editorId: repository.ProjectRoleEditor}, entry.Members)
+
+	entry, rErr = r.Project.DeleteProjectMember(ownerId, projectId, editorId)
+	require.Nil(t, rErr)
+	assert.Nil(t, entry.Members)
+
+	for _, userId := range []string{editorId, viewerId} {
+		projects, rErr := r.Project.FetchProjects(userId)
+		require.Nil(t, rErr)
+		assert.Empty(t, projects)
+
+		_, rErr = r.Project.FetchProject(userId, projectId)
+		assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	}
+}
+
+func testProjectMemberAccess(t *testing.T, r Repositories) {
+	ownerId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, ownerId, projectId, "Chapter", 1)
+	sectionId, _ := insertLinkableGraph(t, r, ownerId, projectId, chapterId)
+
+	editorId := UserId() + "-editor"
+	viewerId := UserId() + "-viewer"
+	_, rErr := r.Project.UpdateProjectMember(ownerId, projectId, editorId, repository.ProjectRoleEditor)
+	require.Nil(t, rErr)
+	_, rErr = r.Project.UpdateProjectMember(ownerId, projectId, viewerId, repository.ProjectRoleViewer)
+	require.Nil(t, rErr)
+
+	for _, userId := range []string{editorId, viewerId} {
+		_, rErr = r.Chapter.FetchChapter(userId, projectId, chapterId)
+		assert.Nil(t, rErr)
+		_, rErr = r.Paper.FetchPaper(userId, projectId, chapterId)
+		assert.Nil(t, rErr)
+		_, rErr = r.Graph.FetchGraph(userId, projectId, chapterId, sectionId)
+		assert.Nil(t, rErr)
+		_, rErr = r.Link.FetchLinks(userId, projectId)
+		assert.Nil(t, rErr)
+	}
+
+	_, rErr = r.Project.UpdateProject(viewerId, projectId, record.ProjectWithoutAutofieldEntry{
+		Name: "Updated by Viewer",
+	}, nil)
+	assertError(t, rErr, repository.NotFoundError, "failed to update project")
+	_, _, rErr = r.Chapter.InsertChapter(viewerId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter by Viewer",
+		Number: 2,
+	})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	_, rErr = r.Paper.UpdatePaper(viewerId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "Updated by Viewer",
+	}, nil)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	_, rErr = r.Graph.UpdateGraphContent(viewerId, projectId, chapterId, sectionId, record.GraphContentEntry{
+		Paragraph: "Updated by Viewer",
+	}, nil)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	rErr = r.Graph.TrashGraph(viewerId, projectId, chapterId, sectionId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	updated, rErr := r.Project.UpdateProject(editorId, projectId, record.ProjectWithoutAutofieldEntry{
+		Name: "Updated by Editor",
+	}, nil)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated by Editor", updated.Name)
+	assert.Equal(t, ownerId, updated.UserId)
+	insertChapter(t, r, editorId, projectId, "Chapter by Editor", 2)
+	_, rErr = r.Paper.UpdatePaper(editorId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "Updated by Editor",
+	}, nil)
+	assert.Nil(t, rErr)
+	_, rErr = r.Graph.UpdateGraphContent(editorId, projectId, chapterId, sectionId, record.GraphContentEntry{
+		Paragraph: "Updated by Editor",
+	}, nil)
+	assert.Nil(t, rErr)
+
+	for _, userId := range []string{editorId, viewerId} {
+		rErr = r.Project.TrashProject(userId, projectId)
+		assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+		count, rErr := r.Project.DeleteProject(userId, projectId)
+		assert.Equal(t, 0, count)
+		assertError(t, rErr, repository.NotFoundError, "failed to delete project")
+	}
+}
+
+func testChapterOrdering(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+
+	// inserting at the head and in the middle shifts the following chapters
+	idB := insertChapter(t, r, userId, projectId, "B", 1)
+	idA := insertChapter(t, r, userId, projectId, "A", 1)
+	idD := insertChapter(t, r, userId, projectId, "D", 3)
+	idC := insertChapter(t, r, userId, projectId, "C", 3)
+	assertChapterOrder(t, r, userId, projectId, []string{idA, idB, idC, idD})
+
+	updated, rErr := r.Chapter.UpdateChapter(userId, projectId, idA, record.ChapterWithoutAutofieldEntry{
+		Name:   "A'",
+		Number: 4,
+	}, nil)
+	require.Nil(t, rErr)
+	assert.Equal(t, "A'", updated.Name)
+	assert.Equal(t, 4, updated.Number)
+	assertChapterOrder(t, r, userId, projectId, []string{idB, idC, idD, idA})
+
+	_, rErr = r.Chapter.UpdateChapter(userId, projectId, idD, record.ChapterWithoutAutofieldEntry{
+		Name:   "D",
+		Number: 1,
+	}, nil)
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idD, idB, idC, idA})
+
+	_, rErr = r.Chapter.UpdateChapter(userId, projectId, idB, record.ChapterWithoutAutofieldEntry{
+		Name:   "B'",
+		Number: 2,
+	}, nil)
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idD, idB, idC, idA})
+
+	rErr = r.Chapter.TrashChapter(userId, projectId, idB)
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idD, idC, idA})
+
+	chapter, rErr := r.Chapter.FetchChapter(userId, projectId, idA)
+	require.Nil(t, rErr)
+	assert.Equal(t, "A'", chapter.Name)
+	assert.Equal(t, 3, chapter.Number)
+	assert.Empty(t, chapter.Sections)
+	assert.Equal(t, userId, chapter.UserId)
+}
+
+func testChapterInvalidArgument(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	id, entry, rErr := r.Chapter.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 3,
+	})
+	assert.Empty(t, id)
+	assert.Nil(t, entry)
+	assertError(t, rErr, repository.InvalidArgumentError, "chapter number is too large")
+
+	entry, rErr = r.Chapter.UpdateChapter(userId, projectId, chapterId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 2,
+	}, nil)
+	assert.Nil(t, entry)
+	assertError(t, rErr, repository.InvalidArgumentError, "chapter number is too large")
+
+	assertChapterOrder(t, r, userId, projectId, []string{chapterId})
+}
+
+func testChapterNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	entry, rErr := r.Chapter.FetchChapter(userId, projectId, "UNKNOWN_CHAPTER")
+	assert.Nil(t, entry)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	entry, rErr = r.Chapter.UpdateChapter(userId, projectId, "UNKNOWN_CHAPTER", record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 1,
+	}, nil)
+	assert.Nil(t, entry)
+	assertError(t, rErr, repository.NotFoundError, "failed to update chapter")
+
+	sections, rErr := r.Chapter.UpdateChapterSections(userId, projectId, "UNKNOWN_CHAPTER", nil)
+	assert.Nil(t, sections)
+	assertError(t, rErr, repository.NotFoundError, "failed to update sections of chapter")
+
+	rErr = r.Chapter.TrashChapter(userId, projectId, "UNKNOWN_CHAPTER")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	_, _, rErr = r.Chapter.InsertChapter(userId+"-other", projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter",
+		Number: 1,
+	})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+}
+
+func testChapterSections(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	sections, rErr := r.Chapter.UpdateChapterSections(userId, projectId, chapterId, []record.SectionWithoutAutofieldEntry{
+		{Id: "SECTION_TWO", Name: "Section Two"},
+		{Id: "SECTION_ONE", Name: "Section One"},
+	})
+	require.Nil(t, rErr)
+	require.Len(t, sections, 2)
+	assert.Equal(t, "SECTION_TWO", sections[0].Id)
+	assert.Equal(t, "Section Two", sections[0].Name)
+	assert.Equal(t, "SECTION_ONE", sections[1].Id)
+	assert.Equal(t, userId, sections[1].UserId)
+
+	chapter, rErr := r.Chapter.FetchChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, sections, chapter.Sections)
+}
+
+func testPaper(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	// a chapter is created together with its empty paper
+	paper, rErr := r.Paper.FetchPaper(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Empty(t, paper.Content)
+	assert.Equal(t, userId, paper.UserId)
+
+	updated, rErr := r.Paper.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Introduction",
+	}, nil)
+	require.Nil(t, rErr)
+	assert.Equal(t, "## Introduction", updated.Content)
+	assert.Equal(t, paper.CreatedAt, updated.CreatedAt)
+
+	fetched, rErr := r.Paper.FetchPaper(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, *updated, *fetched)
+
+	id, inserted, rErr := r.Paper.InsertPaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Overwritten",
+	})
+	require.Nil(t, rErr)
+	assert.Equal(t, chapterId, id)
+	assert.Equal(t, "## Overwritten", inserted.Content)
+
+	rErr = r.Paper.DeletePaper(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+
+	paper, rErr = r.Paper.FetchPaper(userId, projectId, "UNKNOWN_CHAPTER")
+	assert.Nil(t, paper)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	paper, rErr = r.Paper.UpdatePaper(userId+"-other", projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "content",
+	}, nil)
+	assert.Nil(t, paper)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+}
+
+func testPaperRevisions(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	// the empty paper created together with a chapter has no revision
+	revisions, rErr := r.Paper.FetchPaperRevisions(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Empty(t, revisions)
+
+	first, rErr := r.Paper.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## First",
+	}, nil)
+	require.Nil(t, rErr)
+
+	second, rErr := r.Paper.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "## Second",
+	}, nil)
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Paper.FetchPaperRevisions(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 2)
+
+	revisionIds := make(map[string]string)
+	for id, revision := range revisions {
+		revisionIds[revision.Content] = id
+		assert.Equal(t, userId, revision.AuthorId)
+		assert.Equal(t, userId, revision.UserId)
+	}
+	require.Contains(t, revisionIds, "## First")
+	require.Contains(t, revisionIds, "## Second")
+	assert.Equal(t, first.UpdatedAt, revisions[revisionIds["## First"]].CreatedAt)
+	assert.Equal(t, second.UpdatedAt, revisions[revisionIds["## Second"]].CreatedAt)
+
+	revision, rErr := r.Paper.FetchPaperRevision(userId, projectId, chapterId, revisionIds["## First"])
+	require.Nil(t, rErr)
+	assert.Equal(t, revisions[revisionIds["## First"]], *revision)
+
+	rErr = r.Paper.DeletePaperRevisions(userId, projectId, chapterId, []string{revisionIds["## First"]})
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Paper.FetchPaperRevisions(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 1)
+	assert.Contains(t, revisions, revisionIds["## Second"])
+
+	revision, rErr = r.Paper.FetchPaperRevision(userId, projectId, chapterId, revisionIds["## First"])
+	assert.Nil(t, revision)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch paper revision")
+
+	revisions, rErr = r.Paper.FetchPaperRevisions(userId+"-other", projectId, chapterId)
+	assert.Nil(t, revisions)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	rErr = r.Paper.DeletePaperRevisions(userId, projectId, "UNKNOWN_CHAPTER", []string{revisionIds["## Second"]})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	rErr = r.Chapter.TrashChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Paper.FetchPaperRevisions(userId, projectId, chapterId)
+	assert.Nil(t, revisions)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+}
+
+func testGraph(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	exists, rErr := r.Graph.GraphExists(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.False(t, exists)
+
+	children := []record.GraphChildEntry{
+		{
+			Name:        "Child",
+			Relation:    "part of",
+			Description: "description",
+			Children: []record.GraphChildEntry{
+				{Name: "Grandchild", Relation: "example", Children: []record.GraphChildEntry{}},
+			},
+		},
+	}
+
+	ids, entries, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: children},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+	require.Len(t, ids, 2)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Section One", entries[0].Name)
+	assert.Equal(t, "Paragraph One", entries[0].Paragraph)
+	AssertGraphChildren(t, children, entries[0].Children)
+	assert.Equal(t, "Section Two", entries[1].Name)
+	assert.Equal(t, userId, entries[1].UserId)
+
+	exists, rErr = r.Graph.GraphExists(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.True(t, exists)
+
+	chapter, rErr := r.Chapter.FetchChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	require.Len(t, chapter.Sections, 2)
+	assert.Equal(t, ids[0], chapter.Sections[0].Id)
+	assert.Equal(t, "Section One", chapter.Sections[0].Name)
+	assert.Equal(t, ids[1], chapter.Sections[1].Id)
+
+	_, _, rErr = r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section Three", Paragraph: "Paragraph Three", Children: []record.GraphChildEntry{}},
+	})
+	assertError(t, rErr, repository.InvalidArgumentError, "graph already exists")
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	assert.Equal(t, entries[0], *graph)
+
+	updated, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[1], record.GraphContentEntry{
+		Paragraph: "Updated Paragraph Two",
+		Children:  children,
+	}, nil)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Section Two", updated.Name)
+	assert.Equal(t, "Updated Paragraph Two", updated.Paragraph)
+	AssertGraphChildren(t, children, updated.Children)
+	assert.Equal(t, entries[1].CreatedAt, updated.CreatedAt)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+
+	chapter, rErr = r.Chapter.FetchChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	require.Len(t, chapter.Sections, 1)
+	assert.Equal(t, ids[1], chapter.Sections[0].Id)
+
+	graph, rErr = r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	assert.Nil(t, graph)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+}
+
+func testGraphNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, "UNKNOWN_SECTION")
+	assert.Nil(t, graph)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+
+	graph, rErr = r.Graph.UpdateGraphContent(userId, projectId, chapterId, "UNKNOWN_SECTION", record.GraphContentEntry{
+		Paragraph: "Paragraph",
+		Children:  []record.GraphChildEntry{},
+	}, nil)
+	assert.Nil(t, graph)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterId, "UNKNOWN_SECTION")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+
+	_, rErr = r.Graph.GraphExists(userId, projectId, "UNKNOWN_CHAPTER")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	_, _, rErr = r.Graph.InsertGraphs(userId+"-other", projectId, chapterId, []record.GraphWithoutAutofieldEntry{})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+}
+
+func testGraphRevisions(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	// the graphs created by sectionalization have no revision
+	revisions, rErr := r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	assert.Empty(t, revisions)
+
+	children := []record.GraphChildEntry{
+		{
+			Name:        "Child",
+			Relation:    "part of",
+			Description: "description",
+			Children: []record.GraphChildEntry{
+				{Name: "Grandchild", Relation: "example", Children: []record.GraphChildEntry{}},
+			},
+		},
+	}
+
+	first, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "First Paragraph",
+		Children:  []record.GraphChildEntry{},
+	}, nil)
+	require.Nil(t, rErr)
+
+	second, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "Second Paragraph",
+		Children:  children,
+	}, nil)
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 2)
+
+	revisionIds := make(map[string]string)
+	for id, revision := range revisions {
+		revisionIds[revision.Paragraph] = id
+		assert.Equal(t, userId, revision.AuthorId)
+		assert.Equal(t, userId, revision.UserId)
+	}
+	require.Contains(t, revisionIds, "First Paragraph")
+	require.Contains(t, revisionIds, "Second Paragraph")
+	assert.Empty(t, revisions[revisionIds["First Paragraph"]].Children)
+	AssertGraphChildren(t, children, revisions[revisionIds["Second Paragraph"]].Children)
+	assert.Equal(t, first.UpdatedAt, revisions[revisionIds["First Paragraph"]].CreatedAt)
+	assert.Equal(t, second.UpdatedAt, revisions[revisionIds["Second Paragraph"]].CreatedAt)
+
+	revision, rErr := r.Graph.FetchGraphRevision(userId, projectId, chapterId, ids[0], revisionIds["Second Paragraph"])
+	require.Nil(t, rErr)
+	assert.Equal(t, revisions[revisionIds["Second Paragraph"]], *revision)
+
+	rErr = r.Graph.DeleteGraphRevisions(userId, projectId, chapterId, ids[0], []string{revisionIds["First Paragraph"]})
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 1)
+	assert.Contains(t, revisions, revisionIds["Second Paragraph"])
+
+	revision, rErr = r.Graph.FetchGraphRevision(userId, projectId, chapterId, ids[0], revisionIds["First Paragraph"])
+	assert.Nil(t, revision)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph revision")
+
+	revisions, rErr = r.Graph.FetchGraphRevisions(userId, projectId, chapterId, "UNKNOWN_SECTION")
+	assert.Nil(t, revisions)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+
+	rErr = r.Graph.DeleteGraphRevisions(userId+"-other", projectId, chapterId, ids[0], []string{})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+
+	revisions, rErr = r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	assert.Nil(t, revisions)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+}
+
+func testUpdateGraphChildren(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, entries, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	child := record.GraphChildEntry{Name: "Child", Relation: "part of", Children: []record.GraphChildEntry{}}
+	updated, rErr := r.Graph.UpdateGraphChildren(userId, projectId, chapterId, ids[0],
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			assert.Equal(t, []record.GraphChildEntry{}, children)
+			return append(children, child), nil
+		})
+	require.Nil(t, rErr)
+	assert.Equal(t, "Section One", updated.Name)
+	assert.Equal(t, "Paragraph One", updated.Paragraph)
+	AssertGraphChildren(t, []record.GraphChildEntry{child}, updated.Children)
+	assert.Equal(t, entries[0].CreatedAt, updated.CreatedAt)
+
+	revisions, rErr := r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 1)
+	for _, revision := range revisions {
+		assert.Equal(t, "Paragraph One", revision.Paragraph)
+		AssertGraphChildren(t, []record.GraphChildEntry{child}, revision.Children)
+	}
+
+	updated, rErr = r.Graph.UpdateGraphChildren(userId, projectId, chapterId, ids[0],
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return nil, errors.New("graph child is not found")
+		})
+	assert.Nil(t, updated)
+	assertError(t, rErr, repository.InvalidArgumentError, "failed to update graph children: graph child is not found")
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	AssertGraphChildren(t, []record.GraphChildEntry{child}, graph.Children)
+
+	updated, rErr = r.Graph.UpdateGraphChildren(userId, projectId, chapterId, "UNKNOWN_SECTION",
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return children, nil
+		})
+	assert.Nil(t, updated)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+}
+
+func testGraphChildIds(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, entries, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{
+			{Id: "UNKNOWN_CHILD", Name: "Child", Relation: "part of", Children: []record.GraphChildEntry{
+				{Name: "Grandchild", Relation: "example", Children: []record.GraphChildEntry{}},
+			}},
+		}},
+	})
+	require.Nil(t, rErr)
+
+	child := entries[0].Children[0]
+	grandchild := child.Children[0]
+	assert.NotEqual(t, "UNKNOWN_CHILD", child.Id)
+	assert.NotEqual(t, child.Id, grandchild.Id)
+
+	// echoed ids are kept across renames and moves, while duplicated and unknown ones are replaced
+	updated, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "Paragraph One",
+		Children: []record.GraphChildEntry{
+			{Id: grandchild.Id, Name: "Moved Grandchild", Relation: "example", Children: []record.GraphChildEntry{}},
+			{Id: child.Id, Name: "Renamed Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+			{Id: child.Id, Name: "Copied Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+			{Id: "UNKNOWN_CHILD", Name: "New Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+		},
+	}, nil)
+	require.Nil(t, rErr)
+	require.Len(t, updated.Children, 4)
+	assert.Equal(t, grandchild.Id, updated.Children[0].Id)
+	assert.Equal(t, child.Id, updated.Children[1].Id)
+	assert.NotEqual(t, child.Id, updated.Children[2].Id)
+	assert.NotEqual(t, "UNKNOWN_CHILD", updated.Children[3].Id)
+	assert.NotEqual(t, updated.Children[2].Id, updated.Children[3].Id)
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	assert.Equal(t, updated.Children, graph.Children)
+
+	updated, rErr = r.Graph.UpdateGraphChildren(userId, projectId, chapterId, ids[0],
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return []record.GraphChildEntry{
+				{Name: "Child", Relation: "part of", Children: children[1:2]},
+				children[0],
+			}, nil
+		})
+	require.Nil(t, rErr)
+	require.Len(t, updated.Children, 2)
+	assert.NotEmpty(t, updated.Children[0].Id)
+	assert.Equal(t, []record.GraphChildEntry{graph.Children[1]}, updated.Children[0].Children)
+	assert.Equal(t, graph.Children[0], updated.Children[1])
+
+	count, rErr := r.Graph.AssignMissingGraphChildIds()
+	require.Nil(t, rErr)
+	assert.GreaterOrEqual(t, count, 0)
+
+	count, rErr = r.Graph.AssignMissingGraphChildIds()
+	require.Nil(t, rErr)
+	assert.Equal(t, 0, count)
+
+	graph, rErr = r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	assert.Equal(t, updated.Children, graph.Children)
+}
+
+func testResectionalizeGraphs(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	children := []record.GraphChildEntry{
+		{Name: "Child", Relation: "part of", Description: "description", Children: []record.GraphChildEntry{}},
+	}
+
+	ids, entries, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: children},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+		{Name: "Section Three", Paragraph: "Paragraph Three", Children: []record.GraphChildEntry{}},
+		{Name: "Section Four", Paragraph: "Paragraph Four", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	fetchedIds, fetched, rErr := r.Graph.FetchGraphs(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, ids, fetchedIds)
+	assert.Equal(t, entries, fetched)
+
+	newIds, newEntries, rErr := r.Graph.ResectionalizeGraphs(userId, projectId, chapterId,
+		[]record.GraphResectionalizeEntry{
+			{Id: ids[1], Name: "Section Two", Paragraph: "Paragraph Two"},
+			{Name: "Section Five", Paragraph: "Paragraph Five"},
+			{Id: ids[0], Name: "Renamed Section One", Paragraph: "Updated Paragraph One"},
+		},
+		[]string{ids[2], ids[3]},
+		repository.GraphOrphanActionArchive,
+	)
+	require.Nil(t, rErr)
+	require.Len(t, newIds, 3)
+	assert.Equal(t, ids[1], newIds[0])
+	assert.NotContains(t, ids, newIds[1])
+	assert.Equal(t, ids[0], newIds[2])
+
+	// unchanged graphs keep their timestamps and get no revision
+	assert.Equal(t, entries[1], newEntries[0])
+	assert.Equal(t, "Section Five", newEntries[1].Name)
+	assert.Equal(t, "Paragraph Five", newEntries[1].Paragraph)
+	assert.Empty(t, newEntries[1].Children)
+	assert.Equal(t, "Renamed Section One", newEntries[2].Name)
+	assert.Equal(t, "Updated Paragraph One", newEntries[2].Paragraph)
+	AssertGraphChildren(t, children, newEntries[2].Children)
+	assert.Equal(t, entries[0].CreatedAt, newEntries[2].CreatedAt)
+
+	fetchedIds, fetched, rErr = r.Graph.FetchGraphs(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, newIds, fetchedIds)
+	assert.Equal(t, newEntries, fetched)
+
+	revisions, rErr := r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	require.Len(t, revisions, 1)
+	for _, revision := range revisions {
+		assert.Equal(t, "Updated Paragraph One", revision.Paragraph)
+		AssertGraphChildren(t, children, revision.Children)
+	}
+	revisions, rErr = r.Graph.FetchGraphRevisions(userId, projectId, chapterId, ids[1])
+	require.Nil(t, rErr)
+	assert.Empty(t, revisions)
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 2)
+	positions := make(map[string]int)
+	for _, item := range items {
+		assert.Equal(t, repository.TrashItemTypeSection, item.Type)
+		positions[item.SectionId] = item.Position
+	}
+	assert.Equal(t, map[string]int{ids[2]: 2, ids[3]: 3}, positions)
+
+	_, _, rErr = r.Graph.ResectionalizeGraphs(userId, projectId, chapterId,
+		[]record.GraphResectionalizeEntry{
+			{Id: newIds[2], Name: "Renamed Section One", Paragraph: "Updated Paragraph One"},
+		},
+		[]string{newIds[0], newIds[1]},
+		repository.GraphOrphanActionDelete,
+	)
+	require.Nil(t, rErr)
+
+	fetchedIds, _, rErr = r.Graph.FetchGraphs(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, []string{ids[0]}, fetchedIds)
+
+	// the deleted graphs are not moved to trash
+	items, rErr = r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, items, 2)
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, newIds[0])
+	assert.Nil(t, graph)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch graph")
+}
+
+func testResectionalizeGraphsConflict(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	tt := []struct {
+		name      string
+		entries   []record.GraphResectionalizeEntry
+		orphanIds []string
+	}{
+		{
+			name: "should return error when a graph is neither matched nor orphaned",
+			entries: []record.GraphResectionalizeEntry{
+				{Id: ids[0], Name: "Section One", Paragraph: "Paragraph One"},
+			},
+			orphanIds: []string{},
+		},
+		{
+			name: "should return error when an unknown graph is matched",
+			entries: []record.GraphResectionalizeEntry{
+				{Id: ids[0], Name: "Section One", Paragraph: "Paragraph One"},
+				{Id: "UNKNOWN_SECTION", Name: "Section Two", Paragraph: "Paragraph Two"},
+			},
+			orphanIds: []string{ids[1]},
+		},
+		{
+			name: "should return error when a graph is matched twice",
+			entries: []record.GraphResectionalizeEntry{
+				{Id: ids[0], Name: "Section One", Paragraph: "Paragraph One"},
+				{Id: ids[0], Name: "Section Two", Paragraph: "Paragraph Two"},
+			},
+			orphanIds: []string{ids[1]},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			resIds, entries, rErr := r.Graph.ResectionalizeGraphs(userId, projectId, chapterId,
+				tc.entries, tc.orphanIds, repository.GraphOrphanActionArchive)
+			assert.Nil(t, resIds)
+			assert.Nil(t, entries)
+			assertError(t, rErr, repository.ConflictError, "graphs have been updated since they were fetched")
+		})
+	}
+
+	fetchedIds, _, rErr := r.Graph.FetchGraphs(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	assert.Equal(t, ids, fetchedIds)
+
+	_, _, rErr = r.Graph.ResectionalizeGraphs(userId+"-other", projectId, chapterId,
+		[]record.GraphResectionalizeEntry{}, ids, repository.GraphOrphanActionArchive)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	_, _, rErr = r.Graph.FetchGraphs(userId, projectId, "UNKNOWN_CHAPTER")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+}
+
+func testLink(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterOneId := insertChapter(t, r, userId, projectId, "Chapter One", 1)
+	chapterTwoId := insertChapter(t, r, userId, projectId, "Chapter Two", 2)
+	sectionOneId, nodeId := insertLinkableGraph(t, r, userId, projectId, chapterOneId)
+	sectionTwoId, _ := insertLinkableGraph(t, r, userId, projectId, chapterTwoId)
+
+	from := record.LinkEndpointEntry{ChapterId: chapterOneId, SectionId: sectionOneId, NodeId: nodeId}
+	to := record.LinkEndpointEntry{ChapterId: chapterTwoId, SectionId: sectionTwoId}
+
+	linkId, created, rErr := r.Link.InsertLink(userId, projectId, record.LinkWithoutAutofieldEntry{
+		From:        from,
+		To:          to,
+		Relation:    "related to",
+		Description: "description",
+	})
+	require.Nil(t, rErr)
+	assert.NotEmpty(t, linkId)
+	assert.Equal(t, from, created.From)
+	assert.Equal(t, to, created.To)
+	assert.Equal(t, "related to", created.Relation)
+	assert.Equal(t, "description", created.Description)
+	assert.Equal(t, userId, created.UserId)
+	assert.Less(t, time.Since(created.CreatedAt), time.Minute)
+	assert.Equal(t, created.CreatedAt, created.UpdatedAt)
+
+	links, rErr := r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	require.Len(t, links, 1)
+	assert.Equal(t, *created, links[linkId])
+
+	updated, rErr := r.Link.UpdateLink(userId, projectId, linkId, record.LinkWithoutAutofieldEntry{
+		From:     to,
+		To:       from,
+		Relation: "depends on",
+	})
+	require.Nil(t, rErr)
+	assert.Equal(t, to, updated.From)
+	assert.Equal(t, from, updated.To)
+	assert.Equal(t, "depends on", updated.Relation)
+	assert.Empty(t, updated.Description)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+	rErr = r.Link.DeleteLink(userId, projectId, linkId)
+	require.Nil(t, rErr)
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Empty(t, links)
+}
+
+func testLinkInvalidArgument(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+	sectionId, nodeId := insertLinkableGraph(t, r, userId, projectId, chapterId)
+
+	valid := record.LinkEndpointEntry{ChapterId: chapterId, SectionId: sectionId, NodeId: nodeId}
+	for _, endpoint := range []record.LinkEndpointEntry{
+		{ChapterId: "UNKNOWN_CHAPTER", SectionId: sectionId},
+		{ChapterId: chapterId, SectionId: "UNKNOWN_SECTION"},
+		{ChapterId: chapterId, SectionId: sectionId, NodeId: "UNKNOWN_NODE"},
+	} {
+		_, _, rErr := r.Link.InsertLink(userId, projectId, record.LinkWithoutAutofieldEntry{
+			From:     valid,
+			To:       endpoint,
+			Relation: "related to",
+		})
+		assertError(t, rErr, repository.InvalidArgumentError, "link endpoint does not exist")
+	}
+
+	linkId, _, rErr := r.Link.InsertLink(userId, projectId, record.LinkWithoutAutofieldEntry{
+		From:     valid,
+		To:       record.LinkEndpointEntry{ChapterId: chapterId, SectionId: sectionId},
+		Relation: "related to",
+	})
+	require.Nil(t, rErr)
+
+	_, rErr = r.Link.UpdateLink(userId, projectId, linkId, record.LinkWithoutAutofieldEntry{
+		From:     record.LinkEndpointEntry{ChapterId: chapterId, SectionId: "UNKNOWN_SECTION"},
+		To:       valid,
+		Relation: "related to",
+	})
+	assertError(t, rErr, repository.InvalidArgumentError, "link endpoint does not exist")
+}
+
+func testLinkNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+	sectionId, nodeId := insertLinkableGraph(t, r, userId, projectId, chapterId)
+
+	entry := record.LinkWithoutAutofieldEntry{
+		From:     record.LinkEndpointEntry{ChapterId: chapterId, SectionId: sectionId},
+		To:       record.LinkEndpointEntry{ChapterId: chapterId, SectionId: sectionId, NodeId: nodeId},
+		Relation: "related to",
+	}
+
+	link, rErr := r.Link.UpdateLink(userId, projectId, "UNKNOWN_LINK", entry)
+	assert.Nil(t, link)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch link")
+
+	rErr = r.Link.DeleteLink(userId, projectId, "UNKNOWN_LINK")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch link")
+
+	_, rErr = r.Link.FetchLinks(userId+"-other", projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	_, _, rErr = r.Link.InsertLink(userId+"-other", projectId, entry)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+}
+
+func testLinkCleanup(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterOneId := insertChapter(t, r, userId, projectId, "Chapter One", 1)
+	chapterTwoId := insertChapter(t, r, userId, projectId, "Chapter Two", 2)
+	sectionOneId, nodeOneId := insertLinkableGraph(t, r, userId, projectId, chapterOneId)
+	sectionTwoId, nodeTwoId := insertLinkableGraph(t, r, userId, projectId, chapterTwoId)
+
+	chapterOne, rErr := r.Chapter.FetchChapter(userId, projectId, chapterOneId)
+	require.Nil(t, rErr)
+	sectionThreeId := chapterOne.Sections[1].Id
+
+	insertLink := func(from record.LinkEndpointEntry, to record.LinkEndpointEntry) string {
+		linkId, _, rErr := r.Link.InsertLink(userId, projectId, record.LinkWithoutAutofieldEntry{
+			From:     from,
+			To:       to,
+			Relation: "related to",
+		})
+		require.Nil(t, rErr)
+		return linkId
+	}
+
+	one := record.LinkEndpointEntry{ChapterId: chapterOneId, SectionId: sectionOneId, NodeId: nodeOneId}
+	two := record.LinkEndpointEntry{ChapterId: chapterTwoId, SectionId: sectionTwoId, NodeId: nodeTwoId}
+	three := record.LinkEndpointEntry{ChapterId: chapterOneId, SectionId: sectionThreeId}
+
+	oneToTwo := insertLink(one, two)
+	twoToThree := insertLink(two, three)
+	threeToOne := insertLink(three, one)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterOneId, sectionThreeId)
+	require.Nil(t, rErr)
+
+	links, rErr := r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Len(t, links, 1)
+	assert.Contains(t, links, oneToTwo)
+	assert.NotContains(t, links, twoToThree)
+	assert.NotContains(t, links, threeToOne)
+
+	rErr = r.Chapter.TrashChapter(userId, projectId, chapterTwoId)
+	require.Nil(t, rErr)
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Empty(t, links)
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 2)
+	itemIds := make(map[string]string)
+	for id, item := range items {
+		itemIds[item.Type] = id
+	}
+
+	_, rErr = r.Trash.RestoreTrashItem(userId, itemIds[repository.TrashItemTypeChapter])
+	require.Nil(t, rErr)
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Len(t, links, 1)
+	assert.Contains(t, links, oneToTwo)
+
+	_, rErr = r.Trash.RestoreTrashItem(userId, itemIds[repository.TrashItemTypeSection])
+	require.Nil(t, rErr)
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Len(t, links, 3)
+
+	_, rErr = r.Graph.UpdateGraphChildren(userId, projectId, chapterOneId, sectionOneId,
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			return []record.GraphChildEntry{}, nil
+		})
+	require.Nil(t, rErr)
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Len(t, links, 1)
+	assert.Contains(t, links, twoToThree)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterOneId, sectionThreeId)
+	require.Nil(t, rErr)
+
+	items, rErr = r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 1)
+	for id := range items {
+		count, rErr := r.Trash.PurgeTrashItem(userId, id)
+		require.Nil(t, rErr)
+		// 1 graph + 1 link
+		assert.Equal(t, 2, count)
+	}
+
+	links, rErr = r.Link.FetchLinks(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Empty(t, links)
+}
+
+func testTrashChapter(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	_, rErr = r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "Updated Paragraph One",
+		Children:  []record.GraphChildEntry{},
+	}, nil)
+	require.Nil(t, rErr)
+
+	rErr = r.Chapter.TrashChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+
+	chapters, rErr := r.Chapter.FetchChapters(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Empty(t, chapters)
+
+	paper, rErr := r.Paper.FetchPaper(userId, projectId, chapterId)
+	assert.Nil(t, paper)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	_, rErr = r.Graph.GraphExists(userId, projectId, chapterId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 1)
+	for _, item := range items {
+		assert.Equal(t, repository.TrashItemTypeChapter, item.Type)
+		assert.Equal(t, "Chapter", item.Name)
+		assert.Equal(t, projectId, item.ProjectId)
+		assert.Equal(t, chapterId, item.ChapterId)
+		assert.Empty(t, item.SectionId)
+		assert.Equal(t, 0, item.Position)
+		assert.Equal(t, userId, item.UserId)
+		assert.Less(t, time.Since(item.DeletedAt), time.Minute)
+	}
+}
+
+func testDeleteProject(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterOne := insertChapter(t, r, userId, projectId, "Chapter One", 1)
+	insertChapter(t, r, userId, projectId, "Chapter Two", 2)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterOne, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	_, rErr = r.Paper.UpdatePaper(userId, projectId, chapterOne, record.PaperWithoutAutofieldEntry{
+		Content: "## Introduction",
+	}, nil)
+	require.Nil(t, rErr)
+
+	_, rErr = r.Graph.UpdateGraphContent(userId, projectId, chapterOne, ids[0], record.GraphContentEntry{
+		Paragraph: "Updated Paragraph One",
+		Children:  []record.GraphChildEntry{},
+	}, nil)
+	require.Nil(t, rErr)
+
+	insertAccessToken(t, r, userId, projectId, "-project")
+	otherTokenId := insertAccessToken(t, r, userId, "", "-other")
+
+	count, rErr := r.Project.DeleteProject(userId, projectId)
+	require.Nil(t, rErr)
+	// 1 project + 2 chapters + 2 papers + 1 paper revision + 2 graphs + 1 graph revision
+	assert.Equal(t, 9, count)
+
+	// access tokens scoped to the project are deleted along with it
+	tokens, rErr := r.AccessToken.FetchAccessTokens(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, tokens, 1)
+	assert.Contains(t, tokens, otherTokenId)
+
+	project, rErr := r.Project.FetchProject(userId, projectId)
+	assert.Nil(t, project)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	projects, rErr := r.Project.FetchProjects(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, projects)
+
+	deleting, rErr := r.Project.FetchDeletingProjects()
+	require.Nil(t, rErr)
+	assert.NotContains(t, deleting, projectId)
+
+	count, rErr = r.Project.DeleteProject(userId, projectId)
+	assert.Equal(t, 0, count)
+	assertError(t, rErr, repository.NotFoundError, "failed to delete project")
+}
+
+func testImportingProject(t *testing.T, r Repositories) {
+	userId := UserId()
+
+	projectId, _, rErr := r.Project.InsertImportingProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Imported Project",
+	})
+	require.Nil(t, rErr)
+
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter One", 1)
+	_, _, rErr = r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	project, rErr := r.Project.FetchProject(userId, projectId)
+	assert.Nil(t, project)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	projects, rErr := r.Project.FetchProjects(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, projects)
+
+	importing, rErr := r.Project.FetchImportingProjects(time.Now().Add(-time.Hour))
+	require.Nil(t, rErr)
+	assert.NotContains(t, importing, projectId)
+
+	importing, rErr = r.Project.FetchImportingProjects(time.Now().Add(time.Minute))
+	require.Nil(t, rErr)
+	assert.Contains(t, importing, projectId)
+
+	_, rErr = r.Project.FinishImportingProject(UserId()+"-other", projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	finished, rErr := r.Project.FinishImportingProject(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Imported Project", finished.Name)
+
+	projects, rErr = r.Project.FetchProjects(userId)
+	require.Nil(t, rErr)
+	assert.Equal(t, *finished, projects[projectId])
+
+	importing, rErr = r.Project.FetchImportingProjects(time.Now().Add(time.Minute))
+	require.Nil(t, rErr)
+	assert.NotContains(t, importing, projectId)
+
+	_, rErr = r.Project.FinishImportingProject(userId, projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+}
+
+func testUpdateConflict(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	project, rErr := r.Project.FetchProject(userId, projectId)
+	require.Nil(t, rErr)
+	stale := project.UpdatedAt.Add(-time.Second)
+
+	current, rErr := r.Project.UpdateProject(userId, projectId, record.ProjectWithoutAutofieldEntry{
+		Name: "Stale Project",
+	}, &stale)
+	assertError(t, rErr, repository.ConflictError, "project has been updated since it was fetched")
+	require.NotNil(t, current)
+	assert.Equal(t, *project, *current)
+
+	updatedProject, rErr := r.Project.UpdateProject(userId, projectId, record.ProjectWithoutAutofieldEntry{
+		Name: "Updated Project",
+	}, &project.UpdatedAt)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated Project", updatedProject.Name)
+
+	chapter, rErr := r.Chapter.FetchChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	stale = chapter.UpdatedAt.Add(-time.Second)
+
+	currentChapter, rErr := r.Chapter.UpdateChapter(userId, projectId, chapterId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Stale Chapter",
+		Number: 1,
+	}, &stale)
+	assertError(t, rErr, repository.ConflictError, "chapter has been updated since it was fetched")
+	require.NotNil(t, currentChapter)
+	assert.Equal(t, *chapter, *currentChapter)
+
+	updatedChapter, rErr := r.Chapter.UpdateChapter(userId, projectId, chapterId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Updated Chapter",
+		Number: 1,
+	}, &chapter.UpdatedAt)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated Chapter", updatedChapter.Name)
+
+	paper, rErr := r.Paper.FetchPaper(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+	stale = paper.UpdatedAt.Add(-time.Second)
+
+	currentPaper, rErr := r.Paper.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "Stale Content",
+	}, &stale)
+	assertError(t, rErr, repository.ConflictError, "paper has been updated since it was fetched")
+	require.NotNil(t, currentPaper)
+	assert.Equal(t, *paper, *currentPaper)
+
+	updatedPaper, rErr := r.Paper.UpdatePaper(userId, projectId, chapterId, record.PaperWithoutAutofieldEntry{
+		Content: "Updated Content",
+	}, &paper.UpdatedAt)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated Content", updatedPaper.Content)
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	stale = graph.UpdatedAt.Add(-time.Second)
+
+	currentGraph, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "Stale Paragraph",
+		Children:  []record.GraphChildEntry{},
+	}, &stale)
+	assertError(t, rErr, repository.ConflictError, "graph has been updated since it was fetched")
+	require.NotNil(t, currentGraph)
+	assert.Equal(t, *graph, *currentGraph)
+
+	updatedGraph, rErr := r.Graph.UpdateGraphContent(userId, projectId, chapterId, ids[0], record.GraphContentEntry{
+		Paragraph: "Updated Paragraph",
+		Children:  []record.GraphChildEntry{},
+	}, &graph.UpdatedAt)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Updated Paragraph", updatedGraph.Paragraph)
+}
+
+func testTrashProject(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	rErr := r.Project.TrashProject(userId, projectId)
+	require.Nil(t, rErr)
+
+	project, rErr := r.Project.FetchProject(userId, projectId)
+	assert.Nil(t, project)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	projects, rErr := r.Project.FetchProjects(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, projects)
+
+	_, rErr = r.Chapter.FetchChapters(userId, projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	rErr = r.Project.TrashProject(userId, projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 1)
+
+	var itemId string
+	for id, item := range items {
+		itemId = id
+		assert.Equal(t, repository.TrashItemTypeProject, item.Type)
+		assert.Equal(t, "Project", item.Name)
+		assert.Equal(t, projectId, item.ProjectId)
+		assert.Empty(t, item.ChapterId)
+		assert.Empty(t, item.SectionId)
+	}
+
+	_, rErr = r.Trash.RestoreTrashItem(userId+"-other", itemId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch trash item")
+
+	restored, rErr := r.Trash.RestoreTrashItem(userId, itemId)
+	require.Nil(t, rErr)
+	assert.Equal(t, items[itemId], *restored)
+
+	project, rErr = r.Project.FetchProject(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Equal(t, "Project", project.Name)
+	assertChapterOrder(t, r, userId, projectId, []string{chapterId})
+
+	items, rErr = r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, items)
+
+	_, rErr = r.Trash.RestoreTrashItem(userId, itemId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch trash item")
+}
+
+func testTrashRestorePosition(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	idA := insertChapter(t, r, userId, projectId, "A", 1)
+	idB := insertChapter(t, r, userId, projectId, "B", 2)
+	idC := insertChapter(t, r, userId, projectId, "C", 3)
+
+	rErr := r.Chapter.TrashChapter(userId, projectId, idC)
+	require.Nil(t, rErr)
+	rErr = r.Chapter.TrashChapter(userId, projectId, idA)
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idB})
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, idB, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+		{Name: "Section Three", Paragraph: "Paragraph Three", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, idB, ids[1])
+	require.Nil(t, rErr)
+
+	chapter, rErr := r.Chapter.FetchChapter(userId, projectId, idB)
+	require.Nil(t, rErr)
+	require.Len(t, chapter.Sections, 2)
+	assert.Equal(t, ids[0], chapter.Sections[0].Id)
+	assert.Equal(t, ids[2], chapter.Sections[1].Id)
+
+	exists, rErr := r.Graph.GraphExists(userId, projectId, idB)
+	require.Nil(t, rErr)
+	assert.True(t, exists)
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 3)
+
+	itemIds := make(map[string]string)
+	for id, item := range items {
+		switch item.Type {
+		case repository.TrashItemTypeChapter:
+			itemIds[item.ChapterId] = id
+		case repository.TrashItemTypeSection:
+			itemIds[item.SectionId] = id
+		}
+	}
+	assert.Equal(t, 2, items[itemIds[idC]].Position)
+	assert.Equal(t, 0, items[itemIds[idA]].Position)
+	assert.Equal(t, 1, items[itemIds[ids[1]]].Position)
+	assert.Equal(t, "Section Two", items[itemIds[ids[1]]].Name)
+	assert.Equal(t, idB, items[itemIds[ids[1]]].ChapterId)
+
+	// the former position is clamped to the current number of chapters
+	_, rErr = r.Trash.RestoreTrashItem(userId, itemIds[idC])
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idB, idC})
+
+	_, rErr = r.Trash.RestoreTrashItem(userId, itemIds[idA])
+	require.Nil(t, rErr)
+	assertChapterOrder(t, r, userId, projectId, []string{idA, idB, idC})
+
+	restored, rErr := r.Trash.RestoreTrashItem(userId, itemIds[ids[1]])
+	require.Nil(t, rErr)
+	assert.Equal(t, repository.TrashItemTypeSection, restored.Type)
+
+	chapter, rErr = r.Chapter.FetchChapter(userId, projectId, idB)
+	require.Nil(t, rErr)
+	require.Len(t, chapter.Sections, 3)
+	assert.Equal(t, ids[0], chapter.Sections[0].Id)
+	assert.Equal(t, ids[1], chapter.Sections[1].Id)
+	assert.Equal(t, "Section Two", chapter.Sections[1].Name)
+	assert.Equal(t, ids[2], chapter.Sections[2].Id)
+
+	graph, rErr := r.Graph.FetchGraph(userId, projectId, idB, ids[1])
+	require.Nil(t, rErr)
+	assert.Equal(t, "Paragraph Two", graph.Paragraph)
+}
+
+func testTrashNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterId, ids[0])
+	require.Nil(t, rErr)
+	rErr = r.Chapter.TrashChapter(userId, projectId, chapterId)
+	require.Nil(t, rErr)
+
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 2)
+
+	// a section cannot be restored while its chapter is in the trash
+	for id, item := range items {
+		if item.Type == repository.TrashItemTypeSection {
+			_, rErr = r.Trash.RestoreTrashItem(userId, id)
+			assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+		}
+	}
+
+	_, rErr = r.Trash.RestoreTrashItem(userId, "UNKNOWN_ITEM")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch trash item")
+
+	count, rErr := r.Trash.PurgeTrashItem(userId, "UNKNOWN_ITEM")
+	assert.Equal(t, 0, count)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch trash item")
+
+	items, rErr = r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, items, 2)
+}
+
+func testTrashPurge(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterOne := insertChapter(t, r, userId, projectId, "Chapter One", 1)
+	chapterTwo := insertChapter(t, r, userId, projectId, "Chapter Two", 2)
+
+	ids, _, rErr := r.Graph.InsertGraphs(userId, projectId, chapterOne, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+
+	rErr = r.Graph.TrashGraph(userId, projectId, chapterOne, ids[0])
+	require.Nil(t, rErr)
+	rErr = r.Chapter.TrashChapter(userId, projectId, chapterOne)
+	require.Nil(t, rErr)
+
+	expired, rErr := r.Trash.FetchExpiredTrashItems(time.Now().Add(-time.Hour))
+	require.Nil(t, rErr)
+	for _, item := range expired {
+		assert.NotEqual(t, userId, item.UserId)
+	}
+
+	expired, rErr = r.Trash.FetchExpiredTrashItems(time.Now().Add(time.Minute))
+	require.Nil(t, rErr)
+
+	var chapterItemId string
+	for id, item := range expired {
+		if item.UserId == userId && item.Type == repository.TrashItemTypeChapter {
+			chapterItemId = id
+		}
+	}
+	require.NotEmpty(t, chapterItemId)
+
+	count, rErr := r.Trash.PurgeTrashItem(userId, chapterItemId)
+	require.Nil(t, rErr)
+	// 1 chapter + 1 paper + 2 graphs
+	assert.Equal(t, 4, count)
+
+	purging, rErr := r.Trash.FetchPurgingTrashItems()
+	require.Nil(t, rErr)
+	assert.NotContains(t, purging, chapterItemId)
+
+	// the entry of the section in the purged chapter is removed along with it
+	items, rErr := r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, items)
+	assertChapterOrder(t, r, userId, projectId, []string{chapterTwo})
+
+	insertAccessToken(t, r, userId, projectId, "-project")
+
+	rErr = r.Project.TrashProject(userId, projectId)
+	require.Nil(t, rErr)
+
+	items, rErr = r.Trash.FetchTrashItems(userId)
+	require.Nil(t, rErr)
+	require.Len(t, items, 1)
+
+	for id := range items {
+		count, rErr = r.Trash.PurgeTrashItem(userId, id)
+		require.Nil(t, rErr)
+		// 1 project + 1 chapter + 1 paper
+		assert.Equal(t, 3, count)
+
+		_, rErr = r.Trash.RestoreTrashItem(userId, id)
+		assertError(t, rErr, repository.NotFoundError, "failed to fetch trash item")
+	}
+
+	tokens, rErr := r.AccessToken.FetchAccessTokens(userId)
+	require.Nil(t, rErr)
+	assert.Empty(t, tokens)
+
+	count, rErr = r.Project.DeleteProject(userId, projectId)
+	assert.Equal(t, 0, count)
+	assertError(t, rErr, repository.NotFoundError, "failed to delete project")
+}
+
+func testShare(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	chapterId := insertChapter(t, r, userId, projectId, "Chapter", 1)
+	expiresAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	projectShareId, projectShare, rErr := r.Share.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{})
+	require.Nil(t, rErr)
+	assert.Len(t, projectShareId, 40)
+	assert.Equal(t, projectId, projectShare.ProjectId)
+	assert.Empty(t, projectShare.ChapterId)
+	assert.Nil(t, projectShare.ExpiresAt)
+	assert.Equal(t, userId, projectShare.UserId)
+
+	chapterShareId, chapterShare, rErr := r.Share.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{
+		ChapterId: chapterId,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(t, rErr)
+	assert.NotEqual(t, projectShareId, chapterShareId)
+	assert.Equal(t, chapterId, chapterShare.ChapterId)
+	assert.Equal(t, expiresAt, *chapterShare.ExpiresAt)
+
+	shares, rErr := r.Share.FetchShares(userId, projectId)
+	require.Nil(t, rErr)
+	assert.Len(t, shares, 2)
+	assert.Equal(t, *projectShare, shares[projectShareId])
+	assert.Equal(t, *chapterShare, shares[chapterShareId])
+
+	fetched, rErr := r.Share.FetchShare(chapterShareId)
+	require.Nil(t, rErr)
+	assert.Equal(t, *chapterShare, *fetched)
+
+	rErr = r.Share.DeleteShare(userId, projectId, projectShareId)
+	require.Nil(t, rErr)
+
+	_, rErr = r.Share.FetchShare(projectShareId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch share")
+
+	_, rErr = r.Project.DeleteProject(userId, projectId)
+	require.Nil(t, rErr)
+
+	_, rErr = r.Share.FetchShare(chapterShareId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch share")
+}
+
+func testShareNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	otherId, otherProjectId := insertProject(t, r)
+	editorId := UserId() + "-editor"
+	_, rErr := r.Project.UpdateProjectMember(userId, projectId, editorId, repository.ProjectRoleEditor)
+	require.Nil(t, rErr)
+
+	shareId, _, rErr := r.Share.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{})
+	require.Nil(t, rErr)
+
+	_, _, rErr = r.Share.InsertShare(editorId, projectId, record.ShareWithoutAutofieldEntry{})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	_, _, rErr = r.Share.InsertShare(userId, projectId, record.ShareWithoutAutofieldEntry{
+		ChapterId: "UNKNOWN_CHAPTER",
+	})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch chapter")
+
+	_, rErr = r.Share.FetchShares(editorId, projectId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	rErr = r.Share.DeleteShare(editorId, projectId, shareId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+	rErr = r.Share.DeleteShare(otherId, otherProjectId, shareId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch share")
+
+	_, rErr = r.Share.FetchShare("UNKNOWN_SHARE")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch share")
+}
+
+func testAccessToken(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	expiresAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	tokenHash := UserId() + "-hash"
+
+	readTokenId, readToken, rErr := r.AccessToken.InsertAccessToken(userId, record.AccessTokenWithoutAutofieldEntry{
+		Name:      "CI",
+		Scope:     "read",
+		ProjectId: projectId,
+		TokenHash: tokenHash,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(t, rErr)
+	assert.Equal(t, "CI", readToken.Name)
+	assert.Equal(t, "read", readToken.Scope)
+	assert.Equal(t, projectId, readToken.ProjectId)
+	assert.Equal(t, expiresAt, *readToken.ExpiresAt)
+	assert.Equal(t, userId, readToken.UserId)
+
+	writeTokenId, writeToken, rErr := r.AccessToken.InsertAccessToken(userId, record.AccessTokenWithoutAutofieldEntry{
+		Name:      "Script",
+		Scope:     "write",
+		TokenHash: tokenHash + "-write",
+	})
+	require.Nil(t, rErr)
+	assert.NotEqual(t, readTokenId, writeTokenId)
+	assert.Empty(t, writeToken.ProjectId)
+	assert.Nil(t, writeToken.ExpiresAt)
+
+	tokens, rErr := r.AccessToken.FetchAccessTokens(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, tokens, 2)
+	assert.Equal(t, *readToken, tokens[readTokenId])
+	assert.Equal(t, *writeToken, tokens[writeTokenId])
+
+	fetchedId, fetched, rErr := r.AccessToken.FetchAccessTokenByHash(tokenHash)
+	require.Nil(t, rErr)
+	assert.Equal(t, readTokenId, fetchedId)
+	assert.Equal(t, *readToken, *fetched)
+
+	rErr = r.AccessToken.DeleteAccessToken(userId, readTokenId)
+	require.Nil(t, rErr)
+
+	_, _, rErr = r.AccessToken.FetchAccessTokenByHash(tokenHash)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch access token")
+
+	tokens, rErr = r.AccessToken.FetchAccessTokens(userId)
+	require.Nil(t, rErr)
+	assert.Len(t, tokens, 1)
+}
+
+func testAccessTokenNotFound(t *testing.T, r Repositories) {
+	userId, projectId := insertProject(t, r)
+	otherId, _ := insertProject(t, r)
+
+	_, _, rErr := r.AccessToken.InsertAccessToken(otherId, record.AccessTokenWithoutAutofieldEntry{
+		Name:      "Other",
+		Scope:     "read",
+		ProjectId: projectId,
+		TokenHash: UserId() + "-hash",
+	})
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch project")
+
+	tokenId, _, rErr := r.AccessToken.InsertAccessToken(userId, record.AccessTokenWithoutAutofieldEntry{
+		Name:      "Mine",
+		Scope:     "read",
+		TokenHash: UserId() + "-hash",
+	})
+	require.Nil(t, rErr)
+
+	rErr = r.AccessToken.DeleteAccessToken(otherId, tokenId)
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch access token")
+	rErr = r.AccessToken.DeleteAccessToken(userId, "UNKNOWN_ACCESS_TOKEN")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch access token")
+
+	tokens, rErr := r.AccessToken.FetchAccessTokens(otherId)
+	require.Nil(t, rErr)
+	assert.Empty(t, tokens)
+
+	_, _, rErr = r.AccessToken.FetchAccessTokenByHash("UNKNOWN_HASH")
+	assertError(t, rErr, repository.NotFoundError, "failed to fetch access token")
+}
+
+func insertProject(t *testing.T, r Repositories) (string, string) {
+	userId := UserId()
+	projectId, _, rErr := r.Project.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project",
+	})
+	require.Nil(t, rErr)
+	return userId, projectId
+}
+
+func insertChapter(t *testing.T, r Repositories, userId string, projectId string, name string, number int) string {
+	chapterId, entry, rErr := r.Chapter.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   name,
+		Number: number,
+	})
+	require.Nil(t, rErr)
+	assert.Equal(t, name, entry.Name)
+	assert.Equal(t, number, entry.Number)
+	return chapterId
+}
+
+// insertAccessToken inserts a write token of the user, scoped to the project unless projectId is empty
+func insertAccessToken(t *testing.T, r Repositories, userId string, projectId string, hashSuffix string) string {
+	tokenId, _, rErr := r.AccessToken.InsertAccessToken(userId, record.AccessTokenWithoutAutofieldEntry{
+		Name:      "Token",
+		Scope:     "write",
+		ProjectId: projectId,
+		TokenHash: userId + hashSuffix,
+	})
+	require.Nil(t, rErr)
+	return tokenId
+}
+
+// insertLinkableGraph inserts two sections with a child node into the chapter,
+// and returns the id of the first section and the id of its child node
+func insertLinkableGraph(t *testing.T, r Repositories, userId string, projectId string, chapterId string) (string, string) {
+	ids, entries, rErr := r.Graph.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "Paragraph One", Children: []record.GraphChildEntry{
+			{Name: "Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+		}},
+		{Name: "Section Two", Paragraph: "Paragraph Two", Children: []record.GraphChildEntry{}},
+	})
+	require.Nil(t, rErr)
+	return ids[0], entries[0].Children[0].Id
+}
+
+func assertChapterOrder(t *testing.T, r Repositories, userId string, projectId string, expected []string) {
+	chapters, rErr := r.Chapter.FetchChapters(userId, projectId)
+	require.Nil(t, rErr)
+	require.Len(t, chapters, len(expected))
+	for i, chapterId := range expected {
+		assert.Equal(t, i+1, chapters[chapterId].Number, "number of chapter %s", chapterId)
+	}
+}
+
+// AssertGraphChildren asserts that actual equals expected except for the ids assigned by the repository
+func AssertGraphChildren(t *testing.T, expected []record.GraphChildEntry, actual []record.GraphChildEntry) {
+	t.Helper()
+	assert.Equal(t, expected, withoutGraphChildIds(t, actual))
+}
+
+func withoutGraphChildIds(t *testing.T, children []record.GraphChildEntry) []record.GraphChildEntry {
+	t.Helper()
+	if children == nil {
+		return nil
+	}
+	entries := make([]record.GraphChildEntry, len(children))
+	for i, child := range children {
+		assert.NotEmpty(t, child.Id)
+		entries[i] = child
+		entries[i].Id = ""
+		entries[i].Children = withoutGraphChildIds(t, child.Children)
+	}
+	return entries
+}
+
+func assertError(t *testing.T, rErr *repository.Error, code repository.ErrorCode, message string) {
+	t.Helper()
+	if !assert.NotNil(t, rErr) {
+		return
+	}
+	assert.Equal(t, code, rErr.Code())
+	assert.Equal(t, fmt.Sprintf("%v: %v", code, message), rErr.Error())
+}
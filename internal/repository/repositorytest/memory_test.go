@@ -0,0 +1,24 @@
+package repositorytest_test
+
+import (
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository/repositorytest"
+)
+
+func TestMemoryConformance(t *testing.T) {
+	repositorytest.RunConformance(t, func(t *testing.T) repositorytest.Repositories {
+		store := repository.NewMemoryStore()
+		return repositorytest.Repositories{
+			Project:     repository.NewMemoryProjectRepository(store),
+			Chapter:     repository.NewMemoryChapterRepository(store),
+			Paper:       repository.NewMemoryPaperRepository(store),
+			Graph:       repository.NewMemoryGraphRepository(store),
+			Trash:       repository.NewMemoryTrashRepository(store),
+			Link:        repository.NewMemoryLinkRepository(store),
+			Share:       repository.NewMemoryShareRepository(store),
+			AccessToken: repository.NewMemoryAccessTokenRepository(store),
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package repositorytest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository/repositorytest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteConformance(t *testing.T) {
+	repositorytest.RunConformance(t, func(t *testing.T) repositorytest.Repositories {
+		database, err := db.OpenSQLDatabase(db.SQLiteDriver, filepath.Join(t.TempDir(), "knodeledge.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { database.DB.Close() })
+
+		return sqlRepositories(*database)
+	})
+}
+
+func TestPostgresConformance(t *testing.T) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN is not set")
+	}
+
+	repositorytest.RunConformance(t, func(t *testing.T) repositorytest.Repositories {
+		database, err := db.OpenSQLDatabase(db.PostgresDriver, dsn)
+		require.NoError(t, err)
+		t.Cleanup(func() { database.DB.Close() })
+
+		return sqlRepositories(*database)
+	})
+}
+
+func TestSQLiteMigrationIsIdempotent(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "knodeledge.db")
+
+	for range 2 {
+		database, err := db.OpenSQLDatabase(db.SQLiteDriver, dsn)
+		require.NoError(t, err)
+		require.NoError(t, database.DB.Close())
+	}
+}
+
+func TestSQLiteForeignKeysOnEveryConnection(t *testing.T) {
+	database, err := db.OpenSQLDatabase(db.SQLiteDriver, filepath.Join(t.TempDir(), "knodeledge.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { database.DB.Close() })
+
+	// no idle connection is kept, so every query runs on a newly opened connection
+	database.DB.SetMaxIdleConns(0)
+
+	for range 2 {
+		var enabled int
+		require.NoError(t, database.DB.QueryRow("PRAGMA foreign_keys").Scan(&enabled))
+		require.Equal(t, 1, enabled)
+	}
+}
+
+func sqlRepositories(database db.SQLDatabase) repositorytest.Repositories {
+	return repositorytest.Repositories{
+		Project:     repository.NewSQLProjectRepository(database),
+		Chapter:     repository.NewSQLChapterRepository(database),
+		Paper:       repository.NewSQLPaperRepository(database),
+		Graph:       repository.NewSQLGraphRepository(database),
+		Trash:       repository.NewSQLTrashRepository(database),
+		Link:        repository.NewSQLLinkRepository(database),
+		Share:       repository.NewSQLShareRepository(database),
+		AccessToken: repository.NewSQLAccessTokenRepository(database),
+	}
+}
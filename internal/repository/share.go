@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"cloud.google.com/go/firestore"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const ShareCollection = "shares"
+
+type ShareRepository interface {
+	FetchShares(
+		userId string,
+		projectId string,
+	) (map[string]record.ShareEntry, *Error)
+	FetchShare(
+		shareId string,
+	) (*record.ShareEntry, *Error)
+	InsertShare(
+		userId string,
+		projectId string,
+		entry record.ShareWithoutAutofieldEntry,
+	) (string, *record.ShareEntry, *Error)
+	DeleteShare(
+		userId string,
+		projectId string,
+		shareId string,
+	) *Error
+}
+
+type shareRepository struct {
+	client            firestore.Client
+	chapterRepository chapterRepository
+}
+
+func NewShareRepository(client firestore.Client) ShareRepository {
+	return shareRepository{
+		client:            client,
+		chapterRepository: chapterRepository{client: client},
+	}
+}
+
+func (r shareRepository) FetchShares(
+	userId string,
+	projectId string,
+) (map[string]record.ShareEntry, *Error) {
+	_, rErr := r.chapterRepository.projectValues(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	iter := r.client.Collection(ShareCollection).
+		Where("projectId", "==", projectId).
+		Documents(db.FirestoreContext())
+
+	entries := make(map[string]record.ShareEntry)
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch shares: %w", err)
+		}
+
+		var values document.ShareValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r shareRepository) FetchShare(
+	shareId string,
+) (*record.ShareEntry, *Error) {
+	snapshot, err := r.client.Collection(ShareCollection).
+		Doc(shareId).
+		Get(db.FirestoreContext())
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch share")
+	}
+
+	var values document.ShareValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	return r.valuesToEntry(values), nil
+}
+
+func (r shareRepository) InsertShare(
+	userId string,
+	projectId string,
+	entry record.ShareWithoutAutofieldEntry,
+) (string, *record.ShareEntry, *Error) {
+	if entry.ChapterId == "" {
+		_, rErr := r.chapterRepository.projectValues(userId, projectId, ownerAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	} else {
+		_, rErr := r.chapterRepository.fetchChapter(userId, projectId, entry.ChapterId, ownerAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	}
+
+	ref := r.client.Collection(ShareCollection).
+		Doc(newToken())
+
+	values := map[string]any{
+		"projectId": projectId,
+		"userId":    userId,
+		"createdAt": firestore.ServerTimestamp,
+	}
+	if entry.ChapterId != "" {
+		values["chapterId"] = entry.ChapterId
+	}
+	if entry.ExpiresAt != nil {
+		values["expiresAt"] = *entry.ExpiresAt
+	}
+
+	_, err := ref.Create(db.FirestoreContext(), values)
+	if err != nil {
+		return "", nil, Errorf(WriteFailurePanic, "failed to insert share: %w", err)
+	}
+
+	created, rErr := r.FetchShare(ref.ID)
+	if rErr != nil {
+		return "", nil, Errorf(ReadFailurePanic, "failed to fetch inserted share: %w", rErr.Unwrap())
+	}
+
+	return ref.ID, created, nil
+}
+
+func (r shareRepository) DeleteShare(
+	userId string,
+	projectId string,
+	shareId string,
+) *Error {
+	_, rErr := r.chapterRepository.projectValues(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	entry, rErr := r.FetchShare(shareId)
+	if rErr != nil {
+		return rErr
+	}
+
+	if entry.ProjectId != projectId {
+		return Errorf(NotFoundError, "failed to fetch share")
+	}
+
+	_, err := r.client.Collection(ShareCollection).
+		Doc(shareId).
+		Delete(db.FirestoreContext())
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to delete share: %w", err)
+	}
+
+	return nil
+}
+
+func (r shareRepository) valuesToEntry(
+	values document.ShareValues,
+) *record.ShareEntry {
+	return &record.ShareEntry{
+		ProjectId: values.ProjectId,
+		ChapterId: values.ChapterId,
+		ExpiresAt: values.ExpiresAt,
+		UserId:    values.UserId,
+		CreatedAt: values.CreatedAt,
+	}
+}
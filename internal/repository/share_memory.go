@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryShareRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryShareRepository(store *MemoryStore) ShareRepository {
+	return memoryShareRepository{store: store}
+}
+
+func (r memoryShareRepository) FetchShares(
+	userId string,
+	projectId string,
+) (map[string]record.ShareEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, rErr := r.store.project(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	entries := make(map[string]record.ShareEntry)
+	for id, values := range r.store.shares {
+		if values.ProjectId == projectId {
+			entries[id] = *r.valuesToEntry(values)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r memoryShareRepository) FetchShare(
+	shareId string,
+) (*record.ShareEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	values, ok := r.store.shares[shareId]
+	if !ok {
+		return nil, Errorf(NotFoundError, "failed to fetch share")
+	}
+
+	return r.valuesToEntry(values), nil
+}
+
+func (r memoryShareRepository) InsertShare(
+	userId string,
+	projectId string,
+	entry record.ShareWithoutAutofieldEntry,
+) (string, *record.ShareEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if entry.ChapterId == "" {
+		_, rErr := r.store.project(userId, projectId, ownerAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	} else {
+		_, _, _, rErr := r.store.chapter(userId, projectId, entry.ChapterId, ownerAccess)
+		if rErr != nil {
+			return "", nil, rErr
+		}
+	}
+
+	id := newToken()
+	values := document.ShareValues{
+		ProjectId: projectId,
+		ChapterId: entry.ChapterId,
+		ExpiresAt: entry.ExpiresAt,
+		UserId:    userId,
+		CreatedAt: currentTime(),
+	}
+	r.store.shares[id] = values
+
+	return id, r.valuesToEntry(values), nil
+}
+
+func (r memoryShareRepository) DeleteShare(
+	userId string,
+	projectId string,
+	shareId string,
+) *Error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	_, rErr := r.store.project(userId, projectId, ownerAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	values, ok := r.store.shares[shareId]
+	if !ok || values.ProjectId != projectId {
+		return Errorf(NotFoundError, "failed to fetch share")
+	}
+
+	delete(r.store.shares, shareId)
+	return nil
+}
+
+func (r memoryShareRepository) valuesToEntry(
+	values document.ShareValues,
+) *record.ShareEntry {
+	return &record.ShareEntry{
+		ProjectId: values.ProjectId,
+		ChapterId: values.ChapterId,
+		ExpiresAt: values.ExpiresAt,
+		UserId:    values.UserId,
+		CreatedAt: values.CreatedAt,
+	}
+}
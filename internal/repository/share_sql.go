@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const sqlShareColumns = "id, project_id, chapter_id, expires_at, user_id, created_at"
+
+type sqlShareRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLShareRepository(database db.SQLDatabase) ShareRepository {
+	return sqlShareRepository{database: database}
+}
+
+func (r sqlShareRepository) FetchShares(
+	userId string,
+	projectId string,
+) (map[string]record.ShareEntry, *Error) {
+	rErr := sqlCheckProject(r.database, r.database.DB, userId, projectId, ownerAccess)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlShareColumns+" FROM shares WHERE project_id = ?"), projectId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch shares: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]record.ShareEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch shares: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlShareRepository) FetchShare(
+	shareId string,
+) (*record.ShareEntry, *Error) {
+	_, entry, err := r.scanEntry(r.database.DB.QueryRow(r.database.Rebind(
+		"SELECT "+sqlShareColumns+" FROM shares WHERE id = ?"), shareId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch share")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch share: %w", err)
+	}
+	return entry, nil
+}
+
+func (r sqlShareRepository) InsertShare(
+	userId string,
+	projectId string,
+	entry record.ShareWithoutAutofieldEntry,
+) (string, *record.ShareEntry, *Error) {
+	id := newToken()
+	now := currentTime()
+
+	var expiresAt sql.NullTime
+	if entry.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: entry.ExpiresAt.UTC(), Valid: true}
+	}
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		var rErr *Error
+		if entry.ChapterId == "" {
+			rErr = sqlCheckProject(r.database, tx, userId, projectId, ownerAccess)
+		} else {
+			rErr = sqlCheckChapter(r.database, tx, userId, projectId, entry.ChapterId, ownerAccess)
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind(
+			"INSERT INTO shares ("+sqlShareColumns+") VALUES (?, ?, ?, ?, ?, ?)"),
+			id, projectId, entry.ChapterId, expiresAt, userId, now)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to insert share: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return "", nil, rErr
+	}
+
+	return id, &record.ShareEntry{
+		ProjectId: projectId,
+		ChapterId: entry.ChapterId,
+		ExpiresAt: entry.ExpiresAt,
+		UserId:    userId,
+		CreatedAt: now,
+	}, nil
+}
+
+func (r sqlShareRepository) DeleteShare(
+	userId string,
+	projectId string,
+	shareId string,
+) *Error {
+	return runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		rErr := sqlCheckProject(r.database, tx, userId, projectId, ownerAccess)
+		if rErr != nil {
+			return rErr
+		}
+
+		result, err := tx.Exec(r.database.Rebind("DELETE FROM shares WHERE project_id = ? AND id = ?"),
+			projectId, shareId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete share: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete share: %w", err)
+		}
+		if affected == 0 {
+			return Errorf(NotFoundError, "failed to fetch share")
+		}
+		return nil
+	})
+}
+
+func (r sqlShareRepository) scanEntry(
+	row interface{ Scan(dest ...any) error },
+) (string, *record.ShareEntry, error) {
+	var id string
+	var expiresAt sql.NullTime
+	var entry record.ShareEntry
+	err := row.Scan(&id, &entry.ProjectId, &entry.ChapterId, &expiresAt, &entry.UserId, &entry.CreatedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if expiresAt.Valid {
+		value := expiresAt.Time.UTC()
+		entry.ExpiresAt = &value
+	}
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	return id, &entry, nil
+}
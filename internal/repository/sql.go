@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type sqlQuerier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+func runSQLTransaction(database db.SQLDatabase, fn func(tx *sql.Tx) *Error) *Error {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to begin transaction: %w", err)
+	}
+
+	if rErr := fn(tx); rErr != nil {
+		_ = tx.Rollback()
+		return rErr
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Errorf(WriteFailurePanic, "failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func sqlCheckProject(
+	database db.SQLDatabase,
+	q sqlQuerier,
+	userId string,
+	projectId string,
+	access projectAccess,
+) *Error {
+	query := "SELECT user_id, deleting, trashed FROM projects WHERE id = ?"
+	if access != readAccess {
+		query += database.ForUpdate()
+	}
+
+	var ownerId string
+	var deleting, trashed bool
+	err := q.QueryRow(database.Rebind(query), projectId).Scan(&ownerId, &deleting, &trashed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch project: %w", err)
+	}
+
+	if deleting || trashed {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	role, rErr := sqlProjectRole(database, q, ownerId, userId, projectId)
+	if rErr != nil {
+		return rErr
+	}
+
+	if !roleHasAccess(role, access) {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+	return nil
+}
+
+// sqlProjectRole returns the role of the user in the project, or empty string
+// when the user is neither the owner nor a member
+func sqlProjectRole(
+	database db.SQLDatabase,
+	q sqlQuerier,
+	ownerId string,
+	userId string,
+	projectId string,
+) (string, *Error) {
+	if ownerId == userId {
+		return ProjectRoleOwner, nil
+	}
+
+	var role string
+	err := q.QueryRow(database.Rebind("SELECT role FROM project_members WHERE project_id = ? AND user_id = ?"),
+		projectId, userId).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", Errorf(ReadFailurePanic, "failed to fetch project member: %w", err)
+	}
+	return role, nil
+}
+
+func sqlCheckChapter(
+	database db.SQLDatabase,
+	q sqlQuerier,
+	userId string,
+	projectId string,
+	chapterId string,
+	access projectAccess,
+) *Error {
+	rErr := sqlCheckProject(database, q, userId, projectId, access)
+	if rErr != nil {
+		return rErr
+	}
+
+	var count int
+	err := q.QueryRow(database.Rebind("SELECT COUNT(*) FROM chapters WHERE project_id = ? AND id = ? AND trashed = FALSE"),
+		projectId, chapterId).Scan(&count)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch chapter: %w", err)
+	}
+
+	if count == 0 {
+		return Errorf(NotFoundError, "failed to fetch chapter")
+	}
+	return nil
+}
+
+func sqlDeleteGraphs(database db.SQLDatabase, q sqlQuerier, chapterCondition string, args ...any) *Error {
+	queries := []string{
+		"DELETE FROM graph_revisions WHERE chapter_id IN (" + chapterCondition + ")",
+		"DELETE FROM graph_children WHERE chapter_id IN (" + chapterCondition + ")",
+		"DELETE FROM graphs WHERE chapter_id IN (" + chapterCondition + ")",
+		"DELETE FROM sections WHERE chapter_id IN (" + chapterCondition + ")",
+	}
+	for _, query := range queries {
+		if _, err := q.Exec(database.Rebind(query), args...); err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete graphs: %w", err)
+		}
+	}
+	return nil
+}
+
+func sqlDeleteGraph(database db.SQLDatabase, q sqlQuerier, chapterId string, sectionId string) (int, *Error) {
+	count := 0
+	counts := []string{
+		"SELECT COUNT(*) FROM graphs WHERE chapter_id = ? AND id = ?",
+		"SELECT COUNT(*) FROM graph_revisions WHERE chapter_id = ? AND graph_id = ?",
+	}
+	for _, query := range counts {
+		var n int
+		if err := q.QueryRow(database.Rebind(query), chapterId, sectionId).Scan(&n); err != nil {
+			return 0, Errorf(ReadFailurePanic, "failed to count documents: %w", err)
+		}
+		count += n
+	}
+
+	deletes := []string{
+		"DELETE FROM graph_revisions WHERE chapter_id = ? AND graph_id = ?",
+		"DELETE FROM graph_children WHERE chapter_id = ? AND graph_id = ?",
+		"DELETE FROM graphs WHERE chapter_id = ? AND id = ?",
+	}
+	for _, query := range deletes {
+		if _, err := q.Exec(database.Rebind(query), chapterId, sectionId); err != nil {
+			return 0, Errorf(WriteFailurePanic, "failed to delete graph: %w", err)
+		}
+	}
+	return count, nil
+}
+
+func sqlDeleteChapters(database db.SQLDatabase, q sqlQuerier, chapterCondition string, args ...any) (int, *Error) {
+	counts := []string{
+		"SELECT COUNT(*) FROM chapters WHERE id IN (" + chapterCondition + ")",
+		"SELECT COUNT(*) FROM papers WHERE chapter_id IN (" + chapterCondition + ")",
+		"SELECT COUNT(*) FROM paper_revisions WHERE chapter_id IN (" + chapterCondition + ")",
+		"SELECT COUNT(*) FROM graphs WHERE chapter_id IN (" + chapterCondition + ")",
+		"SELECT COUNT(*) FROM graph_revisions WHERE chapter_id IN (" + chapterCondition + ")",
+	}
+	count := 0
+	for _, query := range counts {
+		var n int
+		if err := q.QueryRow(database.Rebind(query), args...).Scan(&n); err != nil {
+			return 0, Errorf(ReadFailurePanic, "failed to count documents: %w", err)
+		}
+		count += n
+	}
+
+	rErr := sqlDeleteGraphs(database, q, chapterCondition, args...)
+	if rErr != nil {
+		return 0, rErr
+	}
+
+	deletes := []string{
+		"DELETE FROM paper_revisions WHERE chapter_id IN (" + chapterCondition + ")",
+		"DELETE FROM papers WHERE chapter_id IN (" + chapterCondition + ")",
+		"DELETE FROM chapters WHERE id IN (" + chapterCondition + ")",
+	}
+	for _, query := range deletes {
+		if _, err := q.Exec(database.Rebind(query), args...); err != nil {
+			return 0, Errorf(WriteFailurePanic, "failed to delete chapters: %w", err)
+		}
+	}
+	return count, nil
+}
+
+func sqlDeleteProject(database db.SQLDatabase, q sqlQuerier, projectId string) (int, *Error) {
+	count, rErr := sqlDeleteChapters(database, q, "SELECT id FROM chapters WHERE project_id = ?", projectId)
+	if rErr != nil {
+		return 0, rErr
+	}
+
+	var links int
+	if err := q.QueryRow(database.Rebind("SELECT COUNT(*) FROM links WHERE project_id = ?"), projectId).
+		Scan(&links); err != nil {
+		return 0, Errorf(ReadFailurePanic, "failed to count documents: %w", err)
+	}
+	count += links
+
+	if _, err := q.Exec(database.Rebind("DELETE FROM links WHERE project_id = ?"), projectId); err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete links: %w", err)
+	}
+
+	if _, err := q.Exec(database.Rebind("DELETE FROM project_members WHERE project_id = ?"), projectId); err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete project members: %w", err)
+	}
+
+	if _, err := q.Exec(database.Rebind("DELETE FROM shares WHERE project_id = ?"), projectId); err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete shares: %w", err)
+	}
+
+	if _, err := q.Exec(database.Rebind("DELETE FROM access_tokens WHERE project_id = ?"), projectId); err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete access tokens: %w", err)
+	}
+
+	if _, err := q.Exec(database.Rebind("DELETE FROM projects WHERE id = ?"), projectId); err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete project: %w", err)
+	}
+	return count + 1, nil
+}
+
+// sqlDeleteLinks removes the links which have an endpoint in the chapter,
+// or only in the section of it when sectionId is not empty, and returns the number of them
+func sqlDeleteLinks(database db.SQLDatabase, q sqlQuerier, chapterId string, sectionId string) (int, *Error) {
+	query := "DELETE FROM links WHERE from_chapter_id = ? OR to_chapter_id = ?"
+	args := []any{chapterId, chapterId}
+	if sectionId != "" {
+		query = "DELETE FROM links WHERE (from_chapter_id = ? AND from_section_id = ?) " +
+			"OR (to_chapter_id = ? AND to_section_id = ?)"
+		args = []any{chapterId, sectionId, chapterId, sectionId}
+	}
+
+	result, err := q.Exec(database.Rebind(query), args...)
+	if err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete links: %w", err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete links: %w", err)
+	}
+	return int(count), nil
+}
+
+// sqlDeleteNodeLinks removes the links which have an endpoint at a node of the section
+// which is no longer in the graph
+func sqlDeleteNodeLinks(database db.SQLDatabase, q sqlQuerier, chapterId string, sectionId string) *Error {
+	query := "DELETE FROM links WHERE " +
+		"(from_chapter_id = ? AND from_section_id = ? AND from_node_id <> '' AND from_node_id NOT IN " +
+		"(SELECT id FROM graph_children WHERE chapter_id = ? AND graph_id = ?)) OR " +
+		"(to_chapter_id = ? AND to_section_id = ? AND to_node_id <> '' AND to_node_id NOT IN " +
+		"(SELECT id FROM graph_children WHERE chapter_id = ? AND graph_id = ?))"
+	args := []any{chapterId, sectionId, chapterId, sectionId, chapterId, sectionId, chapterId, sectionId}
+
+	if _, err := q.Exec(database.Rebind(query), args...); err != nil {
+		return Errorf(WriteFailurePanic, "failed to delete links: %w", err)
+	}
+	return nil
+}
+
+func sqlInsertTrashItem(database db.SQLDatabase, q sqlQuerier, entry record.TrashItemEntry) *Error {
+	_, err := q.Exec(database.Rebind(
+		"INSERT INTO trash_items (id, user_id, type, name, project_id, chapter_id, section_id, position, deleted_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"),
+		newId(), entry.UserId, entry.Type, entry.Name, entry.ProjectId, entry.ChapterId, entry.SectionId,
+		entry.Position, currentTime())
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to insert trash item: %w", err)
+	}
+	return nil
+}
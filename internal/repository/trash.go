@@ -0,0 +1,586 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"google.golang.org/api/iterator"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const TrashCollection = "trash"
+
+const (
+	TrashItemTypeProject = "project"
+	TrashItemTypeChapter = "chapter"
+	TrashItemTypeSection = "section"
+)
+
+type TrashRepository interface {
+	FetchTrashItems(
+		userId string,
+	) (map[string]record.TrashItemEntry, *Error)
+	RestoreTrashItem(
+		userId string,
+		itemId string,
+	) (*record.TrashItemEntry, *Error)
+	FetchExpiredTrashItems(
+		deletedBefore time.Time,
+	) (map[string]record.TrashItemEntry, *Error)
+	PurgeTrashItem(
+		userId string,
+		itemId string,
+	) (int, *Error)
+	FetchPurgingTrashItems() (map[string]record.TrashItemEntry, *Error)
+}
+
+type trashRepository struct {
+	client            firestore.Client
+	projectRepository projectRepository
+	chapterRepository chapterRepository
+	graphRepository   graphRepository
+}
+
+func NewTrashRepository(client firestore.Client) TrashRepository {
+	return trashRepository{
+		client:            client,
+		projectRepository: projectRepository{client: client},
+		chapterRepository: chapterRepository{client: client},
+		graphRepository:   graphRepository{client: client, chapterRepository: chapterRepository{client: client}},
+	}
+}
+
+func (r trashRepository) FetchTrashItems(
+	userId string,
+) (map[string]record.TrashItemEntry, *Error) {
+	iter := r.client.Collection(TrashCollection).
+		Where("userId", "==", userId).
+		Documents(db.FirestoreContext())
+
+	return r.fetchEntries(iter, false)
+}
+
+func (r trashRepository) RestoreTrashItem(
+	userId string,
+	itemId string,
+) (*record.TrashItemEntry, *Error) {
+	ref := r.client.Collection(TrashCollection).
+		Doc(itemId)
+
+	var restored *record.TrashItemEntry
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		entry, rErr := r.entryInTransaction(tx, userId, itemId)
+		if rErr != nil {
+			return rErr
+		}
+
+		switch entry.Type {
+		case TrashItemTypeProject:
+			rErr = r.restoreProject(tx, *entry)
+		case TrashItemTypeChapter:
+			rErr = r.restoreChapter(tx, *entry)
+		default:
+			rErr = r.restoreSection(tx, *entry)
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		err := tx.Delete(ref)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+		}
+
+		restored = entry
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+
+	return restored, nil
+}
+
+func (r trashRepository) FetchExpiredTrashItems(
+	deletedBefore time.Time,
+) (map[string]record.TrashItemEntry, *Error) {
+	iter := r.client.Collection(TrashCollection).
+		Where("deletedAt", "<", deletedBefore).
+		Documents(db.FirestoreContext())
+
+	return r.fetchEntries(iter, true)
+}
+
+func (r trashRepository) PurgeTrashItem(
+	userId string,
+	itemId string,
+) (int, *Error) {
+	snapshot, err := r.client.Collection(TrashCollection).
+		Doc(itemId).
+		Get(db.FirestoreContext())
+	if err != nil {
+		return 0, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	var values document.TrashItemValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return 0, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if values.UserId != userId {
+		return 0, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	if values.Type == TrashItemTypeProject {
+		return r.purgeProject(userId, values.ProjectId)
+	}
+
+	// a chapter or section may have more documents than a transaction can write,
+	// so the transaction only marks the item as purging and its documents are deleted in batches after it.
+	// An item left purging by an interrupted purge is hidden from the user and purged again by a later purge.
+	if !values.Purging {
+		rErr := r.markPurging(userId, itemId)
+		if rErr != nil {
+			return 0, rErr
+		}
+	}
+
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(values.ProjectId)
+	chapterRef := projectRef.Collection(ChapterCollection).
+		Doc(values.ChapterId)
+
+	var count int
+	var rErr *Error
+	if values.Type == TrashItemTypeChapter {
+		count, rErr = r.purgeChapter(projectRef, chapterRef)
+	} else {
+		count, rErr = r.purgeSection(projectRef, chapterRef.Collection(GraphCollection).Doc(values.SectionId))
+	}
+	if rErr != nil {
+		return count, rErr
+	}
+
+	_, err = snapshot.Ref.Delete(db.FirestoreContext())
+	if err != nil {
+		return count, Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r trashRepository) FetchPurgingTrashItems() (map[string]record.TrashItemEntry, *Error) {
+	iter := r.client.Collection(TrashCollection).
+		Where("purging", "==", true).
+		Documents(db.FirestoreContext())
+
+	return r.fetchEntries(iter, true)
+}
+
+// markPurging marks the trash item as purging,
+// and deletes the trash items of the sections in it when it is a chapter
+func (r trashRepository) markPurging(userId string, itemId string) *Error {
+	ref := r.client.Collection(TrashCollection).
+		Doc(itemId)
+
+	err := r.client.RunTransaction(db.FirestoreContext(), func(ctx context.Context, tx *firestore.Transaction) error {
+		entry, rErr := r.entryInTransaction(tx, userId, itemId)
+		if rErr != nil {
+			return rErr
+		}
+
+		if entry.Type == TrashItemTypeChapter {
+			snapshots, err := tx.Documents(r.client.Collection(TrashCollection).
+				Where("userId", "==", userId).
+				Where("chapterId", "==", entry.ChapterId)).
+				GetAll()
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				if snapshot.Ref.ID == itemId {
+					continue
+				}
+				err := tx.Delete(snapshot.Ref)
+				if err != nil {
+					return Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+				}
+			}
+		}
+
+		err := tx.Update(ref, []firestore.Update{
+			{Path: "purging", Value: true},
+		})
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to mark trash item as purging: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+
+	return nil
+}
+
+// purgeChapter deletes the chapter with its paper, graphs and links in batches,
+// and deletes the chapter itself last so that an interrupted purge still finds it
+func (r trashRepository) purgeChapter(
+	projectRef *firestore.DocumentRef,
+	chapterRef *firestore.DocumentRef,
+) (int, *Error) {
+	paperRef := projectRef.Collection(PaperCollection).
+		Doc(chapterRef.ID)
+
+	count, rErr := r.projectRepository.deleteSubcollections(paperRef)
+	if rErr != nil {
+		return count, rErr
+	}
+
+	deleted, rErr := r.deleteDocument(paperRef)
+	count += deleted
+	if rErr != nil {
+		return count, rErr
+	}
+
+	deleted, rErr = r.projectRepository.deleteSubcollections(chapterRef)
+	count += deleted
+	if rErr != nil {
+		return count, rErr
+	}
+
+	deleted, rErr = r.deleteLinks(projectRef, chapterRef.ID, "")
+	count += deleted
+	if rErr != nil {
+		return count, rErr
+	}
+
+	deleted, rErr = r.deleteDocument(chapterRef)
+	return count + deleted, rErr
+}
+
+// purgeSection deletes the graph of the section with its revisions and links in batches,
+// and deletes the graph itself last so that an interrupted purge still finds it
+func (r trashRepository) purgeSection(
+	projectRef *firestore.DocumentRef,
+	graphRef *firestore.DocumentRef,
+) (int, *Error) {
+	count, rErr := r.projectRepository.deleteSubcollections(graphRef)
+	if rErr != nil {
+		return count, rErr
+	}
+
+	chapterId := graphRef.Parent.Parent.ID
+	deleted, rErr := r.deleteLinks(projectRef, chapterId, graphRef.ID)
+	count += deleted
+	if rErr != nil {
+		return count, rErr
+	}
+
+	deleted, rErr = r.deleteDocument(graphRef)
+	return count + deleted, rErr
+}
+
+// deleteLinks deletes the links which have an endpoint in the chapter,
+// or only in the section of it when sectionId is not empty, in batches
+func (r trashRepository) deleteLinks(
+	projectRef *firestore.DocumentRef,
+	chapterId string,
+	sectionId string,
+) (int, *Error) {
+	count := 0
+	for _, side := range []string{"from", "to"} {
+		query := projectRef.Collection(LinkCollection).
+			Where(side+".chapterId", "==", chapterId)
+		if sectionId != "" {
+			query = query.Where(side+".sectionId", "==", sectionId)
+		}
+
+		for {
+			snapshots, err := query.Limit(deleteBatchSize).
+				Documents(db.FirestoreContext()).
+				GetAll()
+			if err != nil {
+				return count, Errorf(ReadFailurePanic, "failed to fetch links: %w", err)
+			}
+			if len(snapshots) == 0 {
+				break
+			}
+
+			bw := r.client.BulkWriter(db.FirestoreContext())
+
+			jobs := make([]*firestore.BulkWriterJob, len(snapshots))
+			for i, snapshot := range snapshots {
+				job, err := bw.Delete(snapshot.Ref)
+				if err != nil {
+					bw.End()
+					return count, Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+				}
+				jobs[i] = job
+			}
+
+			bw.End()
+
+			for _, job := range jobs {
+				if _, err := job.Results(); err != nil {
+					return count, Errorf(WriteFailurePanic, "failed to delete link: %w", err)
+				}
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// deleteDocument deletes the document and returns 1, or returns 0 when it does not exist
+func (r trashRepository) deleteDocument(ref *firestore.DocumentRef) (int, *Error) {
+	if _, err := ref.Get(db.FirestoreContext()); err != nil {
+		return 0, nil
+	}
+
+	_, err := ref.Delete(db.FirestoreContext())
+	if err != nil {
+		return 0, Errorf(WriteFailurePanic, "failed to delete document: %w", err)
+	}
+	return 1, nil
+}
+
+func (r trashRepository) purgeProject(userId string, projectId string) (int, *Error) {
+	count, rErr := r.projectRepository.DeleteProject(userId, projectId)
+	if rErr != nil && rErr.Code() != NotFoundError {
+		return count, rErr
+	}
+
+	snapshots, err := r.client.Collection(TrashCollection).
+		Where("userId", "==", userId).
+		Where("projectId", "==", projectId).
+		Documents(db.FirestoreContext()).
+		GetAll()
+	if err != nil {
+		return count, Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+	}
+
+	for _, snapshot := range snapshots {
+		_, err := snapshot.Ref.Delete(db.FirestoreContext())
+		if err != nil {
+			return count, Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+		}
+	}
+
+	return count, nil
+}
+
+func (r trashRepository) restoreProject(tx *firestore.Transaction, entry record.TrashItemEntry) *Error {
+	ref := r.client.Collection(ProjectCollection).
+		Doc(entry.ProjectId)
+
+	snapshot, err := tx.Get(ref)
+	if err != nil {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	var values document.ProjectValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if values.UserId != entry.UserId || values.Deleting || !values.Trashed {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+
+	err = tx.Update(ref, []firestore.Update{
+		{Path: "trashed", Value: firestore.Delete},
+	})
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to restore project: %w", err)
+	}
+
+	return nil
+}
+
+func (r trashRepository) restoreChapter(tx *firestore.Transaction, entry record.TrashItemEntry) *Error {
+	projectRef := r.client.Collection(ProjectCollection).
+		Doc(entry.ProjectId)
+	ref := projectRef.Collection(ChapterCollection).
+		Doc(entry.ChapterId)
+
+	projectValues, rErr := r.chapterRepository.projectValuesInTransaction(tx, entry.UserId, entry.ProjectId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	snapshot, err := tx.Get(ref)
+	if err != nil {
+		return Errorf(NotFoundError, "failed to fetch chapter")
+	}
+
+	var values document.ChapterValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if !values.Trashed {
+		return Errorf(NotFoundError, "failed to fetch chapter")
+	}
+
+	err = tx.Update(ref, []firestore.Update{
+		{Path: "trashed", Value: firestore.Delete},
+	})
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to restore chapter: %w", err)
+	}
+
+	err = tx.Update(projectRef, []firestore.Update{
+		{Path: "chapterIds", Value: insertAt(projectValues.ChapterIds, entry.Position, entry.ChapterId)},
+	})
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update chapter ids: %w", err)
+	}
+
+	return nil
+}
+
+func (r trashRepository) restoreSection(tx *firestore.Transaction, entry record.TrashItemEntry) *Error {
+	chapterRef := r.client.Collection(ProjectCollection).
+		Doc(entry.ProjectId).
+		Collection(ChapterCollection).
+		Doc(entry.ChapterId)
+	ref := chapterRef.Collection(GraphCollection).
+		Doc(entry.SectionId)
+
+	chapterValues, rErr := r.graphRepository.chapterValuesInTransaction(tx, entry.UserId, entry.ProjectId, entry.ChapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	snapshot, err := tx.Get(ref)
+	if err != nil {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	var values document.GraphValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if !values.Trashed {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	sections := []map[string]any{}
+	for _, section := range chapterValues.Sections {
+		sections = append(sections, map[string]any{
+			"id":   section.Id,
+			"name": section.Name,
+		})
+	}
+	restored := map[string]any{
+		"id":   entry.SectionId,
+		"name": entry.Name,
+	}
+
+	err = tx.Update(ref, []firestore.Update{
+		{Path: "trashed", Value: firestore.Delete},
+	})
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to restore graph: %w", err)
+	}
+
+	err = tx.Update(chapterRef, []firestore.Update{
+		{Path: "sections", Value: insertAt(sections, entry.Position, restored)},
+		{Path: "updatedAt", Value: firestore.ServerTimestamp},
+	})
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+	}
+
+	return nil
+}
+
+func (r trashRepository) entryInTransaction(
+	tx *firestore.Transaction,
+	userId string,
+	itemId string,
+) (*record.TrashItemEntry, *Error) {
+	snapshot, err := tx.Get(r.client.Collection(TrashCollection).Doc(itemId))
+	if err != nil {
+		return nil, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	var values document.TrashItemValues
+	err = snapshot.DataTo(&values)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+	}
+
+	if values.UserId != userId || values.Purging {
+		return nil, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	return r.valuesToEntry(values), nil
+}
+
+func (r trashRepository) fetchEntries(
+	iter *firestore.DocumentIterator,
+	includePurging bool,
+) (map[string]record.TrashItemEntry, *Error) {
+	entries := make(map[string]record.TrashItemEntry)
+
+	for {
+		snapshot, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+		}
+
+		var values document.TrashItemValues
+		err = snapshot.DataTo(&values)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert snapshot to values: %w", err)
+		}
+
+		if values.Purging && !includePurging {
+			continue
+		}
+		entries[snapshot.Ref.ID] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r trashRepository) valuesToEntry(
+	values document.TrashItemValues,
+) *record.TrashItemEntry {
+	return &record.TrashItemEntry{
+		Type:      values.Type,
+		Name:      values.Name,
+		ProjectId: values.ProjectId,
+		ChapterId: values.ChapterId,
+		SectionId: values.SectionId,
+		Position:  values.Position,
+		UserId:    values.UserId,
+		DeletedAt: values.DeletedAt,
+	}
+}
+
+func insertAt[T any](items []T, position int, item T) []T {
+	position = max(0, min(position, len(items)))
+
+	inserted := make([]T, 0, len(items)+1)
+	inserted = append(inserted, items[:position]...)
+	inserted = append(inserted, item)
+	return append(inserted, items[position:]...)
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/document"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+type memoryTrashRepository struct {
+	store *MemoryStore
+}
+
+func NewMemoryTrashRepository(store *MemoryStore) TrashRepository {
+	return memoryTrashRepository{store: store}
+}
+
+func (r memoryTrashRepository) FetchTrashItems(
+	userId string,
+) (map[string]record.TrashItemEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.TrashItemEntry)
+	for id, values := range r.store.trash {
+		if values.UserId != userId {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r memoryTrashRepository) RestoreTrashItem(
+	userId string,
+	itemId string,
+) (*record.TrashItemEntry, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	values, ok := r.store.trash[itemId]
+	if !ok || values.UserId != userId {
+		return nil, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	switch values.Type {
+	case TrashItemTypeProject:
+		project, ok := r.store.projects[values.ProjectId]
+		if !ok || project.values.UserId != userId || project.values.Deleting || !project.values.Trashed {
+			return nil, Errorf(NotFoundError, "failed to fetch project")
+		}
+
+		project.values.Trashed = false
+	case TrashItemTypeChapter:
+		project, rErr := r.store.project(userId, values.ProjectId, writeAccess)
+		if rErr != nil {
+			return nil, rErr
+		}
+
+		chapter, ok := project.chapters[values.ChapterId]
+		if !ok || !chapter.values.Trashed {
+			return nil, Errorf(NotFoundError, "failed to fetch chapter")
+		}
+
+		chapter.values.Trashed = false
+		project.values.ChapterIds = insertAt(project.values.ChapterIds, values.Position, values.ChapterId)
+	default:
+		_, chapter, _, rErr := r.store.chapter(userId, values.ProjectId, values.ChapterId, writeAccess)
+		if rErr != nil {
+			return nil, rErr
+		}
+
+		graph, ok := chapter.graphs[values.SectionId]
+		if !ok || !graph.Trashed {
+			return nil, Errorf(NotFoundError, "failed to fetch graph")
+		}
+
+		graph.Trashed = false
+		chapter.graphs[values.SectionId] = graph
+		chapter.values.Sections = insertAt(chapter.values.Sections, values.Position, document.SectionValues{
+			Id:   values.SectionId,
+			Name: values.Name,
+		})
+		chapter.values.UpdatedAt = currentTime()
+	}
+
+	delete(r.store.trash, itemId)
+	return r.valuesToEntry(values), nil
+}
+
+func (r memoryTrashRepository) FetchExpiredTrashItems(
+	deletedBefore time.Time,
+) (map[string]record.TrashItemEntry, *Error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	entries := make(map[string]record.TrashItemEntry)
+	for id, values := range r.store.trash {
+		if !values.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		entries[id] = *r.valuesToEntry(values)
+	}
+
+	return entries, nil
+}
+
+func (r memoryTrashRepository) PurgeTrashItem(
+	userId string,
+	itemId string,
+) (int, *Error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	values, ok := r.store.trash[itemId]
+	if !ok || values.UserId != userId {
+		return 0, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+
+	count := 0
+	project, ok := r.store.projects[values.ProjectId]
+	if ok && project.values.UserId == userId {
+		switch values.Type {
+		case TrashItemTypeProject:
+			count = r.purgeProject(values.ProjectId)
+		case TrashItemTypeChapter:
+			count = r.purgeChapter(project, values.ChapterId)
+		default:
+			count = r.purgeGraph(project, values.ChapterId, values.SectionId)
+		}
+	}
+
+	for id, other := range r.store.trash {
+		if other.UserId != userId {
+			continue
+		}
+		if values.Type == TrashItemTypeProject && other.ProjectId == values.ProjectId ||
+			values.Type == TrashItemTypeChapter && other.ChapterId == values.ChapterId {
+			delete(r.store.trash, id)
+		}
+	}
+	delete(r.store.trash, itemId)
+
+	return count, nil
+}
+
+// FetchPurgingTrashItems returns no items, since items are purged at once under the store lock
+func (r memoryTrashRepository) FetchPurgingTrashItems() (map[string]record.TrashItemEntry, *Error) {
+	return map[string]record.TrashItemEntry{}, nil
+}
+
+func (r memoryTrashRepository) purgeProject(projectId string) int {
+	project := r.store.projects[projectId]
+
+	count := 1
+	for chapterId := range project.chapters {
+		count += r.purgeChapter(project, chapterId)
+	}
+
+	r.store.deleteProject(projectId)
+	return count
+}
+
+func (r memoryTrashRepository) purgeChapter(project *memoryProject, chapterId string) int {
+	chapter, ok := project.chapters[chapterId]
+	if !ok {
+		return 0
+	}
+
+	count := 1 + len(project.paperRevisions[chapterId])
+	if _, ok := project.papers[chapterId]; ok {
+		count++
+	}
+	for sectionId := range chapter.graphs {
+		count += r.purgeGraph(project, chapterId, sectionId)
+	}
+	count += project.deleteLinks(chapterId, "")
+
+	delete(project.chapters, chapterId)
+	delete(project.papers, chapterId)
+	delete(project.paperRevisions, chapterId)
+	return count
+}
+
+func (r memoryTrashRepository) purgeGraph(project *memoryProject, chapterId string, sectionId string) int {
+	chapter, ok := project.chapters[chapterId]
+	if !ok {
+		return 0
+	}
+
+	if _, ok := chapter.graphs[sectionId]; !ok {
+		return 0
+	}
+
+	count := 1 + len(chapter.graphRevisions[sectionId]) + project.deleteLinks(chapterId, sectionId)
+	delete(chapter.graphs, sectionId)
+	delete(chapter.graphRevisions, sectionId)
+	return count
+}
+
+func (r memoryTrashRepository) valuesToEntry(
+	values document.TrashItemValues,
+) *record.TrashItemEntry {
+	return &record.TrashItemEntry{
+		Type:      values.Type,
+		Name:      values.Name,
+		ProjectId: values.ProjectId,
+		ChapterId: values.ChapterId,
+		SectionId: values.SectionId,
+		Position:  values.Position,
+		UserId:    values.UserId,
+		DeletedAt: values.DeletedAt,
+	}
+}
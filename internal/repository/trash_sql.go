@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+)
+
+const sqlTrashItemColumns = "id, type, name, project_id, chapter_id, section_id, position, user_id, deleted_at"
+
+type sqlTrashRepository struct {
+	database db.SQLDatabase
+}
+
+func NewSQLTrashRepository(database db.SQLDatabase) TrashRepository {
+	return sqlTrashRepository{database: database}
+}
+
+func (r sqlTrashRepository) FetchTrashItems(
+	userId string,
+) (map[string]record.TrashItemEntry, *Error) {
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlTrashItemColumns+" FROM trash_items WHERE user_id = ?"), userId)
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+	}
+
+	return r.scanEntries(rows)
+}
+
+func (r sqlTrashRepository) RestoreTrashItem(
+	userId string,
+	itemId string,
+) (*record.TrashItemEntry, *Error) {
+	var restored *record.TrashItemEntry
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entry, rErr := r.fetchEntry(tx, userId, itemId)
+		if rErr != nil {
+			return rErr
+		}
+
+		switch entry.Type {
+		case TrashItemTypeProject:
+			rErr = r.restoreProject(tx, *entry)
+		case TrashItemTypeChapter:
+			rErr = r.restoreChapter(tx, *entry)
+		default:
+			rErr = r.restoreSection(tx, *entry)
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind("DELETE FROM trash_items WHERE id = ?"), itemId)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+		}
+
+		restored = entry
+		return nil
+	})
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	return restored, nil
+}
+
+func (r sqlTrashRepository) FetchExpiredTrashItems(
+	deletedBefore time.Time,
+) (map[string]record.TrashItemEntry, *Error) {
+	rows, err := r.database.DB.Query(r.database.Rebind(
+		"SELECT "+sqlTrashItemColumns+" FROM trash_items WHERE deleted_at < ?"), deletedBefore.UTC())
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+	}
+
+	return r.scanEntries(rows)
+}
+
+func (r sqlTrashRepository) PurgeTrashItem(
+	userId string,
+	itemId string,
+) (int, *Error) {
+	count := 0
+
+	rErr := runSQLTransaction(r.database, func(tx *sql.Tx) *Error {
+		entry, rErr := r.fetchEntry(tx, userId, itemId)
+		if rErr != nil {
+			return rErr
+		}
+
+		var cleanup string
+		var args []any
+		switch entry.Type {
+		case TrashItemTypeProject:
+			var exists int
+			err := tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM projects WHERE id = ? AND user_id = ?"),
+				entry.ProjectId, userId).Scan(&exists)
+			if err != nil {
+				return Errorf(ReadFailurePanic, "failed to fetch project: %w", err)
+			}
+			if exists > 0 {
+				count, rErr = sqlDeleteProject(r.database, tx, entry.ProjectId)
+			}
+			cleanup = "DELETE FROM trash_items WHERE user_id = ? AND project_id = ?"
+			args = []any{userId, entry.ProjectId}
+		case TrashItemTypeChapter:
+			count, rErr = sqlDeleteChapters(r.database, tx, "?", entry.ChapterId)
+			if rErr == nil {
+				var links int
+				links, rErr = sqlDeleteLinks(r.database, tx, entry.ChapterId, "")
+				count += links
+			}
+			cleanup = "DELETE FROM trash_items WHERE user_id = ? AND chapter_id = ?"
+			args = []any{userId, entry.ChapterId}
+		default:
+			count, rErr = sqlDeleteGraph(r.database, tx, entry.ChapterId, entry.SectionId)
+			if rErr == nil {
+				var links int
+				links, rErr = sqlDeleteLinks(r.database, tx, entry.ChapterId, entry.SectionId)
+				count += links
+			}
+			cleanup = "DELETE FROM trash_items WHERE id = ?"
+			args = []any{itemId}
+		}
+		if rErr != nil {
+			return rErr
+		}
+
+		_, err := tx.Exec(r.database.Rebind(cleanup), args...)
+		if err != nil {
+			return Errorf(WriteFailurePanic, "failed to delete trash item: %w", err)
+		}
+		return nil
+	})
+	if rErr != nil {
+		return 0, rErr
+	}
+
+	return count, nil
+}
+
+// FetchPurgingTrashItems returns no items, since items are purged in a single transaction
+func (r sqlTrashRepository) FetchPurgingTrashItems() (map[string]record.TrashItemEntry, *Error) {
+	return map[string]record.TrashItemEntry{}, nil
+}
+
+func (r sqlTrashRepository) restoreProject(tx *sql.Tx, entry record.TrashItemEntry) *Error {
+	result, err := tx.Exec(r.database.Rebind(
+		"UPDATE projects SET trashed = FALSE WHERE id = ? AND user_id = ? AND deleting = FALSE AND trashed = TRUE"),
+		entry.ProjectId, entry.UserId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to restore project: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return Errorf(NotFoundError, "failed to fetch project")
+	}
+	return nil
+}
+
+func (r sqlTrashRepository) restoreChapter(tx *sql.Tx, entry record.TrashItemEntry) *Error {
+	rErr := sqlCheckProject(r.database, tx, entry.UserId, entry.ProjectId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	var trashed int
+	err := tx.QueryRow(r.database.Rebind(
+		"SELECT COUNT(*) FROM chapters WHERE project_id = ? AND id = ? AND trashed = TRUE"),
+		entry.ProjectId, entry.ChapterId).Scan(&trashed)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch chapter: %w", err)
+	}
+	if trashed == 0 {
+		return Errorf(NotFoundError, "failed to fetch chapter")
+	}
+
+	var count int
+	err = tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM chapters WHERE project_id = ? AND trashed = FALSE"),
+		entry.ProjectId).Scan(&count)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to count chapters: %w", err)
+	}
+
+	number := max(0, min(entry.Position, count)) + 1
+	_, err = tx.Exec(r.database.Rebind(
+		"UPDATE chapters SET number = number + 1 WHERE project_id = ? AND trashed = FALSE AND number >= ?"),
+		entry.ProjectId, number)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update chapter numbers: %w", err)
+	}
+
+	_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET trashed = FALSE, number = ? WHERE id = ?"),
+		number, entry.ChapterId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to restore chapter: %w", err)
+	}
+	return nil
+}
+
+func (r sqlTrashRepository) restoreSection(tx *sql.Tx, entry record.TrashItemEntry) *Error {
+	rErr := sqlCheckChapter(r.database, tx, entry.UserId, entry.ProjectId, entry.ChapterId, writeAccess)
+	if rErr != nil {
+		return rErr
+	}
+
+	var trashed int
+	err := tx.QueryRow(r.database.Rebind(
+		"SELECT COUNT(*) FROM graphs g WHERE g.chapter_id = ? AND g.id = ? AND NOT EXISTS "+
+			"(SELECT 1 FROM sections s WHERE s.chapter_id = g.chapter_id AND s.id = g.id)"),
+		entry.ChapterId, entry.SectionId).Scan(&trashed)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch graph: %w", err)
+	}
+	if trashed == 0 {
+		return Errorf(NotFoundError, "failed to fetch graph")
+	}
+
+	var count int
+	err = tx.QueryRow(r.database.Rebind("SELECT COUNT(*) FROM sections WHERE chapter_id = ?"), entry.ChapterId).
+		Scan(&count)
+	if err != nil {
+		return Errorf(ReadFailurePanic, "failed to fetch sections: %w", err)
+	}
+
+	position := max(0, min(entry.Position, count))
+	_, err = tx.Exec(r.database.Rebind(
+		"UPDATE sections SET position = position + 1 WHERE chapter_id = ? AND position >= ?"),
+		entry.ChapterId, position)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+	}
+
+	_, err = tx.Exec(r.database.Rebind("INSERT INTO sections (chapter_id, id, name, position) VALUES (?, ?, ?, ?)"),
+		entry.ChapterId, entry.SectionId, entry.Name, position)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+	}
+
+	_, err = tx.Exec(r.database.Rebind("UPDATE chapters SET updated_at = ? WHERE id = ?"),
+		currentTime(), entry.ChapterId)
+	if err != nil {
+		return Errorf(WriteFailurePanic, "failed to update sections of chapter: %w", err)
+	}
+	return nil
+}
+
+func (r sqlTrashRepository) fetchEntry(tx *sql.Tx, userId string, itemId string) (*record.TrashItemEntry, *Error) {
+	_, entry, err := r.scanEntry(tx.QueryRow(r.database.Rebind(
+		"SELECT "+sqlTrashItemColumns+" FROM trash_items WHERE id = ? AND user_id = ?"+r.database.ForUpdate()),
+		itemId, userId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, Errorf(NotFoundError, "failed to fetch trash item")
+	}
+	if err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch trash item: %w", err)
+	}
+	return entry, nil
+}
+
+func (r sqlTrashRepository) scanEntries(rows *sql.Rows) (map[string]record.TrashItemEntry, *Error) {
+	defer rows.Close()
+
+	entries := make(map[string]record.TrashItemEntry)
+	for rows.Next() {
+		id, entry, err := r.scanEntry(rows)
+		if err != nil {
+			return nil, Errorf(ReadFailurePanic, "failed to convert row to entry: %w", err)
+		}
+		entries[id] = *entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Errorf(ReadFailurePanic, "failed to fetch trash items: %w", err)
+	}
+	return entries, nil
+}
+
+func (r sqlTrashRepository) scanEntry(
+	row interface{ Scan(dest ...any) error },
+) (string, *record.TrashItemEntry, error) {
+	var id string
+	var entry record.TrashItemEntry
+	err := row.Scan(&id, &entry.Type, &entry.Name, &entry.ProjectId, &entry.ChapterId, &entry.SectionId,
+		&entry.Position, &entry.UserId, &entry.DeletedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	entry.DeletedAt = entry.DeletedAt.UTC()
+	return id, &entry, nil
+}
@@ -0,0 +1,256 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/db"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchTrashItemsValidDocument(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Fetch Trash Items",
+	})
+	assert.Nil(t, rErr)
+
+	chapterIds := make([]string, 2)
+	for i, name := range []string{"Chapter One", "Chapter Two"} {
+		chapterIds[i], _, rErr = cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   name,
+			Number: i + 1,
+		})
+		assert.Nil(t, rErr)
+	}
+
+	rErr = cr.TrashChapter(userId, projectId, chapterIds[1])
+	assert.Nil(t, rErr)
+
+	items, rErr := r.FetchTrashItems(userId)
+
+	assert.Nil(t, rErr)
+
+	found := 0
+	for _, item := range items {
+		if item.ProjectId != projectId {
+			continue
+		}
+		found++
+		assert.Equal(t, repository.TrashItemTypeChapter, item.Type)
+		assert.Equal(t, "Chapter Two", item.Name)
+		assert.Equal(t, chapterIds[1], item.ChapterId)
+		assert.Empty(t, item.SectionId)
+		assert.Equal(t, 1, item.Position)
+		assert.Equal(t, userId, item.UserId)
+		assert.Less(t, time.Since(item.DeletedAt), time.Minute)
+	}
+	assert.Equal(t, 1, found)
+
+	items, rErr = r.FetchTrashItems(testutil.ReadOnlyUserId())
+
+	assert.Nil(t, rErr)
+
+	for _, item := range items {
+		assert.NotEqual(t, projectId, item.ProjectId)
+	}
+}
+
+func TestRestoreTrashItemValidDocument(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Restore Trash Items",
+	})
+	assert.Nil(t, rErr)
+
+	chapterIds := make([]string, 2)
+	for i, name := range []string{"Chapter One", "Chapter Two"} {
+		chapterIds[i], _, rErr = cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+			Name:   name,
+			Number: i + 1,
+		})
+		assert.Nil(t, rErr)
+	}
+
+	sectionIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterIds[1], []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one", Children: []record.GraphChildEntry{}},
+		{Name: "Section Two", Paragraph: "paragraph two", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	rErr = gr.TrashGraph(userId, projectId, chapterIds[1], sectionIds[0])
+	assert.Nil(t, rErr)
+	rErr = cr.TrashChapter(userId, projectId, chapterIds[0])
+	assert.Nil(t, rErr)
+
+	items, rErr := r.FetchTrashItems(userId)
+	assert.Nil(t, rErr)
+
+	for id, item := range items {
+		if item.ProjectId != projectId {
+			continue
+		}
+
+		restored, rErr := r.RestoreTrashItem(userId, id)
+
+		assert.Nil(t, rErr)
+		assert.Equal(t, item, *restored)
+	}
+
+	chapters, rErr := cr.FetchChapters(userId, projectId)
+
+	assert.Nil(t, rErr)
+	assert.Len(t, chapters, 2)
+	assert.Equal(t, 1, chapters[chapterIds[0]].Number)
+	assert.Equal(t, 2, chapters[chapterIds[1]].Number)
+	assert.Len(t, chapters[chapterIds[1]].Sections, 2)
+	assert.Equal(t, sectionIds[0], chapters[chapterIds[1]].Sections[0].Id)
+	assert.Equal(t, "Section One", chapters[chapterIds[1]].Sections[0].Name)
+	assert.Equal(t, sectionIds[1], chapters[chapterIds[1]].Sections[1].Id)
+
+	graph, rErr := gr.FetchGraph(userId, projectId, chapterIds[1], sectionIds[0])
+
+	assert.Nil(t, rErr)
+	assert.Equal(t, "paragraph one", graph.Paragraph)
+}
+
+func TestRestoreTrashItemNotFound(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Restore by Another User",
+	})
+	assert.Nil(t, rErr)
+
+	rErr = pr.TrashProject(userId, projectId)
+	assert.Nil(t, rErr)
+
+	items, rErr := r.FetchTrashItems(userId)
+	assert.Nil(t, rErr)
+
+	itemId := ""
+	for id, item := range items {
+		if item.ProjectId == projectId {
+			itemId = id
+		}
+	}
+	assert.NotEmpty(t, itemId)
+
+	tt := []struct {
+		name   string
+		userId string
+		itemId string
+	}{
+		{
+			name:   "should return error when trash item is not found",
+			userId: userId,
+			itemId: "UNKNOWN_ITEM",
+		},
+		{
+			name:   "should return error when user is not owner of the trash item",
+			userId: testutil.ReadOnlyUserId(),
+			itemId: itemId,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, rErr := r.RestoreTrashItem(tc.userId, tc.itemId)
+
+			assert.Nil(t, entry)
+			assert.Equal(t, repository.NotFoundError, rErr.Code())
+			assert.Equal(t, "not found: failed to fetch trash item", rErr.Error())
+		})
+	}
+}
+
+func TestPurgeTrashItemValidDocument(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+	pr := repository.NewProjectRepository(*client)
+	cr := repository.NewChapterRepository(*client)
+	gr := repository.NewGraphRepository(*client)
+
+	userId := testutil.ModifyOnlyUserId()
+
+	projectId, _, rErr := pr.InsertProject(userId, record.ProjectWithoutAutofieldEntry{
+		Name: "Project to Purge Trash Items",
+	})
+	assert.Nil(t, rErr)
+
+	chapterId, _, rErr := cr.InsertChapter(userId, projectId, record.ChapterWithoutAutofieldEntry{
+		Name:   "Chapter One",
+		Number: 1,
+	})
+	assert.Nil(t, rErr)
+
+	sectionIds, _, rErr := gr.InsertGraphs(userId, projectId, chapterId, []record.GraphWithoutAutofieldEntry{
+		{Name: "Section One", Paragraph: "paragraph one", Children: []record.GraphChildEntry{}},
+	})
+	assert.Nil(t, rErr)
+
+	rErr = gr.TrashGraph(userId, projectId, chapterId, sectionIds[0])
+	assert.Nil(t, rErr)
+	rErr = pr.TrashProject(userId, projectId)
+	assert.Nil(t, rErr)
+
+	expired, rErr := r.FetchExpiredTrashItems(time.Now().Add(time.Minute))
+	assert.Nil(t, rErr)
+
+	found := 0
+	for id, item := range expired {
+		if item.ProjectId != projectId || item.Type != repository.TrashItemTypeProject {
+			continue
+		}
+		found++
+
+		count, rErr := r.PurgeTrashItem(userId, id)
+
+		assert.Nil(t, rErr)
+		// 1 project + 1 chapter + 1 paper + 1 graph
+		assert.Equal(t, 4, count)
+	}
+	assert.Equal(t, 1, found)
+
+	items, rErr := r.FetchTrashItems(userId)
+
+	assert.Nil(t, rErr)
+
+	for _, item := range items {
+		assert.NotEqual(t, projectId, item.ProjectId)
+	}
+
+	_, err := client.Collection(repository.ProjectCollection).Doc(projectId).Get(db.FirestoreContext())
+	assert.NotNil(t, err)
+}
+
+func TestPurgeTrashItemNotFound(t *testing.T) {
+	client := db.FirestoreClient()
+	r := repository.NewTrashRepository(*client)
+
+	count, rErr := r.PurgeTrashItem(testutil.ModifyOnlyUserId(), "UNKNOWN_ITEM")
+
+	assert.Equal(t, 0, count)
+	assert.Equal(t, repository.NotFoundError, rErr.Code())
+	assert.Equal(t, "not found: failed to fetch trash item", rErr.Error())
+}
@@ -0,0 +1,244 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// minPrefixLength is the shortest query word which also matches longer words beginning with it
+const minPrefixLength = 2
+
+// prefixMatchFactor discounts the score of a word which only begins with the query word
+const prefixMatchFactor = 0.5
+
+const (
+	KindProject = "project"
+	KindChapter = "chapter"
+	KindSection = "section"
+	KindNode    = "node"
+	KindPaper   = "paper"
+)
+
+var kindOrder = map[string]int{
+	KindProject: 0,
+	KindChapter: 1,
+	KindSection: 2,
+	KindNode:    3,
+	KindPaper:   4,
+}
+
+// Location is where a document deep-links to. Empty ids are not part of the location
+type Location struct {
+	ProjectId string
+	ChapterId string
+	SectionId string
+	NodeId    string
+}
+
+// Within reports whether l is the same as or inside of scope
+func (l Location) Within(scope Location) bool {
+	return (scope.ProjectId == "" || l.ProjectId == scope.ProjectId) &&
+		(scope.ChapterId == "" || l.ChapterId == scope.ChapterId) &&
+		(scope.SectionId == "" || l.SectionId == scope.SectionId) &&
+		(scope.NodeId == "" || l.NodeId == scope.NodeId)
+}
+
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Document is a unit of search results. A document is identified by its kind and location
+type Document struct {
+	Kind     string
+	Location Location
+	Title    string
+	Fields   []Field
+}
+
+func (d Document) key() string {
+	return strings.Join([]string{d.Kind, d.Location.ProjectId, d.Location.ChapterId, d.Location.SectionId, d.Location.NodeId}, "/")
+}
+
+type Result struct {
+	Document Document
+	Score    float64
+	Snippet  Snippet
+}
+
+type queryTerm struct {
+	term   string
+	prefix bool
+}
+
+// Index is an inverted index which is safe for concurrent use
+type Index struct {
+	mu        sync.RWMutex
+	documents map[string]Document
+	postings  map[string]map[string]float64
+}
+
+func NewIndex() *Index {
+	return &Index{
+		documents: make(map[string]Document),
+		postings:  make(map[string]map[string]float64),
+	}
+}
+
+// Put adds a document, or replaces the document of the same kind and location
+func (i *Index) Put(document Document) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	key := document.key()
+	i.remove(key)
+	i.documents[key] = document
+
+	for term, weight := range termWeights(document) {
+		postings, ok := i.postings[term]
+		if !ok {
+			postings = make(map[string]float64)
+			i.postings[term] = postings
+		}
+		postings[key] = weight
+	}
+}
+
+// RemoveWithin removes all documents inside of scope, such as all documents of a chapter
+func (i *Index) RemoveWithin(scope Location) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for key, document := range i.documents {
+		if document.Location.Within(scope) {
+			i.remove(key)
+		}
+	}
+}
+
+// Get returns the document of kind at location
+func (i *Index) Get(kind string, location Location) (Document, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	document, ok := i.documents[Document{Kind: kind, Location: location}.key()]
+	return document, ok
+}
+
+func (i *Index) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return len(i.documents)
+}
+
+// Search returns documents inside of scope which contain all terms of query,
+// in descending order of tf-idf score weighted by fields
+func (i *Index) Search(query string, scope Location, limit int) []Result {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	terms := tokenizeQuery(query)
+	if len(terms) == 0 {
+		return []Result{}
+	}
+
+	scores := map[string]float64{}
+	matched := map[string]map[string]struct{}{}
+	for n, term := range terms {
+		termScores := map[string]float64{}
+		for indexTerm, postings := range i.postings {
+			factor := 1.0
+			if indexTerm != term.term {
+				if !term.prefix || !strings.HasPrefix(indexTerm, term.term) || classifyRune([]rune(indexTerm)[0]) == classCJK {
+					continue
+				}
+				factor = prefixMatchFactor
+			}
+			idf := math.Log(1 + float64(len(i.documents))/float64(len(postings)))
+			for key, weight := range postings {
+				if n > 0 {
+					if _, ok := scores[key]; !ok {
+						continue
+					}
+				}
+				if !i.documents[key].Location.Within(scope) {
+					continue
+				}
+				termScores[key] = math.Max(termScores[key], factor*weight*idf)
+				if _, ok := matched[key]; !ok {
+					matched[key] = map[string]struct{}{}
+				}
+				matched[key][indexTerm] = struct{}{}
+			}
+		}
+
+		next := make(map[string]float64, len(termScores))
+		for key, score := range termScores {
+			next[key] = scores[key] + score
+		}
+		scores = next
+		if len(scores) == 0 {
+			return []Result{}
+		}
+	}
+
+	keys := make([]string, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		if scores[keys[a]] != scores[keys[b]] {
+			return scores[keys[a]] > scores[keys[b]]
+		}
+		kindA, kindB := kindOrder[i.documents[keys[a]].Kind], kindOrder[i.documents[keys[b]].Kind]
+		if kindA != kindB {
+			return kindA < kindB
+		}
+		return keys[a] < keys[b]
+	})
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+
+	results := make([]Result, len(keys))
+	for n, key := range keys {
+		document := i.documents[key]
+		results[n] = Result{
+			Document: document,
+			Score:    scores[key],
+			Snippet:  makeSnippet(document, matched[key]),
+		}
+	}
+	return results
+}
+
+func (i *Index) remove(key string) {
+	if _, ok := i.documents[key]; !ok {
+		return
+	}
+	for term := range termWeights(i.documents[key]) {
+		delete(i.postings[term], key)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+	delete(i.documents, key)
+}
+
+// termWeights sums up the weights of fields containing each term, damped by the term frequency
+func termWeights(document Document) map[string]float64 {
+	weights := map[string]float64{}
+	for _, field := range document.Fields {
+		frequencies := map[string]int{}
+		for _, token := range Tokenize(field.Text) {
+			frequencies[token.Term]++
+		}
+		for term, frequency := range frequencies {
+			weights[term] += field.Weight * (1 + math.Log(float64(frequency)))
+		}
+	}
+	return weights
+}
@@ -0,0 +1,101 @@
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+const snippetLength = 120
+
+// snippetContext is how many runes before the first match are kept in a snippet
+const snippetContext = 30
+
+const ellipsis = "…"
+
+// Highlight is a range in Snippet.Text, counted in runes
+type Highlight struct {
+	Offset int
+	Length int
+}
+
+type Snippet struct {
+	Text       string
+	Highlights []Highlight
+}
+
+// makeSnippet cuts the part around the first match out of the heaviest field which contains a match
+func makeSnippet(document Document, terms map[string]struct{}) Snippet {
+	fields := make([]Field, len(document.Fields))
+	copy(fields, document.Fields)
+	sort.SliceStable(fields, func(a, b int) bool {
+		return fields[a].Weight > fields[b].Weight
+	})
+
+	for _, field := range fields {
+		spans := []Highlight{}
+		for _, token := range Tokenize(field.Text) {
+			if _, ok := terms[token.Term]; ok {
+				spans = append(spans, Highlight{Offset: token.Start, Length: token.End - token.Start})
+			}
+		}
+		if len(spans) > 0 {
+			return cutSnippet(field.Text, mergeHighlights(spans))
+		}
+	}
+
+	if len(fields) == 0 {
+		return Snippet{Text: "", Highlights: []Highlight{}}
+	}
+	return cutSnippet(fields[0].Text, []Highlight{})
+}
+
+func cutSnippet(text string, highlights []Highlight) Snippet {
+	runes := []rune(strings.NewReplacer("\r", " ", "\n", " ", "\t", " ").Replace(text))
+
+	start := 0
+	if len(highlights) > 0 {
+		start = max(0, highlights[0].Offset-snippetContext)
+	}
+	end := min(len(runes), start+snippetLength)
+	start = max(0, min(start, end-snippetLength))
+
+	var b strings.Builder
+	shift := -start
+	if start > 0 {
+		b.WriteString(ellipsis)
+		shift += len([]rune(ellipsis))
+	}
+	b.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		b.WriteString(ellipsis)
+	}
+
+	clipped := []Highlight{}
+	for _, highlight := range highlights {
+		from := max(highlight.Offset, start)
+		to := min(highlight.Offset+highlight.Length, end)
+		if from >= to {
+			continue
+		}
+		clipped = append(clipped, Highlight{Offset: from + shift, Length: to - from})
+	}
+	return Snippet{Text: b.String(), Highlights: clipped}
+}
+
+// mergeHighlights joins overlapping ranges, such as bigrams of a CJK word
+func mergeHighlights(highlights []Highlight) []Highlight {
+	sort.Slice(highlights, func(a, b int) bool {
+		return highlights[a].Offset < highlights[b].Offset
+	})
+
+	merged := []Highlight{highlights[0]}
+	for _, highlight := range highlights[1:] {
+		last := &merged[len(merged)-1]
+		if highlight.Offset <= last.Offset+last.Length {
+			last.Length = max(last.Length, highlight.Offset+highlight.Length-last.Offset)
+			continue
+		}
+		merged = append(merged, highlight)
+	}
+	return merged
+}
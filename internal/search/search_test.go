@@ -0,0 +1,255 @@
+package search_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tt := []struct {
+		name     string
+		text     string
+		expected []search.Token
+	}{
+		{
+			name: "should split words at spaces and punctuation with case folded",
+			text: "Knowledge-Graph, v2",
+			expected: []search.Token{
+				{Term: "knowledge", Start: 0, End: 9},
+				{Term: "graph", Start: 10, End: 15},
+				{Term: "v2", Start: 17, End: 19},
+			},
+		},
+		{
+			name: "should fold full-width alphabets",
+			text: "ＡＢＣ",
+			expected: []search.Token{
+				{Term: "abc", Start: 0, End: 3},
+			},
+		},
+		{
+			name: "should split cjk into unigrams and bigrams",
+			text: "知識グラフ",
+			expected: []search.Token{
+				{Term: "知", Start: 0, End: 1},
+				{Term: "知識", Start: 0, End: 2},
+				{Term: "識", Start: 1, End: 2},
+				{Term: "識グ", Start: 1, End: 3},
+				{Term: "グ", Start: 2, End: 3},
+				{Term: "グラ", Start: 2, End: 4},
+				{Term: "ラ", Start: 3, End: 4},
+				{Term: "ラフ", Start: 3, End: 5},
+				{Term: "フ", Start: 4, End: 5},
+			},
+		},
+		{
+			name: "should split mixed text at script boundaries",
+			text: "Go言語",
+			expected: []search.Token{
+				{Term: "go", Start: 0, End: 2},
+				{Term: "言", Start: 2, End: 3},
+				{Term: "言語", Start: 2, End: 4},
+				{Term: "語", Start: 3, End: 4},
+			},
+		},
+		{
+			name:     "should return no tokens for punctuation only",
+			text:     "!? ...",
+			expected: []search.Token{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, search.Tokenize(tc.text))
+		})
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	index := newIndex()
+
+	tt := []struct {
+		name     string
+		query    string
+		scope    search.Location
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "should rank heavier fields first",
+			query:    "graph",
+			expected: []string{"project:p1", "node:p1/c1/s1/n1", "paper:p1/c1"},
+		},
+		{
+			name:     "should match all terms",
+			query:    "graph theory",
+			expected: []string{"paper:p1/c1"},
+		},
+		{
+			name:     "should match words by prefix",
+			query:    "know",
+			expected: []string{"project:p1", "chapter:p1/c2"},
+		},
+		{
+			name:     "should match cjk words by bigrams",
+			query:    "知識グラフ",
+			expected: []string{"section:p2/c3/s2", "paper:p2/c3"},
+		},
+		{
+			name:     "should match single cjk character",
+			query:    "識",
+			expected: []string{"section:p2/c3/s2", "paper:p2/c3"},
+		},
+		{
+			name:     "should search within scope",
+			query:    "graph",
+			scope:    search.Location{ProjectId: "p1", ChapterId: "c1"},
+			expected: []string{"node:p1/c1/s1/n1", "paper:p1/c1"},
+		},
+		{
+			name:     "should limit results",
+			query:    "graph",
+			limit:    1,
+			expected: []string{"project:p1"},
+		},
+		{
+			name:     "should return nothing for unknown terms",
+			query:    "unknown",
+			expected: []string{},
+		},
+		{
+			name:     "should return nothing for empty query",
+			query:    "  ",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			results := index.Search(tc.query, tc.scope, tc.limit)
+			assert.Equal(t, tc.expected, resultKeys(results))
+		})
+	}
+}
+
+func TestIndexSnippet(t *testing.T) {
+	index := newIndex()
+
+	results := index.Search("theory", search.Location{}, 0)
+	assert.Len(t, results, 1)
+	assert.Equal(t, search.Snippet{
+		Text:       "…sts of edges and nodes. Graph theory is the study of graphs, and this paragraph is long enough to be cut in the snippet.…",
+		Highlights: []search.Highlight{{Offset: 31, Length: 6}},
+	}, results[0].Snippet)
+
+	results = index.Search("知識グラフ", search.Location{ProjectId: "p2", ChapterId: "c3", SectionId: "s2"}, 0)
+	assert.Len(t, results, 1)
+	assert.Equal(t, search.Snippet{
+		Text:       "知識グラフの作り方",
+		Highlights: []search.Highlight{{Offset: 0, Length: 5}},
+	}, results[0].Snippet)
+}
+
+func TestIndexRemoveWithin(t *testing.T) {
+	index := newIndex()
+	assert.Equal(t, 7, index.Len())
+
+	index.RemoveWithin(search.Location{ProjectId: "p1", ChapterId: "c1"})
+	assert.Equal(t, 5, index.Len())
+	assert.Equal(t, []string{"project:p1"}, resultKeys(index.Search("graph", search.Location{}, 0)))
+
+	index.RemoveWithin(search.Location{ProjectId: "p2"})
+	assert.Equal(t, 2, index.Len())
+	assert.Equal(t, []string{}, resultKeys(index.Search("知識", search.Location{}, 0)))
+}
+
+func TestIndexPutReplaces(t *testing.T) {
+	index := newIndex()
+
+	index.Put(search.Document{
+		Kind:     search.KindProject,
+		Location: search.Location{ProjectId: "p1"},
+		Title:    "Renamed",
+		Fields:   []search.Field{{Text: "Renamed", Weight: 5}},
+	})
+	assert.Equal(t, 7, index.Len())
+	assert.Equal(t, []string{"node:p1/c1/s1/n1", "paper:p1/c1"}, resultKeys(index.Search("graph", search.Location{}, 0)))
+	assert.Equal(t, []string{"project:p1"}, resultKeys(index.Search("renamed", search.Location{}, 0)))
+
+	document, ok := index.Get(search.KindProject, search.Location{ProjectId: "p1"})
+	assert.True(t, ok)
+	assert.Equal(t, "Renamed", document.Title)
+
+	_, ok = index.Get(search.KindChapter, search.Location{ProjectId: "p1"})
+	assert.False(t, ok)
+}
+
+func newIndex() *search.Index {
+	index := search.NewIndex()
+	index.Put(search.Document{
+		Kind:     search.KindProject,
+		Location: search.Location{ProjectId: "p1"},
+		Title:    "Knowledge Graph",
+		Fields:   []search.Field{{Text: "Knowledge Graph", Weight: 5}, {Text: "My first project", Weight: 2}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindPaper,
+		Location: search.Location{ProjectId: "p1", ChapterId: "c1"},
+		Title:    "Introduction",
+		Fields: []search.Field{{
+			Text: "A graph consists of edges and nodes. Graph theory is the study of graphs, " +
+				"and this paragraph is long enough to be cut in the snippet. " + strings.Repeat("x", 40),
+			Weight: 1,
+		}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindNode,
+		Location: search.Location{ProjectId: "p1", ChapterId: "c1", SectionId: "s1", NodeId: "n1"},
+		Title:    "Graph",
+		Fields:   []search.Field{{Text: "Graph", Weight: 3}, {Text: "part of", Weight: 1}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindChapter,
+		Location: search.Location{ProjectId: "p1", ChapterId: "c2"},
+		Title:    "Knowing",
+		Fields:   []search.Field{{Text: "Knowing", Weight: 4}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindProject,
+		Location: search.Location{ProjectId: "p2"},
+		Title:    "ノート",
+		Fields:   []search.Field{{Text: "ノート", Weight: 5}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindSection,
+		Location: search.Location{ProjectId: "p2", ChapterId: "c3", SectionId: "s2"},
+		Title:    "知識グラフの作り方",
+		Fields:   []search.Field{{Text: "知識グラフの作り方", Weight: 4}},
+	})
+	index.Put(search.Document{
+		Kind:     search.KindPaper,
+		Location: search.Location{ProjectId: "p2", ChapterId: "c3"},
+		Title:    "第一章",
+		Fields:   []search.Field{{Text: "この章では知識グラフについて説明します。", Weight: 1}},
+	})
+	return index
+}
+
+func resultKeys(results []search.Result) []string {
+	keys := make([]string, len(results))
+	for i, result := range results {
+		location := result.Document.Location
+		ids := []string{}
+		for _, id := range []string{location.ProjectId, location.ChapterId, location.SectionId, location.NodeId} {
+			if id != "" {
+				ids = append(ids, id)
+			}
+		}
+		keys[i] = result.Document.Kind + ":" + strings.Join(ids, "/")
+	}
+	return keys
+}
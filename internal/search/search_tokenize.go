@@ -0,0 +1,108 @@
+package search
+
+import (
+	"unicode"
+)
+
+// Token is a term with its position in the original text, counted in runes
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+type runeClass int
+
+const (
+	classOther runeClass = iota
+	classWord
+	classCJK
+)
+
+// Tokenize splits text into terms for indexing. Words are split at spaces and punctuation.
+// Since CJK text has no spaces between words, runs of CJK characters are indexed
+// as both unigrams and bigrams, so that queries of any length can be matched
+func Tokenize(text string) []Token {
+	tokens := []Token{}
+	forEachRun(text, func(runes []rune, start int, class runeClass) {
+		switch class {
+		case classWord:
+			tokens = append(tokens, Token{Term: string(runes), Start: start, End: start + len(runes)})
+		case classCJK:
+			for i := range runes {
+				tokens = append(tokens, Token{Term: string(runes[i : i+1]), Start: start + i, End: start + i + 1})
+				if i+1 < len(runes) {
+					tokens = append(tokens, Token{Term: string(runes[i : i+2]), Start: start + i, End: start + i + 2})
+				}
+			}
+		}
+	})
+	return tokens
+}
+
+// tokenizeQuery splits a query into terms which all have to match.
+// A run of CJK characters is matched by its bigrams, or by itself when it is a single character
+func tokenizeQuery(query string) []queryTerm {
+	terms := []queryTerm{}
+	forEachRun(query, func(runes []rune, _ int, class runeClass) {
+		switch class {
+		case classWord:
+			terms = append(terms, queryTerm{term: string(runes), prefix: len(runes) >= minPrefixLength})
+		case classCJK:
+			if len(runes) == 1 {
+				terms = append(terms, queryTerm{term: string(runes)})
+			}
+			for i := 0; i+1 < len(runes); i++ {
+				terms = append(terms, queryTerm{term: string(runes[i : i+2])})
+			}
+		}
+	})
+	return terms
+}
+
+func forEachRun(text string, f func(runes []rune, start int, class runeClass)) {
+	run := []rune{}
+	runClass := classOther
+	start := 0
+
+	flush := func() {
+		if len(run) > 0 && runClass != classOther {
+			f(run, start, runClass)
+		}
+		run = []rune{}
+	}
+
+	position := 0
+	for _, r := range text {
+		r = normalizeRune(r)
+		class := classifyRune(r)
+		if class != runClass || class == classOther {
+			flush()
+			runClass = class
+			start = position
+		}
+		run = append(run, r)
+		position++
+	}
+	flush()
+}
+
+// normalizeRune folds case and full-width alphabets and digits, rune by rune
+// so that positions in the normalized text are the same as in the original
+func normalizeRune(r rune) rune {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+func classifyRune(r rune) runeClass {
+	switch {
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul),
+		r == 'ー', r == '々', r == '〆':
+		return classCJK
+	case unicode.IsLetter(r), unicode.IsDigit(r), unicode.Is(unicode.Mn, r):
+		return classWord
+	}
+	return classOther
+}
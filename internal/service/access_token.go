@@ -0,0 +1,216 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const accessTokenSecretBytes = 32
+
+type AccessTokenService interface {
+	ListAccessTokens(
+		userId domain.UserIdObject,
+	) ([]domain.AccessTokenEntity, *Error)
+	CreateAccessToken(
+		userId domain.UserIdObject,
+		accessToken domain.AccessTokenWithoutAutofieldEntity,
+	) (*domain.AccessTokenEntity, *domain.AccessTokenSecretObject, *Error)
+	RevokeAccessToken(
+		userId domain.UserIdObject,
+		accessTokenId domain.AccessTokenIdObject,
+	) *Error
+	AuthenticateAccessToken(
+		secret domain.AccessTokenSecretObject,
+	) (*domain.UserIdObject, *domain.AccessTokenEntity, *Error)
+}
+
+type accessTokenService struct {
+	repository repository.AccessTokenRepository
+}
+
+func NewAccessTokenService(repository repository.AccessTokenRepository) AccessTokenService {
+	return accessTokenService{repository: repository}
+}
+
+func (s accessTokenService) ListAccessTokens(
+	userId domain.UserIdObject,
+) ([]domain.AccessTokenEntity, *Error) {
+	entries, rErr := s.repository.FetchAccessTokens(userId.Value())
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch access tokens: %w", rErr.Unwrap())
+	}
+
+	accessTokens := []domain.AccessTokenEntity{}
+	for key, entry := range entries {
+		accessToken, sErr := s.entryToEntity(key, entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+
+		accessTokens = append(accessTokens, *accessToken)
+	}
+
+	sort.Slice(accessTokens, func(i, j int) bool {
+		if !accessTokens[i].CreatedAt().Value().Equal(accessTokens[j].CreatedAt().Value()) {
+			return accessTokens[i].CreatedAt().Value().Before(accessTokens[j].CreatedAt().Value())
+		}
+		return accessTokens[i].Id().Value() < accessTokens[j].Id().Value()
+	})
+
+	return accessTokens, nil
+}
+
+func (s accessTokenService) CreateAccessToken(
+	userId domain.UserIdObject,
+	accessToken domain.AccessTokenWithoutAutofieldEntity,
+) (*domain.AccessTokenEntity, *domain.AccessTokenSecretObject, *Error) {
+	secret, err := newAccessTokenSecret()
+	if err != nil {
+		return nil, nil, Errorf(DomainFailurePanic, "failed to generate access token secret: %w", err)
+	}
+
+	entry := record.AccessTokenWithoutAutofieldEntry{
+		Name:      accessToken.Name().Value(),
+		Scope:     accessToken.Scope().Value(),
+		TokenHash: hashAccessTokenSecret(*secret),
+	}
+	if accessToken.ProjectId() != nil {
+		entry.ProjectId = accessToken.ProjectId().Value()
+	}
+	if accessToken.ExpiresAt() != nil {
+		if !accessToken.ExpiresAt().Value().After(time.Now()) {
+			err := errors.New("access token expiration must be in the future")
+			return nil, nil, Errorf(InvalidArgumentError, "failed to create access token: %w", err)
+		}
+
+		expiresAt := accessToken.ExpiresAt().Value().UTC()
+		entry.ExpiresAt = &expiresAt
+	}
+
+	key, created, rErr := s.repository.InsertAccessToken(userId.Value(), entry)
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, nil, Errorf(NotFoundError, "failed to create access token: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, nil, Errorf(RepositoryFailurePanic, "failed to insert access token: %w", rErr.Unwrap())
+	}
+
+	entity, sErr := s.entryToEntity(key, *created)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+
+	return entity, secret, nil
+}
+
+func (s accessTokenService) RevokeAccessToken(
+	userId domain.UserIdObject,
+	accessTokenId domain.AccessTokenIdObject,
+) *Error {
+	rErr := s.repository.DeleteAccessToken(userId.Value(), accessTokenId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return Errorf(NotFoundError, "failed to revoke access token: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete access token: %w", rErr.Unwrap())
+	}
+
+	return nil
+}
+
+func (s accessTokenService) AuthenticateAccessToken(
+	secret domain.AccessTokenSecretObject,
+) (*domain.UserIdObject, *domain.AccessTokenEntity, *Error) {
+	key, entry, rErr := s.repository.FetchAccessTokenByHash(hashAccessTokenSecret(secret))
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, nil, Errorf(NotFoundError, "failed to authenticate access token: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, nil, Errorf(RepositoryFailurePanic, "failed to fetch access token: %w", rErr.Unwrap())
+	}
+
+	if entry.ExpiresAt != nil && !entry.ExpiresAt.After(time.Now()) {
+		err := errors.New("access token has expired")
+		return nil, nil, Errorf(NotFoundError, "failed to authenticate access token: %w", err)
+	}
+
+	userId, err := domain.NewUserIdObject(entry.UserId)
+	if err != nil {
+		return nil, nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (userId): %w", err)
+	}
+
+	entity, sErr := s.entryToEntity(key, *entry)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+
+	return userId, entity, nil
+}
+
+func (s accessTokenService) entryToEntity(
+	key string,
+	entry record.AccessTokenEntry,
+) (*domain.AccessTokenEntity, *Error) {
+	id, err := domain.NewAccessTokenIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	name, err := domain.NewAccessTokenNameObject(entry.Name)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+	}
+	scope, err := domain.NewAccessTokenScopeObject(entry.Scope)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (scope): %w", err)
+	}
+
+	var projectId *domain.ProjectIdObject
+	if entry.ProjectId != "" {
+		projectId, err = domain.NewProjectIdObject(entry.ProjectId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (projectId): %w", err)
+		}
+	}
+
+	var expiresAt *domain.ExpiresAtObject
+	if entry.ExpiresAt != nil {
+		expiresAt, err = domain.NewExpiresAtObject(*entry.ExpiresAt)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (expiresAt): %w", err)
+		}
+	}
+
+	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
+	}
+
+	return domain.NewAccessTokenEntity(*id, *name, *scope, projectId, expiresAt, *createdAt), nil
+}
+
+func newAccessTokenSecret() (*domain.AccessTokenSecretObject, error) {
+	random := make([]byte, accessTokenSecretBytes)
+	_, err := rand.Read(random)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewAccessTokenSecretObject(domain.AccessTokenSecretPrefix + base64.RawURLEncoding.EncodeToString(random))
+}
+
+// hashAccessTokenSecret returns the value stored in place of the secret.
+// Secrets are random enough that a plain SHA-256 cannot be reversed.
+func hashAccessTokenSecret(secret domain.AccessTokenSecretObject) string {
+	sum := sha256.Sum256([]byte(secret.Value()))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,363 @@
+package service_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAccessTokensValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := testutil.Date().Add(24 * time.Hour)
+
+	r := mock_repository.NewMockAccessTokenRepository(ctrl)
+	r.EXPECT().
+		FetchAccessTokens(testutil.ModifyOnlyUserId()).
+		Return(map[string]record.AccessTokenEntry{
+			"ACCESS_TOKEN_2": {
+				Name:      "CI",
+				Scope:     "read",
+				ProjectId: "0000000000000001",
+				ExpiresAt: &expiresAt,
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date().Add(-1 * time.Hour),
+			},
+			"ACCESS_TOKEN_1": {
+				Name:      "Script",
+				Scope:     "write",
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date().Add(-2 * time.Hour),
+			},
+		}, nil)
+
+	s := service.NewAccessTokenService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+
+	accessTokens, sErr := s.ListAccessTokens(*userId)
+	assert.Nil(t, sErr)
+	assert.Len(t, accessTokens, 2)
+
+	accessToken := accessTokens[0]
+	assert.Equal(t, "ACCESS_TOKEN_1", accessToken.Id().Value())
+	assert.Equal(t, "Script", accessToken.Name().Value())
+	assert.Equal(t, "write", accessToken.Scope().Value())
+	assert.Nil(t, accessToken.ProjectId())
+	assert.Nil(t, accessToken.ExpiresAt())
+	assert.Equal(t, testutil.Date().Add(-2*time.Hour), accessToken.CreatedAt().Value())
+
+	accessToken = accessTokens[1]
+	assert.Equal(t, "ACCESS_TOKEN_2", accessToken.Id().Value())
+	assert.Equal(t, "CI", accessToken.Name().Value())
+	assert.Equal(t, "read", accessToken.Scope().Value())
+	assert.Equal(t, "0000000000000001", accessToken.ProjectId().Value())
+	assert.Equal(t, expiresAt, accessToken.ExpiresAt().Value())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), accessToken.CreatedAt().Value())
+}
+
+func TestListAccessTokensRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockAccessTokenRepository(ctrl)
+	r.EXPECT().
+		FetchAccessTokens(testutil.ModifyOnlyUserId()).
+		Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+
+	s := service.NewAccessTokenService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+
+	accessTokens, sErr := s.ListAccessTokens(*userId)
+	assert.Nil(t, accessTokens)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to fetch access tokens: repository error", sErr.Error())
+}
+
+func TestCreateAccessTokenValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC()
+
+	var storedHash string
+	r := mock_repository.NewMockAccessTokenRepository(ctrl)
+	r.EXPECT().
+		InsertAccessToken(testutil.ModifyOnlyUserId(), gomock.Any()).
+		DoAndReturn(func(
+			userId string,
+			entry record.AccessTokenWithoutAutofieldEntry,
+		) (string, *record.AccessTokenEntry, *repository.Error) {
+			assert.Equal(t, "CI", entry.Name)
+			assert.Equal(t, "read", entry.Scope)
+			assert.Equal(t, "0000000000000001", entry.ProjectId)
+			assert.Equal(t, expiresAt, *entry.ExpiresAt)
+			storedHash = entry.TokenHash
+			return "ACCESS_TOKEN", &record.AccessTokenEntry{
+				Name:      entry.Name,
+				Scope:     entry.Scope,
+				ProjectId: entry.ProjectId,
+				ExpiresAt: entry.ExpiresAt,
+				UserId:    userId,
+				CreatedAt: testutil.Date(),
+			}, nil
+		})
+
+	s := service.NewAccessTokenService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	name, err := domain.NewAccessTokenNameObject("CI")
+	assert.Nil(t, err)
+	scope, err := domain.NewAccessTokenScopeObject("read")
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	expiresAtObject, err := domain.NewExpiresAtObject(expiresAt)
+	assert.Nil(t, err)
+	accessToken := domain.NewAccessTokenWithoutAutofieldEntity(*name, *scope, projectId, expiresAtObject)
+
+	created, secret, sErr := s.CreateAccessToken(*userId, *accessToken)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "ACCESS_TOKEN", created.Id().Value())
+	assert.Equal(t, "CI", created.Name().Value())
+	assert.Equal(t, "read", created.Scope().Value())
+	assert.Equal(t, "0000000000000001", created.ProjectId().Value())
+	assert.Equal(t, expiresAt, created.ExpiresAt().Value())
+	assert.Equal(t, testutil.Date(), created.CreatedAt().Value())
+
+	assert.True(t, strings.HasPrefix(secret.Value(), domain.AccessTokenSecretPrefix))
+	sum := sha256.Sum256([]byte(secret.Value()))
+	assert.Equal(t, hex.EncodeToString(sum[:]), storedHash)
+	assert.NotContains(t, storedHash, secret.Value())
+}
+
+func TestCreateAccessTokenExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockAccessTokenRepository(ctrl)
+
+	s := service.NewAccessTokenService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	name, err := domain.NewAccessTokenNameObject("CI")
+	assert.Nil(t, err)
+	scope, err := domain.NewAccessTokenScopeObject("read")
+	assert.Nil(t, err)
+	expiresAt, err := domain.NewExpiresAtObject(time.Now().Add(-1 * time.Hour))
+	assert.Nil(t, err)
+	accessToken := domain.NewAccessTokenWithoutAutofieldEntity(*name, *scope, nil, expiresAt)
+
+	created, secret, sErr := s.CreateAccessToken(*userId, *accessToken)
+	assert.Nil(t, created)
+	assert.Nil(t, secret)
+	assert.Equal(t, service.InvalidArgumentError, sErr.Code())
+	assert.Equal(t, "invalid argument: failed to create access token: access token expiration must be in the future", sErr.Error())
+}
+
+func TestCreateAccessTokenRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return not found error",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to create access token: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			errorCode:     repository.WriteFailurePanic,
+			expectedError: "repository failure: failed to insert access token: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockAccessTokenRepository(ctrl)
+			r.EXPECT().
+				InsertAccessToken(testutil.ModifyOnlyUserId(), gomock.Any()).
+				Return("", nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewAccessTokenService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			name, err := domain.NewAccessTokenNameObject("CI")
+			assert.Nil(t, err)
+			scope, err := domain.NewAccessTokenScopeObject("write")
+			assert.Nil(t, err)
+			accessToken := domain.NewAccessTokenWithoutAutofieldEntity(*name, *scope, nil, nil)
+
+			created, secret, sErr := s.CreateAccessToken(*userId, *accessToken)
+			assert.Nil(t, created)
+			assert.Nil(t, secret)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	tt := []struct {
+		name          string
+		rErr          *repository.Error
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name: "should revoke access token",
+		},
+		{
+			name:          "should return not found error",
+			rErr:          repository.Errorf(repository.NotFoundError, "repository error"),
+			expectedError: "not found: failed to revoke access token: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			rErr:          repository.Errorf(repository.WriteFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to delete access token: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockAccessTokenRepository(ctrl)
+			r.EXPECT().
+				DeleteAccessToken(testutil.ModifyOnlyUserId(), "ACCESS_TOKEN").
+				Return(tc.rErr)
+
+			s := service.NewAccessTokenService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			accessTokenId, err := domain.NewAccessTokenIdObject("ACCESS_TOKEN")
+			assert.Nil(t, err)
+
+			sErr := s.RevokeAccessToken(*userId, *accessTokenId)
+			if tc.rErr == nil {
+				assert.Nil(t, sErr)
+				return
+			}
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestAuthenticateAccessToken(t *testing.T) {
+	secretValue := domain.AccessTokenSecretPrefix + "SECRET"
+	sum := sha256.Sum256([]byte(secretValue))
+	tokenHash := hex.EncodeToString(sum[:])
+	expired := time.Now().Add(-1 * time.Hour)
+
+	tt := []struct {
+		name          string
+		entry         *record.AccessTokenEntry
+		rErr          *repository.Error
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name: "should resolve the user of access token",
+			entry: &record.AccessTokenEntry{
+				Name:      "CI",
+				Scope:     "read",
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date(),
+			},
+		},
+		{
+			name: "should return not found error when access token has expired",
+			entry: &record.AccessTokenEntry{
+				Name:      "CI",
+				Scope:     "read",
+				ExpiresAt: &expired,
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date(),
+			},
+			expectedError: "not found: failed to authenticate access token: access token has expired",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return not found error when access token is unknown",
+			rErr:          repository.Errorf(repository.NotFoundError, "repository error"),
+			expectedError: "not found: failed to authenticate access token: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			rErr:          repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to fetch access token: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockAccessTokenRepository(ctrl)
+			if tc.rErr != nil {
+				r.EXPECT().
+					FetchAccessTokenByHash(tokenHash).
+					Return("", nil, tc.rErr)
+			} else {
+				r.EXPECT().
+					FetchAccessTokenByHash(tokenHash).
+					Return("ACCESS_TOKEN", tc.entry, nil)
+			}
+
+			s := service.NewAccessTokenService(r)
+
+			secret, err := domain.NewAccessTokenSecretObject(secretValue)
+			assert.Nil(t, err)
+
+			userId, accessToken, sErr := s.AuthenticateAccessToken(*secret)
+			if tc.expectedError == "" {
+				assert.Nil(t, sErr)
+				assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+				assert.Equal(t, "ACCESS_TOKEN", accessToken.Id().Value())
+				assert.Equal(t, "0000000000000001", accessToken.ProjectId().Value())
+				assert.False(t, accessToken.Scope().CanWrite())
+				return
+			}
+			assert.Nil(t, userId)
+			assert.Nil(t, accessToken)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
@@ -25,6 +25,7 @@ type ChapterService interface {
 		projectId domain.ProjectIdObject,
 		chapterId domain.ChapterIdObject,
 		chapter domain.ChapterWithoutAutofieldEntity,
+		updatedAt *domain.UpdatedAtObject,
 	) (*domain.ChapterEntity, *Error)
 	DeleteChapter(
 		userId domain.UserIdObject,
@@ -34,15 +35,11 @@ type ChapterService interface {
 }
 
 type chapterService struct {
-	repository      repository.ChapterRepository
-	paperRepository repository.PaperRepository
+	repository repository.ChapterRepository
 }
 
-func NewChapterService(
-	repository repository.ChapterRepository,
-	paperRepository repository.PaperRepository,
-) ChapterService {
-	return chapterService{repository: repository, paperRepository: paperRepository}
+func NewChapterService(repository repository.ChapterRepository) ChapterService {
+	return chapterService{repository: repository}
 }
 
 func (s chapterService) ListChapters(
@@ -95,18 +92,6 @@ func (s chapterService) CreateChapter(
 		return nil, Errorf(RepositoryFailurePanic, "failed to create chapter: %w", rErr.Unwrap())
 	}
 
-	paperEntryWithoutAutofield := record.PaperWithoutAutofieldEntry{
-		Content: "",
-	}
-
-	_, _, rErr = s.paperRepository.InsertPaper(userId.Value(), projectId.Value(), key, paperEntryWithoutAutofield)
-	if rErr != nil && rErr.Code() == repository.NotFoundError {
-		return nil, Errorf(NotFoundError, "failed to create paper: %w", rErr.Unwrap())
-	}
-	if rErr != nil {
-		return nil, Errorf(RepositoryFailurePanic, "failed to create paper: %w", rErr.Unwrap())
-	}
-
 	return s.entryToEntity(key, *entry)
 }
 
@@ -115,6 +100,7 @@ func (s chapterService) UpdateChapter(
 	projectId domain.ProjectIdObject,
 	chapterId domain.ChapterIdObject,
 	chapter domain.ChapterWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
 ) (*domain.ChapterEntity, *Error) {
 	entryWithoutAutofield := record.ChapterWithoutAutofieldEntry{
 		Name:   chapter.Name().Value(),
@@ -126,7 +112,15 @@ func (s chapterService) UpdateChapter(
 		projectId.Value(),
 		chapterId.Value(),
 		entryWithoutAutofield,
+		updatedAtToTime(updatedAt),
 	)
+	if rErr != nil && rErr.Code() == repository.ConflictError {
+		current, sErr := s.entryToEntity(chapterId.Value(), *entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		return current, Errorf(ConflictError, "failed to update chapter: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
 		return nil, Errorf(InvalidArgumentError, "failed to update chapter: %w", rErr.Unwrap())
 	}
@@ -145,15 +139,7 @@ func (s chapterService) DeleteChapter(
 	projectId domain.ProjectIdObject,
 	chapterId domain.ChapterIdObject,
 ) *Error {
-	rErr := s.paperRepository.DeletePaper(userId.Value(), projectId.Value(), chapterId.Value())
-	if rErr != nil && rErr.Code() == repository.NotFoundError {
-		return Errorf(NotFoundError, "failed to delete paper: %w", rErr.Unwrap())
-	}
-	if rErr != nil {
-		return Errorf(RepositoryFailurePanic, "failed to delete paper: %w", rErr.Unwrap())
-	}
-
-	rErr = s.repository.DeleteChapter(userId.Value(), projectId.Value(), chapterId.Value())
+	rErr := s.repository.TrashChapter(userId.Value(), projectId.Value(), chapterId.Value())
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return Errorf(NotFoundError, "failed to delete chapter: %w", rErr.Unwrap())
 	}
@@ -91,9 +91,7 @@ func TestListChaptersValidEntry(t *testing.T) {
 			},
 		}, nil)
 
-	pr := mock_repository.NewMockPaperRepository(ctrl)
-
-	s := service.NewChapterService(r, pr)
+	s := service.NewChapterService(r)
 
 	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 	assert.Nil(t, err)
@@ -168,9 +166,7 @@ func TestListChaptersNoEntry(t *testing.T) {
 		FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
 		Return(map[string]record.ChapterEntry{}, nil)
 
-	pr := mock_repository.NewMockPaperRepository(ctrl)
-
-	s := service.NewChapterService(r, pr)
+	s := service.NewChapterService(r)
 
 	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 	assert.Nil(t, err)
@@ -329,9 +325,7 @@ func TestListChaptersInvalidEntry(t *testing.T) {
 					tc.chapterId: tc.chapter,
 				}, nil)
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -382,9 +376,7 @@ func TestListChaptersRepositoryError(t *testing.T) {
 				FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -440,18 +432,7 @@ func TestCreateChapterValidEntry(t *testing.T) {
 					UpdatedAt: testutil.Date(),
 				}, nil)
 
-			paper := record.PaperWithoutAutofieldEntry{Content: ""}
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-			pr.EXPECT().
-				InsertPaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", paper).
-				Return("1000000000000001", &record.PaperEntry{
-					Content:   paper.Content,
-					UserId:    testutil.ModifyOnlyUserId(),
-					CreatedAt: testutil.Date(),
-					UpdatedAt: testutil.Date(),
-				}, nil)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -604,18 +585,7 @@ func TestCreateChapterInvalidCreatedEntry(t *testing.T) {
 				}).
 				Return("1000000000000001", &tc.createdChapter, nil)
 
-			paper := record.PaperWithoutAutofieldEntry{Content: ""}
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-			pr.EXPECT().
-				InsertPaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", paper).
-				Return("1000000000000001", &record.PaperEntry{
-					Content:   paper.Content,
-					UserId:    testutil.ModifyOnlyUserId(),
-					CreatedAt: testutil.Date(),
-					UpdatedAt: testutil.Date(),
-				}, nil)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -683,80 +653,7 @@ func TestCreateChapterRepositoryError(t *testing.T) {
 				}).
 				Return("", nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
-
-			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
-			assert.Nil(t, err)
-			projectId, err := domain.NewProjectIdObject("0000000000000001")
-			assert.Nil(t, err)
-
-			name, err := domain.NewChapterNameObject("Chapter One")
-			assert.Nil(t, err)
-			number, err := domain.NewChapterNumberObject(1)
-			assert.Nil(t, err)
-
-			chapter := domain.NewChapterWithoutAutofieldEntity(*name, *number)
-
-			createdChapter, sErr := s.CreateChapter(*userId, *projectId, *chapter)
-			assert.NotNil(t, sErr)
-			assert.Equal(t, tc.expectedCode, sErr.Code())
-			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
-			assert.Nil(t, createdChapter)
-		})
-	}
-}
-
-func TestCreateChapterPaperRepositoryError(t *testing.T) {
-	tt := []struct {
-		name          string
-		errorCode     repository.ErrorCode
-		errorMessage  string
-		expectedError string
-		expectedCode  service.ErrorCode
-	}{
-		{
-			name:          "should return error when repository returns not found error",
-			errorCode:     repository.NotFoundError,
-			errorMessage:  "failed to fetch project",
-			expectedError: "failed to create paper: failed to fetch project",
-			expectedCode:  service.NotFoundError,
-		},
-		{
-			name:          "should return error when repository returns write failure error",
-			errorCode:     repository.WriteFailurePanic,
-			errorMessage:  "repository error",
-			expectedError: "failed to create paper: repository error",
-			expectedCode:  service.RepositoryFailurePanic,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			r := mock_repository.NewMockChapterRepository(ctrl)
-			r.EXPECT().
-				InsertChapter(testutil.ModifyOnlyUserId(), "0000000000000001", record.ChapterWithoutAutofieldEntry{
-					Name:   "Chapter One",
-					Number: 1,
-				}).
-				Return("1000000000000001", &record.ChapterEntry{
-					Name:     "Chapter One",
-					Number:   1,
-					Sections: []record.SectionEntry{},
-					UserId:   testutil.ModifyOnlyUserId(),
-				}, nil)
-
-			paper := record.PaperWithoutAutofieldEntry{Content: ""}
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-			pr.EXPECT().
-				InsertPaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", paper).
-				Return("", nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -834,7 +731,7 @@ func TestUpdateChapterValidEntry(t *testing.T) {
 
 			r := mock_repository.NewMockChapterRepository(ctrl)
 			r.EXPECT().
-				UpdateChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", tc.chapter).
+				UpdateChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", tc.chapter, nil).
 				Return(&record.ChapterEntry{
 					Name:      tc.chapter.Name,
 					Number:    tc.chapter.Number,
@@ -843,9 +740,7 @@ func TestUpdateChapterValidEntry(t *testing.T) {
 					UpdatedAt: testutil.Date(),
 				}, nil)
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -871,7 +766,7 @@ func TestUpdateChapterValidEntry(t *testing.T) {
 
 			chapter := domain.NewChapterWithoutAutofieldEntity(*name, *number)
 
-			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter)
+			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter, nil)
 			updatedSections := updatedChapter.Sections()
 			assert.Nil(t, sErr)
 
@@ -1019,12 +914,11 @@ func TestUpdateChapterInvalidUpdatedEntry(t *testing.T) {
 						Name:   "Chapter One",
 						Number: 1,
 					},
+					nil,
 				).
 				Return(&tc.updatedChapter, nil)
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -1040,7 +934,7 @@ func TestUpdateChapterInvalidUpdatedEntry(t *testing.T) {
 
 			chapter := domain.NewChapterWithoutAutofieldEntity(*name, *number)
 
-			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter)
+			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
 
@@ -1096,12 +990,11 @@ func TestUpdateChapterRepositoryError(t *testing.T) {
 						Name:   "Chapter One",
 						Number: 1,
 					},
+					nil,
 				).
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -1117,7 +1010,7 @@ func TestUpdateChapterRepositoryError(t *testing.T) {
 
 			chapter := domain.NewChapterWithoutAutofieldEntity(*name, *number)
 
-			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter)
+			updatedChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter, nil)
 
 			assert.NotNil(t, sErr)
 			assert.Equal(t, tc.expectedCode, sErr.Code())
@@ -1127,21 +1020,73 @@ func TestUpdateChapterRepositoryError(t *testing.T) {
 	}
 }
 
-func TestDeleteChapterValidEntry(t *testing.T) {
+func TestUpdateChapterConflict(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	r := mock_repository.NewMockChapterRepository(ctrl)
 	r.EXPECT().
-		DeleteChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-		Return(nil)
+		UpdateChapter(
+			testutil.ModifyOnlyUserId(),
+			"0000000000000001",
+			"1000000000000001",
+			record.ChapterWithoutAutofieldEntry{
+				Name:   "Updated Chapter",
+				Number: 1,
+			},
+			gomock.Any(),
+		).
+		Do(func(userId string, projectId string, chapterId string,
+			entry record.ChapterWithoutAutofieldEntry, expectedUpdatedAt *time.Time) {
+			assert.Equal(t, testutil.Date(), *expectedUpdatedAt)
+		}).
+		Return(&record.ChapterEntry{
+			Name:      "Chapter One",
+			Number:    2,
+			Sections:  []record.SectionEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date().Add(time.Hour),
+		}, repository.Errorf(repository.ConflictError, "chapter has been updated since it was fetched"))
+
+	s := service.NewChapterService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	name, err := domain.NewChapterNameObject("Updated Chapter")
+	assert.Nil(t, err)
+	number, err := domain.NewChapterNumberObject(1)
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	chapter := domain.NewChapterWithoutAutofieldEntity(*name, *number)
+
+	currentChapter, sErr := s.UpdateChapter(*userId, *projectId, *chapterId, *chapter, updatedAt)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.ConflictError, sErr.Code())
+	assert.Equal(t, "conflict: failed to update chapter: chapter has been updated since it was fetched", sErr.Error())
 
-	pr := mock_repository.NewMockPaperRepository(ctrl)
-	pr.EXPECT().
-		DeletePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+	assert.Equal(t, "1000000000000001", currentChapter.Id().Value())
+	assert.Equal(t, "Chapter One", currentChapter.Name().Value())
+	assert.Equal(t, 2, currentChapter.Number().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), currentChapter.UpdatedAt().Value())
+}
+
+func TestDeleteChapterValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockChapterRepository(ctrl)
+	r.EXPECT().
+		TrashChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
 		Return(nil)
 
-	s := service.NewChapterService(r, pr)
+	s := service.NewChapterService(r)
 
 	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 	assert.Nil(t, err)
@@ -1185,68 +1130,10 @@ func TestDeleteChapterRepositoryError(t *testing.T) {
 
 			r := mock_repository.NewMockChapterRepository(ctrl)
 			r.EXPECT().
-				DeleteChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
-
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-			pr.EXPECT().
-				DeletePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(nil)
-
-			s := service.NewChapterService(r, pr)
-
-			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
-			assert.Nil(t, err)
-			projectId, err := domain.NewProjectIdObject("0000000000000001")
-			assert.Nil(t, err)
-			chapterId, err := domain.NewChapterIdObject("1000000000000001")
-			assert.Nil(t, err)
-
-			sErr := s.DeleteChapter(*userId, *projectId, *chapterId)
-			assert.NotNil(t, sErr)
-			assert.Equal(t, tc.expectedCode, sErr.Code())
-			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
-		})
-	}
-}
-
-func TestDeletePaperRepositoryError(t *testing.T) {
-	tt := []struct {
-		name          string
-		errorCode     repository.ErrorCode
-		errorMessage  string
-		expectedError string
-		expectedCode  service.ErrorCode
-	}{
-		{
-			name:          "should return error when repository returns not found error",
-			errorCode:     repository.NotFoundError,
-			errorMessage:  "failed to delete paper",
-			expectedError: "failed to delete paper: failed to delete paper",
-			expectedCode:  service.NotFoundError,
-		},
-		{
-			name:          "should return error when repository returns write failure error",
-			errorCode:     repository.WriteFailurePanic,
-			errorMessage:  "repository error",
-			expectedError: "failed to delete paper: repository error",
-			expectedCode:  service.RepositoryFailurePanic,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			r := mock_repository.NewMockChapterRepository(ctrl)
-
-			pr := mock_repository.NewMockPaperRepository(ctrl)
-			pr.EXPECT().
-				DeletePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+				TrashChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
 				Return(repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			s := service.NewChapterService(r, pr)
+			s := service.NewChapterService(r)
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+func unifiedDiff(fromName string, toName string, from string, to string) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	changed := false
+	for _, line := range lines {
+		if line.op != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", fromName, toName)
+
+	fromLine, toLine := 0, 0
+	for start := 0; start < len(lines); {
+		first := start
+		for first < len(lines) && lines[first].op == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+
+		// extend the hunk while changes are separated by at most twice the context
+		last := first
+		for i := first; i < len(lines) && i-last <= 2*diffContextLines+1; i++ {
+			if lines[i].op != ' ' {
+				last = i
+			}
+		}
+
+		hunkStart := max(start, first-diffContextLines)
+		hunkEnd := min(len(lines), last+diffContextLines+1)
+
+		for _, line := range lines[start:hunkStart] {
+			fromLine, toLine = advanceDiffLine(line, fromLine, toLine)
+		}
+
+		fromCount, toCount := 0, 0
+		for _, line := range lines[hunkStart:hunkEnd] {
+			fromCount, toCount = advanceDiffLine(line, fromCount, toCount)
+		}
+
+		fmt.Fprintf(&builder, "@@ -%s +%s @@\n",
+			diffRange(fromLine, fromCount), diffRange(toLine, toCount))
+		for _, line := range lines[hunkStart:hunkEnd] {
+			builder.WriteByte(line.op)
+			builder.WriteString(line.text)
+			builder.WriteByte('\n')
+		}
+
+		fromLine += fromCount
+		toLine += toCount
+		start = hunkEnd
+	}
+
+	return builder.String()
+}
+
+func splitLines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+func diffLines(from []string, to []string) []diffLine {
+	// lines are compared by their IDs, which are equal if and only if the lines are equal
+	ids := make(map[string]int, len(from)+len(to))
+	fromIds := lineIds(from, ids)
+	toIds := lineIds(to, ids)
+
+	// lines which appear on only one side never match, so they are left out before comparing
+	fromIndexes := sharedLineIndexes(fromIds, toIds)
+	toIndexes := sharedLineIndexes(toIds, fromIds)
+	a := make([]int, len(fromIndexes))
+	for i, index := range fromIndexes {
+		a[i] = fromIds[index]
+	}
+	b := make([]int, len(toIndexes))
+	for i, index := range toIndexes {
+		b[i] = toIds[index]
+	}
+
+	size := (len(a)+len(b)+1)/2 + 1
+	d := myersDiff{a: a, b: b, forward: make([]int, 2*size+1), backward: make([]int, 2*size+1)}
+	d.compare(0, len(a), 0, len(b))
+
+	matches := make([]lineMatch, 0, len(d.matches)+1)
+	for _, match := range d.matches {
+		matches = append(matches, lineMatch{from: fromIndexes[match.from], to: toIndexes[match.to]})
+	}
+	matches = append(matches, lineMatch{from: len(from), to: len(to)})
+
+	lines := make([]diffLine, 0, len(from)+len(to))
+	i, j := 0, 0
+	for _, match := range matches {
+		// deleted lines come before inserted lines between two common lines
+		for ; i < match.from; i++ {
+			lines = append(lines, diffLine{op: '-', text: from[i]})
+		}
+		for ; j < match.to; j++ {
+			lines = append(lines, diffLine{op: '+', text: to[j]})
+		}
+		if i < len(from) {
+			lines = append(lines, diffLine{op: ' ', text: from[i]})
+			i++
+			j++
+		}
+	}
+	return lines
+}
+
+func lineIds(lines []string, ids map[string]int) []int {
+	result := make([]int, len(lines))
+	for i, line := range lines {
+		id, ok := ids[line]
+		if !ok {
+			id = len(ids)
+			ids[line] = id
+		}
+		result[i] = id
+	}
+	return result
+}
+
+func sharedLineIndexes(ids []int, otherIds []int) []int {
+	others := make(map[int]struct{}, len(otherIds))
+	for _, id := range otherIds {
+		others[id] = struct{}{}
+	}
+
+	indexes := []int{}
+	for i, id := range ids {
+		if _, ok := others[id]; ok {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+type lineMatch struct {
+	from int
+	to   int
+}
+
+// myersDiff finds the longest common subsequence of a and b with the linear space variation
+// of Myers' O(ND) difference algorithm, which splits the problem at the middle snake recursively.
+// forward and backward are the furthest reaching x of each diagonal, shared by every recursion.
+type myersDiff struct {
+	a        []int
+	b        []int
+	forward  []int
+	backward []int
+	matches  []lineMatch
+}
+
+// compare appends the common lines of a[aLow:aHigh] and b[bLow:bHigh] to matches in order
+func (d *myersDiff) compare(aLow int, aHigh int, bLow int, bHigh int) {
+	for aLow < aHigh && bLow < bHigh && d.a[aLow] == d.b[bLow] {
+		d.matches = append(d.matches, lineMatch{from: aLow, to: bLow})
+		aLow++
+		bLow++
+	}
+	suffix := 0
+	for aLow < aHigh-suffix && bLow < bHigh-suffix && d.a[aHigh-1-suffix] == d.b[bHigh-1-suffix] {
+		suffix++
+	}
+	aHigh -= suffix
+	bHigh -= suffix
+
+	if aLow < aHigh && bLow < bHigh {
+		x, y, u, v := d.middleSnake(aLow, aHigh, bLow, bHigh)
+		d.compare(aLow, x, bLow, y)
+		for ; x < u; x, y = x+1, y+1 {
+			d.matches = append(d.matches, lineMatch{from: x, to: y})
+		}
+		d.compare(u, aHigh, v, bHigh)
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.matches = append(d.matches, lineMatch{from: aHigh + i, to: bHigh + i})
+	}
+}
+
+// middleSnake returns the snake from (x, y) to (u, v) in the middle of a shortest edit script
+// of a[aLow:aHigh] and b[bLow:bHigh], both of which are not empty
+func (d *myersDiff) middleSnake(aLow int, aHigh int, bLow int, bHigh int) (int, int, int, int) {
+	n, m := aHigh-aLow, bHigh-bLow
+	delta := n - m
+	odd := delta%2 != 0
+
+	// diagonal k is stored at k+offset, and the backward diagonal k corresponds to the forward diagonal delta-k
+	offset := len(d.forward) / 2
+	d.forward[offset+1] = 0
+	d.backward[offset+1] = 0
+
+	for depth := 0; depth <= (n+m+1)/2; depth++ {
+		for k := -depth; k <= depth; k += 2 {
+			x := d.forward[offset+k-1] + 1
+			if k == -depth || (k != depth && d.forward[offset+k-1] < d.forward[offset+k+1]) {
+				x = d.forward[offset+k+1]
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.a[aLow+x] == d.b[bLow+y] {
+				x++
+				y++
+			}
+			d.forward[offset+k] = x
+
+			if odd && delta-k >= -(depth-1) && delta-k <= depth-1 && x+d.backward[offset+delta-k] >= n {
+				return aLow + startX, bLow + startY, aLow + x, bLow + y
+			}
+		}
+
+		for k := -depth; k <= depth; k += 2 {
+			x := d.backward[offset+k-1] + 1
+			if k == -depth || (k != depth && d.backward[offset+k-1] < d.backward[offset+k+1]) {
+				x = d.backward[offset+k+1]
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.a[aHigh-1-x] == d.b[bHigh-1-y] {
+				x++
+				y++
+			}
+			d.backward[offset+k] = x
+
+			if !odd && delta-k >= -depth && delta-k <= depth && x+d.forward[offset+delta-k] >= n {
+				return aHigh - x, bHigh - y, aHigh - startX, bHigh - startY
+			}
+		}
+	}
+
+	// unreachable since a shortest edit script has at most n+m edits
+	return aLow, bLow, aLow, bLow
+}
+
+func advanceDiffLine(line diffLine, fromLine int, toLine int) (int, int) {
+	if line.op != '+' {
+		fromLine++
+	}
+	if line.op != '-' {
+		toLine++
+	}
+	return fromLine, toLine
+}
+
+func diffRange(before int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
@@ -9,6 +9,7 @@ type ErrorCode string
 const (
 	InvalidArgumentError   ErrorCode = "invalid argument"
 	NotFoundError          ErrorCode = "not found"
+	ConflictError          ErrorCode = "conflict"
 	DomainFailurePanic     ErrorCode = "domain failure"
 	RepositoryFailurePanic ErrorCode = "repository failure"
 )
@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
@@ -11,6 +12,11 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
 
 type GraphService interface {
+	ListGraphs(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+	) ([]domain.GraphEntity, *Error)
 	FindGraph(
 		userId domain.UserIdObject,
 		projectId domain.ProjectIdObject,
@@ -23,6 +29,7 @@ type GraphService interface {
 		chapterId domain.ChapterIdObject,
 		graphId domain.GraphIdObject,
 		graph domain.GraphContentEntity,
+		updatedAt *domain.UpdatedAtObject,
 	) (*domain.GraphEntity, *Error)
 	DeleteGraph(
 		userId domain.UserIdObject,
@@ -36,18 +43,122 @@ type GraphService interface {
 		chapterId domain.ChapterIdObject,
 		sections domain.SectionWithoutAutofieldEntityList,
 	) ([]domain.GraphEntity, *Error)
+	PreviewResectionalization(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sections domain.SectionWithoutAutofieldEntityList,
+	) (*domain.GraphResectionalizationEntity, *Error)
+	ResectionalizeGraphs(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sections domain.SectionWithoutAutofieldEntityList,
+		orphanAction domain.GraphOrphanActionObject,
+	) ([]domain.GraphEntity, []domain.GraphEntity, *Error)
+	AddGraphChild(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		parentPath domain.GraphPathObject,
+		child domain.GraphChildEntity,
+	) (*domain.GraphEntity, *Error)
+	RenameGraphChild(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		path domain.GraphPathObject,
+		name domain.GraphNameObject,
+	) (*domain.GraphEntity, *Error)
+	UpdateGraphChild(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		path domain.GraphPathObject,
+		relation domain.GraphRelationObject,
+		description domain.GraphDescriptionObject,
+	) (*domain.GraphEntity, *Error)
+	MoveGraphChild(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		path domain.GraphPathObject,
+		parentPath domain.GraphPathObject,
+	) (*domain.GraphEntity, *Error)
+	DeleteGraphChild(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		path domain.GraphPathObject,
+	) (*domain.GraphEntity, *Error)
+	ListGraphRevisions(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+	) ([]domain.GraphRevisionEntity, *Error)
+	FindGraphRevision(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		revisionId domain.GraphRevisionIdObject,
+	) (*domain.GraphRevisionEntity, *Error)
+	DiffGraphRevisions(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		fromRevisionId domain.GraphRevisionIdObject,
+		toRevisionId domain.GraphRevisionIdObject,
+	) (*domain.GraphRevisionDiffEntity, *Error)
+	RestoreGraphRevision(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+		revisionId domain.GraphRevisionIdObject,
+	) (*domain.GraphEntity, *Error)
+	AssignMissingGraphChildIds() (int, *Error)
 }
 
 type graphService struct {
-	repository        repository.GraphRepository
-	chapterRepository repository.ChapterRepository
+	repository repository.GraphRepository
+	retention  RevisionRetention
 }
 
-func NewGraphService(
-	repository repository.GraphRepository,
-	chapterRepository repository.ChapterRepository,
-) GraphService {
-	return graphService{repository: repository, chapterRepository: chapterRepository}
+func NewGraphService(repository repository.GraphRepository, retention RevisionRetention) GraphService {
+	return graphService{repository: repository, retention: retention}
+}
+
+func (s graphService) ListGraphs(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+) ([]domain.GraphEntity, *Error) {
+	keys, entries, rErr := s.repository.FetchGraphs(userId.Value(), projectId.Value(), chapterId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list graphs: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+	}
+
+	entities := make([]domain.GraphEntity, len(entries))
+	for i, entry := range entries {
+		entity, sErr := s.entryToEntity(keys[i], entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		entities[i] = *entity
+	}
+
+	return entities, nil
 }
 
 func (s graphService) FindGraph(
@@ -73,6 +184,7 @@ func (s graphService) UpdateGraphContent(
 	chapterId domain.ChapterIdObject,
 	graphId domain.GraphIdObject,
 	graph domain.GraphContentEntity,
+	updatedAt *domain.UpdatedAtObject,
 ) (*domain.GraphEntity, *Error) {
 	entryWithoutAutofield := record.GraphContentEntry{
 		Paragraph: graph.Paragraph().Value(),
@@ -85,7 +197,15 @@ func (s graphService) UpdateGraphContent(
 		chapterId.Value(),
 		graphId.Value(),
 		entryWithoutAutofield,
+		updatedAtToTime(updatedAt),
 	)
+	if rErr != nil && rErr.Code() == repository.ConflictError {
+		current, sErr := s.entryToEntity(graphId.Value(), *entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		return current, Errorf(ConflictError, "failed to update graph content: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return nil, Errorf(NotFoundError, "failed to update graph content: %w", rErr.Unwrap())
 	}
@@ -93,6 +213,11 @@ func (s graphService) UpdateGraphContent(
 		return nil, Errorf(RepositoryFailurePanic, "failed to update graph content: %w", rErr.Unwrap())
 	}
 
+	sErr := s.pruneRevisions(userId, projectId, chapterId, graphId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
 	return s.entryToEntity(graphId.Value(), *entry)
 }
 
@@ -102,39 +227,7 @@ func (s graphService) DeleteGraph(
 	chapterId domain.ChapterIdObject,
 	sectionId domain.SectionIdObject,
 ) *Error {
-	chapter, rErr := s.chapterRepository.FetchChapter(userId.Value(), projectId.Value(), chapterId.Value())
-	if rErr != nil && rErr.Code() == repository.NotFoundError {
-		return Errorf(NotFoundError, "failed to delete graph: %w", rErr.Unwrap())
-	}
-	if rErr != nil {
-		return Errorf(RepositoryFailurePanic, "failed to delete graph: %w", rErr.Unwrap())
-	}
-
-	rErr = s.repository.DeleteGraph(userId.Value(), projectId.Value(), chapterId.Value(), sectionId.Value())
-	if rErr != nil && rErr.Code() == repository.NotFoundError {
-		return Errorf(NotFoundError, "failed to delete graph: %w", rErr.Unwrap())
-	}
-	if rErr != nil {
-		return Errorf(RepositoryFailurePanic, "failed to delete graph: %w", rErr.Unwrap())
-	}
-
-	sectionWithoutAutofield := make([]record.SectionWithoutAutofieldEntry, 0, len(chapter.Sections)-1)
-	for _, section := range chapter.Sections {
-		if section.Id == sectionId.Value() {
-			continue
-		}
-		sectionWithoutAutofield = append(sectionWithoutAutofield, record.SectionWithoutAutofieldEntry{
-			Id:   section.Id,
-			Name: section.Name,
-		})
-	}
-
-	_, rErr = s.chapterRepository.UpdateChapterSections(
-		userId.Value(),
-		projectId.Value(),
-		chapterId.Value(),
-		sectionWithoutAutofield,
-	)
+	rErr := s.repository.TrashGraph(userId.Value(), projectId.Value(), chapterId.Value(), sectionId.Value())
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return Errorf(NotFoundError, "failed to delete graph: %w", rErr.Unwrap())
 	}
@@ -178,6 +271,9 @@ func (s graphService) SectionalizeIntoGraphs(
 		chapterId.Value(),
 		entriesWithoutAutofield,
 	)
+	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
+		return nil, Errorf(InvalidArgumentError, "failed to sectionalize into graphs: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return nil, Errorf(NotFoundError, "failed to sectionalize into graphs: %w", rErr.Unwrap())
 	}
@@ -194,30 +290,457 @@ func (s graphService) SectionalizeIntoGraphs(
 		entities[i] = *entity
 	}
 
-	sectionWithoutAutofield := make([]record.SectionWithoutAutofieldEntry, sections.Len())
+	return entities, nil
+}
+
+func (s graphService) PreviewResectionalization(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sections domain.SectionWithoutAutofieldEntityList,
+) (*domain.GraphResectionalizationEntity, *Error) {
+	keys, entries, rErr := s.repository.FetchGraphs(userId.Value(), projectId.Value(), chapterId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to preview resectionalization: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+	}
+
+	matches := s.matchSections(sections, entries)
+
+	matchEntities := make([]domain.GraphMatchEntity, sections.Len())
 	for i, section := range sections.Value() {
-		sectionWithoutAutofield[i] = record.SectionWithoutAutofieldEntry{
-			Id:   keys[i],
-			Name: section.Name().Value(),
+		var graphId *domain.GraphIdObject
+		if matches[i] >= 0 {
+			id, err := domain.NewGraphIdObject(keys[matches[i]])
+			if err != nil {
+				return nil, Errorf(DomainFailurePanic, "failed to preview resectionalization: %w", err)
+			}
+			graphId = id
 		}
+		matchEntities[i] = *domain.NewGraphMatchEntity(*section.Name(), graphId)
+	}
+
+	orphans, sErr := s.orphanEntities(keys, entries, matches)
+	if sErr != nil {
+		return nil, sErr
 	}
 
-	_, rErr = s.chapterRepository.UpdateChapterSections(
+	return domain.NewGraphResectionalizationEntity(matchEntities, orphans), nil
+}
+
+func (s graphService) ResectionalizeGraphs(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sections domain.SectionWithoutAutofieldEntityList,
+	orphanAction domain.GraphOrphanActionObject,
+) ([]domain.GraphEntity, []domain.GraphEntity, *Error) {
+	keys, entries, rErr := s.repository.FetchGraphs(userId.Value(), projectId.Value(), chapterId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, nil, Errorf(NotFoundError, "failed to resectionalize graphs: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+	}
+
+	matches := s.matchSections(sections, entries)
+
+	resectionalizeEntries := make([]record.GraphResectionalizeEntry, sections.Len())
+	for i, section := range sections.Value() {
+		resectionalizeEntries[i] = record.GraphResectionalizeEntry{
+			Name:      section.Name().Value(),
+			Paragraph: section.Content().Value(),
+		}
+		if matches[i] >= 0 {
+			resectionalizeEntries[i].Id = keys[matches[i]]
+		}
+	}
+
+	orphans, sErr := s.orphanEntities(keys, entries, matches)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+	orphanIds := make([]string, len(orphans))
+	for i, orphan := range orphans {
+		orphanIds[i] = orphan.Id().Value()
+	}
+
+	newKeys, newEntries, rErr := s.repository.ResectionalizeGraphs(
 		userId.Value(),
 		projectId.Value(),
 		chapterId.Value(),
-		sectionWithoutAutofield,
+		resectionalizeEntries,
+		orphanIds,
+		orphanAction.Value(),
 	)
+	if rErr != nil && rErr.Code() == repository.ConflictError {
+		return nil, nil, Errorf(ConflictError, "failed to resectionalize graphs: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
-		return nil, Errorf(NotFoundError, "failed to sectionalize into graphs: %w", rErr.Unwrap())
+		return nil, nil, Errorf(NotFoundError, "failed to resectionalize graphs: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, nil, Errorf(RepositoryFailurePanic, "failed to resectionalize graphs: %w", rErr.Unwrap())
+	}
+
+	entities := make([]domain.GraphEntity, len(newEntries))
+	for i, entry := range newEntries {
+		entity, sErr := s.entryToEntity(newKeys[i], entry)
+		if sErr != nil {
+			return nil, nil, sErr
+		}
+		entities[i] = *entity
+
+		if resectionalizeEntries[i].Id == "" {
+			continue
+		}
+		sErr = s.pruneRevisions(userId, projectId, chapterId, *entity.Id())
+		if sErr != nil {
+			return nil, nil, sErr
+		}
+	}
+
+	return entities, orphans, nil
+}
+
+func (s graphService) AddGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	parentPath domain.GraphPathObject,
+	child domain.GraphChildEntity,
+) (*domain.GraphEntity, *Error) {
+	return s.updateGraphChildren(userId, projectId, chapterId, sectionId, "add graph child",
+		func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error) {
+			return children.AddChild(parentPath, child)
+		})
+}
+
+func (s graphService) RenameGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	name domain.GraphNameObject,
+) (*domain.GraphEntity, *Error) {
+	return s.updateGraphChildren(userId, projectId, chapterId, sectionId, "rename graph child",
+		func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error) {
+			return children.RenameChild(path, name)
+		})
+}
+
+func (s graphService) UpdateGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	relation domain.GraphRelationObject,
+	description domain.GraphDescriptionObject,
+) (*domain.GraphEntity, *Error) {
+	return s.updateGraphChildren(userId, projectId, chapterId, sectionId, "update graph child",
+		func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error) {
+			return children.UpdateChild(path, relation, description)
+		})
+}
+
+func (s graphService) MoveGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	parentPath domain.GraphPathObject,
+) (*domain.GraphEntity, *Error) {
+	return s.updateGraphChildren(userId, projectId, chapterId, sectionId, "move graph child",
+		func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error) {
+			return children.MoveChild(path, parentPath)
+		})
+}
+
+func (s graphService) DeleteGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+) (*domain.GraphEntity, *Error) {
+	return s.updateGraphChildren(userId, projectId, chapterId, sectionId, "delete graph child",
+		func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error) {
+			return children.DeleteChild(path)
+		})
+}
+
+func (s graphService) ListGraphRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+) ([]domain.GraphRevisionEntity, *Error) {
+	entries, rErr := s.repository.FetchGraphRevisions(
+		userId.Value(),
+		projectId.Value(),
+		chapterId.Value(),
+		sectionId.Value(),
+	)
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list graph revisions: %w", rErr.Unwrap())
 	}
 	if rErr != nil {
-		return nil, Errorf(RepositoryFailurePanic, "failed to update sections of chapter: %w", rErr.Unwrap())
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch graph revisions: %w", rErr.Unwrap())
 	}
 
+	createdAts := make(map[string]time.Time, len(entries))
+	for id, entry := range entries {
+		createdAts[id] = entry.CreatedAt
+	}
+	ids := sortedRevisionIds(createdAts)
+
+	entities := make([]domain.GraphRevisionEntity, len(ids))
+	for i, id := range ids {
+		entity, sErr := s.entryToRevisionEntity(id, entries[id])
+		if sErr != nil {
+			return nil, sErr
+		}
+		entities[i] = *entity
+	}
 	return entities, nil
 }
 
+func (s graphService) FindGraphRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	revisionId domain.GraphRevisionIdObject,
+) (*domain.GraphRevisionEntity, *Error) {
+	entry, rErr := s.repository.FetchGraphRevision(
+		userId.Value(),
+		projectId.Value(),
+		chapterId.Value(),
+		sectionId.Value(),
+		revisionId.Value(),
+	)
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to find graph revision: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch graph revision: %w", rErr.Unwrap())
+	}
+
+	return s.entryToRevisionEntity(revisionId.Value(), *entry)
+}
+
+func (s graphService) DiffGraphRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	fromRevisionId domain.GraphRevisionIdObject,
+	toRevisionId domain.GraphRevisionIdObject,
+) (*domain.GraphRevisionDiffEntity, *Error) {
+	from, sErr := s.FindGraphRevision(userId, projectId, chapterId, sectionId, fromRevisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+	to, sErr := s.FindGraphRevision(userId, projectId, chapterId, sectionId, toRevisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	changes := structuralGraphDiff(*from.Paragraph(), *from.Children(), *to.Paragraph(), *to.Children())
+
+	entities := make([]domain.GraphRevisionChangeEntity, len(changes))
+	for i, change := range changes {
+		changeType, err := domain.NewGraphRevisionChangeTypeObject(change.changeType)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to diff graph revisions: %w", err)
+		}
+		entities[i] = *domain.NewGraphRevisionChangeEntity(
+			*changeType,
+			change.fromPath,
+			change.toPath,
+			change.fromValue,
+			change.toValue,
+		)
+	}
+
+	return domain.NewGraphRevisionDiffEntity(entities), nil
+}
+
+func (s graphService) RestoreGraphRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	revisionId domain.GraphRevisionIdObject,
+) (*domain.GraphEntity, *Error) {
+	revision, sErr := s.FindGraphRevision(userId, projectId, chapterId, sectionId, revisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	graphId, err := domain.NewGraphIdObject(sectionId.Value())
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to restore graph revision: %w", err)
+	}
+
+	graph := domain.NewGraphContentEntity(*revision.Paragraph(), *revision.Children())
+	return s.UpdateGraphContent(userId, projectId, chapterId, *graphId, *graph, nil)
+}
+
+func (s graphService) AssignMissingGraphChildIds() (int, *Error) {
+	count, rErr := s.repository.AssignMissingGraphChildIds()
+	if rErr != nil {
+		return count, Errorf(RepositoryFailurePanic, "failed to assign missing graph child ids: %w", rErr.Unwrap())
+	}
+	return count, nil
+}
+
+func (s graphService) updateGraphChildren(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	operation string,
+	update func(children domain.GraphChildrenEntity) (*domain.GraphChildrenEntity, error),
+) (*domain.GraphEntity, *Error) {
+	var conversionErr *Error
+	entry, rErr := s.repository.UpdateGraphChildren(
+		userId.Value(),
+		projectId.Value(),
+		chapterId.Value(),
+		sectionId.Value(),
+		func(children []record.GraphChildEntry) ([]record.GraphChildEntry, error) {
+			entity, sErr := s.childrenEntryToEntity(children)
+			if sErr != nil {
+				conversionErr = sErr
+				return nil, sErr
+			}
+			updated, err := update(*entity)
+			if err != nil {
+				return nil, err
+			}
+			return s.childrenEntityToEntry(*updated), nil
+		},
+	)
+	if conversionErr != nil {
+		return nil, conversionErr
+	}
+	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
+		return nil, Errorf(InvalidArgumentError, "failed to %s: %w", operation, rErr.Unwrap())
+	}
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to %s: %w", operation, rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to %s: %w", operation, rErr.Unwrap())
+	}
+
+	graphId, err := domain.NewGraphIdObject(sectionId.Value())
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to %s: %w", operation, err)
+	}
+
+	sErr := s.pruneRevisions(userId, projectId, chapterId, *graphId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	return s.entryToEntity(sectionId.Value(), *entry)
+}
+
+func (s graphService) pruneRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	graphId domain.GraphIdObject,
+) *Error {
+	if !s.retention.enabled() {
+		return nil
+	}
+
+	entries, rErr := s.repository.FetchGraphRevisions(
+		userId.Value(),
+		projectId.Value(),
+		chapterId.Value(),
+		graphId.Value(),
+	)
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to fetch graph revisions: %w", rErr.Unwrap())
+	}
+
+	createdAts := make(map[string]time.Time, len(entries))
+	for id, entry := range entries {
+		createdAts[id] = entry.CreatedAt
+	}
+
+	revisionIds := s.retention.revisionIdsToPrune(createdAts)
+	if len(revisionIds) == 0 {
+		return nil
+	}
+
+	rErr = s.repository.DeleteGraphRevisions(
+		userId.Value(),
+		projectId.Value(),
+		chapterId.Value(),
+		graphId.Value(),
+		revisionIds,
+	)
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete graph revisions: %w", rErr.Unwrap())
+	}
+	return nil
+}
+
+func (s graphService) matchSections(
+	sections domain.SectionWithoutAutofieldEntityList,
+	entries []record.GraphEntry,
+) []int {
+	sectionCandidates := make([]graphMatchCandidate, sections.Len())
+	for i, section := range sections.Value() {
+		sectionCandidates[i] = graphMatchCandidate{
+			name:      section.Name().Value(),
+			paragraph: section.Content().Value(),
+		}
+	}
+	graphCandidates := make([]graphMatchCandidate, len(entries))
+	for i, entry := range entries {
+		graphCandidates[i] = graphMatchCandidate{name: entry.Name, paragraph: entry.Paragraph}
+	}
+	return matchSectionsToGraphs(sectionCandidates, graphCandidates)
+}
+
+func (s graphService) orphanEntities(
+	keys []string,
+	entries []record.GraphEntry,
+	matches []int,
+) ([]domain.GraphEntity, *Error) {
+	matched := make([]bool, len(entries))
+	for _, match := range matches {
+		if match >= 0 {
+			matched[match] = true
+		}
+	}
+
+	orphans := []domain.GraphEntity{}
+	for i, entry := range entries {
+		if matched[i] {
+			continue
+		}
+		entity, sErr := s.entryToEntity(keys[i], entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		orphans = append(orphans, *entity)
+	}
+	return orphans, nil
+}
+
 func (s graphService) entryToEntity(key string, entry record.GraphEntry) (*domain.GraphEntity, *Error) {
 	id, err := domain.NewGraphIdObject(key)
 	if err != nil {
@@ -247,9 +770,45 @@ func (s graphService) entryToEntity(key string, entry record.GraphEntry) (*domai
 	return domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt), nil
 }
 
+func (s graphService) entryToRevisionEntity(
+	key string,
+	entry record.GraphRevisionEntry,
+) (*domain.GraphRevisionEntity, *Error) {
+	id, err := domain.NewGraphRevisionIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	paragraph, err := domain.NewGraphParagraphObject(entry.Paragraph)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (paragraph): %w", err)
+	}
+	children, sErr := s.childrenEntryToEntity(entry.Children)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (children): %w", sErr.Unwrap())
+	}
+	authorId, err := domain.NewUserIdObject(entry.AuthorId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (authorId): %w", err)
+	}
+	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
+	}
+
+	return domain.NewGraphRevisionEntity(*id, *paragraph, *children, *authorId, *createdAt), nil
+}
+
 func (s graphService) childrenEntryToEntity(entry []record.GraphChildEntry) (*domain.GraphChildrenEntity, *Error) {
 	entities := make([]domain.GraphChildEntity, len(entry))
 	for i, child := range entry {
+		var id *domain.GraphChildIdObject
+		if child.Id != "" {
+			childId, err := domain.NewGraphChildIdObject(child.Id)
+			if err != nil {
+				return nil, Errorf(DomainFailurePanic, "failed to convert child entry to entity (id): %w", err)
+			}
+			id = childId
+		}
 		name, err := domain.NewGraphNameObject(child.Name)
 		if err != nil {
 			return nil, Errorf(DomainFailurePanic, "failed to convert child entry to entity (name): %w", err)
@@ -263,7 +822,7 @@ func (s graphService) childrenEntryToEntity(entry []record.GraphChildEntry) (*do
 			return nil, Errorf(DomainFailurePanic, "failed to convert child entry to entity (description): %w", err)
 		}
 		children, err := s.childrenEntryToEntity(child.Children)
-		entities[i] = *domain.NewGraphChildEntity(*name, *relation, *description, *children)
+		entities[i] = *domain.NewGraphChildEntity(id, *name, *relation, *description, *children)
 	}
 
 	entity, err := domain.NewGraphChildrenEntity(entities)
@@ -276,7 +835,12 @@ func (s graphService) childrenEntryToEntity(entry []record.GraphChildEntry) (*do
 func (s graphService) childrenEntityToEntry(entity domain.GraphChildrenEntity) []record.GraphChildEntry {
 	entries := make([]record.GraphChildEntry, entity.Len())
 	for i, child := range entity.Value() {
+		id := ""
+		if child.Id() != nil {
+			id = child.Id().Value()
+		}
 		entries[i] = record.GraphChildEntry{
+			Id:          id,
 			Name:        child.Name().Value(),
 			Relation:    child.Relation().Value(),
 			Description: child.Description().Value(),
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+)
+
+const (
+	graphChangeParagraph   = "paragraph"
+	graphChangeAdded       = "added"
+	graphChangeRemoved     = "removed"
+	graphChangeRenamed     = "renamed"
+	graphChangeMoved       = "moved"
+	graphChangeRelation    = "relation"
+	graphChangeDescription = "description"
+)
+
+type graphDiffChange struct {
+	changeType string
+	fromPath   []domain.GraphNameObject
+	toPath     []domain.GraphNameObject
+	fromValue  string
+	toValue    string
+}
+
+// graphDiffCandidate is a node which has no counterpart with the same name under the matched parent.
+// root is false for the descendants of such a node, which can still be matched when they are moved elsewhere.
+type graphDiffCandidate struct {
+	child     domain.GraphChildEntity
+	path      []domain.GraphNameObject
+	parentKey string
+	index     int
+	root      bool
+	consumed  bool
+}
+
+type graphDiffer struct {
+	changes []graphDiffChange
+	removed []*graphDiffCandidate
+	added   []*graphDiffCandidate
+}
+
+func structuralGraphDiff(
+	fromParagraph domain.GraphParagraphObject,
+	fromChildren domain.GraphChildrenEntity,
+	toParagraph domain.GraphParagraphObject,
+	toChildren domain.GraphChildrenEntity,
+) []graphDiffChange {
+	d := &graphDiffer{}
+
+	if fromParagraph.Value() != toParagraph.Value() {
+		d.changes = append(d.changes, graphDiffChange{
+			changeType: graphChangeParagraph,
+			fromValue:  fromParagraph.Value(),
+			toValue:    toParagraph.Value(),
+		})
+	}
+
+	d.compareChildren(fromChildren, toChildren, []domain.GraphNameObject{}, []domain.GraphNameObject{})
+
+	// nodes with the same id are the same node whatever their names are.
+	// otherwise, prefer moves over renames so that a node moved into the position of a removed node is not taken as renamed
+	for {
+		if !d.pairIdentified() && !d.pairMoved(true) && !d.pairRenamed() && !d.pairMoved(false) {
+			break
+		}
+	}
+
+	for _, candidate := range d.removed {
+		if candidate.root && !candidate.consumed {
+			d.changes = append(d.changes, graphDiffChange{changeType: graphChangeRemoved, fromPath: candidate.path})
+		}
+	}
+	for _, candidate := range d.added {
+		if candidate.root && !candidate.consumed {
+			d.changes = append(d.changes, graphDiffChange{changeType: graphChangeAdded, toPath: candidate.path})
+		}
+	}
+
+	return d.changes
+}
+
+func (d *graphDiffer) compareNodes(
+	from domain.GraphChildEntity,
+	to domain.GraphChildEntity,
+	fromPath []domain.GraphNameObject,
+	toPath []domain.GraphNameObject,
+) {
+	if from.Relation().Value() != to.Relation().Value() {
+		d.changes = append(d.changes, graphDiffChange{
+			changeType: graphChangeRelation,
+			fromPath:   fromPath,
+			toPath:     toPath,
+			fromValue:  from.Relation().Value(),
+			toValue:    to.Relation().Value(),
+		})
+	}
+	if from.Description().Value() != to.Description().Value() {
+		d.changes = append(d.changes, graphDiffChange{
+			changeType: graphChangeDescription,
+			fromPath:   fromPath,
+			toPath:     toPath,
+			fromValue:  from.Description().Value(),
+			toValue:    to.Description().Value(),
+		})
+	}
+
+	d.compareChildren(*from.Children(), *to.Children(), fromPath, toPath)
+}
+
+func (d *graphDiffer) compareChildren(
+	from domain.GraphChildrenEntity,
+	to domain.GraphChildrenEntity,
+	fromPath []domain.GraphNameObject,
+	toPath []domain.GraphNameObject,
+) {
+	toIndexes := make(map[string]int, to.Len())
+	for i, child := range to.Value() {
+		toIndexes[child.Name().Value()] = i
+	}
+	fromNames := make(map[string]struct{}, from.Len())
+	for _, child := range from.Value() {
+		fromNames[child.Name().Value()] = struct{}{}
+	}
+
+	parentKey := graphPathKey(toPath)
+	for i, child := range from.Value() {
+		childPath := appendGraphPath(fromPath, *child.Name())
+		if j, ok := toIndexes[child.Name().Value()]; ok {
+			d.compareNodes(child, to.Value()[j], childPath, appendGraphPath(toPath, *child.Name()))
+			continue
+		}
+		d.removed = appendGraphDiffCandidates(d.removed, child, childPath, parentKey, i, true)
+	}
+	for i, child := range to.Value() {
+		if _, ok := fromNames[child.Name().Value()]; ok {
+			continue
+		}
+		childPath := appendGraphPath(toPath, *child.Name())
+		d.added = appendGraphDiffCandidates(d.added, child, childPath, parentKey, i, true)
+	}
+}
+
+func (d *graphDiffer) pairIdentified() bool {
+	for _, removed := range d.removed {
+		if removed.consumed || removed.child.Id() == nil {
+			continue
+		}
+		for _, added := range d.added {
+			if added.consumed || added.child.Id() == nil {
+				continue
+			}
+			if removed.child.Id().Value() != added.child.Id().Value() {
+				continue
+			}
+			if removed.root && added.root && removed.parentKey == added.parentKey {
+				d.pair(graphChangeRenamed, removed, added)
+			} else {
+				d.pair(graphChangeMoved, removed, added)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (d *graphDiffer) pairMoved(rootsOnly bool) bool {
+	for _, removed := range d.removed {
+		if removed.consumed || (rootsOnly && !removed.root) {
+			continue
+		}
+		for _, added := range d.added {
+			if added.consumed || (rootsOnly && !added.root) {
+				continue
+			}
+			if removed.child.Name().Value() == added.child.Name().Value() {
+				d.pair(graphChangeMoved, removed, added)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *graphDiffer) pairRenamed() bool {
+	for _, removed := range d.removed {
+		if removed.consumed || !removed.root {
+			continue
+		}
+		for _, added := range d.added {
+			if added.consumed || !added.root {
+				continue
+			}
+			if removed.parentKey == added.parentKey && removed.index == added.index {
+				d.pair(graphChangeRenamed, removed, added)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *graphDiffer) pair(changeType string, removed *graphDiffCandidate, added *graphDiffCandidate) {
+	consumeGraphDiffCandidates(d.removed, removed.path)
+	consumeGraphDiffCandidates(d.added, added.path)
+
+	d.changes = append(d.changes, graphDiffChange{
+		changeType: changeType,
+		fromPath:   removed.path,
+		toPath:     added.path,
+	})
+	d.compareNodes(removed.child, added.child, removed.path, added.path)
+}
+
+func appendGraphDiffCandidates(
+	candidates []*graphDiffCandidate,
+	child domain.GraphChildEntity,
+	path []domain.GraphNameObject,
+	parentKey string,
+	index int,
+	root bool,
+) []*graphDiffCandidate {
+	candidates = append(candidates, &graphDiffCandidate{
+		child:     child,
+		path:      path,
+		parentKey: parentKey,
+		index:     index,
+		root:      root,
+	})
+	for i, grandchild := range child.Children().Value() {
+		grandchildPath := appendGraphPath(path, *grandchild.Name())
+		candidates = appendGraphDiffCandidates(candidates, grandchild, grandchildPath, graphPathKey(path), i, false)
+	}
+	return candidates
+}
+
+func consumeGraphDiffCandidates(candidates []*graphDiffCandidate, path []domain.GraphNameObject) {
+	for _, candidate := range candidates {
+		if len(candidate.path) < len(path) {
+			continue
+		}
+		if graphPathKey(candidate.path[:len(path)]) == graphPathKey(path) {
+			candidate.consumed = true
+		}
+	}
+}
+
+func appendGraphPath(path []domain.GraphNameObject, name domain.GraphNameObject) []domain.GraphNameObject {
+	childPath := make([]domain.GraphNameObject, len(path)+1)
+	copy(childPath, path)
+	childPath[len(path)] = name
+	return childPath
+}
+
+func graphPathKey(path []domain.GraphNameObject) string {
+	names := make([]string, len(path))
+	for i, name := range path {
+		names[i] = name.Value()
+	}
+	return strings.Join(names, "\x00")
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"sort"
+	"strings"
+)
+
+const graphMatchSimilarityThreshold = 0.5
+
+type graphMatchCandidate struct {
+	name      string
+	paragraph string
+}
+
+type graphMatchPair struct {
+	section    int
+	graph      int
+	similarity float64
+}
+
+// matchSectionsToGraphs assigns each section at most one existing graph and returns,
+// for every section, the index of the matched graph or -1.
+// Sections are first matched to graphs with exactly the same name in order of appearance,
+// and the remaining ones are matched greedily by the similarity of their contents.
+func matchSectionsToGraphs(sections []graphMatchCandidate, graphs []graphMatchCandidate) []int {
+	matches := make([]int, len(sections))
+	matched := make([]bool, len(graphs))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	for i, section := range sections {
+		for j, graph := range graphs {
+			if !matched[j] && section.name == graph.name {
+				matches[i] = j
+				matched[j] = true
+				break
+			}
+		}
+	}
+
+	pairs := []graphMatchPair{}
+	for i, section := range sections {
+		if matches[i] >= 0 {
+			continue
+		}
+		for j, graph := range graphs {
+			if matched[j] {
+				continue
+			}
+			similarity := contentSimilarity(section.paragraph, graph.paragraph)
+			if similarity >= graphMatchSimilarityThreshold {
+				pairs = append(pairs, graphMatchPair{section: i, graph: j, similarity: similarity})
+			}
+		}
+	}
+
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].similarity != pairs[b].similarity {
+			return pairs[a].similarity > pairs[b].similarity
+		}
+		if pairs[a].section != pairs[b].section {
+			return pairs[a].section < pairs[b].section
+		}
+		return pairs[a].graph < pairs[b].graph
+	})
+
+	for _, pair := range pairs {
+		if matches[pair.section] >= 0 || matched[pair.graph] {
+			continue
+		}
+		matches[pair.section] = pair.graph
+		matched[pair.graph] = true
+	}
+
+	return matches
+}
+
+// contentSimilarity is the Jaccard index of the character bigrams of two texts,
+// which works for languages without spaces between words as well.
+func contentSimilarity(a string, b string) float64 {
+	aBigrams := contentBigrams(a)
+	bBigrams := contentBigrams(b)
+	if len(aBigrams) == 0 || len(bBigrams) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for bigram := range aBigrams {
+		if _, ok := bBigrams[bigram]; ok {
+			intersection++
+		}
+	}
+	union := len(aBigrams) + len(bBigrams) - intersection
+	return float64(intersection) / float64(union)
+}
+
+func contentBigrams(text string) map[string]struct{} {
+	runes := []rune(strings.Join(strings.Fields(strings.ToLower(text)), " "))
+	bigrams := map[string]struct{}{}
+	for i := 0; i+1 < len(runes); i++ {
+		bigrams[string(runes[i:i+2])] = struct{}{}
+	}
+	return bigrams
+}
@@ -3,6 +3,7 @@ package service_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
@@ -14,6 +15,115 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func TestListGraphsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return([]string{"2000000000000001", "2000000000000002"}, []record.GraphEntry{
+			{
+				Name:      "Section 1",
+				Paragraph: "This is section content.",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "3000000000000001",
+						Name:        "Child",
+						Relation:    "relation",
+						Description: "This is child description.",
+						Children:    []record.GraphChildEntry{},
+					},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			{
+				Name:      "Section 2",
+				Paragraph: "",
+				Children:  []record.GraphChildEntry{},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+
+	graphs, sErr := s.ListGraphs(*userId, *projectId, *chapterId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, graphs, 2)
+	assert.Equal(t, "2000000000000001", graphs[0].Id().Value())
+	assert.Equal(t, "Section 1", graphs[0].Name().Value())
+	assert.Equal(t, "This is section content.", graphs[0].Paragraph().Value())
+	assert.Equal(t, 1, graphs[0].Children().Len())
+	assert.Equal(t, "3000000000000001", graphs[0].Children().Value()[0].Id().Value())
+	assert.Equal(t, "2000000000000002", graphs[1].Id().Value())
+	assert.Equal(t, "Section 2", graphs[1].Name().Value())
+	assert.Equal(t, 0, graphs[1].Children().Len())
+}
+
+func TestListGraphsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "chapter not found",
+			expectedError: "failed to list graphs: chapter not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch graphs: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(nil, nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+
+			graphs, sErr := s.ListGraphs(*userId, *projectId, *chapterId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, graphs)
+		})
+	}
+}
+
 func TestFindGraphValidEntry(t *testing.T) {
 	maxLengthGraphName := testutil.RandomString(100)
 	maxLengthGraphParagraph := testutil.RandomString(40000)
@@ -74,9 +184,7 @@ func TestFindGraphValidEntry(t *testing.T) {
 				FetchGraph(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
 				Return(&tc.entry, nil)
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -279,9 +387,7 @@ func TestFindGraphInvalidEntry(t *testing.T) {
 				FetchGraph(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
 				Return(&tc.entry, nil)
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -335,9 +441,7 @@ func TestFindGraphRepositoryError(t *testing.T) {
 				FetchGraph(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -363,48 +467,10 @@ func TestDeleteGraphValidEntry(t *testing.T) {
 
 	r := mock_repository.NewMockGraphRepository(ctrl)
 	r.EXPECT().
-		DeleteGraph(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		TrashGraph(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
 		Return(nil)
 
-	cr := mock_repository.NewMockChapterRepository(ctrl)
-	cr.EXPECT().
-		FetchChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-		Return(&record.ChapterEntry{
-			Name:   "Chapter",
-			Number: 1,
-			Sections: []record.SectionEntry{
-				{
-					Id:   "2000000000000001",
-					Name: "Section",
-				},
-				{
-					Id:   "2000000000000002",
-					Name: "Section",
-				},
-			},
-			UserId:    testutil.ModifyOnlyUserId(),
-			CreatedAt: testutil.Date(),
-			UpdatedAt: testutil.Date(),
-		}, nil)
-	cr.EXPECT().
-		UpdateChapterSections(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
-			[]record.SectionWithoutAutofieldEntry{
-				{
-					Id:   "2000000000000002",
-					Name: "Section",
-				},
-			}).
-		Return([]record.SectionEntry{
-			{
-				Id:        "2000000000000002",
-				Name:      "Section",
-				UserId:    testutil.ModifyOnlyUserId(),
-				CreatedAt: testutil.Date(),
-				UpdatedAt: testutil.Date(),
-			},
-		}, nil)
-
-	s := service.NewGraphService(r, cr)
+	s := service.NewGraphService(r, service.RevisionRetention{})
 
 	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 	assert.NoError(t, err)
@@ -423,7 +489,7 @@ func TestDeleteGraphValidEntry(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-func TestDeleteGraphRepositoryDeleteGraphError(t *testing.T) {
+func TestDeleteGraphRepositoryTrashGraphError(t *testing.T) {
 	tt := []struct {
 		name          string
 		errorCode     repository.ErrorCode
@@ -454,177 +520,10 @@ func TestDeleteGraphRepositoryDeleteGraphError(t *testing.T) {
 
 			r := mock_repository.NewMockGraphRepository(ctrl)
 			r.EXPECT().
-				DeleteGraph(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+				TrashGraph(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
 				Return(repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-			cr.EXPECT().
-				FetchChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(&record.ChapterEntry{
-					Name:   "Chapter",
-					Number: 1,
-					Sections: []record.SectionEntry{
-						{
-							Id:   "2000000000000001",
-							Name: "Section",
-						},
-						{
-							Id:   "2000000000000002",
-							Name: "Section",
-						},
-					},
-					UserId:    testutil.ModifyOnlyUserId(),
-					CreatedAt: testutil.Date(),
-					UpdatedAt: testutil.Date(),
-				}, nil)
-
-			s := service.NewGraphService(r, cr)
-
-			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
-			assert.NoError(t, err)
-
-			projectId, err := domain.NewProjectIdObject("0000000000000001")
-			assert.NoError(t, err)
-
-			chapterId, err := domain.NewChapterIdObject("1000000000000001")
-			assert.NoError(t, err)
-
-			sectionId, err := domain.NewSectionIdObject("2000000000000001")
-			assert.NoError(t, err)
-
-			rErr := s.DeleteGraph(*userId, *projectId, *chapterId, *sectionId)
-
-			assert.NotNil(t, rErr)
-			assert.Equal(t, tc.expectedCode, rErr.Code())
-			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), rErr.Error())
-		})
-	}
-}
-
-func TestDeleteGraphRepositoryFetchChapterError(t *testing.T) {
-	tt := []struct {
-		name          string
-		errorCode     repository.ErrorCode
-		errorMessage  string
-		expectedError string
-		expectedCode  service.ErrorCode
-	}{
-		{
-			name:          "should return error when repository returns not found error",
-			errorCode:     repository.NotFoundError,
-			errorMessage:  "chapter not found",
-			expectedError: "failed to delete graph: chapter not found",
-			expectedCode:  service.NotFoundError,
-		},
-		{
-			name:          "should return error when repository returns read failure error",
-			errorCode:     repository.ReadFailurePanic,
-			errorMessage:  "repository error",
-			expectedError: "failed to delete graph: repository error",
-			expectedCode:  service.RepositoryFailurePanic,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			r := mock_repository.NewMockGraphRepository(ctrl)
-
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-			cr.EXPECT().
-				FetchChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
-
-			s := service.NewGraphService(r, cr)
-
-			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
-			assert.NoError(t, err)
-
-			projectId, err := domain.NewProjectIdObject("0000000000000001")
-			assert.NoError(t, err)
-
-			chapterId, err := domain.NewChapterIdObject("1000000000000001")
-			assert.NoError(t, err)
-
-			sectionId, err := domain.NewSectionIdObject("2000000000000001")
-			assert.NoError(t, err)
-
-			rErr := s.DeleteGraph(*userId, *projectId, *chapterId, *sectionId)
-
-			assert.NotNil(t, rErr)
-			assert.Equal(t, tc.expectedCode, rErr.Code())
-			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), rErr.Error())
-		})
-	}
-}
-
-func TestDeleteGraphRepositoryUpdateChapterSectionsError(t *testing.T) {
-	tt := []struct {
-		name          string
-		errorCode     repository.ErrorCode
-		errorMessage  string
-		expectedError string
-		expectedCode  service.ErrorCode
-	}{
-		{
-			name:          "should return error when repository returns not found error",
-			errorCode:     repository.NotFoundError,
-			errorMessage:  "chapter not found",
-			expectedError: "failed to delete graph: chapter not found",
-			expectedCode:  service.NotFoundError,
-		},
-		{
-			name:          "should return error when repository returns write failure error",
-			errorCode:     repository.WriteFailurePanic,
-			errorMessage:  "repository error",
-			expectedError: "failed to delete graph: repository error",
-			expectedCode:  service.RepositoryFailurePanic,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			r := mock_repository.NewMockGraphRepository(ctrl)
-			r.EXPECT().
-				DeleteGraph(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
-				Return(nil)
-
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-			cr.EXPECT().
-				FetchChapter(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(&record.ChapterEntry{
-					Name:   "Chapter",
-					Number: 1,
-					Sections: []record.SectionEntry{
-						{
-							Id:   "2000000000000001",
-							Name: "Section",
-						},
-						{
-							Id:   "2000000000000002",
-							Name: "Section",
-						},
-					},
-					UserId:    testutil.ModifyOnlyUserId(),
-					CreatedAt: testutil.Date(),
-					UpdatedAt: testutil.Date(),
-				}, nil)
-			cr.EXPECT().
-				UpdateChapterSections(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
-					[]record.SectionWithoutAutofieldEntry{
-						{
-							Id:   "2000000000000002",
-							Name: "Section",
-						},
-					}).
-				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.NoError(t, err)
@@ -693,36 +592,7 @@ func TestSectionalizeIntoGraphsValidEntry(t *testing.T) {
 			},
 		}, nil)
 
-	cr := mock_repository.NewMockChapterRepository(ctrl)
-	cr.EXPECT().
-		UpdateChapterSections(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
-			[]record.SectionWithoutAutofieldEntry{
-				{
-					Id:   "2000000000000001",
-					Name: sectonName,
-				},
-				{
-					Id:   "2000000000000002",
-					Name: maxLengthSectonName,
-				},
-			}).
-		Return([]record.SectionEntry{
-			{
-				Id:        "2000000000000001",
-				Name:      sectonName,
-				UserId:    testutil.ModifyOnlyUserId(),
-				CreatedAt: testutil.Date(),
-				UpdatedAt: testutil.Date(),
-			},
-			{
-				Id:        "2000000000000002",
-				Name:      maxLengthSectonName,
-				UserId:    testutil.ModifyOnlyUserId(),
-				CreatedAt: testutil.Date(),
-				UpdatedAt: testutil.Date(),
-			},
-		}, nil)
-	s := service.NewGraphService(r, cr)
+	s := service.NewGraphService(r, service.RevisionRetention{})
 
 	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 	assert.NoError(t, err)
@@ -780,9 +650,7 @@ func TestSectionalizeIntoGraphsGraphExists(t *testing.T) {
 		GraphExists(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
 		Return(true, nil)
 
-	cr := mock_repository.NewMockChapterRepository(ctrl)
-
-	s := service.NewGraphService(r, cr)
+	s := service.NewGraphService(r, service.RevisionRetention{})
 
 	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 	assert.NoError(t, err)
@@ -997,9 +865,7 @@ func TestSectionalizeIntoGraphsInvalidInsertedGraph(t *testing.T) {
 					}).
 				Return([]string{"2000000000000001"}, []record.GraphEntry{tc.insertedGraph}, nil)
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.NoError(t, err)
@@ -1067,9 +933,7 @@ func TestSectionalizeIntoGraphsRepositoryGraphExistsError(t *testing.T) {
 				GraphExists(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
 				Return(false, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.NoError(t, err)
@@ -1107,6 +971,13 @@ func TestSectionalizeIntoGraphsRepotoryInsertGraphsError(t *testing.T) {
 		expectedError string
 		expectedCode  service.ErrorCode
 	}{
+		{
+			name:          "should return error when repository returns invalid argument error",
+			errorCode:     repository.InvalidArgumentError,
+			errorMessage:  "graph already exists",
+			expectedError: "failed to sectionalize into graphs: graph already exists",
+			expectedCode:  service.InvalidArgumentError,
+		},
 		{
 			name:          "should return error when repository returns not found error",
 			errorCode:     repository.NotFoundError,
@@ -1146,9 +1017,7 @@ func TestSectionalizeIntoGraphsRepotoryInsertGraphsError(t *testing.T) {
 					}).
 				Return(nil, nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.NoError(t, err)
@@ -1178,102 +1047,6 @@ func TestSectionalizeIntoGraphsRepotoryInsertGraphsError(t *testing.T) {
 	}
 }
 
-func TestSectionalizeIntoGraphsChapterRepositoryError(t *testing.T) {
-	tt := []struct {
-		name          string
-		errorCode     repository.ErrorCode
-		errorMessage  string
-		expectedError string
-		expectedCode  service.ErrorCode
-	}{
-		{
-			name:          "should return error when repository returns not found error",
-			errorCode:     repository.NotFoundError,
-			errorMessage:  "chapter not found",
-			expectedError: "failed to sectionalize into graphs: chapter not found",
-			expectedCode:  service.NotFoundError,
-		},
-		{
-			name:          "should return error when repository returns write failure error",
-			errorCode:     repository.WriteFailurePanic,
-			errorMessage:  "repository error",
-			expectedError: "failed to update sections of chapter: repository error",
-			expectedCode:  service.RepositoryFailurePanic,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			sectonName := "Section"
-			sectionContent := "This is section content. This is section content."
-
-			r := mock_repository.NewMockGraphRepository(ctrl)
-			r.EXPECT().
-				GraphExists(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
-				Return(false, nil)
-			r.EXPECT().
-				InsertGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
-					[]record.GraphWithoutAutofieldEntry{
-						{
-							Name:      sectonName,
-							Paragraph: sectionContent,
-							Children:  []record.GraphChildEntry{},
-						},
-					}).
-				Return([]string{"2000000000000001"}, []record.GraphEntry{
-					{
-						Name:      sectonName,
-						Paragraph: sectionContent,
-						Children:  []record.GraphChildEntry{},
-						UserId:    testutil.ModifyOnlyUserId(),
-						CreatedAt: testutil.Date(),
-						UpdatedAt: testutil.Date(),
-					},
-				}, nil)
-
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-			cr.EXPECT().
-				UpdateChapterSections(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
-					[]record.SectionWithoutAutofieldEntry{
-						{
-							Id:   "2000000000000001",
-							Name: sectonName,
-						},
-					}).
-				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
-
-			s := service.NewGraphService(r, cr)
-
-			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
-			assert.NoError(t, err)
-
-			projectId, err := domain.NewProjectIdObject("0000000000000001")
-			assert.NoError(t, err)
-
-			chapterId, err := domain.NewChapterIdObject("1000000000000001")
-			assert.NoError(t, err)
-
-			name, err := domain.NewSectionNameObject(sectonName)
-			assert.NoError(t, err)
-			content, err := domain.NewSectionContentObject(sectionContent)
-			assert.NoError(t, err)
-			section := domain.NewSectionWithoutAutofieldEntity(*name, *content)
-
-			sections, err := domain.NewSectionWithoutAutofieldEntityList([]domain.SectionWithoutAutofieldEntity{*section})
-			assert.NoError(t, err)
-
-			insertedGraphs, sErr := s.SectionalizeIntoGraphs(*userId, *projectId, *chapterId, *sections)
-
-			assert.NotNil(t, sErr)
-			assert.Equal(t, tc.expectedCode, sErr.Code())
-			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
-			assert.Nil(t, insertedGraphs)
-		})
-	}
-}
 func TestUpdateGraphContentValidEntry(t *testing.T) {
 	maxLengthGraphName := testutil.RandomString(100)
 	maxLengthGraphParagraph := testutil.RandomString(40000)
@@ -1331,12 +1104,10 @@ func TestUpdateGraphContentValidEntry(t *testing.T) {
 
 			r := mock_repository.NewMockGraphRepository(ctrl)
 			r.EXPECT().
-				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any()).
+				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), nil).
 				Return(&tc.entry, nil)
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -1358,13 +1129,13 @@ func TestUpdateGraphContentValidEntry(t *testing.T) {
 				assert.Nil(t, err)
 				children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
 				assert.Nil(t, err)
-				childList[i] = *domain.NewGraphChildEntity(*name, *relation, *description, *children)
+				childList[i] = *domain.NewGraphChildEntity(nil, *name, *relation, *description, *children)
 			}
 			children, err := domain.NewGraphChildrenEntity(childList)
 			assert.Nil(t, err)
 			graph := domain.NewGraphContentEntity(*paragraph, *children)
 
-			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph)
+			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, nil)
 			assert.Nil(t, sErr)
 			updatedChildren := updatedGraph.Children().Value()
 
@@ -1553,12 +1324,10 @@ func TestUpdateGraphContentInvalidUpdatedGraph(t *testing.T) {
 
 			r := mock_repository.NewMockGraphRepository(ctrl)
 			r.EXPECT().
-				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any()).
+				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), nil).
 				Return(&tc.updatedGraph, nil)
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -1574,7 +1343,7 @@ func TestUpdateGraphContentInvalidUpdatedGraph(t *testing.T) {
 			assert.Nil(t, err)
 			graph := domain.NewGraphContentEntity(*paragraph, *children)
 
-			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph)
+			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
 			assert.Equal(t, fmt.Sprintf("domain failure: %v", tc.expectedError), sErr.Error())
@@ -1614,12 +1383,10 @@ func TestUpdateGraphContentRepositoryError(t *testing.T) {
 
 			r := mock_repository.NewMockGraphRepository(ctrl)
 			r.EXPECT().
-				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any()).
+				UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), nil).
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			cr := mock_repository.NewMockChapterRepository(ctrl)
-
-			s := service.NewGraphService(r, cr)
+			s := service.NewGraphService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -1635,7 +1402,7 @@ func TestUpdateGraphContentRepositoryError(t *testing.T) {
 			assert.Nil(t, err)
 			graph := domain.NewGraphContentEntity(*paragraph, *children)
 
-			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph)
+			updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, tc.expectedCode, sErr.Code())
 			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
@@ -1643,3 +1410,1346 @@ func TestUpdateGraphContentRepositoryError(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGraphContentConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), gomock.Any()).
+		Do(func(userId string, projectId string, chapterId string, sectionId string,
+			entry record.GraphContentEntry, expectedUpdatedAt *time.Time) {
+			assert.Equal(t, "Updated section content.", entry.Paragraph)
+			assert.Equal(t, testutil.Date(), *expectedUpdatedAt)
+		}).
+		Return(&record.GraphEntry{
+			Name:      "Section One",
+			Paragraph: "Section content.",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date().Add(time.Hour),
+		}, repository.Errorf(repository.ConflictError, "graph has been updated since it was fetched"))
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	graphId, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("Updated section content.")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	graph := domain.NewGraphContentEntity(*paragraph, *children)
+
+	currentGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, updatedAt)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.ConflictError, sErr.Code())
+	assert.Equal(t, "conflict: failed to update graph content: graph has been updated since it was fetched", sErr.Error())
+
+	assert.Equal(t, "2000000000000001", currentGraph.Id().Value())
+	assert.Equal(t, "Section One", currentGraph.Name().Value())
+	assert.Equal(t, "Section content.", currentGraph.Paragraph().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), currentGraph.UpdatedAt().Value())
+}
+
+func TestUpdateGraphContentPrunesRevisions(t *testing.T) {
+	now := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), nil).
+		Return(&record.GraphEntry{
+			Name:      "Section",
+			Paragraph: "three",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+	r.EXPECT().
+		FetchGraphRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(map[string]record.GraphRevisionEntry{
+			"REVISION_ONE":   {Paragraph: "one", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-3 * time.Minute)},
+			"REVISION_TWO":   {Paragraph: "two", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-2 * time.Minute)},
+			"REVISION_THREE": {Paragraph: "three", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-time.Minute)},
+		}, nil)
+	r.EXPECT().
+		DeleteGraphRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			[]string{"REVISION_ONE"}).
+		Return(nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{MaxCount: 2})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	graphId, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("three")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	graph := domain.NewGraphContentEntity(*paragraph, *children)
+
+	updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, nil)
+	assert.Nil(t, sErr)
+	assert.Equal(t, "three", updatedGraph.Paragraph().Value())
+}
+
+func TestUpdateGraphContentPruneRevisionsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any(), nil).
+		Return(&record.GraphEntry{
+			Name:      "Section",
+			Paragraph: "paragraph",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+	r.EXPECT().
+		FetchGraphRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+
+	s := service.NewGraphService(r, service.RevisionRetention{MaxCount: 1})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	graphId, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("paragraph")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	graph := domain.NewGraphContentEntity(*paragraph, *children)
+
+	updatedGraph, sErr := s.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, nil)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to fetch graph revisions: repository error", sErr.Error())
+	assert.Nil(t, updatedGraph)
+}
+
+func newResectionalizeSections(t *testing.T, names []string, contents []string) *domain.SectionWithoutAutofieldEntityList {
+	sections := make([]domain.SectionWithoutAutofieldEntity, len(names))
+	for i := range names {
+		name, err := domain.NewSectionNameObject(names[i])
+		assert.Nil(t, err)
+		content, err := domain.NewSectionContentObject(contents[i])
+		assert.Nil(t, err)
+		sections[i] = *domain.NewSectionWithoutAutofieldEntity(*name, *content)
+	}
+	list, err := domain.NewSectionWithoutAutofieldEntityList(sections)
+	assert.Nil(t, err)
+	return list
+}
+
+func existingGraphsForResectionalization() ([]string, []record.GraphEntry, *repository.Error) {
+	return []string{"2000000000000001", "2000000000000002", "2000000000000003"}, []record.GraphEntry{
+		{
+			Name:      "Introduction",
+			Paragraph: "This chapter introduces graphs.",
+			Children: []record.GraphChildEntry{
+				{Name: "Graph", Relation: "about", Description: "", Children: []record.GraphChildEntry{}},
+			},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		},
+		{
+			Name:      "Background",
+			Paragraph: "Graphs consist of nodes and edges connected together.",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		},
+		{
+			Name:      "Old Section",
+			Paragraph: "Obsolete text.",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		},
+	}, nil
+}
+
+func TestPreviewResectionalizationValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(existingGraphsForResectionalization())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sections := newResectionalizeSections(t,
+		[]string{"Summary", "Basics", "Introduction"},
+		[]string{
+			"A brand new summary.",
+			"Graphs consist of nodes and edges connected together!",
+			"This chapter introduces knowledge graphs.",
+		},
+	)
+
+	preview, sErr := s.PreviewResectionalization(*userId, *projectId, *chapterId, *sections)
+	assert.Nil(t, sErr)
+
+	matches := preview.Matches()
+	assert.Len(t, matches, 3)
+	assert.Equal(t, "Summary", matches[0].Name().Value())
+	assert.Nil(t, matches[0].GraphId())
+	assert.Equal(t, "Basics", matches[1].Name().Value())
+	assert.Equal(t, "2000000000000002", matches[1].GraphId().Value())
+	assert.Equal(t, "Introduction", matches[2].Name().Value())
+	assert.Equal(t, "2000000000000001", matches[2].GraphId().Value())
+
+	orphans := preview.Orphans()
+	assert.Len(t, orphans, 1)
+	assert.Equal(t, "2000000000000003", orphans[0].Id().Value())
+	assert.Equal(t, "Old Section", orphans[0].Name().Value())
+	assert.Equal(t, "Obsolete text.", orphans[0].Paragraph().Value())
+}
+
+func TestPreviewResectionalizationRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+	}{
+		{
+			name:          "should return error when chapter is not found",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to preview resectionalization: repository error",
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     repository.ReadFailurePanic,
+			expectedError: "repository failure: failed to fetch graphs: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				FetchGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(nil, nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sections := newResectionalizeSections(t, []string{"Section"}, []string{"content"})
+
+			preview, sErr := s.PreviewResectionalization(*userId, *projectId, *chapterId, *sections)
+			assert.Nil(t, preview)
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestResectionalizeGraphsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(existingGraphsForResectionalization())
+	r.EXPECT().
+		ResectionalizeGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
+			[]record.GraphResectionalizeEntry{
+				{Id: "2000000000000001", Name: "Introduction", Paragraph: "This chapter introduces knowledge graphs."},
+				{Name: "Summary", Paragraph: "A brand new summary."},
+			},
+			[]string{"2000000000000002", "2000000000000003"},
+			repository.GraphOrphanActionArchive,
+		).
+		Return([]string{"2000000000000001", "2000000000000004"}, []record.GraphEntry{
+			{
+				Name:      "Introduction",
+				Paragraph: "This chapter introduces knowledge graphs.",
+				Children: []record.GraphChildEntry{
+					{Name: "Graph", Relation: "about", Description: "", Children: []record.GraphChildEntry{}},
+				},
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date().Add(time.Hour),
+			},
+			{
+				Name:      "Summary",
+				Paragraph: "A brand new summary.",
+				Children:  []record.GraphChildEntry{},
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date().Add(time.Hour),
+				UpdatedAt: testutil.Date().Add(time.Hour),
+			},
+		}, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sections := newResectionalizeSections(t,
+		[]string{"Introduction", "Summary"},
+		[]string{"This chapter introduces knowledge graphs.", "A brand new summary."},
+	)
+	orphanAction, err := domain.NewGraphOrphanActionObject("archive")
+	assert.Nil(t, err)
+
+	graphs, orphans, sErr := s.ResectionalizeGraphs(*userId, *projectId, *chapterId, *sections, *orphanAction)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, graphs, 2)
+	assert.Equal(t, "2000000000000001", graphs[0].Id().Value())
+	assert.Equal(t, "This chapter introduces knowledge graphs.", graphs[0].Paragraph().Value())
+	assert.Equal(t, 1, graphs[0].Children().Len())
+	assert.Equal(t, "2000000000000004", graphs[1].Id().Value())
+	assert.Equal(t, "Summary", graphs[1].Name().Value())
+
+	assert.Len(t, orphans, 2)
+	assert.Equal(t, "2000000000000002", orphans[0].Id().Value())
+	assert.Equal(t, "2000000000000003", orphans[1].Id().Value())
+}
+
+func TestResectionalizeGraphsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		fetchError    *repository.Error
+		updateError   *repository.Error
+		expectedError string
+	}{
+		{
+			name:          "should return error when chapter is not found",
+			fetchError:    repository.Errorf(repository.NotFoundError, "repository error"),
+			expectedError: "not found: failed to resectionalize graphs: repository error",
+		},
+		{
+			name:          "should return error when fetching graphs fails",
+			fetchError:    repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to fetch graphs: repository error",
+		},
+		{
+			name:          "should return error when graphs have been updated",
+			updateError:   repository.Errorf(repository.ConflictError, "repository error"),
+			expectedError: "conflict: failed to resectionalize graphs: repository error",
+		},
+		{
+			name:          "should return error when chapter is deleted",
+			updateError:   repository.Errorf(repository.NotFoundError, "repository error"),
+			expectedError: "not found: failed to resectionalize graphs: repository error",
+		},
+		{
+			name:          "should return error when updating graphs fails",
+			updateError:   repository.Errorf(repository.WriteFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to resectionalize graphs: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			if tc.fetchError != nil {
+				r.EXPECT().
+					FetchGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+					Return(nil, nil, tc.fetchError)
+			} else {
+				r.EXPECT().
+					FetchGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+					Return([]string{}, []record.GraphEntry{}, nil)
+				r.EXPECT().
+					ResectionalizeGraphs(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
+						[]record.GraphResectionalizeEntry{{Name: "Section", Paragraph: "content"}},
+						[]string{},
+						repository.GraphOrphanActionDelete,
+					).
+					Return(nil, nil, tc.updateError)
+			}
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sections := newResectionalizeSections(t, []string{"Section"}, []string{"content"})
+			orphanAction, err := domain.NewGraphOrphanActionObject("delete")
+			assert.Nil(t, err)
+
+			graphs, orphans, sErr := s.ResectionalizeGraphs(*userId, *projectId, *chapterId, *sections, *orphanAction)
+			assert.Nil(t, graphs)
+			assert.Nil(t, orphans)
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func graphChildrenTree() []record.GraphChildEntry {
+	return []record.GraphChildEntry{
+		{
+			Name:        "Node A",
+			Relation:    "part of",
+			Description: "description of A",
+			Children: []record.GraphChildEntry{
+				{Name: "Node A1", Relation: "example", Description: "", Children: []record.GraphChildEntry{}},
+				{Name: "Node A2", Relation: "", Description: "", Children: []record.GraphChildEntry{}},
+			},
+		},
+		{Name: "Node B", Relation: "", Description: "", Children: []record.GraphChildEntry{}},
+	}
+}
+
+func expectUpdateGraphChildren(r *mock_repository.MockGraphRepository, children []record.GraphChildEntry) {
+	r.EXPECT().
+		UpdateGraphChildren(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any()).
+		DoAndReturn(func(
+			userId string,
+			projectId string,
+			chapterId string,
+			sectionId string,
+			update func([]record.GraphChildEntry) ([]record.GraphChildEntry, error),
+		) (*record.GraphEntry, *repository.Error) {
+			updated, err := update(children)
+			if err != nil {
+				return nil, repository.Errorf(repository.InvalidArgumentError, "failed to update graph children: %w", err)
+			}
+			return &record.GraphEntry{
+				Name:      "Section",
+				Paragraph: "paragraph",
+				Children:  updated,
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			}, nil
+		})
+}
+
+func newGraphPath(t *testing.T, names ...string) *domain.GraphPathObject {
+	path, err := domain.NewGraphPathObject(names)
+	assert.Nil(t, err)
+	return path
+}
+
+func graphIdentifiers(t *testing.T) (*domain.UserIdObject, *domain.ProjectIdObject, *domain.ChapterIdObject, *domain.SectionIdObject) {
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	return userId, projectId, chapterId, sectionId
+}
+
+func childNames(children *domain.GraphChildrenEntity) []string {
+	names := make([]string, children.Len())
+	for i, child := range children.Value() {
+		names[i] = child.Name().Value()
+	}
+	return names
+}
+
+func TestAddGraphChildValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	expectUpdateGraphChildren(r, graphChildrenTree())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+	name, err := domain.NewGraphNameObject("Node A3")
+	assert.Nil(t, err)
+	relation, err := domain.NewGraphRelationObject("related to")
+	assert.Nil(t, err)
+	description, err := domain.NewGraphDescriptionObject("description of A3")
+	assert.Nil(t, err)
+	grandchildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	child := domain.NewGraphChildEntity(nil, *name, *relation, *description, *grandchildren)
+
+	graph, sErr := s.AddGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Node A"), *child)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "2000000000000001", graph.Id().Value())
+	assert.Equal(t, []string{"Node A", "Node B"}, childNames(graph.Children()))
+	nodeA := graph.Children().Value()[0]
+	assert.Equal(t, []string{"Node A1", "Node A2", "Node A3"}, childNames(nodeA.Children()))
+	added := nodeA.Children().Value()[2]
+	assert.Equal(t, "related to", added.Relation().Value())
+	assert.Equal(t, "description of A3", added.Description().Value())
+}
+
+func TestRenameGraphChildValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	expectUpdateGraphChildren(r, graphChildrenTree())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+	name, err := domain.NewGraphNameObject("Node X")
+	assert.Nil(t, err)
+
+	graph, sErr := s.RenameGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Node A", "Node A1"), *name)
+	assert.Nil(t, sErr)
+
+	nodeA := graph.Children().Value()[0]
+	assert.Equal(t, []string{"Node X", "Node A2"}, childNames(nodeA.Children()))
+	assert.Equal(t, "example", nodeA.Children().Value()[0].Relation().Value())
+}
+
+func TestUpdateGraphChildValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	expectUpdateGraphChildren(r, graphChildrenTree())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+	relation, err := domain.NewGraphRelationObject("depends on")
+	assert.Nil(t, err)
+	description, err := domain.NewGraphDescriptionObject("updated description")
+	assert.Nil(t, err)
+
+	graph, sErr := s.UpdateGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Node A"),
+		*relation, *description)
+	assert.Nil(t, sErr)
+
+	nodeA := graph.Children().Value()[0]
+	assert.Equal(t, "Node A", nodeA.Name().Value())
+	assert.Equal(t, "depends on", nodeA.Relation().Value())
+	assert.Equal(t, "updated description", nodeA.Description().Value())
+	assert.Equal(t, []string{"Node A1", "Node A2"}, childNames(nodeA.Children()))
+}
+
+func TestMoveGraphChildValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	expectUpdateGraphChildren(r, graphChildrenTree())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+
+	graph, sErr := s.MoveGraphChild(*userId, *projectId, *chapterId, *sectionId,
+		*newGraphPath(t, "Node A"), *newGraphPath(t, "Node B"))
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, []string{"Node B"}, childNames(graph.Children()))
+	nodeB := graph.Children().Value()[0]
+	assert.Equal(t, []string{"Node A"}, childNames(nodeB.Children()))
+	nodeA := nodeB.Children().Value()[0]
+	assert.Equal(t, "description of A", nodeA.Description().Value())
+	assert.Equal(t, []string{"Node A1", "Node A2"}, childNames(nodeA.Children()))
+}
+
+func TestDeleteGraphChildValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	expectUpdateGraphChildren(r, graphChildrenTree())
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+
+	graph, sErr := s.DeleteGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Node A"))
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, []string{"Node B"}, childNames(graph.Children()))
+}
+
+func TestGraphChildOperationInvalidArgument(t *testing.T) {
+	tt := []struct {
+		name          string
+		operate       func(s service.GraphService) *service.Error
+		expectedError string
+	}{
+		{
+			name: "should return error when added child name is duplicated",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				name, _ := domain.NewGraphNameObject("Node B")
+				relation, _ := domain.NewGraphRelationObject("")
+				description, _ := domain.NewGraphDescriptionObject("")
+				children, _ := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+				child := domain.NewGraphChildEntity(nil, *name, *relation, *description, *children)
+				_, sErr := s.AddGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t), *child)
+				return sErr
+			},
+			expectedError: "invalid argument: failed to add graph child: failed to update graph children: " +
+				"names of children must be unique, but got 'Node B' duplicated",
+		},
+		{
+			name: "should return error when parent is not found",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				name, _ := domain.NewGraphNameObject("Node C")
+				relation, _ := domain.NewGraphRelationObject("")
+				description, _ := domain.NewGraphDescriptionObject("")
+				children, _ := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+				child := domain.NewGraphChildEntity(nil, *name, *relation, *description, *children)
+				_, sErr := s.AddGraphChild(*userId, *projectId, *chapterId, *sectionId,
+					*newGraphPath(t, "Node A", "Unknown"), *child)
+				return sErr
+			},
+			expectedError: "invalid argument: failed to add graph child: failed to update graph children: " +
+				"graph child is not found at [\"Node A\" \"Unknown\"]",
+		},
+		{
+			name: "should return error when renamed child name is duplicated",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				name, _ := domain.NewGraphNameObject("Node A2")
+				_, sErr := s.RenameGraphChild(*userId, *projectId, *chapterId, *sectionId,
+					*newGraphPath(t, "Node A", "Node A1"), *name)
+				return sErr
+			},
+			expectedError: "invalid argument: failed to rename graph child: failed to update graph children: " +
+				"names of children must be unique, but got 'Node A2' duplicated",
+		},
+		{
+			name: "should return error when child is moved into itself",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				_, sErr := s.MoveGraphChild(*userId, *projectId, *chapterId, *sectionId,
+					*newGraphPath(t, "Node A"), *newGraphPath(t, "Node A", "Node A1"))
+				return sErr
+			},
+			expectedError: "invalid argument: failed to move graph child: failed to update graph children: " +
+				"graph child cannot be moved into itself, but got [\"Node A\" \"Node A1\"]",
+		},
+		{
+			name: "should return error when child to delete is not found",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				_, sErr := s.DeleteGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Unknown"))
+				return sErr
+			},
+			expectedError: "invalid argument: failed to delete graph child: failed to update graph children: " +
+				"graph child is not found at [\"Unknown\"]",
+		},
+		{
+			name: "should return error when path is empty",
+			operate: func(s service.GraphService) *service.Error {
+				userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+				_, sErr := s.DeleteGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t))
+				return sErr
+			},
+			expectedError: "invalid argument: failed to delete graph child: failed to update graph children: " +
+				"graph path is required, but got []",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			expectUpdateGraphChildren(r, graphChildrenTree())
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			sErr := tc.operate(s)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.InvalidArgumentError, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestGraphChildOperationRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+	}{
+		{
+			name:          "should return error when graph is not found",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to delete graph child: repository error",
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     repository.WriteFailurePanic,
+			expectedError: "repository failure: failed to delete graph child: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				UpdateGraphChildren(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001", gomock.Any()).
+				Return(nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, projectId, chapterId, sectionId := graphIdentifiers(t)
+
+			graph, sErr := s.DeleteGraphChild(*userId, *projectId, *chapterId, *sectionId, *newGraphPath(t, "Node A"))
+			assert.Nil(t, graph)
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestListGraphRevisionsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphRevisions(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(map[string]record.GraphRevisionEntry{
+			"3000000000000001": {
+				Paragraph: "first paragraph",
+				Children:  []record.GraphChildEntry{},
+				AuthorId:  testutil.ReadOnlyUserId(),
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+			},
+			"3000000000000002": {
+				Paragraph: "second paragraph",
+				Children: []record.GraphChildEntry{
+					{Name: "Child", Relation: "relation", Description: "description", Children: []record.GraphChildEntry{}},
+				},
+				AuthorId:  testutil.ModifyOnlyUserId(),
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date().Add(time.Hour),
+			},
+		}, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	revisions, sErr := s.ListGraphRevisions(*userId, *projectId, *chapterId, *sectionId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, revisions, 2)
+
+	revision := revisions[0]
+	assert.Equal(t, "3000000000000002", revision.Id().Value())
+	assert.Equal(t, "second paragraph", revision.Paragraph().Value())
+	assert.Equal(t, 1, revision.Children().Len())
+	assert.Equal(t, "Child", revision.Children().Value()[0].Name().Value())
+	assert.Equal(t, testutil.ModifyOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), revision.CreatedAt().Value())
+
+	revision = revisions[1]
+	assert.Equal(t, "3000000000000001", revision.Id().Value())
+	assert.Equal(t, "first paragraph", revision.Paragraph().Value())
+	assert.Equal(t, 0, revision.Children().Len())
+	assert.Equal(t, testutil.ReadOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date(), revision.CreatedAt().Value())
+}
+
+func TestListGraphRevisionsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "graph not found",
+			expectedError: "failed to list graph revisions: graph not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch graph revisions: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				FetchGraphRevisions(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sectionId, err := domain.NewSectionIdObject("2000000000000001")
+			assert.Nil(t, err)
+
+			revisions, sErr := s.ListGraphRevisions(*userId, *projectId, *chapterId, *sectionId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, revisions)
+		})
+	}
+}
+
+func TestFindGraphRevisionValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			"3000000000000001").
+		Return(&record.GraphRevisionEntry{
+			Paragraph: "paragraph",
+			Children: []record.GraphChildEntry{
+				{Name: "Child", Relation: "relation", Description: "description", Children: []record.GraphChildEntry{}},
+			},
+			AuthorId:  testutil.ReadOnlyUserId(),
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+
+	revision, sErr := s.FindGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "3000000000000001", revision.Id().Value())
+	assert.Equal(t, "paragraph", revision.Paragraph().Value())
+	assert.Equal(t, 1, revision.Children().Len())
+	child := revision.Children().Value()[0]
+	assert.Equal(t, "Child", child.Name().Value())
+	assert.Equal(t, "relation", child.Relation().Value())
+	assert.Equal(t, "description", child.Description().Value())
+	assert.Equal(t, testutil.ReadOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date(), revision.CreatedAt().Value())
+}
+
+func TestFindGraphRevisionRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "graph revision not found",
+			expectedError: "failed to find graph revision: graph revision not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch graph revision: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				FetchGraphRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+					"3000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sectionId, err := domain.NewSectionIdObject("2000000000000001")
+			assert.Nil(t, err)
+			revisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+			assert.Nil(t, err)
+
+			revision, sErr := s.FindGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, revision)
+		})
+	}
+}
+
+func TestDiffGraphRevisionsValidEntry(t *testing.T) {
+	type change struct {
+		changeType string
+		fromPath   []string
+		toPath     []string
+		fromValue  string
+		toValue    string
+	}
+
+	leaf := func(name string, relation string, description string) record.GraphChildEntry {
+		return record.GraphChildEntry{
+			Name:        name,
+			Relation:    relation,
+			Description: description,
+			Children:    []record.GraphChildEntry{},
+		}
+	}
+	node := func(name string, children ...record.GraphChildEntry) record.GraphChildEntry {
+		return record.GraphChildEntry{Name: name, Children: children}
+	}
+	identified := func(id string, child record.GraphChildEntry) record.GraphChildEntry {
+		child.Id = id
+		return child
+	}
+
+	tt := []struct {
+		name            string
+		fromParagraph   string
+		fromChildren    []record.GraphChildEntry
+		toParagraph     string
+		toChildren      []record.GraphChildEntry
+		expectedChanges []change
+	}{
+		{
+			name:            "should return no changes when graphs are identical",
+			fromParagraph:   "paragraph",
+			fromChildren:    []record.GraphChildEntry{node("A", leaf("B", "r", "d"))},
+			toParagraph:     "paragraph",
+			toChildren:      []record.GraphChildEntry{node("A", leaf("B", "r", "d"))},
+			expectedChanges: []change{},
+		},
+		{
+			name:          "should report paragraph, relation and description changes",
+			fromParagraph: "old paragraph",
+			fromChildren:  []record.GraphChildEntry{node("A", leaf("B", "old relation", "old description"))},
+			toParagraph:   "new paragraph",
+			toChildren:    []record.GraphChildEntry{node("A", leaf("B", "new relation", "new description"))},
+			expectedChanges: []change{
+				{changeType: "paragraph", fromValue: "old paragraph", toValue: "new paragraph"},
+				{
+					changeType: "relation",
+					fromPath:   []string{"A", "B"},
+					toPath:     []string{"A", "B"},
+					fromValue:  "old relation",
+					toValue:    "new relation",
+				},
+				{
+					changeType: "description",
+					fromPath:   []string{"A", "B"},
+					toPath:     []string{"A", "B"},
+					fromValue:  "old description",
+					toValue:    "new description",
+				},
+			},
+		},
+		{
+			name:          "should report added and removed subtrees by their roots",
+			fromParagraph: "paragraph",
+			fromChildren:  []record.GraphChildEntry{node("A", leaf("B", "", "")), leaf("C", "", "")},
+			toParagraph:   "paragraph",
+			toChildren:    []record.GraphChildEntry{leaf("C", "", ""), node("D", leaf("E", "", ""))},
+			expectedChanges: []change{
+				{changeType: "removed", fromPath: []string{"A"}},
+				{changeType: "added", toPath: []string{"D"}},
+			},
+		},
+		{
+			name:          "should report renamed node at the same position",
+			fromParagraph: "paragraph",
+			fromChildren:  []record.GraphChildEntry{leaf("A", "", ""), node("B", leaf("C", "r", "d"))},
+			toParagraph:   "paragraph",
+			toChildren:    []record.GraphChildEntry{leaf("A", "", ""), node("Renamed", leaf("C", "r", "changed"))},
+			expectedChanges: []change{
+				{changeType: "renamed", fromPath: []string{"B"}, toPath: []string{"Renamed"}},
+				{
+					changeType: "description",
+					fromPath:   []string{"B", "C"},
+					toPath:     []string{"Renamed", "C"},
+					fromValue:  "d",
+					toValue:    "changed",
+				},
+			},
+		},
+		{
+			name:          "should report moved subtree",
+			fromParagraph: "paragraph",
+			fromChildren:  []record.GraphChildEntry{node("A", node("B", leaf("C", "", ""))), leaf("D", "", "")},
+			toParagraph:   "paragraph",
+			toChildren:    []record.GraphChildEntry{leaf("A", "", ""), node("D", node("B", leaf("C", "", "")))},
+			expectedChanges: []change{
+				{changeType: "moved", fromPath: []string{"A", "B"}, toPath: []string{"D", "B"}},
+			},
+		},
+		{
+			name:          "should prefer move over rename",
+			fromParagraph: "paragraph",
+			fromChildren:  []record.GraphChildEntry{leaf("A", "", ""), node("B", leaf("C", "", ""))},
+			toParagraph:   "paragraph",
+			toChildren:    []record.GraphChildEntry{node("B", leaf("A", "", "")), leaf("C", "", "")},
+			expectedChanges: []change{
+				{changeType: "moved", fromPath: []string{"A"}, toPath: []string{"B", "A"}},
+				{changeType: "moved", fromPath: []string{"B", "C"}, toPath: []string{"C"}},
+			},
+		},
+		{
+			name:          "should report node moved out of removed subtree",
+			fromParagraph: "paragraph",
+			fromChildren:  []record.GraphChildEntry{node("A", leaf("B", "", "")), leaf("C", "", "")},
+			toParagraph:   "paragraph",
+			toChildren:    []record.GraphChildEntry{node("C", leaf("B", "", ""))},
+			expectedChanges: []change{
+				{changeType: "moved", fromPath: []string{"A", "B"}, toPath: []string{"C", "B"}},
+				{changeType: "removed", fromPath: []string{"A"}},
+			},
+		},
+		{
+			name:          "should report renamed node by its id even at a different position",
+			fromParagraph: "paragraph",
+			fromChildren: []record.GraphChildEntry{
+				identified("0000000000000000000A", leaf("A", "", "")),
+				identified("0000000000000000000B", leaf("B", "", "")),
+			},
+			toParagraph: "paragraph",
+			toChildren: []record.GraphChildEntry{
+				identified("0000000000000000000B", leaf("Renamed", "", "")),
+				identified("0000000000000000000A", leaf("A", "", "")),
+			},
+			expectedChanges: []change{
+				{changeType: "renamed", fromPath: []string{"B"}, toPath: []string{"Renamed"}},
+			},
+		},
+		{
+			name:          "should report node moved and renamed by its id",
+			fromParagraph: "paragraph",
+			fromChildren: []record.GraphChildEntry{
+				identified("0000000000000000000A", node("A", identified("0000000000000000000B", leaf("B", "r", "")))),
+				identified("0000000000000000000C", leaf("C", "", "")),
+			},
+			toParagraph: "paragraph",
+			toChildren: []record.GraphChildEntry{
+				identified("0000000000000000000A", leaf("A", "", "")),
+				identified("0000000000000000000C", node("C", identified("0000000000000000000B", leaf("Renamed", "r", "")))),
+			},
+			expectedChanges: []change{
+				{changeType: "moved", fromPath: []string{"A", "B"}, toPath: []string{"C", "Renamed"}},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockGraphRepository(ctrl)
+			r.EXPECT().
+				FetchGraphRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+					"3000000000000001").
+				Return(&record.GraphRevisionEntry{
+					Paragraph: tc.fromParagraph,
+					Children:  tc.fromChildren,
+					AuthorId:  testutil.ReadOnlyUserId(),
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+				}, nil)
+			r.EXPECT().
+				FetchGraphRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+					"3000000000000002").
+				Return(&record.GraphRevisionEntry{
+					Paragraph: tc.toParagraph,
+					Children:  tc.toChildren,
+					AuthorId:  testutil.ReadOnlyUserId(),
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date().Add(time.Hour),
+				}, nil)
+
+			s := service.NewGraphService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sectionId, err := domain.NewSectionIdObject("2000000000000001")
+			assert.Nil(t, err)
+			fromRevisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+			assert.Nil(t, err)
+			toRevisionId, err := domain.NewGraphRevisionIdObject("3000000000000002")
+			assert.Nil(t, err)
+
+			diff, sErr := s.DiffGraphRevisions(*userId, *projectId, *chapterId, *sectionId, *fromRevisionId, *toRevisionId)
+			assert.Nil(t, sErr)
+
+			pathToNames := func(path []domain.GraphNameObject) []string {
+				if len(path) == 0 {
+					return nil
+				}
+				names := make([]string, len(path))
+				for i, name := range path {
+					names[i] = name.Value()
+				}
+				return names
+			}
+
+			changes := make([]change, diff.Len())
+			for i, c := range diff.Value() {
+				changes[i] = change{
+					changeType: c.ChangeType().Value(),
+					fromPath:   pathToNames(c.FromPath()),
+					toPath:     pathToNames(c.ToPath()),
+					fromValue:  c.FromValue(),
+					toValue:    c.ToValue(),
+				}
+			}
+			assert.Equal(t, tc.expectedChanges, changes)
+		})
+	}
+}
+
+func TestDiffGraphRevisionsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			"3000000000000001").
+		Return(nil, repository.Errorf(repository.NotFoundError, "graph revision not found"))
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	fromRevisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+	toRevisionId, err := domain.NewGraphRevisionIdObject("3000000000000002")
+	assert.Nil(t, err)
+
+	diff, sErr := s.DiffGraphRevisions(*userId, *projectId, *chapterId, *sectionId, *fromRevisionId, *toRevisionId)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to find graph revision: graph revision not found", sErr.Error())
+	assert.Nil(t, diff)
+}
+
+func TestRestoreGraphRevisionValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	children := []record.GraphChildEntry{
+		{Name: "Child", Relation: "relation", Description: "description", Children: []record.GraphChildEntry{}},
+	}
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphRevision(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			"3000000000000001").
+		Return(&record.GraphRevisionEntry{
+			Paragraph: "restored paragraph",
+			Children:  children,
+			AuthorId:  testutil.ModifyOnlyUserId(),
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+	r.EXPECT().
+		UpdateGraphContent(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			record.GraphContentEntry{Paragraph: "restored paragraph", Children: children}, nil).
+		Return(&record.GraphEntry{
+			Name:      "Section",
+			Paragraph: "restored paragraph",
+			Children:  children,
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date().Add(time.Hour),
+		}, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.RestoreGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "2000000000000001", graph.Id().Value())
+	assert.Equal(t, "Section", graph.Name().Value())
+	assert.Equal(t, "restored paragraph", graph.Paragraph().Value())
+	assert.Equal(t, 1, graph.Children().Len())
+	assert.Equal(t, "Child", graph.Children().Value()[0].Name().Value())
+	assert.Equal(t, testutil.Date(), graph.CreatedAt().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), graph.UpdatedAt().Value())
+}
+
+func TestRestoreGraphRevisionRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		FetchGraphRevision(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001",
+			"3000000000000001").
+		Return(nil, repository.Errorf(repository.NotFoundError, "graph revision not found"))
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.RestoreGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to find graph revision: graph revision not found", sErr.Error())
+	assert.Nil(t, graph)
+}
+
+func TestAssignMissingGraphChildIdsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		AssignMissingGraphChildIds().
+		Return(2, nil)
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	count, sErr := s.AssignMissingGraphChildIds()
+	assert.Nil(t, sErr)
+	assert.Equal(t, 2, count)
+}
+
+func TestAssignMissingGraphChildIdsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockGraphRepository(ctrl)
+	r.EXPECT().
+		AssignMissingGraphChildIds().
+		Return(1, repository.Errorf(repository.WriteFailurePanic, "repository error"))
+
+	s := service.NewGraphService(r, service.RevisionRetention{})
+
+	count, sErr := s.AssignMissingGraphChildIds()
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to assign missing graph child ids: repository error", sErr.Error())
+	assert.Equal(t, 1, count)
+}
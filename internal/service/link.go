@@ -0,0 +1,203 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type LinkService interface {
+	ListLinks(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+	) ([]domain.LinkEntity, *Error)
+	CreateLink(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		link domain.LinkWithoutAutofieldEntity,
+	) (*domain.LinkEntity, *Error)
+	UpdateLink(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		linkId domain.LinkIdObject,
+		link domain.LinkWithoutAutofieldEntity,
+	) (*domain.LinkEntity, *Error)
+	DeleteLink(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		linkId domain.LinkIdObject,
+	) *Error
+}
+
+type linkService struct {
+	repository repository.LinkRepository
+}
+
+func NewLinkService(repository repository.LinkRepository) LinkService {
+	return linkService{repository: repository}
+}
+
+func (s linkService) ListLinks(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) ([]domain.LinkEntity, *Error) {
+	entries, rErr := s.repository.FetchLinks(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list links: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch links: %w", rErr.Unwrap())
+	}
+
+	links := []domain.LinkEntity{}
+	for key, entry := range entries {
+		link, err := s.entryToEntity(key, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, *link)
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		if !links[i].CreatedAt().Value().Equal(links[j].CreatedAt().Value()) {
+			return links[i].CreatedAt().Value().Before(links[j].CreatedAt().Value())
+		}
+		return links[i].Id().Value() < links[j].Id().Value()
+	})
+
+	return links, nil
+}
+
+func (s linkService) CreateLink(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	link domain.LinkWithoutAutofieldEntity,
+) (*domain.LinkEntity, *Error) {
+	key, entry, rErr := s.repository.InsertLink(userId.Value(), projectId.Value(), s.entityToEntry(link))
+	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
+		return nil, Errorf(InvalidArgumentError, "failed to create link: %w", rErr.Unwrap())
+	}
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to create link: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to create link: %w", rErr.Unwrap())
+	}
+
+	return s.entryToEntity(key, *entry)
+}
+
+func (s linkService) UpdateLink(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	linkId domain.LinkIdObject,
+	link domain.LinkWithoutAutofieldEntity,
+) (*domain.LinkEntity, *Error) {
+	entry, rErr := s.repository.UpdateLink(userId.Value(), projectId.Value(), linkId.Value(), s.entityToEntry(link))
+	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
+		return nil, Errorf(InvalidArgumentError, "failed to update link: %w", rErr.Unwrap())
+	}
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to update link: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to update link: %w", rErr.Unwrap())
+	}
+
+	return s.entryToEntity(linkId.Value(), *entry)
+}
+
+func (s linkService) DeleteLink(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	linkId domain.LinkIdObject,
+) *Error {
+	rErr := s.repository.DeleteLink(userId.Value(), projectId.Value(), linkId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return Errorf(NotFoundError, "failed to delete link: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete link: %w", rErr.Unwrap())
+	}
+
+	return nil
+}
+
+func (s linkService) entityToEntry(link domain.LinkWithoutAutofieldEntity) record.LinkWithoutAutofieldEntry {
+	return record.LinkWithoutAutofieldEntry{
+		From:        s.endpointEntityToEntry(*link.From()),
+		To:          s.endpointEntityToEntry(*link.To()),
+		Relation:    link.Relation().Value(),
+		Description: link.Description().Value(),
+	}
+}
+
+func (s linkService) endpointEntityToEntry(endpoint domain.LinkEndpointEntity) record.LinkEndpointEntry {
+	entry := record.LinkEndpointEntry{
+		ChapterId: endpoint.ChapterId().Value(),
+		SectionId: endpoint.SectionId().Value(),
+	}
+	if endpoint.NodeId() != nil {
+		entry.NodeId = endpoint.NodeId().Value()
+	}
+	return entry
+}
+
+func (s linkService) entryToEntity(key string, entry record.LinkEntry) (*domain.LinkEntity, *Error) {
+	id, err := domain.NewLinkIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	from, sErr := s.endpointEntryToEntity(entry.From)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (from): %w", sErr.Unwrap())
+	}
+	to, sErr := s.endpointEntryToEntity(entry.To)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (to): %w", sErr.Unwrap())
+	}
+	relation, err := domain.NewGraphRelationObject(entry.Relation)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (relation): %w", err)
+	}
+	description, err := domain.NewGraphDescriptionObject(entry.Description)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (description): %w", err)
+	}
+	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
+	}
+	updatedAt, err := domain.NewUpdatedAtObject(entry.UpdatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (updatedAt): %w", err)
+	}
+
+	return domain.NewLinkEntity(*id, *from, *to, *relation, *description, *createdAt, *updatedAt), nil
+}
+
+func (s linkService) endpointEntryToEntity(entry record.LinkEndpointEntry) (*domain.LinkEndpointEntity, *Error) {
+	chapterId, err := domain.NewChapterIdObject(entry.ChapterId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (chapterId): %w", err)
+	}
+	sectionId, err := domain.NewSectionIdObject(entry.SectionId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sectionId): %w", err)
+	}
+
+	var nodeId *domain.GraphChildIdObject
+	if entry.NodeId != "" {
+		nodeId, err = domain.NewGraphChildIdObject(entry.NodeId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (nodeId): %w", err)
+		}
+	}
+
+	return domain.NewLinkEndpointEntity(*chapterId, *sectionId, nodeId), nil
+}
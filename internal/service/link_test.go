@@ -0,0 +1,568 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListLinksValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockLinkRepository(ctrl)
+	r.EXPECT().
+		FetchLinks(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.LinkEntry{
+			"4000000000000002": {
+				From:        record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				To:          record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				Relation:    "depends on",
+				Description: "",
+				UserId:      testutil.ReadOnlyUserId(),
+				CreatedAt:   testutil.Date().Add(-1 * time.Hour),
+				UpdatedAt:   testutil.Date().Add(-1 * time.Hour),
+			},
+			"4000000000000001": {
+				From: record.LinkEndpointEntry{
+					ChapterId: "1000000000000001",
+					SectionId: "2000000000000001",
+					NodeId:    "3000000000000001",
+				},
+				To:          record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation:    "related to",
+				Description: "description",
+				UserId:      testutil.ReadOnlyUserId(),
+				CreatedAt:   testutil.Date().Add(-2 * time.Hour),
+				UpdatedAt:   testutil.Date().Add(-1 * time.Hour),
+			},
+		}, nil)
+
+	s := service.NewLinkService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	links, sErr := s.ListLinks(*userId, *projectId)
+	assert.Nil(t, sErr)
+	assert.Len(t, links, 2)
+
+	link := links[0]
+	assert.Equal(t, "4000000000000001", link.Id().Value())
+	assert.Equal(t, "1000000000000001", link.From().ChapterId().Value())
+	assert.Equal(t, "2000000000000001", link.From().SectionId().Value())
+	assert.Equal(t, "3000000000000001", link.From().NodeId().Value())
+	assert.Equal(t, "1000000000000002", link.To().ChapterId().Value())
+	assert.Equal(t, "2000000000000002", link.To().SectionId().Value())
+	assert.Nil(t, link.To().NodeId())
+	assert.Equal(t, "related to", link.Relation().Value())
+	assert.Equal(t, "description", link.Description().Value())
+	assert.Equal(t, testutil.Date().Add(-2*time.Hour), link.CreatedAt().Value())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), link.UpdatedAt().Value())
+
+	link = links[1]
+	assert.Equal(t, "4000000000000002", link.Id().Value())
+	assert.Nil(t, link.From().NodeId())
+	assert.Equal(t, "depends on", link.Relation().Value())
+	assert.Equal(t, "", link.Description().Value())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), link.CreatedAt().Value())
+}
+
+func TestListLinksNoEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockLinkRepository(ctrl)
+	r.EXPECT().
+		FetchLinks(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.LinkEntry{}, nil)
+
+	s := service.NewLinkService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	links, sErr := s.ListLinks(*userId, *projectId)
+	assert.Nil(t, sErr)
+	assert.Len(t, links, 0)
+}
+
+func TestListLinksInvalidEntry(t *testing.T) {
+	tt := []struct {
+		name          string
+		linkId        string
+		link          record.LinkEntry
+		expectedError string
+	}{
+		{
+			name:   "should return error when link id is empty",
+			linkId: "",
+			link: record.LinkEntry{
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation:  "related to",
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			expectedError: "failed to convert entry to entity (id): link id is required, but got ''",
+		},
+		{
+			name:   "should return error when endpoint section id is empty",
+			linkId: "4000000000000001",
+			link: record.LinkEntry{
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: ""},
+				Relation:  "related to",
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			expectedError: "failed to convert entry to entity (to): " +
+				"failed to convert entry to entity (sectionId): section id is required, but got ''",
+		},
+		{
+			name:   "should return error when relation is too long",
+			linkId: "4000000000000001",
+			link: record.LinkEntry{
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation:  testutil.RandomString(101),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			expectedError: "failed to convert entry to entity (relation): graph relation cannot be longer than 100 characters, but got",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				FetchLinks(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(map[string]record.LinkEntry{tc.linkId: tc.link}, nil)
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			links, sErr := s.ListLinks(*userId, *projectId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
+			assert.Contains(t, sErr.Error(), fmt.Sprintf("%v: %v", service.DomainFailurePanic, tc.expectedError))
+			assert.Nil(t, links)
+		})
+	}
+}
+
+func TestListLinksRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "failed to list links: failed to fetch project",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch links: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				FetchLinks(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			links, sErr := s.ListLinks(*userId, *projectId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, links)
+		})
+	}
+}
+
+func TestCreateLinkValidEntry(t *testing.T) {
+	tt := []struct {
+		name string
+		link record.LinkWithoutAutofieldEntry
+	}{
+		{
+			name: "should return link between child nodes",
+			link: record.LinkWithoutAutofieldEntry{
+				From: record.LinkEndpointEntry{
+					ChapterId: "1000000000000001",
+					SectionId: "2000000000000001",
+					NodeId:    "3000000000000001",
+				},
+				To: record.LinkEndpointEntry{
+					ChapterId: "1000000000000002",
+					SectionId: "2000000000000002",
+					NodeId:    "3000000000000002",
+				},
+				Relation:    "related to",
+				Description: "description",
+			},
+		},
+		{
+			name: "should return link between graph roots",
+			link: record.LinkWithoutAutofieldEntry{
+				From:     record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000002"},
+				Relation: "related to",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				InsertLink(testutil.ModifyOnlyUserId(), "0000000000000001", tc.link).
+				Return("4000000000000001", &record.LinkEntry{
+					From:        tc.link.From,
+					To:          tc.link.To,
+					Relation:    tc.link.Relation,
+					Description: tc.link.Description,
+					UserId:      testutil.ModifyOnlyUserId(),
+					CreatedAt:   testutil.Date(),
+					UpdatedAt:   testutil.Date(),
+				}, nil)
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			link := newLinkWithoutAutofieldEntity(t, tc.link)
+
+			createdLink, sErr := s.CreateLink(*userId, *projectId, *link)
+			assert.Nil(t, sErr)
+
+			assert.Equal(t, "4000000000000001", createdLink.Id().Value())
+			assert.True(t, createdLink.From().Equals(link.From()))
+			assert.True(t, createdLink.To().Equals(link.To()))
+			assert.Equal(t, tc.link.Relation, createdLink.Relation().Value())
+			assert.Equal(t, tc.link.Description, createdLink.Description().Value())
+			assert.Equal(t, testutil.Date(), createdLink.CreatedAt().Value())
+			assert.Equal(t, testutil.Date(), createdLink.UpdatedAt().Value())
+		})
+	}
+}
+
+func TestCreateLinkRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns invalid argument error",
+			errorCode:     repository.InvalidArgumentError,
+			errorMessage:  "link endpoint does not exist",
+			expectedError: "failed to create link: link endpoint does not exist",
+			expectedCode:  service.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "failed to create link: failed to fetch project",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to create link: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			entry := record.LinkWithoutAutofieldEntry{
+				From:     record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation: "related to",
+			}
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				InsertLink(testutil.ModifyOnlyUserId(), "0000000000000001", entry).
+				Return("", nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			createdLink, sErr := s.CreateLink(*userId, *projectId, *newLinkWithoutAutofieldEntity(t, entry))
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, createdLink)
+		})
+	}
+}
+
+func TestUpdateLinkValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entry := record.LinkWithoutAutofieldEntry{
+		From:        record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+		To:          record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+		Relation:    "depends on",
+		Description: "updated description",
+	}
+
+	r := mock_repository.NewMockLinkRepository(ctrl)
+	r.EXPECT().
+		UpdateLink(testutil.ModifyOnlyUserId(), "0000000000000001", "4000000000000001", entry).
+		Return(&record.LinkEntry{
+			From:        entry.From,
+			To:          entry.To,
+			Relation:    entry.Relation,
+			Description: entry.Description,
+			UserId:      testutil.ModifyOnlyUserId(),
+			CreatedAt:   testutil.Date().Add(-1 * time.Hour),
+			UpdatedAt:   testutil.Date(),
+		}, nil)
+
+	s := service.NewLinkService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	linkId, err := domain.NewLinkIdObject("4000000000000001")
+	assert.Nil(t, err)
+
+	link := newLinkWithoutAutofieldEntity(t, entry)
+
+	updatedLink, sErr := s.UpdateLink(*userId, *projectId, *linkId, *link)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "4000000000000001", updatedLink.Id().Value())
+	assert.True(t, updatedLink.From().Equals(link.From()))
+	assert.True(t, updatedLink.To().Equals(link.To()))
+	assert.Equal(t, "depends on", updatedLink.Relation().Value())
+	assert.Equal(t, "updated description", updatedLink.Description().Value())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), updatedLink.CreatedAt().Value())
+	assert.Equal(t, testutil.Date(), updatedLink.UpdatedAt().Value())
+}
+
+func TestUpdateLinkRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns invalid argument error",
+			errorCode:     repository.InvalidArgumentError,
+			errorMessage:  "link endpoint does not exist",
+			expectedError: "failed to update link: link endpoint does not exist",
+			expectedCode:  service.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch link",
+			expectedError: "failed to update link: failed to fetch link",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to update link: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			entry := record.LinkWithoutAutofieldEntry{
+				From:     record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       record.LinkEndpointEntry{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation: "related to",
+			}
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				UpdateLink(testutil.ModifyOnlyUserId(), "0000000000000001", "4000000000000001", entry).
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			linkId, err := domain.NewLinkIdObject("4000000000000001")
+			assert.Nil(t, err)
+
+			updatedLink, sErr := s.UpdateLink(*userId, *projectId, *linkId, *newLinkWithoutAutofieldEntity(t, entry))
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, updatedLink)
+		})
+	}
+}
+
+func TestDeleteLinkValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockLinkRepository(ctrl)
+	r.EXPECT().
+		DeleteLink(testutil.ModifyOnlyUserId(), "0000000000000001", "4000000000000001").
+		Return(nil)
+
+	s := service.NewLinkService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	linkId, err := domain.NewLinkIdObject("4000000000000001")
+	assert.Nil(t, err)
+
+	sErr := s.DeleteLink(*userId, *projectId, *linkId)
+	assert.Nil(t, sErr)
+}
+
+func TestDeleteLinkRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch link",
+			expectedError: "failed to delete link: failed to fetch link",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to delete link: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockLinkRepository(ctrl)
+			r.EXPECT().
+				DeleteLink(testutil.ModifyOnlyUserId(), "0000000000000001", "4000000000000001").
+				Return(repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewLinkService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			linkId, err := domain.NewLinkIdObject("4000000000000001")
+			assert.Nil(t, err)
+
+			sErr := s.DeleteLink(*userId, *projectId, *linkId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+		})
+	}
+}
+
+func newLinkWithoutAutofieldEntity(t *testing.T, entry record.LinkWithoutAutofieldEntry) *domain.LinkWithoutAutofieldEntity {
+	endpoint := func(entry record.LinkEndpointEntry) *domain.LinkEndpointEntity {
+		chapterId, err := domain.NewChapterIdObject(entry.ChapterId)
+		assert.Nil(t, err)
+		sectionId, err := domain.NewSectionIdObject(entry.SectionId)
+		assert.Nil(t, err)
+		var nodeId *domain.GraphChildIdObject
+		if entry.NodeId != "" {
+			nodeId, err = domain.NewGraphChildIdObject(entry.NodeId)
+			assert.Nil(t, err)
+		}
+		return domain.NewLinkEndpointEntity(*chapterId, *sectionId, nodeId)
+	}
+
+	relation, err := domain.NewGraphRelationObject(entry.Relation)
+	assert.Nil(t, err)
+	description, err := domain.NewGraphDescriptionObject(entry.Description)
+	assert.Nil(t, err)
+
+	link, err := domain.NewLinkWithoutAutofieldEntity(*endpoint(entry.From), *endpoint(entry.To), *relation, *description)
+	assert.Nil(t, err)
+	return link
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
 	"github.com/kumachan-mis/knodeledge-api/internal/repository"
@@ -19,15 +21,46 @@ type PaperService interface {
 		projectId domain.ProjectIdObject,
 		paperId domain.PaperIdObject,
 		paper domain.PaperWithoutAutofieldEntity,
+		updatedAt *domain.UpdatedAtObject,
 	) (*domain.PaperEntity, *Error)
+	ListPaperRevisions(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		paperId domain.PaperIdObject,
+	) ([]domain.PaperRevisionEntity, *Error)
+	FindPaperRevision(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		paperId domain.PaperIdObject,
+		revisionId domain.PaperRevisionIdObject,
+	) (*domain.PaperRevisionEntity, *Error)
+	DiffPaperRevisions(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		paperId domain.PaperIdObject,
+		fromRevisionId domain.PaperRevisionIdObject,
+		toRevisionId domain.PaperRevisionIdObject,
+	) (*domain.PaperRevisionDiffObject, *Error)
+	RestorePaperRevision(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		paperId domain.PaperIdObject,
+		revisionId domain.PaperRevisionIdObject,
+	) (*domain.PaperEntity, *Error)
+	SectionalizePaper(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		paperId domain.PaperIdObject,
+	) (*domain.SectionWithoutAutofieldEntityList, *Error)
 }
 
 type paperService struct {
 	repository repository.PaperRepository
+	retention  RevisionRetention
 }
 
-func NewPaperService(repository repository.PaperRepository) PaperService {
-	return paperService{repository: repository}
+func NewPaperService(repository repository.PaperRepository, retention RevisionRetention) PaperService {
+	return paperService{repository: repository, retention: retention}
 }
 
 func (s paperService) FindPaper(
@@ -51,6 +84,7 @@ func (s paperService) UpdatePaper(
 	projectId domain.ProjectIdObject,
 	paperId domain.PaperIdObject,
 	paper domain.PaperWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
 ) (*domain.PaperEntity, *Error) {
 	entryWithoutAutofield := record.PaperWithoutAutofieldEntry{
 		Content: paper.Content().Value(),
@@ -61,7 +95,15 @@ func (s paperService) UpdatePaper(
 		projectId.Value(),
 		paperId.Value(),
 		entryWithoutAutofield,
+		updatedAtToTime(updatedAt),
 	)
+	if rErr != nil && rErr.Code() == repository.ConflictError {
+		current, sErr := s.entryToEntity(paperId.Value(), *entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		return current, Errorf(ConflictError, "failed to update paper: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return nil, Errorf(NotFoundError, "failed to update paper: %w", rErr.Unwrap())
 	}
@@ -69,9 +111,186 @@ func (s paperService) UpdatePaper(
 		return nil, Errorf(RepositoryFailurePanic, "failed to update paper: %w", rErr.Unwrap())
 	}
 
+	sErr := s.pruneRevisions(userId, projectId, paperId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
 	return s.entryToEntity(paperId.Value(), *entry)
 }
 
+func (s paperService) ListPaperRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+) ([]domain.PaperRevisionEntity, *Error) {
+	entries, rErr := s.repository.FetchPaperRevisions(userId.Value(), projectId.Value(), paperId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list paper revisions: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch paper revisions: %w", rErr.Unwrap())
+	}
+
+	return s.entriesToRevisionEntities(entries)
+}
+
+func (s paperService) FindPaperRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+	revisionId domain.PaperRevisionIdObject,
+) (*domain.PaperRevisionEntity, *Error) {
+	entry, rErr := s.repository.FetchPaperRevision(
+		userId.Value(),
+		projectId.Value(),
+		paperId.Value(),
+		revisionId.Value(),
+	)
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to find paper revision: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch paper revision: %w", rErr.Unwrap())
+	}
+
+	return s.entryToRevisionEntity(revisionId.Value(), *entry)
+}
+
+func (s paperService) DiffPaperRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+	fromRevisionId domain.PaperRevisionIdObject,
+	toRevisionId domain.PaperRevisionIdObject,
+) (*domain.PaperRevisionDiffObject, *Error) {
+	from, sErr := s.FindPaperRevision(userId, projectId, paperId, fromRevisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+	to, sErr := s.FindPaperRevision(userId, projectId, paperId, toRevisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	diff, err := domain.NewPaperRevisionDiffObject(unifiedDiff(
+		fromRevisionId.Value(),
+		toRevisionId.Value(),
+		from.Content().Value(),
+		to.Content().Value(),
+	))
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to diff paper revisions: %w", err)
+	}
+
+	return diff, nil
+}
+
+func (s paperService) RestorePaperRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+	revisionId domain.PaperRevisionIdObject,
+) (*domain.PaperEntity, *Error) {
+	revision, sErr := s.FindPaperRevision(userId, projectId, paperId, revisionId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	paper := domain.NewPaperWithoutAutofieldEntity(*revision.Content())
+	return s.UpdatePaper(userId, projectId, paperId, *paper, nil)
+}
+
+func (s paperService) SectionalizePaper(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+) (*domain.SectionWithoutAutofieldEntityList, *Error) {
+	entry, rErr := s.repository.FetchPaper(userId.Value(), projectId.Value(), paperId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to sectionalize paper: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch paper: %w", rErr.Unwrap())
+	}
+
+	paper, sErr := s.entryToEntity(paperId.Value(), *entry)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	markdownSections := sectionalizeMarkdown(paper.Content().Value())
+	sections := make([]domain.SectionWithoutAutofieldEntity, len(markdownSections))
+	for i, markdownSection := range markdownSections {
+		name, err := domain.NewSectionNameObject(markdownSection.name)
+		if err != nil {
+			return nil, Errorf(InvalidArgumentError, "failed to sectionalize paper (name): %w", err)
+		}
+		content, err := domain.NewSectionContentObject(markdownSection.content)
+		if err != nil {
+			return nil, Errorf(InvalidArgumentError, "failed to sectionalize paper (content): %w", err)
+		}
+		sections[i] = *domain.NewSectionWithoutAutofieldEntity(*name, *content)
+	}
+
+	list, err := domain.NewSectionWithoutAutofieldEntityList(sections)
+	if err != nil {
+		return nil, Errorf(InvalidArgumentError, "failed to sectionalize paper (sections): %w", err)
+	}
+	return list, nil
+}
+
+func (s paperService) pruneRevisions(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+) *Error {
+	if !s.retention.enabled() {
+		return nil
+	}
+
+	entries, rErr := s.repository.FetchPaperRevisions(userId.Value(), projectId.Value(), paperId.Value())
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to fetch paper revisions: %w", rErr.Unwrap())
+	}
+
+	createdAts := make(map[string]time.Time, len(entries))
+	for id, entry := range entries {
+		createdAts[id] = entry.CreatedAt
+	}
+
+	revisionIds := s.retention.revisionIdsToPrune(createdAts)
+	if len(revisionIds) == 0 {
+		return nil
+	}
+
+	rErr = s.repository.DeletePaperRevisions(userId.Value(), projectId.Value(), paperId.Value(), revisionIds)
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete paper revisions: %w", rErr.Unwrap())
+	}
+	return nil
+}
+
+func (s paperService) entriesToRevisionEntities(
+	entries map[string]record.PaperRevisionEntry,
+) ([]domain.PaperRevisionEntity, *Error) {
+	createdAts := make(map[string]time.Time, len(entries))
+	for id, entry := range entries {
+		createdAts[id] = entry.CreatedAt
+	}
+	ids := sortedRevisionIds(createdAts)
+
+	entities := make([]domain.PaperRevisionEntity, len(ids))
+	for i, id := range ids {
+		entity, sErr := s.entryToRevisionEntity(id, entries[id])
+		if sErr != nil {
+			return nil, sErr
+		}
+		entities[i] = *entity
+	}
+	return entities, nil
+}
+
 func (s paperService) entryToEntity(key string, entry record.PaperEntry) (*domain.PaperEntity, *Error) {
 	id, err := domain.NewPaperIdObject(key)
 	if err != nil {
@@ -92,3 +311,27 @@ func (s paperService) entryToEntity(key string, entry record.PaperEntry) (*domai
 
 	return domain.NewPaperEntity(*id, *content, *createdAt, *updatedAt), nil
 }
+
+func (s paperService) entryToRevisionEntity(
+	key string,
+	entry record.PaperRevisionEntry,
+) (*domain.PaperRevisionEntity, *Error) {
+	id, err := domain.NewPaperRevisionIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	content, err := domain.NewPaperContentObject(entry.Content)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (content): %w", err)
+	}
+	authorId, err := domain.NewUserIdObject(entry.AuthorId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (authorId): %w", err)
+	}
+	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
+	}
+
+	return domain.NewPaperRevisionEntity(*id, *content, *authorId, *createdAt), nil
+}
@@ -2,7 +2,9 @@ package service_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
@@ -51,7 +53,7 @@ func TestFindPaperValidEntry(t *testing.T) {
 				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
 				Return(&tc.entry, nil)
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -101,7 +103,7 @@ func TestFindPaperInvalidEntry(t *testing.T) {
 				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
 				Return(&tc.entry, nil)
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -153,7 +155,7 @@ func TestFindPaperRepositoryError(t *testing.T) {
 				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
 			assert.Nil(t, err)
@@ -200,14 +202,14 @@ func TestUpdatePaperValidEntry(t *testing.T) {
 
 			r := mock_repository.NewMockPaperRepository(ctrl)
 			r.EXPECT().
-				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", tc.paper).
+				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", tc.paper, nil).
 				Return(&record.PaperEntry{
 					Content:   tc.paper.Content,
 					CreatedAt: testutil.Date(),
 					UpdatedAt: testutil.Date(),
 				}, nil)
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -221,7 +223,7 @@ func TestUpdatePaperValidEntry(t *testing.T) {
 
 			paper := domain.NewPaperWithoutAutofieldEntity(*content)
 
-			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper)
+			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, nil)
 			assert.Nil(t, sErr)
 
 			assert.Equal(t, "1000000000000001", updatedPaper.Id().Value())
@@ -260,10 +262,10 @@ func TestUpdatePaperInvalidUpdatedEntry(t *testing.T) {
 
 			r := mock_repository.NewMockPaperRepository(ctrl)
 			r.EXPECT().
-				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any()).
+				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any(), nil).
 				Return(&tc.updatedPaper, nil)
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(tc.updatedPaper.UserId)
 			assert.Nil(t, err)
@@ -277,7 +279,7 @@ func TestUpdatePaperInvalidUpdatedEntry(t *testing.T) {
 
 			paper := domain.NewPaperWithoutAutofieldEntity(*content)
 
-			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper)
+			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
 			assert.Equal(t, fmt.Sprintf("domain failure: %v", tc.expectedError), sErr.Error())
@@ -317,10 +319,10 @@ func TestUpdatePaperRepositoryError(t *testing.T) {
 
 			r := mock_repository.NewMockPaperRepository(ctrl)
 			r.EXPECT().
-				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any()).
+				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any(), nil).
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			s := service.NewPaperService(r)
+			s := service.NewPaperService(r, service.RevisionRetention{})
 
 			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
 			assert.Nil(t, err)
@@ -334,7 +336,7 @@ func TestUpdatePaperRepositoryError(t *testing.T) {
 
 			paper := domain.NewPaperWithoutAutofieldEntity(*content)
 
-			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper)
+			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, tc.expectedCode, sErr.Code())
 			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
@@ -342,3 +344,811 @@ func TestUpdatePaperRepositoryError(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePaperConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any(), gomock.Any()).
+		Do(func(userId string, projectId string, chapterId string,
+			entry record.PaperWithoutAutofieldEntry, expectedUpdatedAt *time.Time) {
+			assert.Equal(t, "updated content", entry.Content)
+			assert.Equal(t, testutil.Date(), *expectedUpdatedAt)
+		}).
+		Return(&record.PaperEntry{
+			Content:   "content",
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date().Add(time.Hour),
+		}, repository.Errorf(repository.ConflictError, "paper has been updated since it was fetched"))
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("updated content")
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	paper := domain.NewPaperWithoutAutofieldEntity(*content)
+
+	currentPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, updatedAt)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.ConflictError, sErr.Code())
+	assert.Equal(t, "conflict: failed to update paper: paper has been updated since it was fetched", sErr.Error())
+
+	assert.Equal(t, "1000000000000001", currentPaper.Id().Value())
+	assert.Equal(t, "content", currentPaper.Content().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), currentPaper.UpdatedAt().Value())
+}
+
+func TestUpdatePaperPrunesRevisions(t *testing.T) {
+	now := time.Now()
+
+	tt := []struct {
+		name                string
+		retention           service.RevisionRetention
+		revisions           map[string]record.PaperRevisionEntry
+		expectedRevisionIds []string
+	}{
+		{
+			name:      "should delete revisions beyond max count",
+			retention: service.RevisionRetention{MaxCount: 2},
+			revisions: map[string]record.PaperRevisionEntry{
+				"REVISION_ONE":   {Content: "one", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-3 * time.Minute)},
+				"REVISION_TWO":   {Content: "two", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-2 * time.Minute)},
+				"REVISION_THREE": {Content: "three", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-time.Minute)},
+			},
+			expectedRevisionIds: []string{"REVISION_ONE"},
+		},
+		{
+			name:      "should squash revisions older than max age into the newest of them",
+			retention: service.RevisionRetention{MaxAge: 24 * time.Hour},
+			revisions: map[string]record.PaperRevisionEntry{
+				"REVISION_ONE":   {Content: "one", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-72 * time.Hour)},
+				"REVISION_TWO":   {Content: "two", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-48 * time.Hour)},
+				"REVISION_THREE": {Content: "three", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-time.Minute)},
+			},
+			expectedRevisionIds: []string{"REVISION_ONE"},
+		},
+		{
+			name:      "should apply both limits",
+			retention: service.RevisionRetention{MaxCount: 3, MaxAge: 24 * time.Hour},
+			revisions: map[string]record.PaperRevisionEntry{
+				"REVISION_ONE":   {Content: "one", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-96 * time.Hour)},
+				"REVISION_TWO":   {Content: "two", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-72 * time.Hour)},
+				"REVISION_THREE": {Content: "three", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-48 * time.Hour)},
+				"REVISION_FOUR":  {Content: "four", AuthorId: testutil.ModifyOnlyUserId(), CreatedAt: now.Add(-time.Minute)},
+			},
+			expectedRevisionIds: []string{"REVISION_TWO", "REVISION_ONE"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any(), nil).
+				Return(&record.PaperEntry{
+					Content:   "three",
+					CreatedAt: testutil.Date(),
+					UpdatedAt: testutil.Date(),
+				}, nil)
+			r.EXPECT().
+				FetchPaperRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(tc.revisions, nil)
+			r.EXPECT().
+				DeletePaperRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
+					tc.expectedRevisionIds).
+				Return(nil)
+
+			s := service.NewPaperService(r, tc.retention)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+			content, err := domain.NewPaperContentObject("three")
+			assert.Nil(t, err)
+
+			paper := domain.NewPaperWithoutAutofieldEntity(*content)
+
+			updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, nil)
+			assert.Nil(t, sErr)
+			assert.Equal(t, "three", updatedPaper.Content().Value())
+		})
+	}
+}
+
+func TestUpdatePaperPruneRevisionsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", gomock.Any(), nil).
+		Return(&record.PaperEntry{
+			Content:   "content",
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+	r.EXPECT().
+		FetchPaperRevisions(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+
+	s := service.NewPaperService(r, service.RevisionRetention{MaxCount: 1})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("content")
+	assert.Nil(t, err)
+
+	paper := domain.NewPaperWithoutAutofieldEntity(*content)
+
+	updatedPaper, sErr := s.UpdatePaper(*userId, *projectId, *paperId, *paper, nil)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to fetch paper revisions: repository error", sErr.Error())
+	assert.Nil(t, updatedPaper)
+}
+
+func TestListPaperRevisionsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		FetchPaperRevisions(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(map[string]record.PaperRevisionEntry{
+			"2000000000000001": {
+				Content:   "first content",
+				AuthorId:  testutil.ReadOnlyUserId(),
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+			},
+			"2000000000000002": {
+				Content:   "second content",
+				AuthorId:  testutil.ModifyOnlyUserId(),
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date().Add(time.Hour),
+			},
+		}, nil)
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+
+	revisions, sErr := s.ListPaperRevisions(*userId, *projectId, *paperId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, revisions, 2)
+
+	revision := revisions[0]
+	assert.Equal(t, "2000000000000002", revision.Id().Value())
+	assert.Equal(t, "second content", revision.Content().Value())
+	assert.Equal(t, testutil.ModifyOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), revision.CreatedAt().Value())
+
+	revision = revisions[1]
+	assert.Equal(t, "2000000000000001", revision.Id().Value())
+	assert.Equal(t, "first content", revision.Content().Value())
+	assert.Equal(t, testutil.ReadOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date(), revision.CreatedAt().Value())
+}
+
+func TestListPaperRevisionsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "paper not found",
+			expectedError: "failed to list paper revisions: paper not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch paper revisions: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaperRevisions(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+
+			revisions, sErr := s.ListPaperRevisions(*userId, *projectId, *paperId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, revisions)
+		})
+	}
+}
+
+func TestFindPaperRevisionValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		FetchPaperRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(&record.PaperRevisionEntry{
+			Content:   "content",
+			AuthorId:  testutil.ReadOnlyUserId(),
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	revision, sErr := s.FindPaperRevision(*userId, *projectId, *paperId, *revisionId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "2000000000000001", revision.Id().Value())
+	assert.Equal(t, "content", revision.Content().Value())
+	assert.Equal(t, testutil.ReadOnlyUserId(), revision.AuthorId().Value())
+	assert.Equal(t, testutil.Date(), revision.CreatedAt().Value())
+}
+
+func TestFindPaperRevisionRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "paper revision not found",
+			expectedError: "failed to find paper revision: paper revision not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch paper revision: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaperRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+			revisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+			assert.Nil(t, err)
+
+			revision, sErr := s.FindPaperRevision(*userId, *projectId, *paperId, *revisionId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, revision)
+		})
+	}
+}
+
+func TestDiffPaperRevisionsValidEntry(t *testing.T) {
+	tt := []struct {
+		name         string
+		fromContent  string
+		toContent    string
+		expectedDiff string
+	}{
+		{
+			name:         "should return empty diff when contents are identical",
+			fromContent:  "line 1\nline 2\n",
+			toContent:    "line 1\nline 2\n",
+			expectedDiff: "",
+		},
+		{
+			name:        "should return unified diff with context lines",
+			fromContent: "line 1\nline 2\nline 3\nline 4\nline 5\n",
+			toContent:   "line 1\nline 2\nline three\nline 4\nline 5\nline 6\n",
+			expectedDiff: strings.Join([]string{
+				"--- 2000000000000001",
+				"+++ 2000000000000002",
+				"@@ -1,5 +1,6 @@",
+				" line 1",
+				" line 2",
+				"-line 3",
+				"+line three",
+				" line 4",
+				" line 5",
+				"+line 6",
+				"",
+			}, "\n"),
+		},
+		{
+			name:        "should split distant changes into hunks",
+			fromContent: "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n",
+			toContent:   "A\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nL\n",
+			expectedDiff: strings.Join([]string{
+				"--- 2000000000000001",
+				"+++ 2000000000000002",
+				"@@ -1,4 +1,4 @@",
+				"-a",
+				"+A",
+				" b",
+				" c",
+				" d",
+				"@@ -9,4 +9,4 @@",
+				" i",
+				" j",
+				" k",
+				"-l",
+				"+L",
+				"",
+			}, "\n"),
+		},
+		{
+			name:        "should return shortest diff of moved line",
+			fromContent: "a\nb\nc\n",
+			toContent:   "c\na\nb\n",
+			expectedDiff: strings.Join([]string{
+				"--- 2000000000000001",
+				"+++ 2000000000000002",
+				"@@ -1,3 +1,3 @@",
+				"+c",
+				" a",
+				" b",
+				"-c",
+				"",
+			}, "\n"),
+		},
+		{
+			name:        "should return diff from empty content",
+			fromContent: "",
+			toContent:   "line 1\n",
+			expectedDiff: strings.Join([]string{
+				"--- 2000000000000001",
+				"+++ 2000000000000002",
+				"@@ -0,0 +1 @@",
+				"+line 1",
+				"",
+			}, "\n"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaperRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+				Return(&record.PaperRevisionEntry{
+					Content:   tc.fromContent,
+					AuthorId:  testutil.ReadOnlyUserId(),
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+				}, nil)
+			r.EXPECT().
+				FetchPaperRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000002").
+				Return(&record.PaperRevisionEntry{
+					Content:   tc.toContent,
+					AuthorId:  testutil.ReadOnlyUserId(),
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date().Add(time.Hour),
+				}, nil)
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+			fromRevisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+			assert.Nil(t, err)
+			toRevisionId, err := domain.NewPaperRevisionIdObject("2000000000000002")
+			assert.Nil(t, err)
+
+			diff, sErr := s.DiffPaperRevisions(*userId, *projectId, *paperId, *fromRevisionId, *toRevisionId)
+			assert.Nil(t, sErr)
+
+			assert.Equal(t, tc.expectedDiff, diff.Value())
+		})
+	}
+}
+
+func TestDiffPaperRevisionsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		FetchPaperRevision(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(nil, repository.Errorf(repository.NotFoundError, "paper revision not found"))
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	fromRevisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	toRevisionId, err := domain.NewPaperRevisionIdObject("2000000000000002")
+	assert.Nil(t, err)
+
+	diff, sErr := s.DiffPaperRevisions(*userId, *projectId, *paperId, *fromRevisionId, *toRevisionId)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to find paper revision: paper revision not found", sErr.Error())
+	assert.Nil(t, diff)
+}
+
+func TestRestorePaperRevisionValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		FetchPaperRevision(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(&record.PaperRevisionEntry{
+			Content:   "restored content",
+			AuthorId:  testutil.ModifyOnlyUserId(),
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+	r.EXPECT().
+		UpdatePaper(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001",
+			record.PaperWithoutAutofieldEntry{Content: "restored content"}, nil).
+		Return(&record.PaperEntry{
+			Content:   "restored content",
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date().Add(time.Hour),
+		}, nil)
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	paper, sErr := s.RestorePaperRevision(*userId, *projectId, *paperId, *revisionId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "1000000000000001", paper.Id().Value())
+	assert.Equal(t, "restored content", paper.Content().Value())
+	assert.Equal(t, testutil.Date(), paper.CreatedAt().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), paper.UpdatedAt().Value())
+}
+
+func TestRestorePaperRevisionRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockPaperRepository(ctrl)
+	r.EXPECT().
+		FetchPaperRevision(testutil.ModifyOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(nil, repository.Errorf(repository.NotFoundError, "paper revision not found"))
+
+	s := service.NewPaperService(r, service.RevisionRetention{})
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	revisionId, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	paper, sErr := s.RestorePaperRevision(*userId, *projectId, *paperId, *revisionId)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to find paper revision: paper revision not found", sErr.Error())
+	assert.Nil(t, paper)
+}
+
+func TestSectionalizePaperValidEntry(t *testing.T) {
+	type section struct {
+		name    string
+		content string
+	}
+
+	tt := []struct {
+		name     string
+		content  string
+		expected []section
+	}{
+		{
+			name:    "should split paper at the shallowest heading level",
+			content: "## Introduction\nThis is the introduction.\n\n## Method\nThis is the method.\n",
+			expected: []section{
+				{name: "Introduction", content: "This is the introduction."},
+				{name: "Method", content: "This is the method."},
+			},
+		},
+		{
+			name: "should keep deeper headings in section content",
+			content: "## Introduction\nintro\n### Background\nbackground\n\n" +
+				"## Conclusion\nconclusion",
+			expected: []section{
+				{name: "Introduction", content: "intro\n### Background\nbackground"},
+				{name: "Conclusion", content: "conclusion"},
+			},
+		},
+		{
+			name: "should skip a single title heading",
+			content: "# Paper Title\npreface\n\n## Introduction\nintro\n\n" +
+				"## Conclusion ##\nconclusion",
+			expected: []section{
+				{name: "Introduction", content: "intro"},
+				{name: "Conclusion", content: "conclusion"},
+			},
+		},
+		{
+			name:    "should use a single heading when there is no deeper heading",
+			content: "# Only Section\ncontent",
+			expected: []section{
+				{name: "Only Section", content: "content"},
+			},
+		},
+		{
+			name: "should skip front-matter",
+			content: "---\ntitle: Paper\n# not a heading\n---\n" +
+				"## Introduction\nintro\n## Conclusion\nconclusion",
+			expected: []section{
+				{name: "Introduction", content: "intro"},
+				{name: "Conclusion", content: "conclusion"},
+			},
+		},
+		{
+			name: "should ignore headings in code fences",
+			content: "## Script\n```sh\n# comment\n## another comment\n```\n" +
+				"~~~~\n```\n# still code\n~~~~\n## Result\nresult",
+			expected: []section{
+				{name: "Script", content: "```sh\n# comment\n## another comment\n```\n~~~~\n```\n# still code\n~~~~"},
+				{name: "Result", content: "result"},
+			},
+		},
+		{
+			name:    "should normalize line endings",
+			content: "## A\r\na\r\n## B\r\nb\r\n",
+			expected: []section{
+				{name: "A", content: "a"},
+				{name: "B", content: "b"},
+			},
+		},
+		{
+			name:    "should not treat lines without space after # as headings",
+			content: "## C# Tips\n#hashtag\n## Empty\n",
+			expected: []section{
+				{name: "C# Tips", content: "#hashtag"},
+				{name: "Empty", content: ""},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(&record.PaperEntry{
+					Content:   tc.content,
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+					UpdatedAt: testutil.Date(),
+				}, nil)
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+
+			sections, sErr := s.SectionalizePaper(*userId, *projectId, *paperId)
+			assert.Nil(t, sErr)
+
+			actual := make([]section, sections.Len())
+			for i, s := range sections.Value() {
+				actual[i] = section{name: s.Name().Value(), content: s.Content().Value()}
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSectionalizePaperInvalidContent(t *testing.T) {
+	tooManySections := ""
+	for i := 0; i < 21; i++ {
+		tooManySections += fmt.Sprintf("## Section %d\ncontent\n", i+1)
+	}
+
+	tt := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{
+			name:          "should return error when paper has no headings",
+			content:       "```\n# code\n```\nplain text",
+			expectedError: "failed to sectionalize paper (sections): sections are required, but got []",
+		},
+		{
+			name:    "should return error when paper has too many sections",
+			content: tooManySections,
+			expectedError: "failed to sectionalize paper (sections): " +
+				"sections length must be less than or equal to 20, but got 21",
+		},
+		{
+			name:    "should return error when section name is too long",
+			content: "## " + strings.Repeat("a", 101) + "\ncontent",
+			expectedError: "failed to sectionalize paper (name): " +
+				"section name cannot be longer than 100 characters, but got '" + strings.Repeat("a", 101) + "'",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(&record.PaperEntry{
+					Content:   tc.content,
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+					UpdatedAt: testutil.Date(),
+				}, nil)
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+
+			sections, sErr := s.SectionalizePaper(*userId, *projectId, *paperId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.InvalidArgumentError, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("invalid argument: %s", tc.expectedError), sErr.Error())
+			assert.Nil(t, sections)
+		})
+	}
+}
+
+func TestSectionalizePaperRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "paper not found",
+			expectedError: "failed to sectionalize paper: paper not found",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch paper: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockPaperRepository(ctrl)
+			r.EXPECT().
+				FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewPaperService(r, service.RevisionRetention{})
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			paperId, err := domain.NewPaperIdObject("1000000000000001")
+			assert.Nil(t, err)
+
+			sections, sErr := s.SectionalizePaper(*userId, *projectId, *paperId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, sections)
+		})
+	}
+}
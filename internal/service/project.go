@@ -1,7 +1,9 @@
 package service
 
 import (
+	"maps"
 	"sort"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/record"
@@ -26,13 +28,18 @@ type ProjectService interface {
 		userId domain.UserIdObject,
 		projectId domain.ProjectIdObject,
 		project domain.ProjectWithoutAutofieldEntity,
+		updatedAt *domain.UpdatedAtObject,
 	) (*domain.ProjectEntity, *Error)
 	DeleteProject(
 		userId domain.UserIdObject,
 		projectId domain.ProjectIdObject,
 	) *Error
+	ResumeDeletingProjects() (int, *Error)
 }
 
+// ImportingProjectTimeout is how long an import may take before its project is treated as abandoned
+const ImportingProjectTimeout = time.Hour
+
 type projectService struct {
 	repository repository.ProjectRepository
 }
@@ -51,7 +58,7 @@ func (s projectService) ListProjects(
 
 	projects := []domain.ProjectEntity{}
 	for key, entry := range entries {
-		project, err := s.entryToEntity(key, entry)
+		project, err := s.entryToEntity(key, entry, userId.Value())
 		if err != nil {
 			return nil, err
 		}
@@ -80,7 +87,7 @@ func (s projectService) FindProject(
 		return nil, Errorf(RepositoryFailurePanic, "failed to fetch project: %w", rErr.Unwrap())
 	}
 
-	entity, err := s.entryToEntity(projectId.Value(), *entry)
+	entity, err := s.entryToEntity(projectId.Value(), *entry, userId.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -102,20 +109,33 @@ func (s projectService) CreateProject(
 		return nil, Errorf(RepositoryFailurePanic, "failed to insert project: %w", rErr.Unwrap())
 	}
 
-	return s.entryToEntity(key, *entry)
+	return s.entryToEntity(key, *entry, userId.Value())
 }
 
 func (s projectService) UpdateProject(
 	userId domain.UserIdObject,
 	projectId domain.ProjectIdObject,
 	project domain.ProjectWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
 ) (*domain.ProjectEntity, *Error) {
 	entryWithoutAutofield := record.ProjectWithoutAutofieldEntry{
 		Name:        project.Name().Value(),
 		Description: project.Description().Value(),
 	}
 
-	entry, rErr := s.repository.UpdateProject(userId.Value(), projectId.Value(), entryWithoutAutofield)
+	entry, rErr := s.repository.UpdateProject(
+		userId.Value(),
+		projectId.Value(),
+		entryWithoutAutofield,
+		updatedAtToTime(updatedAt),
+	)
+	if rErr != nil && rErr.Code() == repository.ConflictError {
+		current, sErr := s.entryToEntity(projectId.Value(), *entry, userId.Value())
+		if sErr != nil {
+			return nil, sErr
+		}
+		return current, Errorf(ConflictError, "failed to update project: %w", rErr.Unwrap())
+	}
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return nil, Errorf(NotFoundError, "failed to update project: %w", rErr.Unwrap())
 	}
@@ -123,14 +143,14 @@ func (s projectService) UpdateProject(
 		return nil, Errorf(RepositoryFailurePanic, "failed to update project: %w", rErr.Unwrap())
 	}
 
-	return s.entryToEntity(projectId.Value(), *entry)
+	return s.entryToEntity(projectId.Value(), *entry, userId.Value())
 }
 
 func (s projectService) DeleteProject(
 	userId domain.UserIdObject,
 	projectId domain.ProjectIdObject,
 ) *Error {
-	rErr := s.repository.DeleteProject(userId.Value(), projectId.Value())
+	rErr := s.repository.TrashProject(userId.Value(), projectId.Value())
 	if rErr != nil && rErr.Code() == repository.NotFoundError {
 		return Errorf(NotFoundError, "failed to delete project: %w", rErr.Unwrap())
 	}
@@ -141,7 +161,39 @@ func (s projectService) DeleteProject(
 	return nil
 }
 
-func (s projectService) entryToEntity(key string, entry record.ProjectEntry) (*domain.ProjectEntity, *Error) {
+func (s projectService) ResumeDeletingProjects() (int, *Error) {
+	entries, rErr := s.repository.FetchDeletingProjects()
+	if rErr != nil {
+		return 0, Errorf(RepositoryFailurePanic, "failed to fetch deleting projects: %w", rErr.Unwrap())
+	}
+
+	// imports still running elsewhere are younger than the timeout, so only abandoned ones are deleted
+	importingEntries, rErr := s.repository.FetchImportingProjects(time.Now().Add(-ImportingProjectTimeout))
+	if rErr != nil {
+		return 0, Errorf(RepositoryFailurePanic, "failed to fetch importing projects: %w", rErr.Unwrap())
+	}
+	maps.Copy(entries, importingEntries)
+
+	total := 0
+	for key, entry := range entries {
+		count, rErr := s.repository.DeleteProject(entry.UserId, key)
+		total += count
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			continue
+		}
+		if rErr != nil {
+			return total, Errorf(RepositoryFailurePanic, "failed to delete project: %w", rErr.Unwrap())
+		}
+	}
+
+	return total, nil
+}
+
+func (s projectService) entryToEntity(
+	key string,
+	entry record.ProjectEntry,
+	userId string,
+) (*domain.ProjectEntity, *Error) {
 	id, err := domain.NewProjectIdObject(key)
 	if err != nil {
 		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
@@ -154,6 +206,10 @@ func (s projectService) entryToEntity(key string, entry record.ProjectEntry) (*d
 	if err != nil {
 		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (description): %w", err)
 	}
+	role, err := domain.NewProjectRoleObject(projectRoleOf(entry, userId))
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (role): %w", err)
+	}
 	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
 	if err != nil {
 		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
@@ -163,5 +219,13 @@ func (s projectService) entryToEntity(key string, entry record.ProjectEntry) (*d
 		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (updatedAt): %w", err)
 	}
 
-	return domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt), nil
+	return domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt), nil
+}
+
+// projectRoleOf returns the role of the user in the project whose entry was fetched by the user
+func projectRoleOf(entry record.ProjectEntry, userId string) string {
+	if entry.UserId == userId {
+		return repository.ProjectRoleOwner
+	}
+	return entry.Members[userId]
 }
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ProjectArchiveService interface {
+	ExportProject(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+	) (*domain.ProjectArchiveEntity, *Error)
+	ImportProject(
+		userId domain.UserIdObject,
+		archive domain.ProjectArchiveEntity,
+	) (*domain.ProjectEntity, *Error)
+}
+
+type projectArchiveService struct {
+	projectRepository repository.ProjectRepository
+	chapterRepository repository.ChapterRepository
+	paperRepository   repository.PaperRepository
+	graphRepository   repository.GraphRepository
+	linkRepository    repository.LinkRepository
+}
+
+func NewProjectArchiveService(
+	projectRepository repository.ProjectRepository,
+	chapterRepository repository.ChapterRepository,
+	paperRepository repository.PaperRepository,
+	graphRepository repository.GraphRepository,
+	linkRepository repository.LinkRepository,
+) ProjectArchiveService {
+	return projectArchiveService{
+		projectRepository: projectRepository,
+		chapterRepository: chapterRepository,
+		paperRepository:   paperRepository,
+		graphRepository:   graphRepository,
+		linkRepository:    linkRepository,
+	}
+}
+
+type projectArchiveEndpointKey struct {
+	chapterId string
+	sectionId string
+	nodeId    string
+}
+
+func (s projectArchiveService) ExportProject(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) (*domain.ProjectArchiveEntity, *Error) {
+	projectEntry, rErr := s.projectRepository.FetchProject(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to find project: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch project: %w", rErr.Unwrap())
+	}
+
+	chapterEntries, rErr := s.chapterRepository.FetchChapters(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to export project: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch chapters: %w", rErr.Unwrap())
+	}
+
+	chapterIds := make([]string, 0, len(chapterEntries))
+	for key := range chapterEntries {
+		chapterIds = append(chapterIds, key)
+	}
+	sort.Slice(chapterIds, func(i, j int) bool {
+		return chapterEntries[chapterIds[i]].Number < chapterEntries[chapterIds[j]].Number
+	})
+
+	endpoints := make(map[projectArchiveEndpointKey]struct{})
+	chapters := make([]domain.ProjectArchiveChapterEntity, len(chapterIds))
+	for i, chapterKey := range chapterIds {
+		paperEntry, rErr := s.paperRepository.FetchPaper(userId.Value(), projectId.Value(), chapterKey)
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			return nil, Errorf(NotFoundError, "failed to export project: %w", rErr.Unwrap())
+		}
+		if rErr != nil {
+			return nil, Errorf(RepositoryFailurePanic, "failed to fetch paper: %w", rErr.Unwrap())
+		}
+
+		sectionKeys, graphEntries, rErr := s.graphRepository.FetchGraphs(userId.Value(), projectId.Value(), chapterKey)
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			return nil, Errorf(NotFoundError, "failed to export project: %w", rErr.Unwrap())
+		}
+		if rErr != nil {
+			return nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+		}
+
+		for j, graphEntry := range graphEntries {
+			endpoints[projectArchiveEndpointKey{chapterId: chapterKey, sectionId: sectionKeys[j]}] = struct{}{}
+			s.collectEndpoints(chapterKey, sectionKeys[j], graphEntry.Children, endpoints)
+		}
+
+		chapter, sErr := s.chapterEntryToEntity(
+			chapterKey, chapterEntries[chapterKey], *paperEntry, sectionKeys, graphEntries)
+		if sErr != nil {
+			return nil, sErr
+		}
+		chapters[i] = *chapter
+	}
+
+	linkEntries, rErr := s.linkRepository.FetchLinks(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to export project: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch links: %w", rErr.Unwrap())
+	}
+
+	linkIds := make([]string, 0, len(linkEntries))
+	for key, entry := range linkEntries {
+		// links to nodes removed after linking cannot be restored, so they are left out of the archive
+		if !s.hasEndpoint(entry.From, endpoints) || !s.hasEndpoint(entry.To, endpoints) {
+			continue
+		}
+		linkIds = append(linkIds, key)
+	}
+	sort.Slice(linkIds, func(i, j int) bool {
+		icreatedAt := linkEntries[linkIds[i]].CreatedAt
+		jcreatedAt := linkEntries[linkIds[j]].CreatedAt
+		if icreatedAt.Equal(jcreatedAt) {
+			return linkIds[i] < linkIds[j]
+		}
+		return icreatedAt.Before(jcreatedAt)
+	})
+
+	links := make([]domain.LinkWithoutAutofieldEntity, len(linkIds))
+	for i, key := range linkIds {
+		link, sErr := s.linkEntryToEntity(linkEntries[key])
+		if sErr != nil {
+			return nil, sErr
+		}
+		links[i] = *link
+	}
+
+	project, sErr := s.projectEntryToEntity(*projectEntry)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	archive, err := domain.NewProjectArchiveEntity(*project, chapters, links)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (archive): %w", err)
+	}
+	return archive, nil
+}
+
+func (s projectArchiveService) ImportProject(
+	userId domain.UserIdObject,
+	archive domain.ProjectArchiveEntity,
+) (*domain.ProjectEntity, *Error) {
+	// the project stays hidden from lists until the last content is written,
+	// and is left to ResumeDeletingProjects if the import never finishes
+	projectKey, _, rErr := s.projectRepository.InsertImportingProject(userId.Value(), record.ProjectWithoutAutofieldEntry{
+		Name:        archive.Project().Name().Value(),
+		Description: archive.Project().Description().Value(),
+	})
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to insert project: %w", rErr.Unwrap())
+	}
+
+	sErr := s.importContents(userId, projectKey, archive)
+	if sErr != nil {
+		return nil, s.rollbackImport(userId, projectKey, sErr)
+	}
+
+	projectEntry, rErr := s.projectRepository.FinishImportingProject(userId.Value(), projectKey)
+	if rErr != nil {
+		return nil, s.rollbackImport(userId, projectKey,
+			Errorf(RepositoryFailurePanic, "failed to finish importing project: %w", rErr.Unwrap()))
+	}
+
+	return projectService{}.entryToEntity(projectKey, *projectEntry, userId.Value())
+}
+
+// rollbackImport deletes the project, which removes everything inserted under it,
+// so nothing is left half-imported
+func (s projectArchiveService) rollbackImport(
+	userId domain.UserIdObject,
+	projectKey string,
+	sErr *Error,
+) *Error {
+	_, rErr := s.projectRepository.DeleteProject(userId.Value(), projectKey)
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to roll back imported project: %w", rErr.Unwrap())
+	}
+	return sErr
+}
+
+func (s projectArchiveService) importContents(
+	userId domain.UserIdObject,
+	projectKey string,
+	archive domain.ProjectArchiveEntity,
+) *Error {
+	endpoints := make(map[projectArchiveEndpointKey]projectArchiveEndpointKey)
+
+	for i, chapter := range archive.Chapters() {
+		chapterKey, _, rErr := s.chapterRepository.InsertChapter(userId.Value(), projectKey, record.ChapterWithoutAutofieldEntry{
+			Name:   chapter.Name().Value(),
+			Number: i + 1,
+		})
+		if rErr != nil {
+			return Errorf(RepositoryFailurePanic, "failed to insert chapter: %w", rErr.Unwrap())
+		}
+
+		if chapter.Paper().Value() != "" {
+			_, rErr = s.paperRepository.UpdatePaper(userId.Value(), projectKey, chapterKey, record.PaperWithoutAutofieldEntry{
+				Content: chapter.Paper().Value(),
+			}, nil)
+			if rErr != nil {
+				return Errorf(RepositoryFailurePanic, "failed to update paper: %w", rErr.Unwrap())
+			}
+		}
+
+		if len(chapter.Sections()) == 0 {
+			continue
+		}
+
+		graphEntries := make([]record.GraphWithoutAutofieldEntry, len(chapter.Sections()))
+		for j, section := range chapter.Sections() {
+			graphEntries[j] = record.GraphWithoutAutofieldEntry{
+				Name:      section.Name().Value(),
+				Paragraph: section.Paragraph().Value(),
+				Children:  s.childrenEntityToEntry(*section.Children()),
+			}
+		}
+
+		sectionKeys, insertedEntries, rErr := s.graphRepository.InsertGraphs(
+			userId.Value(), projectKey, chapterKey, graphEntries)
+		if rErr != nil {
+			return Errorf(RepositoryFailurePanic, "failed to insert graphs: %w", rErr.Unwrap())
+		}
+
+		for j, section := range chapter.Sections() {
+			from := projectArchiveEndpointKey{chapterId: chapter.Id().Value(), sectionId: section.Id().Value()}
+			to := projectArchiveEndpointKey{chapterId: chapterKey, sectionId: sectionKeys[j]}
+			endpoints[from] = to
+			s.mapNodeIds(from, to, *section.Children(), insertedEntries[j].Children, endpoints)
+		}
+	}
+
+	for _, link := range archive.Links() {
+		_, _, rErr := s.linkRepository.InsertLink(userId.Value(), projectKey, record.LinkWithoutAutofieldEntry{
+			From:        s.endpointEntityToEntry(*link.From(), endpoints),
+			To:          s.endpointEntityToEntry(*link.To(), endpoints),
+			Relation:    link.Relation().Value(),
+			Description: link.Description().Value(),
+		})
+		if rErr != nil {
+			return Errorf(RepositoryFailurePanic, "failed to insert link: %w", rErr.Unwrap())
+		}
+	}
+
+	return nil
+}
+
+// mapNodeIds pairs ids in the archive with the ids assigned on insert, which keep the order of children
+func (s projectArchiveService) mapNodeIds(
+	from projectArchiveEndpointKey,
+	to projectArchiveEndpointKey,
+	children domain.GraphChildrenEntity,
+	entries []record.GraphChildEntry,
+	endpoints map[projectArchiveEndpointKey]projectArchiveEndpointKey,
+) {
+	for i, child := range children.Value() {
+		if child.Id() != nil {
+			endpoints[projectArchiveEndpointKey{chapterId: from.chapterId, sectionId: from.sectionId, nodeId: child.Id().Value()}] =
+				projectArchiveEndpointKey{chapterId: to.chapterId, sectionId: to.sectionId, nodeId: entries[i].Id}
+		}
+		s.mapNodeIds(from, to, *child.Children(), entries[i].Children, endpoints)
+	}
+}
+
+func (s projectArchiveService) collectEndpoints(
+	chapterId string,
+	sectionId string,
+	entries []record.GraphChildEntry,
+	endpoints map[projectArchiveEndpointKey]struct{},
+) {
+	for _, entry := range entries {
+		endpoints[projectArchiveEndpointKey{chapterId: chapterId, sectionId: sectionId, nodeId: entry.Id}] = struct{}{}
+		s.collectEndpoints(chapterId, sectionId, entry.Children, endpoints)
+	}
+}
+
+func (s projectArchiveService) hasEndpoint(
+	entry record.LinkEndpointEntry,
+	endpoints map[projectArchiveEndpointKey]struct{},
+) bool {
+	_, ok := endpoints[projectArchiveEndpointKey{chapterId: entry.ChapterId, sectionId: entry.SectionId, nodeId: entry.NodeId}]
+	return ok
+}
+
+func (s projectArchiveService) endpointEntityToEntry(
+	endpoint domain.LinkEndpointEntity,
+	endpoints map[projectArchiveEndpointKey]projectArchiveEndpointKey,
+) record.LinkEndpointEntry {
+	key := projectArchiveEndpointKey{chapterId: endpoint.ChapterId().Value(), sectionId: endpoint.SectionId().Value()}
+	if endpoint.NodeId() != nil {
+		key.nodeId = endpoint.NodeId().Value()
+	}
+	mapped := endpoints[key]
+	return record.LinkEndpointEntry{ChapterId: mapped.chapterId, SectionId: mapped.sectionId, NodeId: mapped.nodeId}
+}
+
+func (s projectArchiveService) childrenEntityToEntry(entity domain.GraphChildrenEntity) []record.GraphChildEntry {
+	entries := make([]record.GraphChildEntry, entity.Len())
+	for i, child := range entity.Value() {
+		// ids in the archive are replaced with new ones on insert
+		entries[i] = record.GraphChildEntry{
+			Name:        child.Name().Value(),
+			Relation:    child.Relation().Value(),
+			Description: child.Description().Value(),
+			Children:    s.childrenEntityToEntry(*child.Children()),
+		}
+	}
+	return entries
+}
+
+func (s projectArchiveService) projectEntryToEntity(
+	entry record.ProjectEntry,
+) (*domain.ProjectWithoutAutofieldEntity, *Error) {
+	name, err := domain.NewProjectNameObject(entry.Name)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+	}
+	description, err := domain.NewProjectDescriptionObject(entry.Description)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (description): %w", err)
+	}
+
+	return domain.NewProjectWithoutAutofieldEntity(*name, *description), nil
+}
+
+func (s projectArchiveService) chapterEntryToEntity(
+	key string,
+	entry record.ChapterEntry,
+	paperEntry record.PaperEntry,
+	sectionKeys []string,
+	graphEntries []record.GraphEntry,
+) (*domain.ProjectArchiveChapterEntity, *Error) {
+	id, err := domain.NewChapterIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	name, err := domain.NewChapterNameObject(entry.Name)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+	}
+	paper, err := domain.NewPaperContentObject(paperEntry.Content)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (paper): %w", err)
+	}
+
+	sections := make([]domain.ProjectArchiveSectionEntity, len(graphEntries))
+	for i, graphEntry := range graphEntries {
+		section, sErr := s.graphEntryToEntity(sectionKeys[i], graphEntry)
+		if sErr != nil {
+			return nil, sErr
+		}
+		sections[i] = *section
+	}
+
+	chapter, err := domain.NewProjectArchiveChapterEntity(*id, *name, *paper, sections)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sections): %w", err)
+	}
+	return chapter, nil
+}
+
+func (s projectArchiveService) graphEntryToEntity(
+	key string,
+	entry record.GraphEntry,
+) (*domain.ProjectArchiveSectionEntity, *Error) {
+	id, err := domain.NewSectionIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sectionId): %w", err)
+	}
+	name, err := domain.NewSectionNameObject(entry.Name)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sectionName): %w", err)
+	}
+	paragraph, err := domain.NewGraphParagraphObject(entry.Paragraph)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (paragraph): %w", err)
+	}
+	children, sErr := graphService{}.childrenEntryToEntity(entry.Children)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (children): %w", sErr.Unwrap())
+	}
+
+	return domain.NewProjectArchiveSectionEntity(*id, *name, *paragraph, *children), nil
+}
+
+func (s projectArchiveService) linkEntryToEntity(entry record.LinkEntry) (*domain.LinkWithoutAutofieldEntity, *Error) {
+	from, sErr := linkService{}.endpointEntryToEntity(entry.From)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (from): %w", sErr.Unwrap())
+	}
+	to, sErr := linkService{}.endpointEntryToEntity(entry.To)
+	if sErr != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (to): %w", sErr.Unwrap())
+	}
+	relation, err := domain.NewGraphRelationObject(entry.Relation)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (relation): %w", err)
+	}
+	description, err := domain.NewGraphDescriptionObject(entry.Description)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (description): %w", err)
+	}
+
+	link, err := domain.NewLinkWithoutAutofieldEntity(*from, *to, *relation, *description)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (link): %w", err)
+	}
+	return link, nil
+}
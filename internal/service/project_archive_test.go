@@ -0,0 +1,446 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportProjectValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pr := mock_repository.NewMockProjectRepository(ctrl)
+	pr.EXPECT().
+		FetchProject(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(&record.ProjectEntry{
+			Name:        "Project",
+			Description: "Description",
+			UserId:      testutil.ReadOnlyUserId(),
+			CreatedAt:   testutil.Date(),
+			UpdatedAt:   testutil.Date(),
+		}, nil)
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.ChapterEntry{
+			"1000000000000002": {Name: "Chapter 2", Number: 2, Sections: []record.SectionEntry{}},
+			"1000000000000001": {Name: "Chapter 1", Number: 1, Sections: []record.SectionEntry{
+				{Id: "2000000000000001", Name: "Section 1"},
+			}},
+		}, nil)
+
+	ppr := mock_repository.NewMockPaperRepository(ctrl)
+	ppr.EXPECT().
+		FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(&record.PaperEntry{Content: "## Section 1\n"}, nil)
+	ppr.EXPECT().
+		FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000002").
+		Return(&record.PaperEntry{Content: ""}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return([]string{"2000000000000001"}, []record.GraphEntry{
+			{
+				Name:      "Section 1",
+				Paragraph: "paragraph",
+				Children: []record.GraphChildEntry{
+					{Id: "3000000000000001", Name: "Concept", Relation: "part of", Children: []record.GraphChildEntry{}},
+				},
+			},
+		}, nil)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000002").
+		Return([]string{}, []record.GraphEntry{}, nil)
+
+	lr := mock_repository.NewMockLinkRepository(ctrl)
+	lr.EXPECT().
+		FetchLinks(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.LinkEntry{
+			"4000000000000002": {
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				Relation:  "second",
+				CreatedAt: testutil.Date().Add(time.Hour),
+			},
+			"4000000000000001": {
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				Relation:  "first",
+				CreatedAt: testutil.Date(),
+			},
+			"4000000000000003": {
+				From:      record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000009"},
+				To:        record.LinkEndpointEntry{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				Relation:  "dangling",
+				CreatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	s := service.NewProjectArchiveService(pr, cr, ppr, gr, lr)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	archive, sErr := s.ExportProject(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "Project", archive.Project().Name().Value())
+	assert.Equal(t, "Description", archive.Project().Description().Value())
+
+	chapters := archive.Chapters()
+	assert.Len(t, chapters, 2)
+	assert.Equal(t, "1000000000000001", chapters[0].Id().Value())
+	assert.Equal(t, "Chapter 1", chapters[0].Name().Value())
+	assert.Equal(t, "## Section 1\n", chapters[0].Paper().Value())
+	assert.Len(t, chapters[0].Sections(), 1)
+	section := chapters[0].Sections()[0]
+	assert.Equal(t, "2000000000000001", section.Id().Value())
+	assert.Equal(t, "Section 1", section.Name().Value())
+	assert.Equal(t, "paragraph", section.Paragraph().Value())
+	assert.Equal(t, "3000000000000001", section.Children().Value()[0].Id().Value())
+	assert.Equal(t, "1000000000000002", chapters[1].Id().Value())
+	assert.Empty(t, chapters[1].Sections())
+
+	links := archive.Links()
+	assert.Len(t, links, 2)
+	assert.Equal(t, "first", links[0].Relation().Value())
+	assert.Equal(t, "3000000000000001", links[0].From().NodeId().Value())
+	assert.Nil(t, links[0].To().NodeId())
+	assert.Equal(t, "second", links[1].Relation().Value())
+}
+
+func TestExportProjectRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return not found error when project is not found",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to find project: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure when project cannot be fetched",
+			errorCode:     repository.ReadFailurePanic,
+			expectedError: "repository failure: failed to fetch project: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pr := mock_repository.NewMockProjectRepository(ctrl)
+			pr.EXPECT().
+				FetchProject(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewProjectArchiveService(
+				pr,
+				mock_repository.NewMockChapterRepository(ctrl),
+				mock_repository.NewMockPaperRepository(ctrl),
+				mock_repository.NewMockGraphRepository(ctrl),
+				mock_repository.NewMockLinkRepository(ctrl),
+			)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			archive, sErr := s.ExportProject(*userId, *projectId)
+			assert.Nil(t, archive)
+			assert.Equal(t, tc.expectedError, sErr.Error())
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+		})
+	}
+}
+
+func TestImportProjectValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := testutil.ModifyOnlyUserId()
+
+	pr := mock_repository.NewMockProjectRepository(ctrl)
+	pr.EXPECT().
+		InsertImportingProject(userId, record.ProjectWithoutAutofieldEntry{Name: "Project", Description: "Description"}).
+		Return("0000000000000009", &record.ProjectEntry{
+			Name:        "Project",
+			Description: "Description",
+			UserId:      userId,
+			CreatedAt:   testutil.Date(),
+			UpdatedAt:   testutil.Date(),
+		}, nil)
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		InsertChapter(userId, "0000000000000009", record.ChapterWithoutAutofieldEntry{Name: "Chapter 1", Number: 1}).
+		Return("1000000000000009", &record.ChapterEntry{}, nil)
+	cr.EXPECT().
+		InsertChapter(userId, "0000000000000009", record.ChapterWithoutAutofieldEntry{Name: "Chapter 2", Number: 2}).
+		Return("1000000000000010", &record.ChapterEntry{}, nil)
+
+	ppr := mock_repository.NewMockPaperRepository(ctrl)
+	ppr.EXPECT().
+		UpdatePaper(userId, "0000000000000009", "1000000000000009",
+			record.PaperWithoutAutofieldEntry{Content: "## Section 1\n"}, nil).
+		Return(&record.PaperEntry{}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+	gr.EXPECT().
+		InsertGraphs(userId, "0000000000000009", "1000000000000009", []record.GraphWithoutAutofieldEntry{
+			{
+				Name:      "Section 1",
+				Paragraph: "paragraph",
+				Children: []record.GraphChildEntry{
+					{Name: "Concept", Relation: "part of", Description: "", Children: []record.GraphChildEntry{}},
+				},
+			},
+		}).
+		Return([]string{"2000000000000009"}, []record.GraphEntry{
+			{
+				Name:      "Section 1",
+				Paragraph: "paragraph",
+				Children: []record.GraphChildEntry{
+					{Id: "3000000000000009", Name: "Concept", Relation: "part of", Children: []record.GraphChildEntry{}},
+				},
+			},
+		}, nil)
+
+	lr := mock_repository.NewMockLinkRepository(ctrl)
+	insertLink := lr.EXPECT().
+		InsertLink(userId, "0000000000000009", record.LinkWithoutAutofieldEntry{
+			From:     record.LinkEndpointEntry{ChapterId: "1000000000000009", SectionId: "2000000000000009", NodeId: "3000000000000009"},
+			To:       record.LinkEndpointEntry{ChapterId: "1000000000000009", SectionId: "2000000000000009"},
+			Relation: "related to",
+		}).
+		Return("4000000000000009", &record.LinkEntry{}, nil)
+
+	pr.EXPECT().
+		FinishImportingProject(userId, "0000000000000009").
+		After(insertLink).
+		Return(&record.ProjectEntry{
+			Name:        "Project",
+			Description: "Description",
+			UserId:      userId,
+			CreatedAt:   testutil.Date(),
+			UpdatedAt:   testutil.Date(),
+		}, nil)
+
+	s := service.NewProjectArchiveService(pr, cr, ppr, gr, lr)
+
+	userIdObject, err := domain.NewUserIdObject(userId)
+	assert.Nil(t, err)
+
+	project, sErr := s.ImportProject(*userIdObject, *newProjectArchiveEntity(t))
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "0000000000000009", project.Id().Value())
+	assert.Equal(t, "Project", project.Name().Value())
+	assert.Equal(t, "Description", project.Description().Value())
+}
+
+func TestImportProjectRollback(t *testing.T) {
+	tt := []struct {
+		name          string
+		deleteError   *repository.Error
+		expectedError string
+	}{
+		{
+			name:          "should delete inserted project when import fails",
+			deleteError:   nil,
+			expectedError: "repository failure: failed to insert graphs: repository error",
+		},
+		{
+			name:          "should return error when inserted project cannot be deleted",
+			deleteError:   repository.Errorf(repository.WriteFailurePanic, "delete error"),
+			expectedError: "repository failure: failed to roll back imported project: delete error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userId := testutil.ModifyOnlyUserId()
+
+			pr := mock_repository.NewMockProjectRepository(ctrl)
+			pr.EXPECT().
+				InsertImportingProject(userId, gomock.Any()).
+				Return("0000000000000009", &record.ProjectEntry{}, nil)
+			pr.EXPECT().
+				DeleteProject(userId, "0000000000000009").
+				Return(3, tc.deleteError)
+
+			cr := mock_repository.NewMockChapterRepository(ctrl)
+			cr.EXPECT().
+				InsertChapter(userId, "0000000000000009", gomock.Any()).
+				Return("1000000000000009", &record.ChapterEntry{}, nil)
+
+			ppr := mock_repository.NewMockPaperRepository(ctrl)
+			ppr.EXPECT().
+				UpdatePaper(userId, "0000000000000009", "1000000000000009", gomock.Any(), nil).
+				Return(&record.PaperEntry{}, nil)
+
+			gr := mock_repository.NewMockGraphRepository(ctrl)
+			gr.EXPECT().
+				InsertGraphs(userId, "0000000000000009", "1000000000000009", gomock.Any()).
+				Return(nil, nil, repository.Errorf(repository.WriteFailurePanic, "repository error"))
+
+			s := service.NewProjectArchiveService(pr, cr, ppr, gr, mock_repository.NewMockLinkRepository(ctrl))
+
+			userIdObject, err := domain.NewUserIdObject(userId)
+			assert.Nil(t, err)
+
+			project, sErr := s.ImportProject(*userIdObject, *newProjectArchiveEntity(t))
+			assert.Nil(t, project)
+			assert.Equal(t, tc.expectedError, sErr.Error())
+			assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+		})
+	}
+}
+
+func TestImportProjectFinishError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userId := testutil.ModifyOnlyUserId()
+
+	pr := mock_repository.NewMockProjectRepository(ctrl)
+	pr.EXPECT().
+		InsertImportingProject(userId, gomock.Any()).
+		Return("0000000000000009", &record.ProjectEntry{}, nil)
+	pr.EXPECT().
+		FinishImportingProject(userId, "0000000000000009").
+		Return(nil, repository.Errorf(repository.WriteFailurePanic, "repository error"))
+	pr.EXPECT().
+		DeleteProject(userId, "0000000000000009").
+		Return(5, nil)
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		InsertChapter(userId, "0000000000000009", gomock.Any()).
+		Return("1000000000000009", &record.ChapterEntry{}, nil).
+		Times(2)
+
+	ppr := mock_repository.NewMockPaperRepository(ctrl)
+	ppr.EXPECT().
+		UpdatePaper(userId, "0000000000000009", "1000000000000009", gomock.Any(), nil).
+		Return(&record.PaperEntry{}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+	gr.EXPECT().
+		InsertGraphs(userId, "0000000000000009", "1000000000000009", gomock.Any()).
+		Return([]string{"2000000000000009"}, []record.GraphEntry{
+			{
+				Name: "Section 1",
+				Children: []record.GraphChildEntry{
+					{Id: "3000000000000009", Name: "Concept", Relation: "part of", Children: []record.GraphChildEntry{}},
+				},
+			},
+		}, nil)
+
+	lr := mock_repository.NewMockLinkRepository(ctrl)
+	lr.EXPECT().
+		InsertLink(userId, "0000000000000009", gomock.Any()).
+		Return("4000000000000009", &record.LinkEntry{}, nil)
+
+	s := service.NewProjectArchiveService(pr, cr, ppr, gr, lr)
+
+	userIdObject, err := domain.NewUserIdObject(userId)
+	assert.Nil(t, err)
+
+	project, sErr := s.ImportProject(*userIdObject, *newProjectArchiveEntity(t))
+	assert.Nil(t, project)
+	assert.Equal(t, "repository failure: failed to finish importing project: repository error", sErr.Error())
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+}
+
+func newProjectArchiveEntity(t *testing.T) *domain.ProjectArchiveEntity {
+	projectName, err := domain.NewProjectNameObject("Project")
+	assert.Nil(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("Description")
+	assert.Nil(t, err)
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+
+	nodeId, err := domain.NewGraphChildIdObject("3000000000000001")
+	assert.Nil(t, err)
+	nodeName, err := domain.NewGraphNameObject("Concept")
+	assert.Nil(t, err)
+	relation, err := domain.NewGraphRelationObject("part of")
+	assert.Nil(t, err)
+	description, err := domain.NewGraphDescriptionObject("")
+	assert.Nil(t, err)
+	grandchildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{
+		*domain.NewGraphChildEntity(nodeId, *nodeName, *relation, *description, *grandchildren),
+	})
+	assert.Nil(t, err)
+
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	sectionName, err := domain.NewSectionNameObject("Section 1")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("paragraph")
+	assert.Nil(t, err)
+	section := domain.NewProjectArchiveSectionEntity(*sectionId, *sectionName, *paragraph, *children)
+
+	chapterId1, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	chapterName1, err := domain.NewChapterNameObject("Chapter 1")
+	assert.Nil(t, err)
+	paper1, err := domain.NewPaperContentObject("## Section 1\n")
+	assert.Nil(t, err)
+	chapter1, err := domain.NewProjectArchiveChapterEntity(
+		*chapterId1, *chapterName1, *paper1, []domain.ProjectArchiveSectionEntity{*section})
+	assert.Nil(t, err)
+
+	chapterId2, err := domain.NewChapterIdObject("1000000000000002")
+	assert.Nil(t, err)
+	chapterName2, err := domain.NewChapterNameObject("Chapter 2")
+	assert.Nil(t, err)
+	paper2, err := domain.NewPaperContentObject("")
+	assert.Nil(t, err)
+	chapter2, err := domain.NewProjectArchiveChapterEntity(
+		*chapterId2, *chapterName2, *paper2, []domain.ProjectArchiveSectionEntity{})
+	assert.Nil(t, err)
+
+	linkRelation, err := domain.NewGraphRelationObject("related to")
+	assert.Nil(t, err)
+	link, err := domain.NewLinkWithoutAutofieldEntity(
+		*domain.NewLinkEndpointEntity(*chapterId1, *sectionId, nodeId),
+		*domain.NewLinkEndpointEntity(*chapterId1, *sectionId, nil),
+		*linkRelation,
+		*description,
+	)
+	assert.Nil(t, err)
+
+	archive, err := domain.NewProjectArchiveEntity(
+		*project,
+		[]domain.ProjectArchiveChapterEntity{*chapter1, *chapter2},
+		[]domain.LinkWithoutAutofieldEntity{*link},
+	)
+	assert.Nil(t, err)
+	return archive
+}
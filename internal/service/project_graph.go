@@ -0,0 +1,209 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ProjectGraphService interface {
+	FindProjectGraph(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+	) (*domain.ProjectGraphEntity, *Error)
+}
+
+type projectGraphService struct {
+	chapterRepository repository.ChapterRepository
+	graphRepository   repository.GraphRepository
+}
+
+func NewProjectGraphService(
+	chapterRepository repository.ChapterRepository,
+	graphRepository repository.GraphRepository,
+) ProjectGraphService {
+	return projectGraphService{chapterRepository: chapterRepository, graphRepository: graphRepository}
+}
+
+type projectGraphEdgeKey struct {
+	source   string
+	target   string
+	relation string
+}
+
+type projectGraphBuilder struct {
+	nodes       []domain.ProjectGraphNodeEntity
+	nodeIndices map[string]int
+	edges       []domain.ProjectGraphEdgeEntity
+	edgeIndices map[projectGraphEdgeKey]int
+}
+
+func (s projectGraphService) FindProjectGraph(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) (*domain.ProjectGraphEntity, *Error) {
+	chapterEntries, rErr := s.chapterRepository.FetchChapters(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to find project graph: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch chapters: %w", rErr.Unwrap())
+	}
+
+	chapterIds := make([]string, 0, len(chapterEntries))
+	for key := range chapterEntries {
+		chapterIds = append(chapterIds, key)
+	}
+	sort.Slice(chapterIds, func(i, j int) bool {
+		return chapterEntries[chapterIds[i]].Number < chapterEntries[chapterIds[j]].Number
+	})
+
+	builder := &projectGraphBuilder{
+		nodes:       []domain.ProjectGraphNodeEntity{},
+		nodeIndices: make(map[string]int),
+		edges:       []domain.ProjectGraphEdgeEntity{},
+		edgeIndices: make(map[projectGraphEdgeKey]int),
+	}
+
+	for _, chapterKey := range chapterIds {
+		keys, entries, rErr := s.graphRepository.FetchGraphs(userId.Value(), projectId.Value(), chapterKey)
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			return nil, Errorf(NotFoundError, "failed to find project graph: %w", rErr.Unwrap())
+		}
+		if rErr != nil {
+			return nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+		}
+
+		chapterId, err := domain.NewChapterIdObject(chapterKey)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (chapterId): %w", err)
+		}
+
+		for i, entry := range entries {
+			sectionId, err := domain.NewSectionIdObject(keys[i])
+			if err != nil {
+				return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sectionId): %w", err)
+			}
+
+			sErr := s.addGraph(builder, *chapterId, *sectionId, entry)
+			if sErr != nil {
+				return nil, sErr
+			}
+		}
+	}
+
+	return domain.NewProjectGraphEntity(builder.nodes, builder.edges), nil
+}
+
+func (s projectGraphService) addGraph(
+	builder *projectGraphBuilder,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	entry record.GraphEntry,
+) *Error {
+	name, err := domain.NewGraphNameObject(entry.Name)
+	if err != nil {
+		return Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+	}
+
+	occurrence := domain.NewProjectGraphOccurrenceEntity(chapterId, sectionId, nil)
+	s.addNode(builder, *name, *occurrence)
+
+	return s.addChildren(builder, chapterId, sectionId, *name, entry.Children)
+}
+
+func (s projectGraphService) addChildren(
+	builder *projectGraphBuilder,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	parent domain.GraphNameObject,
+	entries []record.GraphChildEntry,
+) *Error {
+	for _, entry := range entries {
+		nodeId, err := domain.NewGraphChildIdObject(entry.Id)
+		if err != nil {
+			return Errorf(DomainFailurePanic, "failed to convert entry to entity (nodeId): %w", err)
+		}
+		name, err := domain.NewGraphNameObject(entry.Name)
+		if err != nil {
+			return Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+		}
+		relation, err := domain.NewGraphRelationObject(entry.Relation)
+		if err != nil {
+			return Errorf(DomainFailurePanic, "failed to convert entry to entity (relation): %w", err)
+		}
+		description, err := domain.NewGraphDescriptionObject(entry.Description)
+		if err != nil {
+			return Errorf(DomainFailurePanic, "failed to convert entry to entity (description): %w", err)
+		}
+
+		occurrence := domain.NewProjectGraphOccurrenceEntity(chapterId, sectionId, nodeId)
+		s.addNode(builder, *name, *occurrence)
+		s.addEdge(builder, parent, *name, *relation, *description, *occurrence)
+
+		sErr := s.addChildren(builder, chapterId, sectionId, *name, entry.Children)
+		if sErr != nil {
+			return sErr
+		}
+	}
+
+	return nil
+}
+
+func (s projectGraphService) addNode(
+	builder *projectGraphBuilder,
+	name domain.GraphNameObject,
+	occurrence domain.ProjectGraphOccurrenceEntity,
+) {
+	index, ok := builder.nodeIndices[name.Value()]
+	if !ok {
+		builder.nodeIndices[name.Value()] = len(builder.nodes)
+		builder.nodes = append(builder.nodes, *domain.NewProjectGraphNodeEntity(
+			name,
+			[]domain.ProjectGraphOccurrenceEntity{occurrence},
+		))
+		return
+	}
+
+	node := builder.nodes[index]
+	builder.nodes[index] = *domain.NewProjectGraphNodeEntity(
+		*node.Name(),
+		append(node.Occurrences(), occurrence),
+	)
+}
+
+func (s projectGraphService) addEdge(
+	builder *projectGraphBuilder,
+	source domain.GraphNameObject,
+	target domain.GraphNameObject,
+	relation domain.GraphRelationObject,
+	description domain.GraphDescriptionObject,
+	occurrence domain.ProjectGraphOccurrenceEntity,
+) {
+	key := projectGraphEdgeKey{source: source.Value(), target: target.Value(), relation: relation.Value()}
+	index, ok := builder.edgeIndices[key]
+	if !ok {
+		builder.edgeIndices[key] = len(builder.edges)
+		builder.edges = append(builder.edges, *domain.NewProjectGraphEdgeEntity(
+			source,
+			target,
+			relation,
+			description,
+			[]domain.ProjectGraphOccurrenceEntity{occurrence},
+		))
+		return
+	}
+
+	edge := builder.edges[index]
+	builder.edges[index] = *domain.NewProjectGraphEdgeEntity(
+		*edge.Source(),
+		*edge.Target(),
+		*edge.Relation(),
+		*edge.Description(),
+		append(edge.Occurrences(), occurrence),
+	)
+}
@@ -0,0 +1,508 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFindProjectGraphValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.ChapterEntry{
+			"1000000000000002": {
+				Name:   "Chapter 2",
+				Number: 2,
+				Sections: []record.SectionEntry{
+					{Id: "2000000000000003", Name: "Section 3"},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			"1000000000000001": {
+				Name:   "Chapter 1",
+				Number: 1,
+				Sections: []record.SectionEntry{
+					{Id: "2000000000000001", Name: "Section 1"},
+					{Id: "2000000000000002", Name: "Section 2"},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return([]string{"2000000000000001", "2000000000000002"}, []record.GraphEntry{
+			{
+				Name:      "Section 1",
+				Paragraph: "paragraph",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "3000000000000001",
+						Name:        "Concept",
+						Relation:    "part of",
+						Description: "first description",
+						Children: []record.GraphChildEntry{
+							{
+								Id:          "3000000000000002",
+								Name:        "Detail",
+								Relation:    "example of",
+								Description: "",
+								Children:    []record.GraphChildEntry{},
+							},
+						},
+					},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			{
+				Name:      "Section 2",
+				Paragraph: "paragraph",
+				Children:  []record.GraphChildEntry{},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000002").
+		Return([]string{"2000000000000003"}, []record.GraphEntry{
+			{
+				Name:      "Section 3",
+				Paragraph: "paragraph",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "3000000000000003",
+						Name:        "Concept",
+						Relation:    "part of",
+						Description: "second description",
+						Children:    []record.GraphChildEntry{},
+					},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	s := service.NewProjectGraphService(cr, gr)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.FindProjectGraph(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	nodes := graph.Nodes()
+	assert.Len(t, nodes, 5)
+
+	assert.Equal(t, "Section 1", nodes[0].Name().Value())
+	assert.Len(t, nodes[0].Occurrences(), 1)
+	assert.Equal(t, "1000000000000001", nodes[0].Occurrences()[0].ChapterId().Value())
+	assert.Equal(t, "2000000000000001", nodes[0].Occurrences()[0].SectionId().Value())
+	assert.Nil(t, nodes[0].Occurrences()[0].NodeId())
+
+	assert.Equal(t, "Concept", nodes[1].Name().Value())
+	assert.Len(t, nodes[1].Occurrences(), 2)
+	assert.Equal(t, "1000000000000001", nodes[1].Occurrences()[0].ChapterId().Value())
+	assert.Equal(t, "2000000000000001", nodes[1].Occurrences()[0].SectionId().Value())
+	assert.Equal(t, "3000000000000001", nodes[1].Occurrences()[0].NodeId().Value())
+	assert.Equal(t, "1000000000000002", nodes[1].Occurrences()[1].ChapterId().Value())
+	assert.Equal(t, "2000000000000003", nodes[1].Occurrences()[1].SectionId().Value())
+	assert.Equal(t, "3000000000000003", nodes[1].Occurrences()[1].NodeId().Value())
+
+	assert.Equal(t, "Detail", nodes[2].Name().Value())
+	assert.Len(t, nodes[2].Occurrences(), 1)
+	assert.Equal(t, "3000000000000002", nodes[2].Occurrences()[0].NodeId().Value())
+
+	assert.Equal(t, "Section 2", nodes[3].Name().Value())
+	assert.Len(t, nodes[3].Occurrences(), 1)
+	assert.Equal(t, "2000000000000002", nodes[3].Occurrences()[0].SectionId().Value())
+
+	assert.Equal(t, "Section 3", nodes[4].Name().Value())
+	assert.Len(t, nodes[4].Occurrences(), 1)
+	assert.Equal(t, "1000000000000002", nodes[4].Occurrences()[0].ChapterId().Value())
+
+	edges := graph.Edges()
+	assert.Len(t, edges, 3)
+
+	assert.Equal(t, "Section 1", edges[0].Source().Value())
+	assert.Equal(t, "Concept", edges[0].Target().Value())
+	assert.Equal(t, "part of", edges[0].Relation().Value())
+	assert.Equal(t, "first description", edges[0].Description().Value())
+	assert.Len(t, edges[0].Occurrences(), 1)
+	assert.Equal(t, "3000000000000001", edges[0].Occurrences()[0].NodeId().Value())
+
+	assert.Equal(t, "Concept", edges[1].Source().Value())
+	assert.Equal(t, "Detail", edges[1].Target().Value())
+	assert.Equal(t, "example of", edges[1].Relation().Value())
+	assert.Equal(t, "", edges[1].Description().Value())
+	assert.Len(t, edges[1].Occurrences(), 1)
+	assert.Equal(t, "3000000000000002", edges[1].Occurrences()[0].NodeId().Value())
+
+	assert.Equal(t, "Section 3", edges[2].Source().Value())
+	assert.Equal(t, "Concept", edges[2].Target().Value())
+	assert.Equal(t, "part of", edges[2].Relation().Value())
+	assert.Equal(t, "second description", edges[2].Description().Value())
+	assert.Len(t, edges[2].Occurrences(), 1)
+	assert.Equal(t, "3000000000000003", edges[2].Occurrences()[0].NodeId().Value())
+}
+
+func TestFindProjectGraphMergedEdges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.ChapterEntry{
+			"1000000000000001": {
+				Name:   "Chapter 1",
+				Number: 1,
+				Sections: []record.SectionEntry{
+					{Id: "2000000000000001", Name: "Section"},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+			"1000000000000002": {
+				Name:   "Chapter 2",
+				Number: 2,
+				Sections: []record.SectionEntry{
+					{Id: "2000000000000002", Name: "Section"},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return([]string{"2000000000000001"}, []record.GraphEntry{
+			{
+				Name: "Section",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "3000000000000001",
+						Name:        "Concept",
+						Relation:    "part of",
+						Description: "first description",
+						Children:    []record.GraphChildEntry{},
+					},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+	gr.EXPECT().
+		FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000002").
+		Return([]string{"2000000000000002"}, []record.GraphEntry{
+			{
+				Name: "Section",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "3000000000000002",
+						Name:        "Concept",
+						Relation:    "part of",
+						Description: "second description",
+						Children:    []record.GraphChildEntry{},
+					},
+					{
+						Id:          "3000000000000003",
+						Name:        "Concept",
+						Relation:    "related to",
+						Description: "",
+						Children:    []record.GraphChildEntry{},
+					},
+				},
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+				UpdatedAt: testutil.Date(),
+			},
+		}, nil)
+
+	s := service.NewProjectGraphService(cr, gr)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.FindProjectGraph(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	nodes := graph.Nodes()
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "Section", nodes[0].Name().Value())
+	assert.Len(t, nodes[0].Occurrences(), 2)
+	assert.Equal(t, "Concept", nodes[1].Name().Value())
+	assert.Len(t, nodes[1].Occurrences(), 3)
+
+	edges := graph.Edges()
+	assert.Len(t, edges, 2)
+
+	assert.Equal(t, "Section", edges[0].Source().Value())
+	assert.Equal(t, "Concept", edges[0].Target().Value())
+	assert.Equal(t, "part of", edges[0].Relation().Value())
+	assert.Equal(t, "first description", edges[0].Description().Value())
+	assert.Len(t, edges[0].Occurrences(), 2)
+	assert.Equal(t, "1000000000000001", edges[0].Occurrences()[0].ChapterId().Value())
+	assert.Equal(t, "3000000000000001", edges[0].Occurrences()[0].NodeId().Value())
+	assert.Equal(t, "1000000000000002", edges[0].Occurrences()[1].ChapterId().Value())
+	assert.Equal(t, "3000000000000002", edges[0].Occurrences()[1].NodeId().Value())
+
+	assert.Equal(t, "Section", edges[1].Source().Value())
+	assert.Equal(t, "Concept", edges[1].Target().Value())
+	assert.Equal(t, "related to", edges[1].Relation().Value())
+	assert.Len(t, edges[1].Occurrences(), 1)
+}
+
+func TestFindProjectGraphNoEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cr := mock_repository.NewMockChapterRepository(ctrl)
+	cr.EXPECT().
+		FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(map[string]record.ChapterEntry{}, nil)
+
+	gr := mock_repository.NewMockGraphRepository(ctrl)
+
+	s := service.NewProjectGraphService(cr, gr)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.FindProjectGraph(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, graph.Nodes(), 0)
+	assert.Len(t, graph.Edges(), 0)
+}
+
+func TestFindProjectGraphInvalidEntry(t *testing.T) {
+	tooLongName := testutil.RandomString(101)
+
+	tt := []struct {
+		name          string
+		graph         record.GraphEntry
+		expectedError string
+	}{
+		{
+			name: "should return error when graph name is empty",
+			graph: record.GraphEntry{
+				Name:     "",
+				Children: []record.GraphChildEntry{},
+			},
+			expectedError: "failed to convert entry to entity (name): graph name is required, but got ''",
+		},
+		{
+			name: "should return error when child id is empty",
+			graph: record.GraphEntry{
+				Name: "Section",
+				Children: []record.GraphChildEntry{
+					{Id: "", Name: "Child", Relation: "part of", Children: []record.GraphChildEntry{}},
+				},
+			},
+			expectedError: "failed to convert entry to entity (nodeId): graph child id is required, but got ''",
+		},
+		{
+			name: "should return error when child name is too long",
+			graph: record.GraphEntry{
+				Name: "Section",
+				Children: []record.GraphChildEntry{
+					{Id: "3000000000000001", Name: tooLongName, Relation: "part of", Children: []record.GraphChildEntry{}},
+				},
+			},
+			expectedError: fmt.Sprintf("failed to convert entry to entity (name): "+
+				"graph name cannot be longer than 100 characters, but got '%v'", tooLongName),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cr := mock_repository.NewMockChapterRepository(ctrl)
+			cr.EXPECT().
+				FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(map[string]record.ChapterEntry{
+					"1000000000000001": {
+						Name:      "Chapter 1",
+						Number:    1,
+						Sections:  []record.SectionEntry{{Id: "2000000000000001", Name: "Section"}},
+						UserId:    testutil.ReadOnlyUserId(),
+						CreatedAt: testutil.Date(),
+						UpdatedAt: testutil.Date(),
+					},
+				}, nil)
+
+			gr := mock_repository.NewMockGraphRepository(ctrl)
+			gr.EXPECT().
+				FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return([]string{"2000000000000001"}, []record.GraphEntry{tc.graph}, nil)
+
+			s := service.NewProjectGraphService(cr, gr)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			graph, sErr := s.FindProjectGraph(*userId, *projectId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("domain failure: %v", tc.expectedError), sErr.Error())
+			assert.Nil(t, graph)
+		})
+	}
+}
+
+func TestFindProjectGraphChapterRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "failed to find project graph: failed to fetch project",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch chapters: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cr := mock_repository.NewMockChapterRepository(ctrl)
+			cr.EXPECT().
+				FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			gr := mock_repository.NewMockGraphRepository(ctrl)
+
+			s := service.NewProjectGraphService(cr, gr)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			graph, sErr := s.FindProjectGraph(*userId, *projectId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, graph)
+		})
+	}
+}
+
+func TestFindProjectGraphGraphRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch chapter",
+			expectedError: "failed to find project graph: failed to fetch chapter",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "failed to fetch graphs: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cr := mock_repository.NewMockChapterRepository(ctrl)
+			cr.EXPECT().
+				FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(map[string]record.ChapterEntry{
+					"1000000000000001": {
+						Name:      "Chapter 1",
+						Number:    1,
+						Sections:  []record.SectionEntry{},
+						UserId:    testutil.ReadOnlyUserId(),
+						CreatedAt: testutil.Date(),
+						UpdatedAt: testutil.Date(),
+					},
+				}, nil)
+
+			gr := mock_repository.NewMockGraphRepository(ctrl)
+			gr.EXPECT().
+				FetchGraphs(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+				Return(nil, nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewProjectGraphService(cr, gr)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.Nil(t, err)
+
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			graph, sErr := s.FindProjectGraph(*userId, *projectId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, fmt.Sprintf("%v: %v", tc.expectedCode, tc.expectedError), sErr.Error())
+			assert.Nil(t, graph)
+		})
+	}
+}
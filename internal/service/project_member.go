@@ -0,0 +1,124 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ProjectMemberService interface {
+	ListProjectMembers(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+	) ([]domain.ProjectMemberEntity, *Error)
+	InviteProjectMember(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		memberId domain.UserIdObject,
+		role domain.ProjectRoleObject,
+	) (*domain.ProjectMemberEntity, *Error)
+	RemoveProjectMember(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		memberId domain.UserIdObject,
+	) *Error
+}
+
+type projectMemberService struct {
+	repository repository.ProjectRepository
+}
+
+func NewProjectMemberService(repository repository.ProjectRepository) ProjectMemberService {
+	return projectMemberService{repository: repository}
+}
+
+func (s projectMemberService) ListProjectMembers(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) ([]domain.ProjectMemberEntity, *Error) {
+	entry, rErr := s.repository.FetchProject(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list project members: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch project: %w", rErr.Unwrap())
+	}
+
+	owner, sErr := s.entryToEntity(entry.UserId, repository.ProjectRoleOwner)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	members := []domain.ProjectMemberEntity{}
+	for memberId, role := range entry.Members {
+		member, sErr := s.entryToEntity(memberId, role)
+		if sErr != nil {
+			return nil, sErr
+		}
+
+		members = append(members, *member)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserId().Value() < members[j].UserId().Value()
+	})
+
+	return append([]domain.ProjectMemberEntity{*owner}, members...), nil
+}
+
+func (s projectMemberService) InviteProjectMember(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	memberId domain.UserIdObject,
+	role domain.ProjectRoleObject,
+) (*domain.ProjectMemberEntity, *Error) {
+	entry, rErr := s.repository.UpdateProjectMember(
+		userId.Value(),
+		projectId.Value(),
+		memberId.Value(),
+		role.Value(),
+	)
+	if rErr != nil && rErr.Code() == repository.InvalidArgumentError {
+		return nil, Errorf(InvalidArgumentError, "failed to invite project member: %w", rErr.Unwrap())
+	}
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to invite project member: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to update project member: %w", rErr.Unwrap())
+	}
+
+	return s.entryToEntity(memberId.Value(), entry.Members[memberId.Value()])
+}
+
+func (s projectMemberService) RemoveProjectMember(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	memberId domain.UserIdObject,
+) *Error {
+	_, rErr := s.repository.DeleteProjectMember(userId.Value(), projectId.Value(), memberId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return Errorf(NotFoundError, "failed to remove project member: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete project member: %w", rErr.Unwrap())
+	}
+
+	return nil
+}
+
+func (s projectMemberService) entryToEntity(memberId string, role string) (*domain.ProjectMemberEntity, *Error) {
+	userId, err := domain.NewUserIdObject(memberId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (userId): %w", err)
+	}
+	roleObject, err := domain.NewProjectRoleObject(role)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (role): %w", err)
+	}
+
+	return domain.NewProjectMemberEntity(*userId, *roleObject), nil
+}
@@ -0,0 +1,277 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListProjectMembersValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockProjectRepository(ctrl)
+	r.EXPECT().
+		FetchProject(testutil.ReadOnlyUserId(), "0000000000000001").
+		Return(&record.ProjectEntry{
+			Name:   "Shared Project",
+			UserId: testutil.ModifyOnlyUserId(),
+			Members: map[string]string{
+				testutil.ReadOnlyUserId(): "viewer",
+				testutil.ErrorUserId(0):   "editor",
+			},
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewProjectMemberService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+
+	members, sErr := s.ListProjectMembers(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, members, 3)
+
+	assert.Equal(t, testutil.ModifyOnlyUserId(), members[0].UserId().Value())
+	assert.Equal(t, "owner", members[0].Role().Value())
+
+	expected := map[string]string{
+		testutil.ReadOnlyUserId(): "viewer",
+		testutil.ErrorUserId(0):   "editor",
+	}
+	assert.Less(t, members[1].UserId().Value(), members[2].UserId().Value())
+	for _, member := range members[1:] {
+		assert.Equal(t, expected[member.UserId().Value()], member.Role().Value())
+	}
+}
+
+func TestListProjectMembersRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "not found: failed to list project members: failed to fetch project",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns read failure error",
+			errorCode:     repository.ReadFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "repository failure: failed to fetch project: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockProjectRepository(ctrl)
+			r.EXPECT().
+				FetchProject(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewProjectMemberService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.NoError(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.NoError(t, err)
+
+			members, sErr := s.ListProjectMembers(*userId, *projectId)
+			assert.Nil(t, members)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestInviteProjectMemberValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockProjectRepository(ctrl)
+	r.EXPECT().
+		UpdateProjectMember(testutil.ModifyOnlyUserId(), "0000000000000001", testutil.ReadOnlyUserId(), "editor").
+		Return(&record.ProjectEntry{
+			Name:      "Shared Project",
+			UserId:    testutil.ModifyOnlyUserId(),
+			Members:   map[string]string{testutil.ReadOnlyUserId(): "editor"},
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewProjectMemberService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	memberId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	role, err := domain.NewProjectMemberRoleObject("editor")
+	assert.NoError(t, err)
+
+	member, sErr := s.InviteProjectMember(*userId, *projectId, *memberId, *role)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, testutil.ReadOnlyUserId(), member.UserId().Value())
+	assert.Equal(t, "editor", member.Role().Value())
+}
+
+func TestInviteProjectMemberRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns invalid argument error",
+			errorCode:     repository.InvalidArgumentError,
+			errorMessage:  "project owner cannot be a member",
+			expectedError: "invalid argument: failed to invite project member: project owner cannot be a member",
+			expectedCode:  service.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "not found: failed to invite project member: failed to fetch project",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "repository failure: failed to update project member: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockProjectRepository(ctrl)
+			r.EXPECT().
+				UpdateProjectMember(testutil.ModifyOnlyUserId(), "0000000000000001", testutil.ReadOnlyUserId(), "viewer").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewProjectMemberService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.NoError(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.NoError(t, err)
+			memberId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.NoError(t, err)
+			role, err := domain.NewProjectMemberRoleObject("viewer")
+			assert.NoError(t, err)
+
+			member, sErr := s.InviteProjectMember(*userId, *projectId, *memberId, *role)
+			assert.Nil(t, member)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestRemoveProjectMemberValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockProjectRepository(ctrl)
+	r.EXPECT().
+		DeleteProjectMember(testutil.ReadOnlyUserId(), "0000000000000001", testutil.ReadOnlyUserId()).
+		Return(&record.ProjectEntry{
+			Name:      "Shared Project",
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewProjectMemberService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+
+	sErr := s.RemoveProjectMember(*userId, *projectId, *userId)
+	assert.Nil(t, sErr)
+}
+
+func TestRemoveProjectMemberRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch project member",
+			expectedError: "not found: failed to remove project member: failed to fetch project member",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "repository failure: failed to delete project member: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockProjectRepository(ctrl)
+			r.EXPECT().
+				DeleteProjectMember(testutil.ModifyOnlyUserId(), "0000000000000001", testutil.ReadOnlyUserId()).
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewProjectMemberService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.NoError(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.NoError(t, err)
+			memberId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.NoError(t, err)
+
+			sErr := s.RemoveProjectMember(*userId, *projectId, *memberId)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
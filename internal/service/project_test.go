@@ -35,7 +35,8 @@ func TestListProjectsValidEntry(t *testing.T) {
 			},
 			"0000000000000002": {
 				Name:      "Second Project",
-				UserId:    testutil.ReadOnlyUserId(),
+				UserId:    testutil.ModifyOnlyUserId(),
+				Members:   map[string]string{testutil.ReadOnlyUserId(): "viewer"},
 				CreatedAt: testutil.Date().Add(-2 * time.Hour),
 				UpdatedAt: testutil.Date().Add(-2 * time.Hour),
 			},
@@ -62,6 +63,7 @@ func TestListProjectsValidEntry(t *testing.T) {
 	assert.Equal(t, "0000000000000001", project.Id().Value())
 	assert.Equal(t, "First Project", project.Name().Value())
 	assert.Equal(t, "This is my first project", project.Description().Value())
+	assert.Equal(t, "owner", project.Role().Value())
 	assert.Equal(t, testutil.Date().Add(-1*time.Hour), project.CreatedAt().Value())
 	assert.Equal(t, testutil.Date().Add(-1*time.Hour), project.UpdatedAt().Value())
 
@@ -69,6 +71,7 @@ func TestListProjectsValidEntry(t *testing.T) {
 	assert.Equal(t, "0000000000000002", project.Id().Value())
 	assert.Equal(t, "Second Project", project.Name().Value())
 	assert.Equal(t, "", project.Description().Value())
+	assert.Equal(t, "viewer", project.Role().Value())
 	assert.Equal(t, testutil.Date().Add(-2*time.Hour), project.CreatedAt().Value())
 	assert.Equal(t, testutil.Date().Add(-2*time.Hour), project.UpdatedAt().Value())
 
@@ -622,10 +625,11 @@ func TestUpdateProjectValidEntry(t *testing.T) {
 
 			r := mock_repository.NewMockProjectRepository(ctrl)
 			r.EXPECT().
-				UpdateProject(testutil.ModifyOnlyUserId(), tc.projectId, tc.project).
+				UpdateProject(testutil.ModifyOnlyUserId(), tc.projectId, tc.project, nil).
 				Return(&record.ProjectEntry{
 					Name:        tc.project.Name,
 					Description: tc.project.Description,
+					UserId:      testutil.ModifyOnlyUserId(),
 					CreatedAt:   testutil.Date(),
 					UpdatedAt:   testutil.Date(),
 				}, nil)
@@ -645,7 +649,7 @@ func TestUpdateProjectValidEntry(t *testing.T) {
 
 			project := domain.NewProjectWithoutAutofieldEntity(*name, *description)
 
-			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project)
+			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project, nil)
 			assert.Nil(t, sErr)
 
 			assert.Equal(t, tc.projectId, updatedProject.Id().Value())
@@ -723,6 +727,7 @@ func TestUpdateProjectInvalidUpdatedEntry(t *testing.T) {
 						Name:        "Updated Project",
 						Description: "This is updated project",
 					},
+					nil,
 				).
 				Return(&tc.updatedProject, nil)
 
@@ -741,7 +746,7 @@ func TestUpdateProjectInvalidUpdatedEntry(t *testing.T) {
 
 			project := domain.NewProjectWithoutAutofieldEntity(*name, *description)
 
-			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project)
+			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
 			assert.Equal(t, fmt.Sprintf("domain failure: %v", tc.expectedError), sErr.Error())
@@ -788,6 +793,7 @@ func TestUpdateProjectRepositoryError(t *testing.T) {
 						Name:        "Updated Project",
 						Description: "This is updated project",
 					},
+					nil,
 				).
 				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
@@ -806,7 +812,7 @@ func TestUpdateProjectRepositoryError(t *testing.T) {
 
 			project := domain.NewProjectWithoutAutofieldEntity(*name, *description)
 
-			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project)
+			updatedProject, sErr := s.UpdateProject(*userId, *projectId, *project, nil)
 			assert.NotNil(t, sErr)
 			assert.Equal(t, tc.expectedCode, sErr.Code())
 			assert.Equal(t, tc.expectedError, sErr.Error())
@@ -815,13 +821,65 @@ func TestUpdateProjectRepositoryError(t *testing.T) {
 	}
 }
 
+func TestUpdateProjectConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockProjectRepository(ctrl)
+	r.EXPECT().
+		UpdateProject(
+			testutil.ModifyOnlyUserId(),
+			"0000000000000001",
+			record.ProjectWithoutAutofieldEntry{
+				Name:        "Updated Project",
+				Description: "This is updated project",
+			},
+			gomock.Any(),
+		).
+		Do(func(userId string, projectId string, entry record.ProjectWithoutAutofieldEntry, expectedUpdatedAt *time.Time) {
+			assert.Equal(t, testutil.Date(), *expectedUpdatedAt)
+		}).
+		Return(&record.ProjectEntry{
+			Name:        "Project",
+			Description: "This is project",
+			UserId:      testutil.ModifyOnlyUserId(),
+			CreatedAt:   testutil.Date(),
+			UpdatedAt:   testutil.Date().Add(time.Hour),
+		}, repository.Errorf(repository.ConflictError, "project has been updated since it was fetched"))
+
+	s := service.NewProjectService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	name, err := domain.NewProjectNameObject("Updated Project")
+	assert.NoError(t, err)
+	description, err := domain.NewProjectDescriptionObject("This is updated project")
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	project := domain.NewProjectWithoutAutofieldEntity(*name, *description)
+
+	currentProject, sErr := s.UpdateProject(*userId, *projectId, *project, updatedAt)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.ConflictError, sErr.Code())
+	assert.Equal(t, "conflict: failed to update project: project has been updated since it was fetched", sErr.Error())
+
+	assert.Equal(t, "0000000000000001", currentProject.Id().Value())
+	assert.Equal(t, "Project", currentProject.Name().Value())
+	assert.Equal(t, "This is project", currentProject.Description().Value())
+	assert.Equal(t, testutil.Date().Add(time.Hour), currentProject.UpdatedAt().Value())
+}
+
 func TestDeleteProjectValidEntry(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	r := mock_repository.NewMockProjectRepository(ctrl)
 	r.EXPECT().
-		DeleteProject(testutil.ModifyOnlyUserId(), "0000000000000001").
+		TrashProject(testutil.ModifyOnlyUserId(), "0000000000000001").
 		Return(nil)
 
 	s := service.NewProjectService(r)
@@ -847,8 +905,8 @@ func TestDeleteProjectRepositoryError(t *testing.T) {
 		{
 			name:          "should return error when repository returns not found error",
 			errorCode:     repository.NotFoundError,
-			errorMessage:  "failed to delete project",
-			expectedError: "not found: failed to delete project: failed to delete project",
+			errorMessage:  "failed to fetch project",
+			expectedError: "not found: failed to delete project: failed to fetch project",
 			expectedCode:  service.NotFoundError,
 		},
 		{
@@ -874,7 +932,7 @@ func TestDeleteProjectRepositoryError(t *testing.T) {
 
 			r := mock_repository.NewMockProjectRepository(ctrl)
 			r.EXPECT().
-				DeleteProject(testutil.ModifyOnlyUserId(), "0000000000000001").
+				TrashProject(testutil.ModifyOnlyUserId(), "0000000000000001").
 				Return(repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
 			s := service.NewProjectService(r)
@@ -892,3 +950,117 @@ func TestDeleteProjectRepositoryError(t *testing.T) {
 		})
 	}
 }
+
+func TestResumeDeletingProjectsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockProjectRepository(ctrl)
+	r.EXPECT().
+		FetchDeletingProjects().
+		Return(map[string]record.ProjectEntry{
+			"0000000000000001": {
+				Name:   "Project Name",
+				UserId: testutil.ModifyOnlyUserId(),
+			},
+			"0000000000000002": {
+				Name:   "Project Name",
+				UserId: testutil.ReadOnlyUserId(),
+			},
+		}, nil)
+	r.EXPECT().
+		DeleteProject(testutil.ModifyOnlyUserId(), "0000000000000001").
+		Return(3, nil)
+	r.EXPECT().
+		DeleteProject(testutil.ReadOnlyUserId(), "0000000000000002").
+		Return(0, repository.Errorf(repository.NotFoundError, "failed to delete project"))
+	r.EXPECT().
+		FetchImportingProjects(gomock.Any()).
+		DoAndReturn(func(createdBefore time.Time) (map[string]record.ProjectEntry, *repository.Error) {
+			assert.WithinDuration(t, time.Now().Add(-service.ImportingProjectTimeout), createdBefore, time.Minute)
+			return map[string]record.ProjectEntry{
+				"0000000000000003": {
+					Name:   "Imported Project",
+					UserId: testutil.ModifyOnlyUserId(),
+				},
+			}, nil
+		})
+	r.EXPECT().
+		DeleteProject(testutil.ModifyOnlyUserId(), "0000000000000003").
+		Return(4, nil)
+
+	s := service.NewProjectService(r)
+
+	count, sErr := s.ResumeDeletingProjects()
+	assert.Nil(t, sErr)
+	assert.Equal(t, 7, count)
+}
+
+func TestResumeDeletingProjectsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		fetchErr          *repository.Error
+		fetchImportingErr *repository.Error
+		deleteErr         *repository.Error
+		expectedError     string
+	}{
+		{
+			name:          "should return error when repository returns error on fetch",
+			fetchErr:      repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to fetch deleting projects: repository error",
+		},
+		{
+			name:              "should return error when repository returns error on fetch of importing projects",
+			fetchImportingErr: repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError:     "repository failure: failed to fetch importing projects: repository error",
+		},
+		{
+			name:          "should return error when repository returns error on delete",
+			deleteErr:     repository.Errorf(repository.WriteFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to delete project: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockProjectRepository(ctrl)
+			if tc.fetchErr != nil {
+				r.EXPECT().
+					FetchDeletingProjects().
+					Return(nil, tc.fetchErr)
+			} else if tc.fetchImportingErr != nil {
+				r.EXPECT().
+					FetchDeletingProjects().
+					Return(map[string]record.ProjectEntry{}, nil)
+				r.EXPECT().
+					FetchImportingProjects(gomock.Any()).
+					Return(nil, tc.fetchImportingErr)
+			} else {
+				r.EXPECT().
+					FetchDeletingProjects().
+					Return(map[string]record.ProjectEntry{
+						"0000000000000001": {
+							Name:   "Project Name",
+							UserId: testutil.ModifyOnlyUserId(),
+						},
+					}, nil)
+				r.EXPECT().
+					FetchImportingProjects(gomock.Any()).
+					Return(map[string]record.ProjectEntry{}, nil)
+				r.EXPECT().
+					DeleteProject(testutil.ModifyOnlyUserId(), "0000000000000001").
+					Return(2, tc.deleteErr)
+			}
+
+			s := service.NewProjectService(r)
+
+			_, sErr := s.ResumeDeletingProjects()
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
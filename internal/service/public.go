@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+// PublicService serves the read-only view of shared projects and chapters.
+// Data is read on behalf of the user who created the share, whose id is never
+// returned to the caller.
+type PublicService interface {
+	FindSharedProject(
+		token domain.ShareTokenObject,
+	) (*domain.ProjectEntity, []domain.ChapterEntity, *Error)
+	FindSharedPaper(
+		token domain.ShareTokenObject,
+		chapterId domain.ChapterIdObject,
+	) (*domain.PaperEntity, *Error)
+	FindSharedGraph(
+		token domain.ShareTokenObject,
+		chapterId domain.ChapterIdObject,
+		sectionId domain.SectionIdObject,
+	) (*domain.GraphEntity, *Error)
+}
+
+type publicService struct {
+	repository     repository.ShareRepository
+	projectService ProjectService
+	chapterService ChapterService
+	paperService   PaperService
+	graphService   GraphService
+}
+
+func NewPublicService(
+	shareRepository repository.ShareRepository,
+	projectRepository repository.ProjectRepository,
+	chapterRepository repository.ChapterRepository,
+	paperRepository repository.PaperRepository,
+	graphRepository repository.GraphRepository,
+) PublicService {
+	return publicService{
+		repository:     shareRepository,
+		projectService: NewProjectService(projectRepository),
+		chapterService: NewChapterService(chapterRepository),
+		paperService:   NewPaperService(paperRepository, DefaultRevisionRetention),
+		graphService:   NewGraphService(graphRepository, DefaultRevisionRetention),
+	}
+}
+
+type sharedScope struct {
+	userId    domain.UserIdObject
+	projectId domain.ProjectIdObject
+	chapterId *domain.ChapterIdObject
+}
+
+func (s publicService) FindSharedProject(
+	token domain.ShareTokenObject,
+) (*domain.ProjectEntity, []domain.ChapterEntity, *Error) {
+	scope, sErr := s.scope(token)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+
+	project, sErr := s.projectService.FindProject(scope.userId, scope.projectId)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+
+	chapters, sErr := s.chapterService.ListChapters(scope.userId, scope.projectId)
+	if sErr != nil {
+		return nil, nil, sErr
+	}
+
+	if scope.chapterId == nil {
+		return project, chapters, nil
+	}
+
+	for _, chapter := range chapters {
+		if chapter.Id().Value() == scope.chapterId.Value() {
+			return project, []domain.ChapterEntity{chapter}, nil
+		}
+	}
+
+	err := errors.New("shared chapter does not exist")
+	return nil, nil, Errorf(NotFoundError, "failed to find shared project: %w", err)
+}
+
+func (s publicService) FindSharedPaper(
+	token domain.ShareTokenObject,
+	chapterId domain.ChapterIdObject,
+) (*domain.PaperEntity, *Error) {
+	scope, sErr := s.chapterScope(token, chapterId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	return s.paperService.FindPaper(scope.userId, scope.projectId, chapterId)
+}
+
+func (s publicService) FindSharedGraph(
+	token domain.ShareTokenObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+) (*domain.GraphEntity, *Error) {
+	scope, sErr := s.chapterScope(token, chapterId)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	return s.graphService.FindGraph(scope.userId, scope.projectId, chapterId, sectionId)
+}
+
+func (s publicService) scope(token domain.ShareTokenObject) (*sharedScope, *Error) {
+	entry, rErr := s.repository.FetchShare(token.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to find share: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch share: %w", rErr.Unwrap())
+	}
+
+	if entry.ExpiresAt != nil && !entry.ExpiresAt.After(time.Now()) {
+		err := errors.New("share has expired")
+		return nil, Errorf(NotFoundError, "failed to find share: %w", err)
+	}
+
+	userId, err := domain.NewUserIdObject(entry.UserId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (userId): %w", err)
+	}
+	projectId, err := domain.NewProjectIdObject(entry.ProjectId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (projectId): %w", err)
+	}
+
+	var chapterId *domain.ChapterIdObject
+	if entry.ChapterId != "" {
+		chapterId, err = domain.NewChapterIdObject(entry.ChapterId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (chapterId): %w", err)
+		}
+	}
+
+	return &sharedScope{userId: *userId, projectId: *projectId, chapterId: chapterId}, nil
+}
+
+func (s publicService) chapterScope(
+	token domain.ShareTokenObject,
+	chapterId domain.ChapterIdObject,
+) (*sharedScope, *Error) {
+	scope, sErr := s.scope(token)
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	if scope.chapterId != nil && scope.chapterId.Value() != chapterId.Value() {
+		err := errors.New("chapter is not shared")
+		return nil, Errorf(NotFoundError, "failed to find shared chapter: %w", err)
+	}
+
+	return scope, nil
+}
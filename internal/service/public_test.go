@@ -0,0 +1,265 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type publicRepositories struct {
+	share   *mock_repository.MockShareRepository
+	project *mock_repository.MockProjectRepository
+	chapter *mock_repository.MockChapterRepository
+	paper   *mock_repository.MockPaperRepository
+	graph   *mock_repository.MockGraphRepository
+}
+
+func newPublicService(ctrl *gomock.Controller) (service.PublicService, publicRepositories) {
+	r := publicRepositories{
+		share:   mock_repository.NewMockShareRepository(ctrl),
+		project: mock_repository.NewMockProjectRepository(ctrl),
+		chapter: mock_repository.NewMockChapterRepository(ctrl),
+		paper:   mock_repository.NewMockPaperRepository(ctrl),
+		graph:   mock_repository.NewMockGraphRepository(ctrl),
+	}
+	return service.NewPublicService(r.share, r.project, r.chapter, r.paper, r.graph), r
+}
+
+func TestFindSharedProjectValidEntry(t *testing.T) {
+	tt := []struct {
+		name             string
+		chapterId        string
+		expectedChapters []string
+	}{
+		{
+			name:             "should return all chapters of shared project",
+			chapterId:        "",
+			expectedChapters: []string{"1000000000000001", "1000000000000002"},
+		},
+		{
+			name:             "should return only shared chapter",
+			chapterId:        "1000000000000002",
+			expectedChapters: []string{"1000000000000002"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s, r := newPublicService(ctrl)
+			r.share.EXPECT().
+				FetchShare("SHARE_TOKEN").
+				Return(&record.ShareEntry{
+					ProjectId: "0000000000000001",
+					ChapterId: tc.chapterId,
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+				}, nil)
+			r.project.EXPECT().
+				FetchProject(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(&record.ProjectEntry{
+					Name:      "Shared Project",
+					UserId:    testutil.ReadOnlyUserId(),
+					CreatedAt: testutil.Date(),
+					UpdatedAt: testutil.Date(),
+				}, nil)
+			r.chapter.EXPECT().
+				FetchChapters(testutil.ReadOnlyUserId(), "0000000000000001").
+				Return(map[string]record.ChapterEntry{
+					"1000000000000002": {
+						Name:      "Chapter Two",
+						Number:    2,
+						Sections:  []record.SectionEntry{},
+						UserId:    testutil.ReadOnlyUserId(),
+						CreatedAt: testutil.Date(),
+						UpdatedAt: testutil.Date(),
+					},
+					"1000000000000001": {
+						Name:      "Chapter One",
+						Number:    1,
+						Sections:  []record.SectionEntry{},
+						UserId:    testutil.ReadOnlyUserId(),
+						CreatedAt: testutil.Date(),
+						UpdatedAt: testutil.Date(),
+					},
+				}, nil)
+
+			token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+			assert.Nil(t, err)
+
+			project, chapters, sErr := s.FindSharedProject(*token)
+			assert.Nil(t, sErr)
+			assert.Equal(t, "0000000000000001", project.Id().Value())
+			assert.Equal(t, "Shared Project", project.Name().Value())
+
+			chapterIds := []string{}
+			for _, chapter := range chapters {
+				chapterIds = append(chapterIds, chapter.Id().Value())
+			}
+			assert.Equal(t, tc.expectedChapters, chapterIds)
+		})
+	}
+}
+
+func TestFindSharedProjectShareNotFound(t *testing.T) {
+	expiredAt := time.Now().Add(-1 * time.Minute)
+
+	tt := []struct {
+		name          string
+		entry         *record.ShareEntry
+		err           *repository.Error
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return not found error when share does not exist",
+			err:           repository.Errorf(repository.NotFoundError, "failed to fetch share"),
+			expectedError: "not found: failed to find share: failed to fetch share",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name: "should return not found error when share has expired",
+			entry: &record.ShareEntry{
+				ProjectId: "0000000000000001",
+				ExpiresAt: &expiredAt,
+				UserId:    testutil.ReadOnlyUserId(),
+				CreatedAt: testutil.Date(),
+			},
+			expectedError: "not found: failed to find share: share has expired",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			err:           repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to fetch share: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s, r := newPublicService(ctrl)
+			r.share.EXPECT().
+				FetchShare("SHARE_TOKEN").
+				Return(tc.entry, tc.err)
+
+			token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+			assert.Nil(t, err)
+
+			project, chapters, sErr := s.FindSharedProject(*token)
+			assert.Nil(t, project)
+			assert.Nil(t, chapters)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestFindSharedPaperValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, r := newPublicService(ctrl)
+	r.share.EXPECT().
+		FetchShare("SHARE_TOKEN").
+		Return(&record.ShareEntry{
+			ProjectId: "0000000000000001",
+			ChapterId: "1000000000000001",
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+	r.paper.EXPECT().
+		FetchPaper(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001").
+		Return(&record.PaperEntry{
+			Content:   "## Introduction",
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+
+	token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+
+	paper, sErr := s.FindSharedPaper(*token, *chapterId)
+	assert.Nil(t, sErr)
+	assert.Equal(t, "1000000000000001", paper.Id().Value())
+	assert.Equal(t, "## Introduction", paper.Content().Value())
+}
+
+func TestFindSharedGraphValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, r := newPublicService(ctrl)
+	r.share.EXPECT().
+		FetchShare("SHARE_TOKEN").
+		Return(&record.ShareEntry{
+			ProjectId: "0000000000000001",
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+	r.graph.EXPECT().
+		FetchGraph(testutil.ReadOnlyUserId(), "0000000000000001", "1000000000000001", "2000000000000001").
+		Return(&record.GraphEntry{
+			Name:      "Introduction",
+			Paragraph: "This is introduction",
+			Children:  []record.GraphChildEntry{},
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+			UpdatedAt: testutil.Date(),
+		}, nil)
+
+	token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.FindSharedGraph(*token, *chapterId, *sectionId)
+	assert.Nil(t, sErr)
+	assert.Equal(t, "2000000000000001", graph.Id().Value())
+	assert.Equal(t, "Introduction", graph.Name().Value())
+}
+
+func TestFindSharedGraphChapterNotShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, r := newPublicService(ctrl)
+	r.share.EXPECT().
+		FetchShare("SHARE_TOKEN").
+		Return(&record.ShareEntry{
+			ProjectId: "0000000000000001",
+			ChapterId: "1000000000000002",
+			UserId:    testutil.ReadOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+
+	token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.Nil(t, err)
+
+	graph, sErr := s.FindSharedGraph(*token, *chapterId, *sectionId)
+	assert.Nil(t, graph)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to find shared chapter: chapter is not shared", sErr.Error())
+}
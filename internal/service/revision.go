@@ -0,0 +1,60 @@
+package service
+
+import (
+	"sort"
+	"time"
+)
+
+type RevisionRetention struct {
+	// keeps only the newest revisions (zero means unlimited)
+	MaxCount int
+	// squashes revisions older than this into the newest of them (zero means unlimited)
+	MaxAge time.Duration
+}
+
+var DefaultRevisionRetention = RevisionRetention{
+	MaxCount: 100,
+	MaxAge:   30 * 24 * time.Hour,
+}
+
+func (retention RevisionRetention) enabled() bool {
+	return retention.MaxCount > 0 || retention.MaxAge > 0
+}
+
+func (retention RevisionRetention) revisionIdsToPrune(createdAts map[string]time.Time) []string {
+	ids := sortedRevisionIds(createdAts)
+	cutoff := time.Now().Add(-retention.MaxAge)
+	keepsOld := false
+
+	revisionIds := []string{}
+	for i, id := range ids {
+		if retention.MaxCount > 0 && i >= retention.MaxCount {
+			revisionIds = append(revisionIds, id)
+			continue
+		}
+		if retention.MaxAge > 0 && createdAts[id].Before(cutoff) {
+			if keepsOld {
+				revisionIds = append(revisionIds, id)
+			}
+			keepsOld = true
+		}
+	}
+	return revisionIds
+}
+
+func sortedRevisionIds(createdAts map[string]time.Time) []string {
+	ids := make([]string, 0, len(createdAts))
+	for id := range createdAts {
+		ids = append(ids, id)
+	}
+
+	// newest first
+	sort.Slice(ids, func(i, j int) bool {
+		ti, tj := createdAts[ids[i]], createdAts[ids[j]]
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return ids[i] > ids[j]
+	})
+	return ids
+}
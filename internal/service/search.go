@@ -0,0 +1,196 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/search"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type SearchService interface {
+	Search(
+		userId domain.UserIdObject,
+		query domain.SearchQueryObject,
+		projectId *domain.ProjectIdObject,
+		limit domain.SearchLimitObject,
+	) ([]domain.SearchHitEntity, *Error)
+}
+
+type searchService struct {
+	index             *SearchIndex
+	projectRepository repository.ProjectRepository
+	chapterRepository repository.ChapterRepository
+	paperRepository   repository.PaperRepository
+	graphRepository   repository.GraphRepository
+}
+
+func NewSearchService(
+	index *SearchIndex,
+	projectRepository repository.ProjectRepository,
+	chapterRepository repository.ChapterRepository,
+	paperRepository repository.PaperRepository,
+	graphRepository repository.GraphRepository,
+) SearchService {
+	return searchService{
+		index:             index,
+		projectRepository: projectRepository,
+		chapterRepository: chapterRepository,
+		paperRepository:   paperRepository,
+		graphRepository:   graphRepository,
+	}
+}
+
+func (s searchService) Search(
+	userId domain.UserIdObject,
+	query domain.SearchQueryObject,
+	projectId *domain.ProjectIdObject,
+	limit domain.SearchLimitObject,
+) ([]domain.SearchHitEntity, *Error) {
+	index, sErr := s.index.load(userId.Value(), func() (*search.Index, *Error) {
+		return s.buildIndex(userId.Value())
+	})
+	if sErr != nil {
+		return nil, sErr
+	}
+
+	scope := search.Location{}
+	if projectId != nil {
+		scope.ProjectId = projectId.Value()
+		if _, ok := index.Get(search.KindProject, scope); !ok {
+			err := errors.New("project not found")
+			return nil, Errorf(NotFoundError, "failed to search: %w", err)
+		}
+	}
+
+	results := index.Search(query.Value(), scope, limit.Value())
+
+	hits := make([]domain.SearchHitEntity, len(results))
+	for i, result := range results {
+		title := result.Document.Title
+		if result.Document.Kind == search.KindPaper {
+			chapterLocation := search.Location{
+				ProjectId: result.Document.Location.ProjectId,
+				ChapterId: result.Document.Location.ChapterId,
+			}
+			if chapter, ok := index.Get(search.KindChapter, chapterLocation); ok {
+				title = chapter.Title
+			}
+		}
+
+		hit, sErr := s.resultToEntity(result, title)
+		if sErr != nil {
+			return nil, sErr
+		}
+		hits[i] = *hit
+	}
+
+	return hits, nil
+}
+
+func (s searchService) buildIndex(userId string) (*search.Index, *Error) {
+	index := search.NewIndex()
+
+	projects, rErr := s.projectRepository.FetchProjects(userId)
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch user projects: %w", rErr.Unwrap())
+	}
+
+	for projectId, project := range projects {
+		putProjectDocument(index, projectId, project.Name, project.Description)
+
+		chapters, rErr := s.chapterRepository.FetchChapters(userId, projectId)
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			// the project has been deleted after it was fetched
+			continue
+		}
+		if rErr != nil {
+			return nil, Errorf(RepositoryFailurePanic, "failed to fetch chapters: %w", rErr.Unwrap())
+		}
+
+		for chapterId, chapter := range chapters {
+			putChapterDocument(index, projectId, chapterId, chapter.Name)
+
+			paper, rErr := s.paperRepository.FetchPaper(userId, projectId, chapterId)
+			if rErr != nil && rErr.Code() != repository.NotFoundError {
+				return nil, Errorf(RepositoryFailurePanic, "failed to fetch paper: %w", rErr.Unwrap())
+			}
+			if rErr == nil {
+				putPaperDocument(index, projectId, chapterId, paper.Content)
+			}
+
+			keys, graphs, rErr := s.graphRepository.FetchGraphs(userId, projectId, chapterId)
+			if rErr != nil && rErr.Code() == repository.NotFoundError {
+				continue
+			}
+			if rErr != nil {
+				return nil, Errorf(RepositoryFailurePanic, "failed to fetch graphs: %w", rErr.Unwrap())
+			}
+			for i, graph := range graphs {
+				putGraphDocuments(
+					index,
+					projectId,
+					chapterId,
+					keys[i],
+					graph.Name,
+					graph.Paragraph,
+					searchNodesFromEntries(graph.Children),
+				)
+			}
+		}
+	}
+
+	return index, nil
+}
+
+func (s searchService) resultToEntity(result search.Result, title string) (*domain.SearchHitEntity, *Error) {
+	location := result.Document.Location
+
+	projectId, err := domain.NewProjectIdObject(location.ProjectId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert result to entity (projectId): %w", err)
+	}
+
+	var chapterId *domain.ChapterIdObject
+	if location.ChapterId != "" {
+		chapterId, err = domain.NewChapterIdObject(location.ChapterId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert result to entity (chapterId): %w", err)
+		}
+	}
+
+	var sectionId *domain.SectionIdObject
+	if location.SectionId != "" {
+		sectionId, err = domain.NewSectionIdObject(location.SectionId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert result to entity (sectionId): %w", err)
+		}
+	}
+
+	var nodeId *domain.GraphChildIdObject
+	if location.NodeId != "" {
+		nodeId, err = domain.NewGraphChildIdObject(location.NodeId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert result to entity (nodeId): %w", err)
+		}
+	}
+
+	highlights := make([]domain.SearchHighlightObject, len(result.Snippet.Highlights))
+	for i, highlight := range result.Snippet.Highlights {
+		highlights[i] = *domain.NewSearchHighlightObject(highlight.Offset, highlight.Length)
+	}
+	snippet := domain.NewSearchSnippetObject(result.Snippet.Text, highlights)
+
+	return domain.NewSearchHitEntity(
+		result.Document.Kind,
+		*projectId,
+		chapterId,
+		sectionId,
+		nodeId,
+		title,
+		*snippet,
+		result.Score,
+	), nil
+}
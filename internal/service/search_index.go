@@ -0,0 +1,320 @@
+package service
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/search"
+)
+
+// DefaultSearchIndexTTL is how long the index of a user is trusted after it is loaded.
+// Writes through other server instances never reach this index, so it is reloaded after the TTL
+const DefaultSearchIndexTTL = 10 * time.Minute
+
+// DefaultSearchIndexMaxUsers bounds how many users have their index kept in memory at once
+const DefaultSearchIndexMaxUsers = 1000
+
+const (
+	projectNameWeight        = 5
+	projectDescriptionWeight = 2
+	chapterNameWeight        = 4
+	sectionNameWeight        = 4
+	sectionParagraphWeight   = 1
+	nodeNameWeight           = 3
+	nodeRelationWeight       = 1
+	nodeDescriptionWeight    = 1
+	paperContentWeight       = 1
+)
+
+// SearchIndex holds a full-text index per user. The index of a user is loaded on the first search,
+// and then kept up to date by the indexed services on every write until it expires.
+// The least recently used indexes are dropped when more than maxUsers users are held
+type SearchIndex struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	maxUsers int
+	users    map[string]*list.Element
+	recent   *list.List
+}
+
+type userSearchIndex struct {
+	mu       sync.Mutex
+	userId   string
+	index    *search.Index
+	loadedAt time.Time
+}
+
+func NewSearchIndex(ttl time.Duration, maxUsers int) *SearchIndex {
+	return &SearchIndex{
+		ttl:      ttl,
+		maxUsers: max(maxUsers, 1),
+		users:    make(map[string]*list.Element),
+		recent:   list.New(),
+	}
+}
+
+// load returns the index of a user, building it when it is not loaded yet or has expired
+func (i *SearchIndex) load(userId string, build func() (*search.Index, *Error)) (*search.Index, *Error) {
+	user := i.user(userId)
+	user.mu.Lock()
+	defer user.mu.Unlock()
+
+	if user.index != nil && time.Since(user.loadedAt) < i.ttl {
+		return user.index, nil
+	}
+
+	index, err := build()
+	if err != nil {
+		return nil, err
+	}
+	user.index = index
+	user.loadedAt = time.Now()
+	return index, nil
+}
+
+// update applies f to the index of a user. Nothing is done unless the index is loaded,
+// since the changes are read from repositories when it is loaded later
+func (i *SearchIndex) update(userId string, f func(index *search.Index)) {
+	i.mu.Lock()
+	element, ok := i.users[userId]
+	i.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	user := element.Value.(*userSearchIndex)
+	user.mu.Lock()
+	defer user.mu.Unlock()
+	if user.index != nil {
+		f(user.index)
+	}
+}
+
+// updateProject applies f to the indexes of all users who can see a project, that is, the owner and members.
+// They are the users whose index has the project, since every project of a user is indexed on load
+func (i *SearchIndex) updateProject(projectId string, f func(index *search.Index)) {
+	for _, user := range i.loaded() {
+		user.mu.Lock()
+		if user.index != nil && hasProjectDocument(user.index, projectId) {
+			f(user.index)
+		}
+		user.mu.Unlock()
+	}
+}
+
+// invalidate drops the index of a user for changes which are too wide to apply one by one
+func (i *SearchIndex) invalidate(userId string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if element, ok := i.users[userId]; ok {
+		i.remove(element)
+	}
+}
+
+// invalidateProject drops the indexes of all users who can see a project
+func (i *SearchIndex) invalidateProject(projectId string) {
+	for _, user := range i.loaded() {
+		user.mu.Lock()
+		found := user.index != nil && hasProjectDocument(user.index, projectId)
+		user.mu.Unlock()
+		if found {
+			i.invalidate(user.userId)
+		}
+	}
+}
+
+func (i *SearchIndex) invalidateAll() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.users = make(map[string]*list.Element)
+	i.recent.Init()
+}
+
+func (i *SearchIndex) user(userId string) *userSearchIndex {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if element, ok := i.users[userId]; ok {
+		i.recent.MoveToFront(element)
+		return element.Value.(*userSearchIndex)
+	}
+
+	i.sweep()
+	for i.recent.Len() >= i.maxUsers {
+		i.remove(i.recent.Back())
+	}
+
+	user := &userSearchIndex{userId: userId}
+	i.users[userId] = i.recent.PushFront(user)
+	return user
+}
+
+// loaded returns the users whose index is held, so that they can be locked one by one without holding i.mu
+func (i *SearchIndex) loaded() []*userSearchIndex {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	users := make([]*userSearchIndex, 0, i.recent.Len())
+	for element := i.recent.Front(); element != nil; element = element.Next() {
+		users = append(users, element.Value.(*userSearchIndex))
+	}
+	return users
+}
+
+// sweep drops expired indexes so that memory is not held for users who stopped searching.
+// Indexes in use are skipped and swept next time
+func (i *SearchIndex) sweep() {
+	for _, element := range i.users {
+		user := element.Value.(*userSearchIndex)
+		if !user.mu.TryLock() {
+			continue
+		}
+		if user.index == nil || time.Since(user.loadedAt) >= i.ttl {
+			i.remove(element)
+		}
+		user.mu.Unlock()
+	}
+}
+
+// remove drops an index from i. An index in use stays valid for its current user until it is unlocked
+func (i *SearchIndex) remove(element *list.Element) {
+	delete(i.users, element.Value.(*userSearchIndex).userId)
+	i.recent.Remove(element)
+}
+
+func hasProjectDocument(index *search.Index, projectId string) bool {
+	_, ok := index.Get(search.KindProject, search.Location{ProjectId: projectId})
+	return ok
+}
+
+type searchNode struct {
+	id          string
+	name        string
+	relation    string
+	description string
+	children    []searchNode
+}
+
+func putProjectDocument(index *search.Index, projectId string, name string, description string) {
+	index.Put(search.Document{
+		Kind:     search.KindProject,
+		Location: search.Location{ProjectId: projectId},
+		Title:    name,
+		Fields: []search.Field{
+			{Text: name, Weight: projectNameWeight},
+			{Text: description, Weight: projectDescriptionWeight},
+		},
+	})
+}
+
+func putChapterDocument(index *search.Index, projectId string, chapterId string, name string) {
+	index.Put(search.Document{
+		Kind:     search.KindChapter,
+		Location: search.Location{ProjectId: projectId, ChapterId: chapterId},
+		Title:    name,
+		Fields:   []search.Field{{Text: name, Weight: chapterNameWeight}},
+	})
+}
+
+// putPaperDocument indexes a paper without title, since the title of a paper is the name of its chapter
+func putPaperDocument(index *search.Index, projectId string, chapterId string, content string) {
+	index.Put(search.Document{
+		Kind:     search.KindPaper,
+		Location: search.Location{ProjectId: projectId, ChapterId: chapterId},
+		Fields:   []search.Field{{Text: content, Weight: paperContentWeight}},
+	})
+}
+
+// putGraphDocuments replaces the documents of a section and all of its nodes
+func putGraphDocuments(
+	index *search.Index,
+	projectId string,
+	chapterId string,
+	sectionId string,
+	name string,
+	paragraph string,
+	children []searchNode,
+) {
+	location := search.Location{ProjectId: projectId, ChapterId: chapterId, SectionId: sectionId}
+	index.RemoveWithin(location)
+	index.Put(search.Document{
+		Kind:     search.KindSection,
+		Location: location,
+		Title:    name,
+		Fields: []search.Field{
+			{Text: name, Weight: sectionNameWeight},
+			{Text: paragraph, Weight: sectionParagraphWeight},
+		},
+	})
+	putNodeDocuments(index, location, children)
+}
+
+func putNodeDocuments(index *search.Index, section search.Location, children []searchNode) {
+	for _, child := range children {
+		// nodes created before ids were introduced cannot be linked until their ids are assigned
+		if child.id != "" {
+			location := section
+			location.NodeId = child.id
+			index.Put(search.Document{
+				Kind:     search.KindNode,
+				Location: location,
+				Title:    child.name,
+				Fields: []search.Field{
+					{Text: child.name, Weight: nodeNameWeight},
+					{Text: child.relation, Weight: nodeRelationWeight},
+					{Text: child.description, Weight: nodeDescriptionWeight},
+				},
+			})
+		}
+		putNodeDocuments(index, section, child.children)
+	}
+}
+
+func putGraphEntityDocuments(index *search.Index, projectId string, chapterId string, graph domain.GraphEntity) {
+	putGraphDocuments(
+		index,
+		projectId,
+		chapterId,
+		graph.Id().Value(),
+		graph.Name().Value(),
+		graph.Paragraph().Value(),
+		searchNodesFromEntity(*graph.Children()),
+	)
+}
+
+func searchNodesFromEntries(entries []record.GraphChildEntry) []searchNode {
+	nodes := make([]searchNode, len(entries))
+	for i, entry := range entries {
+		nodes[i] = searchNode{
+			id:          entry.Id,
+			name:        entry.Name,
+			relation:    entry.Relation,
+			description: entry.Description,
+			children:    searchNodesFromEntries(entry.Children),
+		}
+	}
+	return nodes
+}
+
+func searchNodesFromEntity(children domain.GraphChildrenEntity) []searchNode {
+	nodes := make([]searchNode, children.Len())
+	for i, child := range children.Value() {
+		id := ""
+		if child.Id() != nil {
+			id = child.Id().Value()
+		}
+		nodes[i] = searchNode{
+			id:          id,
+			name:        child.Name().Value(),
+			relation:    child.Relation().Value(),
+			description: child.Description().Value(),
+			children:    searchNodesFromEntity(*child.Children()),
+		}
+	}
+	return nodes
+}
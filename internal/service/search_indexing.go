@@ -0,0 +1,419 @@
+package service
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/search"
+)
+
+// The indexed services wrap services which write contents, and apply every successful write to SearchIndex,
+// in the indexes of all users who can see the project. Reads are passed through as they are
+
+type indexedProjectService struct {
+	ProjectService
+	index *SearchIndex
+}
+
+func NewIndexedProjectService(service ProjectService, index *SearchIndex) ProjectService {
+	return indexedProjectService{ProjectService: service, index: index}
+}
+
+func (s indexedProjectService) CreateProject(
+	userId domain.UserIdObject,
+	project domain.ProjectWithoutAutofieldEntity,
+) (*domain.ProjectEntity, *Error) {
+	entity, sErr := s.ProjectService.CreateProject(userId, project)
+	if sErr == nil {
+		// only the creator can see a new project, whose document is not in any index yet
+		s.index.update(userId.Value(), func(index *search.Index) {
+			putProjectDocument(index, entity.Id().Value(), entity.Name().Value(), entity.Description().Value())
+		})
+	}
+	return entity, sErr
+}
+
+func (s indexedProjectService) UpdateProject(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	project domain.ProjectWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
+) (*domain.ProjectEntity, *Error) {
+	entity, sErr := s.ProjectService.UpdateProject(userId, projectId, project, updatedAt)
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			putProjectDocument(index, entity.Id().Value(), entity.Name().Value(), entity.Description().Value())
+		})
+	}
+	return entity, sErr
+}
+
+func (s indexedProjectService) DeleteProject(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) *Error {
+	sErr := s.ProjectService.DeleteProject(userId, projectId)
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			index.RemoveWithin(search.Location{ProjectId: projectId.Value()})
+		})
+	}
+	return sErr
+}
+
+type indexedChapterService struct {
+	ChapterService
+	index *SearchIndex
+}
+
+func NewIndexedChapterService(service ChapterService, index *SearchIndex) ChapterService {
+	return indexedChapterService{ChapterService: service, index: index}
+}
+
+func (s indexedChapterService) CreateChapter(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapter domain.ChapterWithoutAutofieldEntity,
+) (*domain.ChapterEntity, *Error) {
+	entity, sErr := s.ChapterService.CreateChapter(userId, projectId, chapter)
+	if sErr == nil {
+		s.putChapter(projectId, *entity)
+	}
+	return entity, sErr
+}
+
+func (s indexedChapterService) UpdateChapter(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	chapter domain.ChapterWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
+) (*domain.ChapterEntity, *Error) {
+	entity, sErr := s.ChapterService.UpdateChapter(userId, projectId, chapterId, chapter, updatedAt)
+	if sErr == nil {
+		s.putChapter(projectId, *entity)
+	}
+	return entity, sErr
+}
+
+func (s indexedChapterService) DeleteChapter(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+) *Error {
+	sErr := s.ChapterService.DeleteChapter(userId, projectId, chapterId)
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			index.RemoveWithin(search.Location{ProjectId: projectId.Value(), ChapterId: chapterId.Value()})
+		})
+	}
+	return sErr
+}
+
+func (s indexedChapterService) putChapter(
+	projectId domain.ProjectIdObject,
+	chapter domain.ChapterEntity,
+) {
+	s.index.updateProject(projectId.Value(), func(index *search.Index) {
+		putChapterDocument(index, projectId.Value(), chapter.Id().Value(), chapter.Name().Value())
+	})
+}
+
+type indexedPaperService struct {
+	PaperService
+	index *SearchIndex
+}
+
+func NewIndexedPaperService(service PaperService, index *SearchIndex) PaperService {
+	return indexedPaperService{PaperService: service, index: index}
+}
+
+func (s indexedPaperService) UpdatePaper(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+	paper domain.PaperWithoutAutofieldEntity,
+	updatedAt *domain.UpdatedAtObject,
+) (*domain.PaperEntity, *Error) {
+	entity, sErr := s.PaperService.UpdatePaper(userId, projectId, paperId, paper, updatedAt)
+	if sErr == nil {
+		s.putPaper(projectId, *entity)
+	}
+	return entity, sErr
+}
+
+func (s indexedPaperService) RestorePaperRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	paperId domain.PaperIdObject,
+	revisionId domain.PaperRevisionIdObject,
+) (*domain.PaperEntity, *Error) {
+	entity, sErr := s.PaperService.RestorePaperRevision(userId, projectId, paperId, revisionId)
+	if sErr == nil {
+		s.putPaper(projectId, *entity)
+	}
+	return entity, sErr
+}
+
+// putPaper indexes a paper under its chapter, since the id of a paper is the id of its chapter
+func (s indexedPaperService) putPaper(
+	projectId domain.ProjectIdObject,
+	paper domain.PaperEntity,
+) {
+	s.index.updateProject(projectId.Value(), func(index *search.Index) {
+		putPaperDocument(index, projectId.Value(), paper.Id().Value(), paper.Content().Value())
+	})
+}
+
+type indexedGraphService struct {
+	GraphService
+	index *SearchIndex
+}
+
+func NewIndexedGraphService(service GraphService, index *SearchIndex) GraphService {
+	return indexedGraphService{GraphService: service, index: index}
+}
+
+func (s indexedGraphService) UpdateGraphContent(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	graphId domain.GraphIdObject,
+	graph domain.GraphContentEntity,
+	updatedAt *domain.UpdatedAtObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.UpdateGraphContent(userId, projectId, chapterId, graphId, graph, updatedAt)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) DeleteGraph(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+) *Error {
+	sErr := s.GraphService.DeleteGraph(userId, projectId, chapterId, sectionId)
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			index.RemoveWithin(search.Location{
+				ProjectId: projectId.Value(),
+				ChapterId: chapterId.Value(),
+				SectionId: sectionId.Value(),
+			})
+		})
+	}
+	return sErr
+}
+
+func (s indexedGraphService) SectionalizeIntoGraphs(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sections domain.SectionWithoutAutofieldEntityList,
+) ([]domain.GraphEntity, *Error) {
+	entities, sErr := s.GraphService.SectionalizeIntoGraphs(userId, projectId, chapterId, sections)
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			for _, entity := range entities {
+				putGraphEntityDocuments(index, projectId.Value(), chapterId.Value(), entity)
+			}
+		})
+	}
+	return entities, sErr
+}
+
+// ResectionalizeGraphs drops the indexes of the project, since orphaned sections may be moved or removed
+func (s indexedGraphService) ResectionalizeGraphs(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sections domain.SectionWithoutAutofieldEntityList,
+	orphanAction domain.GraphOrphanActionObject,
+) ([]domain.GraphEntity, []domain.GraphEntity, *Error) {
+	entities, orphans, sErr := s.GraphService.ResectionalizeGraphs(userId, projectId, chapterId, sections, orphanAction)
+	if sErr == nil {
+		s.index.invalidateProject(projectId.Value())
+	}
+	return entities, orphans, sErr
+}
+
+func (s indexedGraphService) AddGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	parentPath domain.GraphPathObject,
+	child domain.GraphChildEntity,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.AddGraphChild(userId, projectId, chapterId, sectionId, parentPath, child)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) RenameGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	name domain.GraphNameObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.RenameGraphChild(userId, projectId, chapterId, sectionId, path, name)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) UpdateGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	relation domain.GraphRelationObject,
+	description domain.GraphDescriptionObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.UpdateGraphChild(
+		userId, projectId, chapterId, sectionId, path, relation, description)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) MoveGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+	parentPath domain.GraphPathObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.MoveGraphChild(userId, projectId, chapterId, sectionId, path, parentPath)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) DeleteGraphChild(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	path domain.GraphPathObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.DeleteGraphChild(userId, projectId, chapterId, sectionId, path)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+func (s indexedGraphService) RestoreGraphRevision(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	sectionId domain.SectionIdObject,
+	revisionId domain.GraphRevisionIdObject,
+) (*domain.GraphEntity, *Error) {
+	entity, sErr := s.GraphService.RestoreGraphRevision(userId, projectId, chapterId, sectionId, revisionId)
+	return s.putGraph(projectId, chapterId, entity, sErr)
+}
+
+// AssignMissingGraphChildIds drops the indexes of all users, since nodes without ids are not indexed
+func (s indexedGraphService) AssignMissingGraphChildIds() (int, *Error) {
+	count, sErr := s.GraphService.AssignMissingGraphChildIds()
+	if count > 0 {
+		s.index.invalidateAll()
+	}
+	return count, sErr
+}
+
+func (s indexedGraphService) putGraph(
+	projectId domain.ProjectIdObject,
+	chapterId domain.ChapterIdObject,
+	entity *domain.GraphEntity,
+	sErr *Error,
+) (*domain.GraphEntity, *Error) {
+	if sErr == nil {
+		s.index.updateProject(projectId.Value(), func(index *search.Index) {
+			putGraphEntityDocuments(index, projectId.Value(), chapterId.Value(), *entity)
+		})
+	}
+	return entity, sErr
+}
+
+type indexedTrashService struct {
+	TrashService
+	index *SearchIndex
+}
+
+func NewIndexedTrashService(service TrashService, index *SearchIndex) TrashService {
+	return indexedTrashService{TrashService: service, index: index}
+}
+
+// RestoreTrashItem drops the indexes of the project, since a restored item brings back a whole tree of contents.
+// A restored project is in no index, so the indexes of all users are dropped for its members
+func (s indexedTrashService) RestoreTrashItem(
+	userId domain.UserIdObject,
+	itemId domain.TrashItemIdObject,
+) (*domain.TrashItemEntity, *Error) {
+	entity, sErr := s.TrashService.RestoreTrashItem(userId, itemId)
+	if sErr != nil {
+		return entity, sErr
+	}
+
+	if entity.Type().Value() == repository.TrashItemTypeProject {
+		s.index.invalidateAll()
+	} else {
+		s.index.invalidateProject(entity.ProjectId().Value())
+	}
+	return entity, sErr
+}
+
+type indexedProjectArchiveService struct {
+	ProjectArchiveService
+	index *SearchIndex
+}
+
+func NewIndexedProjectArchiveService(service ProjectArchiveService, index *SearchIndex) ProjectArchiveService {
+	return indexedProjectArchiveService{ProjectArchiveService: service, index: index}
+}
+
+// ImportProject drops the index of the user, since an imported project brings a whole tree of contents
+func (s indexedProjectArchiveService) ImportProject(
+	userId domain.UserIdObject,
+	archive domain.ProjectArchiveEntity,
+) (*domain.ProjectEntity, *Error) {
+	entity, sErr := s.ProjectArchiveService.ImportProject(userId, archive)
+	if sErr == nil {
+		s.index.invalidate(userId.Value())
+	}
+	return entity, sErr
+}
+
+type indexedProjectMemberService struct {
+	ProjectMemberService
+	index *SearchIndex
+}
+
+func NewIndexedProjectMemberService(service ProjectMemberService, index *SearchIndex) ProjectMemberService {
+	return indexedProjectMemberService{ProjectMemberService: service, index: index}
+}
+
+// InviteProjectMember drops the index of the member, since the shared project becomes searchable for them
+func (s indexedProjectMemberService) InviteProjectMember(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	memberId domain.UserIdObject,
+	role domain.ProjectRoleObject,
+) (*domain.ProjectMemberEntity, *Error) {
+	entity, sErr := s.ProjectMemberService.InviteProjectMember(userId, projectId, memberId, role)
+	if sErr == nil {
+		s.index.invalidate(memberId.Value())
+	}
+	return entity, sErr
+}
+
+// RemoveProjectMember removes the project from the index of the member
+func (s indexedProjectMemberService) RemoveProjectMember(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	memberId domain.UserIdObject,
+) *Error {
+	sErr := s.ProjectMemberService.RemoveProjectMember(userId, projectId, memberId)
+	if sErr == nil {
+		s.index.update(memberId.Value(), func(index *search.Index) {
+			index.RemoveWithin(search.Location{ProjectId: projectId.Value()})
+		})
+	}
+	return sErr
+}
@@ -0,0 +1,547 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type searchRepositories struct {
+	project *mock_repository.MockProjectRepository
+	chapter *mock_repository.MockChapterRepository
+	paper   *mock_repository.MockPaperRepository
+	graph   *mock_repository.MockGraphRepository
+}
+
+func newSearchRepositories(ctrl *gomock.Controller) searchRepositories {
+	return searchRepositories{
+		project: mock_repository.NewMockProjectRepository(ctrl),
+		chapter: mock_repository.NewMockChapterRepository(ctrl),
+		paper:   mock_repository.NewMockPaperRepository(ctrl),
+		graph:   mock_repository.NewMockGraphRepository(ctrl),
+	}
+}
+
+func (r searchRepositories) expectLoad(userId string) {
+	r.project.EXPECT().
+		FetchProjects(userId).
+		Return(map[string]record.ProjectEntry{
+			"PROJECT": {
+				Name:        "Knowledge Graph",
+				Description: "ノートを知識グラフにする",
+				UserId:      userId,
+			},
+		}, nil)
+	r.chapter.EXPECT().
+		FetchChapters(userId, "PROJECT").
+		Return(map[string]record.ChapterEntry{
+			"CHAPTER": {
+				Name:   "Introduction",
+				Number: 1,
+				UserId: userId,
+			},
+		}, nil)
+	r.paper.EXPECT().
+		FetchPaper(userId, "PROJECT", "CHAPTER").
+		Return(&record.PaperEntry{
+			Content: "A graph consists of nodes and edges.",
+			UserId:  userId,
+		}, nil)
+	r.graph.EXPECT().
+		FetchGraphs(userId, "PROJECT", "CHAPTER").
+		Return([]string{"SECTION"}, []record.GraphEntry{
+			{
+				Name:      "Nodes",
+				Paragraph: "Nodes are connected by edges.",
+				Children: []record.GraphChildEntry{
+					{
+						Id:          "NODE",
+						Name:        "edge",
+						Relation:    "connects",
+						Description: "An edge connects two nodes of a graph",
+						Children:    []record.GraphChildEntry{},
+					},
+				},
+				UserId: userId,
+			},
+		}, nil)
+}
+
+func (r searchRepositories) service(index *service.SearchIndex) service.SearchService {
+	return service.NewSearchService(index, r.project, r.chapter, r.paper, r.graph)
+}
+
+func TestSearchValidEntry(t *testing.T) {
+	tt := []struct {
+		name      string
+		query     string
+		projectId string
+		limit     int
+		expected  []string
+	}{
+		{
+			name:     "should return hits in descending order of score",
+			query:    "graph",
+			limit:    20,
+			expected: []string{"project:PROJECT", "node:PROJECT/CHAPTER/SECTION/NODE", "paper:PROJECT/CHAPTER"},
+		},
+		{
+			name:     "should return hits of cjk query",
+			query:    "知識",
+			limit:    20,
+			expected: []string{"project:PROJECT"},
+		},
+		{
+			name:      "should return hits within project",
+			query:     "edges",
+			projectId: "PROJECT",
+			limit:     20,
+			expected:  []string{"section:PROJECT/CHAPTER/SECTION", "paper:PROJECT/CHAPTER"},
+		},
+		{
+			name:     "should return limited hits",
+			query:    "graph",
+			limit:    1,
+			expected: []string{"project:PROJECT"},
+		},
+		{
+			name:     "should return no hits",
+			query:    "unknown",
+			limit:    20,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := newSearchRepositories(ctrl)
+			r.expectLoad(testutil.ReadOnlyUserId())
+			s := r.service(service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers))
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.NoError(t, err)
+			query, err := domain.NewSearchQueryObject(tc.query)
+			assert.NoError(t, err)
+			limit, err := domain.NewSearchLimitObject(tc.limit)
+			assert.NoError(t, err)
+			var projectId *domain.ProjectIdObject
+			if tc.projectId != "" {
+				projectId, err = domain.NewProjectIdObject(tc.projectId)
+				assert.NoError(t, err)
+			}
+
+			hits, sErr := s.Search(*userId, *query, projectId, *limit)
+			assert.Nil(t, sErr)
+			assert.Equal(t, tc.expected, searchHitKeys(hits))
+		})
+	}
+}
+
+func TestSearchHitEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	s := r.service(service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers))
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	query, err := domain.NewSearchQueryObject("consists")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	hits, sErr := s.Search(*userId, *query, nil, *limit)
+	assert.Nil(t, sErr)
+	assert.Len(t, hits, 1)
+
+	hit := hits[0]
+	assert.Equal(t, "paper", hit.Kind())
+	assert.Equal(t, "PROJECT", hit.ProjectId().Value())
+	assert.Equal(t, "CHAPTER", hit.ChapterId().Value())
+	assert.Nil(t, hit.SectionId())
+	assert.Nil(t, hit.NodeId())
+	assert.Equal(t, "Introduction", hit.Title())
+	assert.Equal(t, "A graph consists of nodes and edges.", hit.Snippet().Text())
+	assert.Len(t, hit.Snippet().Highlights(), 1)
+	assert.Equal(t, 8, hit.Snippet().Highlights()[0].Offset())
+	assert.Equal(t, 8, hit.Snippet().Highlights()[0].Length())
+	assert.Greater(t, hit.Score(), 0.0)
+
+	// the index is loaded only once
+	query, err = domain.NewSearchQueryObject("connects")
+	assert.NoError(t, err)
+
+	hits, sErr = s.Search(*userId, *query, nil, *limit)
+	assert.Nil(t, sErr)
+	assert.Len(t, hits, 1)
+
+	hit = hits[0]
+	assert.Equal(t, "node", hit.Kind())
+	assert.Equal(t, "SECTION", hit.SectionId().Value())
+	assert.Equal(t, "NODE", hit.NodeId().Value())
+	assert.Equal(t, "edge", hit.Title())
+}
+
+func TestSearchExpiredIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	r.expectLoad(testutil.ReadOnlyUserId())
+	s := r.service(service.NewSearchIndex(0, service.DefaultSearchIndexMaxUsers))
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	query, err := domain.NewSearchQueryObject("graph")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	for range 2 {
+		hits, sErr := s.Search(*userId, *query, nil, *limit)
+		assert.Nil(t, sErr)
+		assert.Len(t, hits, 3)
+	}
+}
+
+func TestSearchNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	s := r.service(service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers))
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	query, err := domain.NewSearchQueryObject("graph")
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("UNKNOWN")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	hits, sErr := s.Search(*userId, *query, projectId, *limit)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.NotFoundError, sErr.Code())
+	assert.Equal(t, "not found: failed to search: project not found", sErr.Error())
+	assert.Nil(t, hits)
+}
+
+func TestSearchRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		setup         func(r searchRepositories)
+		expectedError string
+	}{
+		{
+			name: "should return error when projects are not fetched",
+			setup: func(r searchRepositories) {
+				r.project.EXPECT().
+					FetchProjects(testutil.ReadOnlyUserId()).
+					Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+			},
+			expectedError: "repository failure: failed to fetch user projects: repository error",
+		},
+		{
+			name: "should return error when chapters are not fetched",
+			setup: func(r searchRepositories) {
+				r.project.EXPECT().
+					FetchProjects(testutil.ReadOnlyUserId()).
+					Return(map[string]record.ProjectEntry{"PROJECT": {Name: "Project"}}, nil)
+				r.chapter.EXPECT().
+					FetchChapters(testutil.ReadOnlyUserId(), "PROJECT").
+					Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+			},
+			expectedError: "repository failure: failed to fetch chapters: repository error",
+		},
+		{
+			name: "should return error when paper is not fetched",
+			setup: func(r searchRepositories) {
+				r.project.EXPECT().
+					FetchProjects(testutil.ReadOnlyUserId()).
+					Return(map[string]record.ProjectEntry{"PROJECT": {Name: "Project"}}, nil)
+				r.chapter.EXPECT().
+					FetchChapters(testutil.ReadOnlyUserId(), "PROJECT").
+					Return(map[string]record.ChapterEntry{"CHAPTER": {Name: "Chapter", Number: 1}}, nil)
+				r.paper.EXPECT().
+					FetchPaper(testutil.ReadOnlyUserId(), "PROJECT", "CHAPTER").
+					Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+			},
+			expectedError: "repository failure: failed to fetch paper: repository error",
+		},
+		{
+			name: "should return error when graphs are not fetched",
+			setup: func(r searchRepositories) {
+				r.project.EXPECT().
+					FetchProjects(testutil.ReadOnlyUserId()).
+					Return(map[string]record.ProjectEntry{"PROJECT": {Name: "Project"}}, nil)
+				r.chapter.EXPECT().
+					FetchChapters(testutil.ReadOnlyUserId(), "PROJECT").
+					Return(map[string]record.ChapterEntry{"CHAPTER": {Name: "Chapter", Number: 1}}, nil)
+				r.paper.EXPECT().
+					FetchPaper(testutil.ReadOnlyUserId(), "PROJECT", "CHAPTER").
+					Return(&record.PaperEntry{Content: "content"}, nil)
+				r.graph.EXPECT().
+					FetchGraphs(testutil.ReadOnlyUserId(), "PROJECT", "CHAPTER").
+					Return(nil, nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+			},
+			expectedError: "repository failure: failed to fetch graphs: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := newSearchRepositories(ctrl)
+			tc.setup(r)
+			s := r.service(service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers))
+
+			userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+			assert.NoError(t, err)
+			query, err := domain.NewSearchQueryObject("graph")
+			assert.NoError(t, err)
+			limit, err := domain.NewSearchLimitObject(20)
+			assert.NoError(t, err)
+
+			hits, sErr := s.Search(*userId, *query, nil, *limit)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+			assert.Nil(t, hits)
+		})
+	}
+}
+
+func TestSearchIndexedServices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	index := service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers)
+	s := r.service(index)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("PROJECT")
+	assert.NoError(t, err)
+	chapterId, err := domain.NewChapterIdObject("CHAPTER")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	search := func(q string) []string {
+		query, err := domain.NewSearchQueryObject(q)
+		assert.NoError(t, err)
+		hits, sErr := s.Search(*userId, *query, nil, *limit)
+		assert.Nil(t, sErr)
+		return searchHitKeys(hits)
+	}
+
+	assert.Equal(t, []string{"project:PROJECT", "node:PROJECT/CHAPTER/SECTION/NODE", "paper:PROJECT/CHAPTER"}, search("graph"))
+
+	projectName, err := domain.NewProjectNameObject("Notebook")
+	assert.NoError(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+
+	ps := mock_service.NewMockProjectService(ctrl)
+	ps.EXPECT().
+		UpdateProject(*userId, *projectId, *project, nil).
+		Return(domain.NewProjectEntity(*projectId, *projectName, *projectDescription, *role, *createdAt, *updatedAt), nil)
+
+	_, sErr := service.NewIndexedProjectService(ps, index).UpdateProject(*userId, *projectId, *project, nil)
+	assert.Nil(t, sErr)
+	assert.Equal(t, []string{"node:PROJECT/CHAPTER/SECTION/NODE", "paper:PROJECT/CHAPTER"}, search("graph"))
+	assert.Equal(t, []string{"project:PROJECT"}, search("notebook"))
+
+	cs := mock_service.NewMockChapterService(ctrl)
+	cs.EXPECT().
+		DeleteChapter(*userId, *projectId, *chapterId).
+		Return(service.Errorf(service.NotFoundError, "failed to delete chapter"))
+	cs.EXPECT().
+		DeleteChapter(*userId, *projectId, *chapterId).
+		Return(nil)
+
+	sErr = service.NewIndexedChapterService(cs, index).DeleteChapter(*userId, *projectId, *chapterId)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, []string{"node:PROJECT/CHAPTER/SECTION/NODE", "paper:PROJECT/CHAPTER"}, search("graph"))
+
+	sErr = service.NewIndexedChapterService(cs, index).DeleteChapter(*userId, *projectId, *chapterId)
+	assert.Nil(t, sErr)
+	assert.Equal(t, []string{}, search("graph"))
+
+	itemId, err := domain.NewTrashItemIdObject("ITEM")
+	assert.NoError(t, err)
+
+	itemType, err := domain.NewTrashItemTypeObject("chapter")
+	assert.NoError(t, err)
+	itemName, err := domain.NewTrashItemNameObject("Introduction")
+	assert.NoError(t, err)
+	deletedAt, err := domain.NewDeletedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	ts := mock_service.NewMockTrashService(ctrl)
+	ts.EXPECT().
+		RestoreTrashItem(*userId, *itemId).
+		Return(domain.NewTrashItemEntity(*itemId, *itemType, *itemName, *projectId, chapterId, nil, *deletedAt), nil)
+
+	_, sErr = service.NewIndexedTrashService(ts, index).RestoreTrashItem(*userId, *itemId)
+	assert.Nil(t, sErr)
+
+	r.expectLoad(testutil.ReadOnlyUserId())
+	assert.Equal(t, []string{"project:PROJECT", "node:PROJECT/CHAPTER/SECTION/NODE", "paper:PROJECT/CHAPTER"}, search("graph"))
+}
+
+func TestSearchIndexedServicesProjectMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	r.expectLoad(testutil.ModifyOnlyUserId())
+	index := service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers)
+	s := r.service(index)
+
+	ownerId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	memberId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("PROJECT")
+	assert.NoError(t, err)
+	chapterId, err := domain.NewChapterIdObject("CHAPTER")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	search := func(userId domain.UserIdObject, q string) []string {
+		query, err := domain.NewSearchQueryObject(q)
+		assert.NoError(t, err)
+		hits, sErr := s.Search(userId, *query, nil, *limit)
+		assert.Nil(t, sErr)
+		return searchHitKeys(hits)
+	}
+
+	assert.Equal(t, []string{"paper:PROJECT/CHAPTER"}, search(*ownerId, "consists"))
+	assert.Equal(t, []string{"paper:PROJECT/CHAPTER"}, search(*memberId, "consists"))
+
+	content, err := domain.NewPaperContentObject("A tree is a graph without cycles.")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	paperId, err := domain.NewPaperIdObject(chapterId.Value())
+	assert.NoError(t, err)
+	paper := domain.NewPaperWithoutAutofieldEntity(*content)
+
+	ps := mock_service.NewMockPaperService(ctrl)
+	ps.EXPECT().
+		UpdatePaper(*memberId, *projectId, *paperId, *paper, nil).
+		Return(domain.NewPaperEntity(*paperId, *content, *createdAt, *updatedAt), nil)
+
+	_, sErr := service.NewIndexedPaperService(ps, index).UpdatePaper(*memberId, *projectId, *paperId, *paper, nil)
+	assert.Nil(t, sErr)
+	assert.Equal(t, []string{}, search(*ownerId, "consists"))
+	assert.Equal(t, []string{"paper:PROJECT/CHAPTER"}, search(*ownerId, "cycles"))
+	assert.Equal(t, []string{"paper:PROJECT/CHAPTER"}, search(*memberId, "cycles"))
+}
+
+func TestSearchIndexEvictsLeastRecentlyUsedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	r.expectLoad(testutil.ReadOnlyUserId())
+	r.expectLoad(testutil.ModifyOnlyUserId())
+	r.expectLoad(testutil.ReadOnlyUserId())
+	s := r.service(service.NewSearchIndex(service.DefaultSearchIndexTTL, 1))
+
+	query, err := domain.NewSearchQueryObject("graph")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	for _, id := range []string{testutil.ReadOnlyUserId(), testutil.ModifyOnlyUserId(), testutil.ReadOnlyUserId()} {
+		userId, err := domain.NewUserIdObject(id)
+		assert.NoError(t, err)
+
+		hits, sErr := s.Search(*userId, *query, nil, *limit)
+		assert.Nil(t, sErr)
+		assert.Len(t, hits, 3)
+	}
+}
+
+func TestSearchIndexedServicesNotLoaded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := newSearchRepositories(ctrl)
+	index := service.NewSearchIndex(service.DefaultSearchIndexTTL, service.DefaultSearchIndexMaxUsers)
+	s := r.service(index)
+
+	userId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("PROJECT")
+	assert.NoError(t, err)
+
+	ps := mock_service.NewMockProjectService(ctrl)
+	ps.EXPECT().
+		DeleteProject(*userId, *projectId).
+		Return(nil)
+
+	sErr := service.NewIndexedProjectService(ps, index).DeleteProject(*userId, *projectId)
+	assert.Nil(t, sErr)
+
+	r.expectLoad(testutil.ReadOnlyUserId())
+	query, err := domain.NewSearchQueryObject("graph")
+	assert.NoError(t, err)
+	limit, err := domain.NewSearchLimitObject(20)
+	assert.NoError(t, err)
+
+	hits, sErr := s.Search(*userId, *query, nil, *limit)
+	assert.Nil(t, sErr)
+	assert.Len(t, hits, 3)
+}
+
+func searchHitKeys(hits []domain.SearchHitEntity) []string {
+	keys := make([]string, len(hits))
+	for i, hit := range hits {
+		key := hit.Kind() + ":" + hit.ProjectId().Value()
+		if hit.ChapterId() != nil {
+			key += "/" + hit.ChapterId().Value()
+		}
+		if hit.SectionId() != nil {
+			key += "/" + hit.SectionId().Value()
+		}
+		if hit.NodeId() != nil {
+			key += "/" + hit.NodeId().Value()
+		}
+		keys[i] = key
+	}
+	return keys
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+)
+
+type markdownSection struct {
+	name    string
+	content string
+}
+
+type markdownHeading struct {
+	line  int
+	level int
+	name  string
+}
+
+var (
+	markdownFenceRegexp     = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})(.*)$")
+	markdownHeadingRegexp   = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?[ \t]*$`)
+	markdownClosingSequence = regexp.MustCompile(`(?:^|[ \t]+)#+$`)
+)
+
+// sectionalizeMarkdown splits a paper into sections at its section headings.
+// The section level is the shallowest ATX heading level in the paper, except that
+// a heading which is the only one at its level (typically a document title) is
+// skipped in favor of the next deeper level. Text before the first section heading
+// and headings shallower than the section level do not belong to any section.
+func sectionalizeMarkdown(content string) []markdownSection {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	start := markdownFrontMatterEnd(lines)
+	headings := markdownHeadings(lines, start)
+
+	level := markdownSectionLevel(headings)
+	if level == 0 {
+		return []markdownSection{}
+	}
+
+	sections := []markdownSection{}
+	var current *markdownHeading
+	for i := range headings {
+		heading := headings[i]
+		if heading.level > level {
+			continue
+		}
+		if current != nil {
+			sections = append(sections, markdownSection{
+				name:    current.name,
+				content: markdownJoinLines(lines[current.line+1 : heading.line]),
+			})
+		}
+		current = nil
+		if heading.level == level {
+			current = &heading
+		}
+	}
+	if current != nil {
+		sections = append(sections, markdownSection{
+			name:    current.name,
+			content: markdownJoinLines(lines[current.line+1:]),
+		})
+	}
+	return sections
+}
+
+func markdownFrontMatterEnd(lines []string) int {
+	if len(lines) == 0 || strings.TrimRight(lines[0], " \t") != "---" {
+		return 0
+	}
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		if line == "---" || line == "..." {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func markdownHeadings(lines []string, start int) []markdownHeading {
+	headings := []markdownHeading{}
+	fence := ""
+	for i := start; i < len(lines); i++ {
+		line := lines[i]
+
+		if fence != "" {
+			match := markdownFenceRegexp.FindStringSubmatch(line)
+			if match != nil && match[1][0] == fence[0] && len(match[1]) >= len(fence) &&
+				strings.TrimSpace(match[2]) == "" {
+				fence = ""
+			}
+			continue
+		}
+
+		if match := markdownFenceRegexp.FindStringSubmatch(line); match != nil {
+			if match[1][0] != '`' || !strings.Contains(match[2], "`") {
+				fence = match[1]
+				continue
+			}
+		}
+
+		match := markdownHeadingRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		name := strings.TrimSpace(markdownClosingSequence.ReplaceAllString(match[2], ""))
+		if name == "" {
+			continue
+		}
+		headings = append(headings, markdownHeading{line: i, level: len(match[1]), name: name})
+	}
+	return headings
+}
+
+func markdownSectionLevel(headings []markdownHeading) int {
+	counts := map[int]int{}
+	for _, heading := range headings {
+		counts[heading.level]++
+	}
+
+	for level := 1; level <= 6; level++ {
+		if counts[level] == 0 {
+			continue
+		}
+		if counts[level] > 1 || !markdownHasDeeperHeading(counts, level) {
+			return level
+		}
+	}
+	return 0
+}
+
+func markdownHasDeeperHeading(counts map[int]int, level int) bool {
+	for deeper := level + 1; deeper <= 6; deeper++ {
+		if counts[deeper] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func markdownJoinLines(lines []string) string {
+	first := 0
+	for first < len(lines) && strings.TrimSpace(lines[first]) == "" {
+		first++
+	}
+	last := len(lines)
+	for last > first && strings.TrimSpace(lines[last-1]) == "" {
+		last--
+	}
+	return strings.Join(lines[first:last], "\n")
+}
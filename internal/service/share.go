@@ -0,0 +1,146 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ShareService interface {
+	ListShares(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+	) ([]domain.ShareEntity, *Error)
+	CreateShare(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		share domain.ShareWithoutAutofieldEntity,
+	) (*domain.ShareEntity, *Error)
+	RevokeShare(
+		userId domain.UserIdObject,
+		projectId domain.ProjectIdObject,
+		token domain.ShareTokenObject,
+	) *Error
+}
+
+type shareService struct {
+	repository repository.ShareRepository
+}
+
+func NewShareService(repository repository.ShareRepository) ShareService {
+	return shareService{repository: repository}
+}
+
+func (s shareService) ListShares(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+) ([]domain.ShareEntity, *Error) {
+	entries, rErr := s.repository.FetchShares(userId.Value(), projectId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to list shares: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch shares: %w", rErr.Unwrap())
+	}
+
+	shares := []domain.ShareEntity{}
+	for key, entry := range entries {
+		share, sErr := s.entryToEntity(key, entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+
+		shares = append(shares, *share)
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if !shares[i].CreatedAt().Value().Equal(shares[j].CreatedAt().Value()) {
+			return shares[i].CreatedAt().Value().Before(shares[j].CreatedAt().Value())
+		}
+		return shares[i].Token().Value() < shares[j].Token().Value()
+	})
+
+	return shares, nil
+}
+
+func (s shareService) CreateShare(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	share domain.ShareWithoutAutofieldEntity,
+) (*domain.ShareEntity, *Error) {
+	entry := record.ShareWithoutAutofieldEntry{}
+	if share.ChapterId() != nil {
+		entry.ChapterId = share.ChapterId().Value()
+	}
+	if share.ExpiresAt() != nil {
+		if !share.ExpiresAt().Value().After(time.Now()) {
+			err := errors.New("share expiration must be in the future")
+			return nil, Errorf(InvalidArgumentError, "failed to create share: %w", err)
+		}
+
+		expiresAt := share.ExpiresAt().Value().UTC()
+		entry.ExpiresAt = &expiresAt
+	}
+
+	key, created, rErr := s.repository.InsertShare(userId.Value(), projectId.Value(), entry)
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to create share: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to insert share: %w", rErr.Unwrap())
+	}
+
+	return s.entryToEntity(key, *created)
+}
+
+func (s shareService) RevokeShare(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	token domain.ShareTokenObject,
+) *Error {
+	rErr := s.repository.DeleteShare(userId.Value(), projectId.Value(), token.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return Errorf(NotFoundError, "failed to revoke share: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return Errorf(RepositoryFailurePanic, "failed to delete share: %w", rErr.Unwrap())
+	}
+
+	return nil
+}
+
+func (s shareService) entryToEntity(key string, entry record.ShareEntry) (*domain.ShareEntity, *Error) {
+	token, err := domain.NewShareTokenObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (token): %w", err)
+	}
+
+	var chapterId *domain.ChapterIdObject
+	if entry.ChapterId != "" {
+		chapterId, err = domain.NewChapterIdObject(entry.ChapterId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (chapterId): %w", err)
+		}
+	}
+
+	var expiresAt *domain.ExpiresAtObject
+	if entry.ExpiresAt != nil {
+		expiresAt, err = domain.NewExpiresAtObject(*entry.ExpiresAt)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (expiresAt): %w", err)
+		}
+	}
+
+	createdAt, err := domain.NewCreatedAtObject(entry.CreatedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (createdAt): %w", err)
+	}
+
+	return domain.NewShareEntity(*token, chapterId, expiresAt, *createdAt), nil
+}
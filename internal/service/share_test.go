@@ -0,0 +1,270 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSharesValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := testutil.Date().Add(24 * time.Hour)
+
+	r := mock_repository.NewMockShareRepository(ctrl)
+	r.EXPECT().
+		FetchShares(testutil.ModifyOnlyUserId(), "0000000000000001").
+		Return(map[string]record.ShareEntry{
+			"SHARE_TOKEN_2": {
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				ExpiresAt: &expiresAt,
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date().Add(-1 * time.Hour),
+			},
+			"SHARE_TOKEN_1": {
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+				CreatedAt: testutil.Date().Add(-2 * time.Hour),
+			},
+		}, nil)
+
+	s := service.NewShareService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+
+	shares, sErr := s.ListShares(*userId, *projectId)
+	assert.Nil(t, sErr)
+	assert.Len(t, shares, 2)
+
+	share := shares[0]
+	assert.Equal(t, "SHARE_TOKEN_1", share.Token().Value())
+	assert.Nil(t, share.ChapterId())
+	assert.Nil(t, share.ExpiresAt())
+	assert.Equal(t, testutil.Date().Add(-2*time.Hour), share.CreatedAt().Value())
+
+	share = shares[1]
+	assert.Equal(t, "SHARE_TOKEN_2", share.Token().Value())
+	assert.Equal(t, "1000000000000001", share.ChapterId().Value())
+	assert.Equal(t, expiresAt, share.ExpiresAt().Value())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), share.CreatedAt().Value())
+}
+
+func TestListSharesRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return not found error",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to list shares: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			errorCode:     repository.ReadFailurePanic,
+			expectedError: "repository failure: failed to fetch shares: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockShareRepository(ctrl)
+			r.EXPECT().
+				FetchShares(testutil.ModifyOnlyUserId(), "0000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewShareService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			shares, sErr := s.ListShares(*userId, *projectId)
+			assert.Nil(t, shares)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestCreateShareValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC()
+
+	r := mock_repository.NewMockShareRepository(ctrl)
+	r.EXPECT().
+		InsertShare(testutil.ModifyOnlyUserId(), "0000000000000001", record.ShareWithoutAutofieldEntry{
+			ChapterId: "1000000000000001",
+			ExpiresAt: &expiresAt,
+		}).
+		Return("SHARE_TOKEN", &record.ShareEntry{
+			ProjectId: "0000000000000001",
+			ChapterId: "1000000000000001",
+			ExpiresAt: &expiresAt,
+			UserId:    testutil.ModifyOnlyUserId(),
+			CreatedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewShareService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	expiresAtObject, err := domain.NewExpiresAtObject(expiresAt)
+	assert.Nil(t, err)
+
+	share, sErr := s.CreateShare(*userId, *projectId, *domain.NewShareWithoutAutofieldEntity(chapterId, expiresAtObject))
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "SHARE_TOKEN", share.Token().Value())
+	assert.Equal(t, "1000000000000001", share.ChapterId().Value())
+	assert.Equal(t, expiresAt, share.ExpiresAt().Value())
+	assert.Equal(t, testutil.Date(), share.CreatedAt().Value())
+}
+
+func TestCreateShareExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockShareRepository(ctrl)
+
+	s := service.NewShareService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.Nil(t, err)
+	expiresAt, err := domain.NewExpiresAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	share, sErr := s.CreateShare(*userId, *projectId, *domain.NewShareWithoutAutofieldEntity(nil, expiresAt))
+	assert.Nil(t, share)
+	assert.Equal(t, service.InvalidArgumentError, sErr.Code())
+	assert.Equal(t, "invalid argument: failed to create share: share expiration must be in the future", sErr.Error())
+}
+
+func TestCreateShareRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return not found error",
+			errorCode:     repository.NotFoundError,
+			expectedError: "not found: failed to create share: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			errorCode:     repository.WriteFailurePanic,
+			expectedError: "repository failure: failed to insert share: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockShareRepository(ctrl)
+			r.EXPECT().
+				InsertShare(testutil.ModifyOnlyUserId(), "0000000000000001", record.ShareWithoutAutofieldEntry{}).
+				Return("", nil, repository.Errorf(tc.errorCode, "repository error"))
+
+			s := service.NewShareService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+
+			share, sErr := s.CreateShare(*userId, *projectId, *domain.NewShareWithoutAutofieldEntity(nil, nil))
+			assert.Nil(t, share)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestRevokeShare(t *testing.T) {
+	tt := []struct {
+		name          string
+		err           *repository.Error
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name: "should revoke share",
+		},
+		{
+			name:          "should return not found error",
+			err:           repository.Errorf(repository.NotFoundError, "repository error"),
+			expectedError: "not found: failed to revoke share: repository error",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return repository failure panic",
+			err:           repository.Errorf(repository.WriteFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to delete share: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockShareRepository(ctrl)
+			r.EXPECT().
+				DeleteShare(testutil.ModifyOnlyUserId(), "0000000000000001", "SHARE_TOKEN").
+				Return(tc.err)
+
+			s := service.NewShareService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.Nil(t, err)
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+			assert.Nil(t, err)
+
+			sErr := s.RevokeShare(*userId, *projectId, *token)
+			if tc.err == nil {
+				assert.Nil(t, sErr)
+				return
+			}
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type TrashService interface {
+	ListTrashItems(
+		userId domain.UserIdObject,
+	) ([]domain.TrashItemEntity, *Error)
+	RestoreTrashItem(
+		userId domain.UserIdObject,
+		itemId domain.TrashItemIdObject,
+	) (*domain.TrashItemEntity, *Error)
+	PurgeTrashItems(maxAge time.Duration) (int, *Error)
+	ResumePurgingTrashItems() (int, *Error)
+}
+
+type trashService struct {
+	repository repository.TrashRepository
+}
+
+func NewTrashService(repository repository.TrashRepository) TrashService {
+	return trashService{repository: repository}
+}
+
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+func (s trashService) ListTrashItems(
+	userId domain.UserIdObject,
+) ([]domain.TrashItemEntity, *Error) {
+	entries, rErr := s.repository.FetchTrashItems(userId.Value())
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to fetch trash items: %w", rErr.Unwrap())
+	}
+
+	items := []domain.TrashItemEntity{}
+	for key, entry := range entries {
+		item, sErr := s.entryToEntity(key, entry)
+		if sErr != nil {
+			return nil, sErr
+		}
+
+		items = append(items, *item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		ikey := items[i].DeletedAt().Value()
+		jkey := items[j].DeletedAt().Value()
+		if !ikey.Equal(jkey) {
+			return ikey.After(jkey)
+		}
+		return items[i].Id().Value() > items[j].Id().Value()
+	})
+
+	return items, nil
+}
+
+func (s trashService) RestoreTrashItem(
+	userId domain.UserIdObject,
+	itemId domain.TrashItemIdObject,
+) (*domain.TrashItemEntity, *Error) {
+	entry, rErr := s.repository.RestoreTrashItem(userId.Value(), itemId.Value())
+	if rErr != nil && rErr.Code() == repository.NotFoundError {
+		return nil, Errorf(NotFoundError, "failed to restore trash item: %w", rErr.Unwrap())
+	}
+	if rErr != nil {
+		return nil, Errorf(RepositoryFailurePanic, "failed to restore trash item: %w", rErr.Unwrap())
+	}
+
+	return s.entryToEntity(itemId.Value(), *entry)
+}
+
+func (s trashService) PurgeTrashItems(maxAge time.Duration) (int, *Error) {
+	entries, rErr := s.repository.FetchExpiredTrashItems(time.Now().Add(-maxAge))
+	if rErr != nil {
+		return 0, Errorf(RepositoryFailurePanic, "failed to fetch expired trash items: %w", rErr.Unwrap())
+	}
+
+	return s.purgeEntries(entries)
+}
+
+func (s trashService) ResumePurgingTrashItems() (int, *Error) {
+	entries, rErr := s.repository.FetchPurgingTrashItems()
+	if rErr != nil {
+		return 0, Errorf(RepositoryFailurePanic, "failed to fetch purging trash items: %w", rErr.Unwrap())
+	}
+
+	return s.purgeEntries(entries)
+}
+
+func (s trashService) purgeEntries(entries map[string]record.TrashItemEntry) (int, *Error) {
+	// purges ancestors first; their descendants' entries are removed along with them
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		iorder := trashItemTypeOrder[entries[keys[i]].Type]
+		jorder := trashItemTypeOrder[entries[keys[j]].Type]
+		if iorder != jorder {
+			return iorder < jorder
+		}
+		return keys[i] < keys[j]
+	})
+
+	// an item which fails to be purged is skipped, so that it does not hold back the others
+	total := 0
+	var errs []error
+	for _, key := range keys {
+		count, rErr := s.repository.PurgeTrashItem(entries[key].UserId, key)
+		total += count
+		if rErr != nil && rErr.Code() == repository.NotFoundError {
+			continue
+		}
+		if rErr != nil {
+			log.Printf("Encountered error while purging trash item %s: %v", key, rErr)
+			errs = append(errs, fmt.Errorf("failed to purge trash item %s: %w", key, rErr.Unwrap()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return total, Errorf(RepositoryFailurePanic, "failed to purge trash items: %w", errors.Join(errs...))
+	}
+	return total, nil
+}
+
+var trashItemTypeOrder = map[string]int{
+	repository.TrashItemTypeProject: 0,
+	repository.TrashItemTypeChapter: 1,
+	repository.TrashItemTypeSection: 2,
+}
+
+func (s trashService) entryToEntity(key string, entry record.TrashItemEntry) (*domain.TrashItemEntity, *Error) {
+	id, err := domain.NewTrashItemIdObject(key)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (id): %w", err)
+	}
+	itemType, err := domain.NewTrashItemTypeObject(entry.Type)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (type): %w", err)
+	}
+	name, err := domain.NewTrashItemNameObject(entry.Name)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (name): %w", err)
+	}
+	projectId, err := domain.NewProjectIdObject(entry.ProjectId)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (projectId): %w", err)
+	}
+	var chapterId *domain.ChapterIdObject
+	if entry.ChapterId != "" {
+		chapterId, err = domain.NewChapterIdObject(entry.ChapterId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (chapterId): %w", err)
+		}
+	}
+	var sectionId *domain.SectionIdObject
+	if entry.SectionId != "" {
+		sectionId, err = domain.NewSectionIdObject(entry.SectionId)
+		if err != nil {
+			return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (sectionId): %w", err)
+		}
+	}
+	deletedAt, err := domain.NewDeletedAtObject(entry.DeletedAt)
+	if err != nil {
+		return nil, Errorf(DomainFailurePanic, "failed to convert entry to entity (deletedAt): %w", err)
+	}
+
+	return domain.NewTrashItemEntity(*id, *itemType, *name, *projectId, chapterId, sectionId, *deletedAt), nil
+}
@@ -0,0 +1,499 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/record"
+	"github.com/kumachan-mis/knodeledge-api/internal/repository"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	mock_repository "github.com/kumachan-mis/knodeledge-api/mock/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTrashItemsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchTrashItems(testutil.ModifyOnlyUserId()).
+		Return(map[string]record.TrashItemEntry{
+			"3000000000000001": {
+				Type:      repository.TrashItemTypeProject,
+				Name:      "Project Name",
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+				DeletedAt: testutil.Date().Add(-2 * time.Hour),
+			},
+			"3000000000000002": {
+				Type:      repository.TrashItemTypeChapter,
+				Name:      "Chapter Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000001",
+				Position:  1,
+				UserId:    testutil.ModifyOnlyUserId(),
+				DeletedAt: testutil.Date().Add(-1 * time.Hour),
+			},
+			"3000000000000003": {
+				Type:      repository.TrashItemTypeSection,
+				Name:      "Section Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000002",
+				SectionId: "2000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+				DeletedAt: testutil.Date().Add(-3 * time.Hour),
+			},
+		}, nil)
+
+	s := service.NewTrashService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+
+	items, sErr := s.ListTrashItems(*userId)
+	assert.Nil(t, sErr)
+
+	assert.Len(t, items, 3)
+
+	item := items[0]
+	assert.Equal(t, "3000000000000002", item.Id().Value())
+	assert.Equal(t, "chapter", item.Type().Value())
+	assert.Equal(t, "Chapter Name", item.Name().Value())
+	assert.Equal(t, "0000000000000002", item.ProjectId().Value())
+	assert.Equal(t, "1000000000000001", item.ChapterId().Value())
+	assert.Nil(t, item.SectionId())
+	assert.Equal(t, testutil.Date().Add(-1*time.Hour), item.DeletedAt().Value())
+
+	item = items[1]
+	assert.Equal(t, "3000000000000001", item.Id().Value())
+	assert.Equal(t, "project", item.Type().Value())
+	assert.Equal(t, "Project Name", item.Name().Value())
+	assert.Equal(t, "0000000000000001", item.ProjectId().Value())
+	assert.Nil(t, item.ChapterId())
+	assert.Nil(t, item.SectionId())
+	assert.Equal(t, testutil.Date().Add(-2*time.Hour), item.DeletedAt().Value())
+
+	item = items[2]
+	assert.Equal(t, "3000000000000003", item.Id().Value())
+	assert.Equal(t, "section", item.Type().Value())
+	assert.Equal(t, "Section Name", item.Name().Value())
+	assert.Equal(t, "0000000000000002", item.ProjectId().Value())
+	assert.Equal(t, "1000000000000002", item.ChapterId().Value())
+	assert.Equal(t, "2000000000000001", item.SectionId().Value())
+	assert.Equal(t, testutil.Date().Add(-3*time.Hour), item.DeletedAt().Value())
+}
+
+func TestListTrashItemsNoEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchTrashItems(testutil.ModifyOnlyUserId()).
+		Return(map[string]record.TrashItemEntry{}, nil)
+
+	s := service.NewTrashService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+
+	items, sErr := s.ListTrashItems(*userId)
+	assert.Nil(t, sErr)
+	assert.Len(t, items, 0)
+}
+
+func TestListTrashItemsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchTrashItems(testutil.ModifyOnlyUserId()).
+		Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+
+	s := service.NewTrashService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+
+	items, sErr := s.ListTrashItems(*userId)
+	assert.Nil(t, items)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to fetch trash items: repository error", sErr.Error())
+}
+
+func TestListTrashItemsInvalidEntry(t *testing.T) {
+	tt := []struct {
+		name          string
+		key           string
+		entry         record.TrashItemEntry
+		expectedError string
+	}{
+		{
+			name: "should return error when type is unknown",
+			key:  "3000000000000001",
+			entry: record.TrashItemEntry{
+				Type:      "paper",
+				Name:      "Paper Name",
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			expectedError: "domain failure: failed to convert entry to entity (type): " +
+				"trash item type is unknown, but got 'paper'",
+		},
+		{
+			name: "should return error when name is empty",
+			key:  "3000000000000001",
+			entry: record.TrashItemEntry{
+				Type:      repository.TrashItemTypeProject,
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			expectedError: "domain failure: failed to convert entry to entity (name): " +
+				"trash item name is required, but got ''",
+		},
+		{
+			name: "should return error when project id is empty",
+			key:  "3000000000000001",
+			entry: record.TrashItemEntry{
+				Type:   repository.TrashItemTypeProject,
+				Name:   "Project Name",
+				UserId: testutil.ModifyOnlyUserId(),
+			},
+			expectedError: "domain failure: failed to convert entry to entity (projectId): " +
+				"project id is required, but got ''",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockTrashRepository(ctrl)
+			r.EXPECT().
+				FetchTrashItems(testutil.ModifyOnlyUserId()).
+				Return(map[string]record.TrashItemEntry{tc.key: tc.entry}, nil)
+
+			s := service.NewTrashService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.NoError(t, err)
+
+			items, sErr := s.ListTrashItems(*userId)
+			assert.Nil(t, items)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.DomainFailurePanic, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestRestoreTrashItemValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		RestoreTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+		Return(&record.TrashItemEntry{
+			Type:      repository.TrashItemTypeSection,
+			Name:      "Section Name",
+			ProjectId: "0000000000000001",
+			ChapterId: "1000000000000001",
+			SectionId: "2000000000000001",
+			Position:  2,
+			UserId:    testutil.ModifyOnlyUserId(),
+			DeletedAt: testutil.Date(),
+		}, nil)
+
+	s := service.NewTrashService(r)
+
+	userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+	itemId, err := domain.NewTrashItemIdObject("3000000000000001")
+	assert.NoError(t, err)
+
+	item, sErr := s.RestoreTrashItem(*userId, *itemId)
+	assert.Nil(t, sErr)
+
+	assert.Equal(t, "3000000000000001", item.Id().Value())
+	assert.Equal(t, "section", item.Type().Value())
+	assert.Equal(t, "Section Name", item.Name().Value())
+	assert.Equal(t, "0000000000000001", item.ProjectId().Value())
+	assert.Equal(t, "1000000000000001", item.ChapterId().Value())
+	assert.Equal(t, "2000000000000001", item.SectionId().Value())
+	assert.Equal(t, testutil.Date(), item.DeletedAt().Value())
+}
+
+func TestRestoreTrashItemRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     repository.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  service.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns not found error",
+			errorCode:     repository.NotFoundError,
+			errorMessage:  "failed to fetch trash item",
+			expectedError: "not found: failed to restore trash item: failed to fetch trash item",
+			expectedCode:  service.NotFoundError,
+		},
+		{
+			name:          "should return error when repository returns write failure error",
+			errorCode:     repository.WriteFailurePanic,
+			errorMessage:  "repository error",
+			expectedError: "repository failure: failed to restore trash item: repository error",
+			expectedCode:  service.RepositoryFailurePanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockTrashRepository(ctrl)
+			r.EXPECT().
+				RestoreTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+				Return(nil, repository.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			s := service.NewTrashService(r)
+
+			userId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+			assert.NoError(t, err)
+			itemId, err := domain.NewTrashItemIdObject("3000000000000001")
+			assert.NoError(t, err)
+
+			item, sErr := s.RestoreTrashItem(*userId, *itemId)
+			assert.Nil(t, item)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, tc.expectedCode, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
+
+func TestPurgeTrashItemsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchExpiredTrashItems(gomock.Any()).
+		Do(func(deletedBefore time.Time) {
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), deletedBefore, time.Minute)
+		}).
+		Return(map[string]record.TrashItemEntry{
+			"3000000000000001": {
+				Type:      repository.TrashItemTypeSection,
+				Name:      "Section Name",
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			"3000000000000002": {
+				Type:      repository.TrashItemTypeProject,
+				Name:      "Project Name",
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			"3000000000000003": {
+				Type:      repository.TrashItemTypeChapter,
+				Name:      "Chapter Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000002",
+				UserId:    testutil.ReadOnlyUserId(),
+			},
+		}, nil)
+	gomock.InOrder(
+		r.EXPECT().
+			PurgeTrashItem(testutil.ModifyOnlyUserId(), "3000000000000002").
+			Return(5, nil),
+		r.EXPECT().
+			PurgeTrashItem(testutil.ReadOnlyUserId(), "3000000000000003").
+			Return(3, nil),
+		r.EXPECT().
+			PurgeTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+			Return(0, repository.Errorf(repository.NotFoundError, "failed to fetch trash item")),
+	)
+
+	s := service.NewTrashService(r)
+
+	count, sErr := s.PurgeTrashItems(24 * time.Hour)
+	assert.Nil(t, sErr)
+	assert.Equal(t, 8, count)
+}
+
+func TestPurgeTrashItemsPartialRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchExpiredTrashItems(gomock.Any()).
+		Return(map[string]record.TrashItemEntry{
+			"3000000000000001": {
+				Type:      repository.TrashItemTypeProject,
+				Name:      "Project Name",
+				ProjectId: "0000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			"3000000000000002": {
+				Type:      repository.TrashItemTypeChapter,
+				Name:      "Chapter Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000002",
+				UserId:    testutil.ReadOnlyUserId(),
+			},
+			"3000000000000003": {
+				Type:      repository.TrashItemTypeSection,
+				Name:      "Section Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000002",
+				SectionId: "2000000000000002",
+				UserId:    testutil.ReadOnlyUserId(),
+			},
+		}, nil)
+	gomock.InOrder(
+		r.EXPECT().
+			PurgeTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+			Return(2, repository.Errorf(repository.WriteFailurePanic, "repository error")),
+		r.EXPECT().
+			PurgeTrashItem(testutil.ReadOnlyUserId(), "3000000000000002").
+			Return(3, nil),
+		r.EXPECT().
+			PurgeTrashItem(testutil.ReadOnlyUserId(), "3000000000000003").
+			Return(0, repository.Errorf(repository.NotFoundError, "failed to fetch trash item")),
+	)
+
+	s := service.NewTrashService(r)
+
+	count, sErr := s.PurgeTrashItems(24 * time.Hour)
+	assert.Equal(t, 5, count)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to purge trash items: "+
+		"failed to purge trash item 3000000000000001: repository error", sErr.Error())
+}
+
+func TestResumePurgingTrashItemsValidEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchPurgingTrashItems().
+		Return(map[string]record.TrashItemEntry{
+			"3000000000000001": {
+				Type:      repository.TrashItemTypeSection,
+				Name:      "Section Name",
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+				UserId:    testutil.ModifyOnlyUserId(),
+			},
+			"3000000000000002": {
+				Type:      repository.TrashItemTypeChapter,
+				Name:      "Chapter Name",
+				ProjectId: "0000000000000002",
+				ChapterId: "1000000000000002",
+				UserId:    testutil.ReadOnlyUserId(),
+			},
+		}, nil)
+	gomock.InOrder(
+		r.EXPECT().
+			PurgeTrashItem(testutil.ReadOnlyUserId(), "3000000000000002").
+			Return(3, nil),
+		r.EXPECT().
+			PurgeTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+			Return(2, nil),
+	)
+
+	s := service.NewTrashService(r)
+
+	count, sErr := s.ResumePurgingTrashItems()
+	assert.Nil(t, sErr)
+	assert.Equal(t, 5, count)
+}
+
+func TestResumePurgingTrashItemsRepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repository.NewMockTrashRepository(ctrl)
+	r.EXPECT().
+		FetchPurgingTrashItems().
+		Return(nil, repository.Errorf(repository.ReadFailurePanic, "repository error"))
+
+	s := service.NewTrashService(r)
+
+	count, sErr := s.ResumePurgingTrashItems()
+	assert.Equal(t, 0, count)
+	assert.NotNil(t, sErr)
+	assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+	assert.Equal(t, "repository failure: failed to fetch purging trash items: repository error", sErr.Error())
+}
+
+func TestPurgeTrashItemsRepositoryError(t *testing.T) {
+	tt := []struct {
+		name          string
+		fetchErr      *repository.Error
+		purgeErr      *repository.Error
+		expectedError string
+	}{
+		{
+			name:          "should return error when repository returns error on fetch",
+			fetchErr:      repository.Errorf(repository.ReadFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to fetch expired trash items: repository error",
+		},
+		{
+			name:          "should return error when repository returns error on purge",
+			purgeErr:      repository.Errorf(repository.WriteFailurePanic, "repository error"),
+			expectedError: "repository failure: failed to purge trash items: failed to purge trash item 3000000000000001: repository error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repository.NewMockTrashRepository(ctrl)
+			if tc.fetchErr != nil {
+				r.EXPECT().
+					FetchExpiredTrashItems(gomock.Any()).
+					Return(nil, tc.fetchErr)
+			} else {
+				r.EXPECT().
+					FetchExpiredTrashItems(gomock.Any()).
+					Return(map[string]record.TrashItemEntry{
+						"3000000000000001": {
+							Type:      repository.TrashItemTypeProject,
+							Name:      "Project Name",
+							ProjectId: "0000000000000001",
+							UserId:    testutil.ModifyOnlyUserId(),
+						},
+					}, nil)
+				r.EXPECT().
+					PurgeTrashItem(testutil.ModifyOnlyUserId(), "3000000000000001").
+					Return(0, tc.purgeErr)
+			}
+
+			s := service.NewTrashService(r)
+
+			_, sErr := s.PurgeTrashItems(24 * time.Hour)
+			assert.NotNil(t, sErr)
+			assert.Equal(t, service.RepositoryFailurePanic, sErr.Code())
+			assert.Equal(t, tc.expectedError, sErr.Error())
+		})
+	}
+}
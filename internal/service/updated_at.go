@@ -0,0 +1,15 @@
+package service
+
+import (
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+)
+
+func updatedAtToTime(updatedAt *domain.UpdatedAtObject) *time.Time {
+	if updatedAt == nil {
+		return nil
+	}
+	value := updatedAt.Value()
+	return &value
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type AccessTokenUseCase interface {
+	ListAccessTokens(req openapi.AccessTokenListRequest) (
+		*openapi.AccessTokenListResponse, *Error[openapi.AccessTokenListErrorResponse])
+	CreateAccessToken(req openapi.AccessTokenCreateRequest) (
+		*openapi.AccessTokenCreateResponse, *Error[openapi.AccessTokenCreateErrorResponse])
+	RevokeAccessToken(req openapi.AccessTokenRevokeRequest) *Error[openapi.AccessTokenRevokeErrorResponse]
+}
+
+type accessTokenUseCase struct {
+	service service.AccessTokenService
+}
+
+func NewAccessTokenUseCase(service service.AccessTokenService) AccessTokenUseCase {
+	return accessTokenUseCase{service: service}
+}
+
+func (uc accessTokenUseCase) ListAccessTokens(req openapi.AccessTokenListRequest) (
+	*openapi.AccessTokenListResponse, *Error[openapi.AccessTokenListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+
+	if userIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.AccessTokenListErrorResponse{
+				UserId: userIdErr.Error(),
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListAccessTokens(*userId)
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.AccessTokenListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	accessTokens := make([]openapi.AccessToken, len(entities))
+	for i, entity := range entities {
+		accessTokens[i] = uc.entityToAccessToken(entity)
+	}
+	return &openapi.AccessTokenListResponse{AccessTokens: accessTokens}, nil
+}
+
+func (uc accessTokenUseCase) CreateAccessToken(req openapi.AccessTokenCreateRequest) (
+	*openapi.AccessTokenCreateResponse, *Error[openapi.AccessTokenCreateErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	name, nameErr := domain.NewAccessTokenNameObject(req.AccessToken.Name)
+	scope, scopeErr := domain.NewAccessTokenScopeObject(req.AccessToken.Scope)
+
+	var projectId *domain.ProjectIdObject
+	var projectIdErr error
+	if req.AccessToken.ProjectId != "" {
+		projectId, projectIdErr = domain.NewProjectIdObject(req.AccessToken.ProjectId)
+	}
+
+	var expiresAt *domain.ExpiresAtObject
+	var expiresAtErr error
+	if req.AccessToken.ExpiresAt != nil && !req.AccessToken.ExpiresAt.IsZero() {
+		expiresAt, expiresAtErr = domain.NewExpiresAtObject(*req.AccessToken.ExpiresAt)
+	}
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	nameMsg := ""
+	if nameErr != nil {
+		nameMsg = nameErr.Error()
+	}
+	scopeMsg := ""
+	if scopeErr != nil {
+		scopeMsg = scopeErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	expiresAtMsg := ""
+	if expiresAtErr != nil {
+		expiresAtMsg = expiresAtErr.Error()
+	}
+
+	if userIdErr != nil || nameErr != nil || scopeErr != nil || projectIdErr != nil || expiresAtErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.AccessTokenCreateErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				AccessToken: openapi.AccessTokenWithoutAutofieldError{
+					Name:      nameMsg,
+					Scope:     scopeMsg,
+					ProjectId: projectIdMsg,
+					ExpiresAt: expiresAtMsg,
+				},
+			},
+		)
+	}
+
+	accessToken := domain.NewAccessTokenWithoutAutofieldEntity(*name, *scope, projectId, expiresAt)
+
+	entity, secret, sErr := uc.service.CreateAccessToken(*userId, *accessToken)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.AccessTokenCreateErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.AccessTokenCreateErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.AccessTokenCreateErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.AccessTokenCreateResponse{
+		AccessToken: uc.entityToAccessToken(*entity),
+		Secret:      secret.Value(),
+	}, nil
+}
+
+func (uc accessTokenUseCase) RevokeAccessToken(
+	req openapi.AccessTokenRevokeRequest) *Error[openapi.AccessTokenRevokeErrorResponse] {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	accessTokenId, accessTokenIdErr := domain.NewAccessTokenIdObject(req.AccessToken.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	accessTokenIdMsg := ""
+	if accessTokenIdErr != nil {
+		accessTokenIdMsg = accessTokenIdErr.Error()
+	}
+
+	if userIdErr != nil || accessTokenIdErr != nil {
+		return NewModelBasedError(
+			DomainValidationError,
+			openapi.AccessTokenRevokeErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				AccessToken: openapi.AccessTokenOnlyIdError{
+					Id: accessTokenIdMsg,
+				},
+			},
+		)
+	}
+
+	sErr := uc.service.RevokeAccessToken(*userId, *accessTokenId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.AccessTokenRevokeErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return NewMessageBasedError[openapi.AccessTokenRevokeErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return nil
+}
+
+func (uc accessTokenUseCase) entityToAccessToken(entity domain.AccessTokenEntity) openapi.AccessToken {
+	accessToken := openapi.AccessToken{
+		Id:        entity.Id().Value(),
+		Name:      entity.Name().Value(),
+		Scope:     entity.Scope().Value(),
+		CreatedAt: entity.CreatedAt().Value(),
+	}
+	if entity.ProjectId() != nil {
+		accessToken.ProjectId = entity.ProjectId().Value()
+	}
+	if entity.ExpiresAt() != nil {
+		expiresAt := entity.ExpiresAt().Value()
+		accessToken.ExpiresAt = &expiresAt
+	}
+	return accessToken
+}
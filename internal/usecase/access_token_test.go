@@ -0,0 +1,401 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAccessTokensValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	readId, err := domain.NewAccessTokenIdObject("READ_ACCESS_TOKEN")
+	assert.NoError(t, err)
+	readName, err := domain.NewAccessTokenNameObject("Backup")
+	assert.NoError(t, err)
+	readScope, err := domain.NewAccessTokenScopeObject("read")
+	assert.NoError(t, err)
+	writeId, err := domain.NewAccessTokenIdObject("WRITE_ACCESS_TOKEN")
+	assert.NoError(t, err)
+	writeName, err := domain.NewAccessTokenNameObject("CI")
+	assert.NoError(t, err)
+	writeScope, err := domain.NewAccessTokenScopeObject("write")
+	assert.NoError(t, err)
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	expiresAt, err := domain.NewExpiresAtObject(testutil.Date().Add(24 * time.Hour))
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockAccessTokenService(ctrl)
+	s.EXPECT().
+		ListAccessTokens(gomock.Any()).
+		Do(func(userId domain.UserIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+		}).
+		Return([]domain.AccessTokenEntity{
+			*domain.NewAccessTokenEntity(*readId, *readName, *readScope, nil, nil, *createdAt),
+			*domain.NewAccessTokenEntity(*writeId, *writeName, *writeScope, projectId, expiresAt, *createdAt),
+		}, nil)
+
+	uc := usecase.NewAccessTokenUseCase(s)
+
+	res, ucErr := uc.ListAccessTokens(openapi.AccessTokenListRequest{
+		UserId: testutil.ModifyOnlyUserId(),
+	})
+	assert.Nil(t, ucErr)
+
+	expectedExpiresAt := testutil.Date().Add(24 * time.Hour)
+	assert.Equal(t, &openapi.AccessTokenListResponse{
+		AccessTokens: []openapi.AccessToken{
+			{Id: "READ_ACCESS_TOKEN", Name: "Backup", Scope: "read", CreatedAt: testutil.Date()},
+			{
+				Id:        "WRITE_ACCESS_TOKEN",
+				Name:      "CI",
+				Scope:     "write",
+				ProjectId: "0000000000000001",
+				ExpiresAt: &expectedExpiresAt,
+				CreatedAt: testutil.Date(),
+			},
+		},
+	}, res)
+}
+
+func TestListAccessTokensDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockAccessTokenService(ctrl)
+
+	uc := usecase.NewAccessTokenUseCase(s)
+
+	res, ucErr := uc.ListAccessTokens(openapi.AccessTokenListRequest{})
+
+	expected := openapi.AccessTokenListErrorResponse{
+		UserId: "user id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestListAccessTokensServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockAccessTokenService(ctrl)
+	s.EXPECT().
+		ListAccessTokens(gomock.Any()).
+		Return(nil, service.Errorf(service.RepositoryFailurePanic, "service error"))
+
+	uc := usecase.NewAccessTokenUseCase(s)
+
+	res, ucErr := uc.ListAccessTokens(openapi.AccessTokenListRequest{
+		UserId: testutil.ModifyOnlyUserId(),
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, "internal error: service error", ucErr.Error())
+	assert.Equal(t, usecase.InternalErrorPanic, ucErr.Code())
+}
+
+func TestCreateAccessTokenValidEntity(t *testing.T) {
+	expiresAt := testutil.Date().Add(24 * time.Hour)
+
+	tt := []struct {
+		name        string
+		accessToken openapi.AccessTokenWithoutAutofield
+		expected    openapi.AccessToken
+	}{
+		{
+			name: "should create read-only access token of all projects without expiration",
+			accessToken: openapi.AccessTokenWithoutAutofield{
+				Name:  "Backup",
+				Scope: "read",
+			},
+			expected: openapi.AccessToken{
+				Id:        "ACCESS_TOKEN",
+				Name:      "Backup",
+				Scope:     "read",
+				CreatedAt: testutil.Date(),
+			},
+		},
+		{
+			name: "should create read-write access token of project with expiration",
+			accessToken: openapi.AccessTokenWithoutAutofield{
+				Name:      "CI",
+				Scope:     "write",
+				ProjectId: "0000000000000001",
+				ExpiresAt: &expiresAt,
+			},
+			expected: openapi.AccessToken{
+				Id:        "ACCESS_TOKEN",
+				Name:      "CI",
+				Scope:     "write",
+				ProjectId: "0000000000000001",
+				ExpiresAt: &expiresAt,
+				CreatedAt: testutil.Date(),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id, err := domain.NewAccessTokenIdObject("ACCESS_TOKEN")
+			assert.NoError(t, err)
+			secret, err := domain.NewAccessTokenSecretObject(domain.AccessTokenSecretPrefix + "SECRET")
+			assert.NoError(t, err)
+			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+
+			s := mock_service.NewMockAccessTokenService(ctrl)
+			s.EXPECT().
+				CreateAccessToken(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					userId domain.UserIdObject,
+					accessToken domain.AccessTokenWithoutAutofieldEntity,
+				) (*domain.AccessTokenEntity, *domain.AccessTokenSecretObject, *service.Error) {
+					assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+					return domain.NewAccessTokenEntity(
+						*id,
+						*accessToken.Name(),
+						*accessToken.Scope(),
+						accessToken.ProjectId(),
+						accessToken.ExpiresAt(),
+						*createdAt,
+					), secret, nil
+				})
+
+			uc := usecase.NewAccessTokenUseCase(s)
+
+			res, ucErr := uc.CreateAccessToken(openapi.AccessTokenCreateRequest{
+				User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				AccessToken: tc.accessToken,
+			})
+			assert.Nil(t, ucErr)
+
+			assert.Equal(t, &openapi.AccessTokenCreateResponse{
+				AccessToken: tc.expected,
+				Secret:      domain.AccessTokenSecretPrefix + "SECRET",
+			}, res)
+		})
+	}
+}
+
+func TestCreateAccessTokenDomainValidationError(t *testing.T) {
+	tooLongName := testutil.RandomString(101)
+
+	tt := []struct {
+		name     string
+		request  openapi.AccessTokenCreateRequest
+		expected openapi.AccessTokenCreateErrorResponse
+	}{
+		{
+			name: "should return error when access token name is too long",
+			request: openapi.AccessTokenCreateRequest{
+				User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				AccessToken: openapi.AccessTokenWithoutAutofield{Name: tooLongName, Scope: "read"},
+			},
+			expected: openapi.AccessTokenCreateErrorResponse{
+				AccessToken: openapi.AccessTokenWithoutAutofieldError{
+					Name: fmt.Sprintf("access token name cannot be longer than 100 characters, but got '%v'", tooLongName),
+				},
+			},
+		},
+		{
+			name: "should return error when all fields are invalid",
+			request: openapi.AccessTokenCreateRequest{
+				AccessToken: openapi.AccessTokenWithoutAutofield{Scope: "admin"},
+			},
+			expected: openapi.AccessTokenCreateErrorResponse{
+				User: openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+				AccessToken: openapi.AccessTokenWithoutAutofieldError{
+					Name:  "access token name is required, but got ''",
+					Scope: "access token scope must be read or write, but got 'admin'",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockAccessTokenService(ctrl)
+
+			uc := usecase.NewAccessTokenUseCase(s)
+
+			res, ucErr := uc.CreateAccessToken(tc.request)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestCreateAccessTokenServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when expiration is in the past",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "access token expiration must be in the future",
+			expectedError: "invalid argument: access token expiration must be in the future",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to create access token",
+			expectedError: "not found: failed to create access token",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockAccessTokenService(ctrl)
+			s.EXPECT().
+				CreateAccessToken(gomock.Any(), gomock.Any()).
+				Return(nil, nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewAccessTokenUseCase(s)
+
+			res, ucErr := uc.CreateAccessToken(openapi.AccessTokenCreateRequest{
+				User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				AccessToken: openapi.AccessTokenWithoutAutofield{Name: "CI", Scope: "write"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestRevokeAccessTokenValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockAccessTokenService(ctrl)
+	s.EXPECT().
+		RevokeAccessToken(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, accessTokenId domain.AccessTokenIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "ACCESS_TOKEN", accessTokenId.Value())
+		}).
+		Return(nil)
+
+	uc := usecase.NewAccessTokenUseCase(s)
+
+	ucErr := uc.RevokeAccessToken(openapi.AccessTokenRevokeRequest{
+		User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		AccessToken: openapi.AccessTokenOnlyId{Id: "ACCESS_TOKEN"},
+	})
+	assert.Nil(t, ucErr)
+}
+
+func TestRevokeAccessTokenDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockAccessTokenService(ctrl)
+
+	uc := usecase.NewAccessTokenUseCase(s)
+
+	ucErr := uc.RevokeAccessToken(openapi.AccessTokenRevokeRequest{})
+
+	expected := openapi.AccessTokenRevokeErrorResponse{
+		User:        openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		AccessToken: openapi.AccessTokenOnlyIdError{Id: "access token id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+}
+
+func TestRevokeAccessTokenServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when access token not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to revoke access token",
+			expectedError: "not found: failed to revoke access token",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockAccessTokenService(ctrl)
+			s.EXPECT().
+				RevokeAccessToken(gomock.Any(), gomock.Any()).
+				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewAccessTokenUseCase(s)
+
+			ucErr := uc.RevokeAccessToken(openapi.AccessTokenRevokeRequest{
+				User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				AccessToken: openapi.AccessTokenOnlyId{Id: "ACCESS_TOKEN"},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
@@ -75,10 +75,11 @@ func (uc chapterUseCase) ListChapters(req openapi.ChapterListRequest) (
 		}
 
 		chapters[i] = openapi.ChapterWithSections{
-			Id:       entity.Id().Value(),
-			Name:     entity.Name().Value(),
-			Number:   int32(entity.Number().Value()),
-			Sections: sections,
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Number:    int32(entity.Number().Value()),
+			Sections:  sections,
+			UpdatedAt: entity.UpdatedAt().Value(),
 		}
 	}
 
@@ -152,10 +153,11 @@ func (uc chapterUseCase) CreateChapter(req openapi.ChapterCreateRequest) (
 
 	return &openapi.ChapterCreateResponse{
 		Chapter: openapi.ChapterWithSections{
-			Id:       chapterEntity.Id().Value(),
-			Name:     chapterEntity.Name().Value(),
-			Number:   int32(chapterEntity.Number().Value()),
-			Sections: []openapi.SectionOfChapter{},
+			Id:        chapterEntity.Id().Value(),
+			Name:      chapterEntity.Name().Value(),
+			Number:    int32(chapterEntity.Number().Value()),
+			Sections:  []openapi.SectionOfChapter{},
+			UpdatedAt: chapterEntity.UpdatedAt().Value(),
 		},
 	}, nil
 }
@@ -167,6 +169,7 @@ func (uc chapterUseCase) UpdateChapter(req openapi.ChapterUpdateRequest) (
 	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
 	chapterName, chapterNameErr := domain.NewChapterNameObject(req.Chapter.Name)
 	chapterNumber, chapterNumberErr := domain.NewChapterNumberObject(int(req.Chapter.Number))
+	updatedAt, updatedAtErr := updatedAtModelToObject(req.Chapter.UpdatedAt)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -189,7 +192,8 @@ func (uc chapterUseCase) UpdateChapter(req openapi.ChapterUpdateRequest) (
 		chapterNumberMsg = chapterNumberErr.Error()
 	}
 
-	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || chapterNameErr != nil || chapterNumberErr != nil {
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil ||
+		chapterNameErr != nil || chapterNumberErr != nil || updatedAtErr != nil {
 		return nil, NewModelBasedError(
 			DomainValidationError,
 			openapi.ChapterUpdateErrorResponse{
@@ -210,7 +214,21 @@ func (uc chapterUseCase) UpdateChapter(req openapi.ChapterUpdateRequest) (
 
 	chapter := domain.NewChapterWithoutAutofieldEntity(*chapterName, *chapterNumber)
 
-	entity, sErr := uc.service.UpdateChapter(*userId, *projectId, *chapterId, *chapter)
+	entity, sErr := uc.service.UpdateChapter(*userId, *projectId, *chapterId, *chapter, updatedAt)
+	if sErr != nil && sErr.Code() == service.ConflictError {
+		return &openapi.ChapterUpdateResponse{
+			Chapter: openapi.ChapterWithSections{
+				Id:        entity.Id().Value(),
+				Name:      entity.Name().Value(),
+				Number:    int32(entity.Number().Value()),
+				Sections:  []openapi.SectionOfChapter{},
+				UpdatedAt: entity.UpdatedAt().Value(),
+			},
+		}, NewMessageBasedError[openapi.ChapterUpdateErrorResponse](
+			ConflictError,
+			sErr.Unwrap().Error(),
+		)
+	}
 	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
 		return nil, NewMessageBasedError[openapi.ChapterUpdateErrorResponse](
 			InvalidArgumentError,
@@ -232,10 +250,11 @@ func (uc chapterUseCase) UpdateChapter(req openapi.ChapterUpdateRequest) (
 
 	return &openapi.ChapterUpdateResponse{
 		Chapter: openapi.ChapterWithSections{
-			Id:       entity.Id().Value(),
-			Name:     entity.Name().Value(),
-			Number:   int32(entity.Number().Value()),
-			Sections: []openapi.SectionOfChapter{},
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Number:    int32(entity.Number().Value()),
+			Sections:  []openapi.SectionOfChapter{},
+			UpdatedAt: entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
@@ -518,13 +519,14 @@ func TestUpdateChapterValidEntity(t *testing.T) {
 			chapter := domain.NewChapterEntity(*id, *name, *number, *sections, *createdAt, *updatedAt)
 
 			s.EXPECT().
-				UpdateChapter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, chapterId domain.ChapterIdObject, chapter domain.ChapterWithoutAutofieldEntity) {
+				UpdateChapter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, chapterId domain.ChapterIdObject, chapter domain.ChapterWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
 					assert.Equal(t, tc.userId, userId.Value())
 					assert.Equal(t, tc.projectId, projectId.Value())
 					assert.Equal(t, tc.chapterId, chapterId.Value())
 					assert.Equal(t, tc.chapter.Name, chapter.Name().Value())
 					assert.Equal(t, int(tc.chapter.Number), chapter.Number().Value())
+					assert.Nil(t, expectedUpdatedAt)
 				}).
 				Return(chapter, nil)
 
@@ -728,7 +730,7 @@ func TestUpdateChapterServiceError(t *testing.T) {
 			s := mock_service.NewMockChapterService(ctrl)
 
 			s.EXPECT().
-				UpdateChapter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				UpdateChapter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
 			uc := usecase.NewChapterUseCase(s)
@@ -754,6 +756,59 @@ func TestUpdateChapterServiceError(t *testing.T) {
 	}
 }
 
+func TestUpdateChapterConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockChapterService(ctrl)
+
+	id, err := domain.NewChapterIdObject("1000000000000001")
+	assert.Nil(t, err)
+	name, err := domain.NewChapterNameObject("Current Chapter")
+	assert.Nil(t, err)
+	number, err := domain.NewChapterNumberObject(2)
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.Nil(t, err)
+
+	chapter := domain.NewChapterEntity(*id, *name, *number, []domain.SectionOfChapterEntity{}, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		UpdateChapter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, chapterId domain.ChapterIdObject,
+			chapter domain.ChapterWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
+			assert.Equal(t, testutil.Date(), expectedUpdatedAt.Value())
+		}).
+		Return(chapter, service.Errorf(service.ConflictError, "chapter has been updated since it was fetched"))
+
+	uc := usecase.NewChapterUseCase(s)
+
+	res, ucErr := uc.UpdateChapter(openapi.ChapterUpdateRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.Chapter{
+			Id:        "1000000000000001",
+			Name:      "Chapter Name",
+			Number:    1,
+			UpdatedAt: testutil.Date(),
+		},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: chapter has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+	assert.Nil(t, ucErr.Response())
+
+	assert.Equal(t, openapi.ChapterWithSections{
+		Id:        "1000000000000001",
+		Name:      "Current Chapter",
+		Number:    2,
+		Sections:  []openapi.SectionOfChapter{},
+		UpdatedAt: testutil.Date().Add(time.Hour),
+	}, res.Chapter)
+}
+
 func TestDeleteChapterValidEntity(t *testing.T) {
 	tt := []struct {
 		name      string
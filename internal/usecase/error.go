@@ -12,6 +12,7 @@ const (
 	DomainValidationError ErrorCode = "domain validation error"
 	InvalidArgumentError  ErrorCode = "invalid argument"
 	NotFoundError         ErrorCode = "not found"
+	ConflictError         ErrorCode = "conflict"
 	InternalErrorPanic    ErrorCode = "internal error"
 )
 
@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/export"
+	"github.com/kumachan-mis/knodeledge-api/internal/importer"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
 )
@@ -11,19 +13,48 @@ import (
 type GraphUseCase interface {
 	FindGraph(request openapi.GraphFindRequest) (
 		*openapi.GraphFindResponse, *Error[openapi.GraphFindErrorResponse])
+	ExportGraph(request openapi.GraphExportRequest) (
+		*openapi.GraphExportResponse, *Error[openapi.GraphExportErrorResponse])
+	ImportGraph(request openapi.GraphImportRequest) (
+		*openapi.GraphImportResponse, *Error[openapi.GraphImportErrorResponse])
 	UpdateGraph(request openapi.GraphUpdateRequest) (
 		*openapi.GraphUpdateResponse, *Error[openapi.GraphUpdateErrorResponse])
+	PatchGraph(request openapi.GraphPatchRequest) (
+		*openapi.GraphUpdateResponse, *Error[openapi.GraphPatchErrorResponse])
 	DeleteGraph(request openapi.GraphDeleteRequest) *Error[openapi.GraphDeleteErrorResponse]
 	SectionalizeGraph(request openapi.GraphSectionalizeRequest) (
 		*openapi.GraphSectionalizeResponse, *Error[openapi.GraphSectionalizeErrorResponse])
+	PreviewResectionalization(request openapi.GraphResectionalizePreviewRequest) (
+		*openapi.GraphResectionalizePreviewResponse, *Error[openapi.GraphResectionalizePreviewErrorResponse])
+	ResectionalizeGraph(request openapi.GraphResectionalizeRequest) (
+		*openapi.GraphResectionalizeResponse, *Error[openapi.GraphResectionalizeErrorResponse])
+	AddGraphNode(request openapi.GraphNodeAddRequest) (
+		*openapi.GraphNodeAddResponse, *Error[openapi.GraphNodeAddErrorResponse])
+	RenameGraphNode(request openapi.GraphNodeRenameRequest) (
+		*openapi.GraphNodeRenameResponse, *Error[openapi.GraphNodeRenameErrorResponse])
+	UpdateGraphNode(request openapi.GraphNodeUpdateRequest) (
+		*openapi.GraphNodeUpdateResponse, *Error[openapi.GraphNodeUpdateErrorResponse])
+	MoveGraphNode(request openapi.GraphNodeMoveRequest) (
+		*openapi.GraphNodeMoveResponse, *Error[openapi.GraphNodeMoveErrorResponse])
+	DeleteGraphNode(request openapi.GraphNodeDeleteRequest) (
+		*openapi.GraphNodeDeleteResponse, *Error[openapi.GraphNodeDeleteErrorResponse])
+	ListGraphRevisions(request openapi.GraphRevisionListRequest) (
+		*openapi.GraphRevisionListResponse, *Error[openapi.GraphRevisionListErrorResponse])
+	FindGraphRevision(request openapi.GraphRevisionFindRequest) (
+		*openapi.GraphRevisionFindResponse, *Error[openapi.GraphRevisionFindErrorResponse])
+	DiffGraphRevisions(request openapi.GraphRevisionDiffRequest) (
+		*openapi.GraphRevisionDiffResponse, *Error[openapi.GraphRevisionDiffErrorResponse])
+	RestoreGraphRevision(request openapi.GraphRevisionRestoreRequest) (
+		*openapi.GraphRevisionRestoreResponse, *Error[openapi.GraphRevisionRestoreErrorResponse])
 }
 
 type graphUseCase struct {
-	service service.GraphService
+	service             service.GraphService
+	projectGraphService service.ProjectGraphService
 }
 
-func NewGraphUseCase(service service.GraphService) GraphUseCase {
-	return graphUseCase{service: service}
+func NewGraphUseCase(service service.GraphService, projectGraphService service.ProjectGraphService) GraphUseCase {
+	return graphUseCase{service: service, projectGraphService: projectGraphService}
 }
 
 func (uc graphUseCase) FindGraph(req openapi.GraphFindRequest) (
@@ -83,18 +114,902 @@ func (uc graphUseCase) FindGraph(req openapi.GraphFindRequest) (
 			Name:      entity.Name().Value(),
 			Paragraph: entity.Paragraph().Value(),
 			Children:  uc.childrenEntityToModel(entity.Children()),
+			UpdatedAt: entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
 
+func (uc graphUseCase) ExportGraph(req openapi.GraphExportRequest) (
+	*openapi.GraphExportResponse, *Error[openapi.GraphExportErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	format, formatErr := domain.NewGraphExportFormatObject(req.Format)
+
+	var chapterId *domain.ChapterIdObject
+	var chapterIdErr error
+	if req.ChapterId != "" || req.SectionId != "" {
+		chapterId, chapterIdErr = domain.NewChapterIdObject(req.ChapterId)
+	}
+	var sectionId *domain.SectionIdObject
+	var sectionIdErr error
+	if req.SectionId != "" {
+		sectionId, sectionIdErr = domain.NewSectionIdObject(req.SectionId)
+	}
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	formatMsg := ""
+	if formatErr != nil {
+		formatMsg = formatErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil || formatErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphExportErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				ChapterId: chapterIdMsg,
+				SectionId: sectionIdMsg,
+				Format:    formatMsg,
+			},
+		)
+	}
+
+	graph, sErr := uc.exportGraph(*userId, *projectId, chapterId, sectionId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphExportErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphExportErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	content, err := export.Render(*graph, format.Value())
+	if err != nil {
+		return nil, NewMessageBasedError[openapi.GraphExportErrorResponse](
+			InternalErrorPanic,
+			err.Error(),
+		)
+	}
+
+	return &openapi.GraphExportResponse{
+		Export: openapi.GraphExport{
+			Format:    format.Value(),
+			MediaType: export.MediaType(format.Value()),
+			Content:   content,
+		},
+	}, nil
+}
+
+func (uc graphUseCase) ImportGraph(req openapi.GraphImportRequest) (
+	*openapi.GraphImportResponse, *Error[openapi.GraphImportErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	format, formatErr := domain.NewGraphImportFormatObject(req.Source.Format)
+	content, contentErr := domain.NewGraphImportContentObject(req.Source.Content)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	formatMsg := ""
+	if formatErr != nil {
+		formatMsg = formatErr.Error()
+	}
+	contentMsg := ""
+	if contentErr != nil {
+		contentMsg = contentErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		formatErr != nil || contentErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphImportErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: userIdMsg},
+				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section: openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Source: openapi.GraphImportSourceError{
+					Format:  formatMsg,
+					Content: contentMsg,
+				},
+			},
+		)
+	}
+
+	nodes, warnings, err := importer.Import(format.Value(), content.Value())
+	if err != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphImportErrorResponse{
+				Source: openapi.GraphImportSourceError{Content: err.Error()},
+			},
+		)
+	}
+
+	current, sErr := uc.service.FindGraph(*userId, *projectId, *chapterId, *sectionId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphImportErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphImportErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	// a single top-level node named after the section is the section itself, as written by export
+	if len(nodes) == 1 && nodes[0].Name == current.Name().Value() {
+		nodes = nodes[0].Children
+	}
+
+	children, nodeErrors := uc.importNodesToEntity(nodes, "document")
+	if len(nodeErrors) > 0 {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphImportErrorResponse{
+				Source: openapi.GraphImportSourceError{Nodes: nodeErrors},
+			},
+		)
+	}
+
+	graph := domain.NewGraphContentEntity(*current.Paragraph(), *children)
+
+	entity, uErr := uc.service.UpdateGraphContent(
+		*userId, *projectId, *chapterId, *current.Id(), *graph, current.UpdatedAt())
+
+	if uErr != nil && uErr.Code() == service.ConflictError {
+		return &openapi.GraphImportResponse{
+			Graph:    uc.graphEntityToModel(entity),
+			Warnings: uc.importWarningsToModel(warnings),
+		}, NewMessageBasedError[openapi.GraphImportErrorResponse](
+			ConflictError,
+			uErr.Unwrap().Error(),
+		)
+	}
+	if uErr != nil && uErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphImportErrorResponse](
+			NotFoundError,
+			uErr.Unwrap().Error(),
+		)
+	}
+	if uErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphImportErrorResponse](
+			InternalErrorPanic,
+			uErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.GraphImportResponse{
+		Graph:    uc.graphEntityToModel(entity),
+		Warnings: uc.importWarningsToModel(warnings),
+	}, nil
+}
+
 func (uc graphUseCase) UpdateGraph(req openapi.GraphUpdateRequest) (
 	*openapi.GraphUpdateResponse, *Error[openapi.GraphUpdateErrorResponse]) {
 	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
 	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
 	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
-	graphId, graphIdErr := domain.NewGraphIdObject(req.Graph.Id)
-	graphParagraph, graphParagraphErr := domain.NewGraphParagraphObject(req.Graph.Paragraph)
-	graphChildren, graphChildrenErr, graphChildrenOk := uc.childrenModelToEntity(req.Graph.Children)
+	graphId, graphIdErr := domain.NewGraphIdObject(req.Graph.Id)
+	graphParagraph, graphParagraphErr := domain.NewGraphParagraphObject(req.Graph.Paragraph)
+	graphChildren, graphChildrenErr, graphChildrenOk := uc.childrenModelToEntity(req.Graph.Children)
+	updatedAt, updatedAtErr := updatedAtModelToObject(req.Graph.UpdatedAt)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	graphIdMsg := ""
+	if graphIdErr != nil {
+		graphIdMsg = graphIdErr.Error()
+	}
+	graphParagraphMsg := ""
+	if graphParagraphErr != nil {
+		graphParagraphMsg = graphParagraphErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil ||
+		graphIdErr != nil || graphParagraphErr != nil || !graphChildrenOk || updatedAtErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphUpdateErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: userIdMsg},
+				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Graph: openapi.GraphContentError{
+					Id:        graphIdMsg,
+					Paragraph: graphParagraphMsg,
+					Children:  *graphChildrenErr,
+				},
+			},
+		)
+	}
+
+	graph := domain.NewGraphContentEntity(*graphParagraph, *graphChildren)
+
+	entity, uErr := uc.service.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph, updatedAt)
+
+	if uErr != nil && uErr.Code() == service.ConflictError {
+		return &openapi.GraphUpdateResponse{
+			Graph: openapi.Graph{
+				Id:        entity.Id().Value(),
+				Name:      entity.Name().Value(),
+				Paragraph: entity.Paragraph().Value(),
+				Children:  uc.childrenEntityToModel(entity.Children()),
+				UpdatedAt: entity.UpdatedAt().Value(),
+			},
+		}, NewMessageBasedError[openapi.GraphUpdateErrorResponse](
+			ConflictError,
+			uErr.Unwrap().Error(),
+		)
+	}
+
+	if uErr != nil && uErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphUpdateErrorResponse](
+			NotFoundError,
+			uErr.Unwrap().Error(),
+		)
+	}
+	if uErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphUpdateErrorResponse](
+			InternalErrorPanic,
+			uErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.GraphUpdateResponse{
+		Graph: openapi.Graph{
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Paragraph: entity.Paragraph().Value(),
+			Children:  uc.childrenEntityToModel(entity.Children()),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc graphUseCase) PatchGraph(req openapi.GraphPatchRequest) (
+	*openapi.GraphUpdateResponse, *Error[openapi.GraphPatchErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.SectionId)
+	operations, patchErr, patchOk := jsonPatchModelToEntity(req.Patch)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil || !patchOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphPatchErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				ChapterId: chapterIdMsg,
+				SectionId: sectionIdMsg,
+				Patch:     *patchErr,
+			},
+		)
+	}
+
+	current, sErr := uc.service.FindGraph(*userId, *projectId, *chapterId, *sectionId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphPatchErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphPatchErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	var content openapi.GraphContentWithoutAutofield
+	patchErr, patchOk = applyJsonPatch(operations, openapi.GraphContentWithoutAutofield{
+		Paragraph: current.Paragraph().Value(),
+		Children:  uc.childrenEntityToModel(current.Children()),
+	}, &content)
+	if !patchOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphPatchErrorResponse{Patch: *patchErr},
+		)
+	}
+
+	graphParagraph, graphParagraphErr := domain.NewGraphParagraphObject(content.Paragraph)
+	graphChildren, graphChildrenErr, graphChildrenOk := uc.childrenModelToEntity(content.Children)
+
+	graphParagraphMsg := ""
+	if graphParagraphErr != nil {
+		graphParagraphMsg = graphParagraphErr.Error()
+	}
+
+	if graphParagraphErr != nil || !graphChildrenOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphPatchErrorResponse{
+				Graph: openapi.GraphContentWithoutAutofieldError{
+					Paragraph: graphParagraphMsg,
+					Children:  *graphChildrenErr,
+				},
+			},
+		)
+	}
+
+	graph := domain.NewGraphContentEntity(*graphParagraph, *graphChildren)
+
+	entity, uErr := uc.service.UpdateGraphContent(
+		*userId, *projectId, *chapterId, *current.Id(), *graph, current.UpdatedAt())
+
+	if uErr != nil && uErr.Code() == service.ConflictError {
+		return &openapi.GraphUpdateResponse{
+			Graph: uc.graphEntityToModel(entity),
+		}, NewMessageBasedError[openapi.GraphPatchErrorResponse](
+			ConflictError,
+			uErr.Unwrap().Error(),
+		)
+	}
+	if uErr != nil && uErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphPatchErrorResponse](
+			NotFoundError,
+			uErr.Unwrap().Error(),
+		)
+	}
+	if uErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphPatchErrorResponse](
+			InternalErrorPanic,
+			uErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.GraphUpdateResponse{
+		Graph: uc.graphEntityToModel(entity),
+	}, nil
+}
+
+func (uc graphUseCase) DeleteGraph(req openapi.GraphDeleteRequest) *Error[openapi.GraphDeleteErrorResponse] {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil {
+		return NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: userIdMsg},
+				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section: openapi.SectionOnlyIdError{Id: sectionIdMsg},
+			},
+		)
+	}
+
+	sErr := uc.service.DeleteGraph(*userId, *projectId, *chapterId, *sectionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.GraphDeleteErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return NewMessageBasedError[openapi.GraphDeleteErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return nil
+}
+
+func (uc graphUseCase) SectionalizeGraph(req openapi.GraphSectionalizeRequest) (
+	*openapi.GraphSectionalizeResponse, *Error[openapi.GraphSectionalizeErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sections, sectionsErr, sectionsOk := uc.sectiionsModelToEntity(req.Sections)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || !sectionsOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphSectionalizeErrorResponse{
+				User:     openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:  openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:  openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Sections: *sectionsErr,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.SectionalizeIntoGraphs(*userId, *projectId, *chapterId, *sections)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	graphs := make([]openapi.Graph, len(entities))
+	for i, entity := range entities {
+		graphs[i] = openapi.Graph{
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Paragraph: entity.Paragraph().Value(),
+			Children:  uc.childrenEntityToModel(entity.Children()),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		}
+	}
+
+	return &openapi.GraphSectionalizeResponse{Graphs: graphs}, nil
+}
+
+func (uc graphUseCase) PreviewResectionalization(req openapi.GraphResectionalizePreviewRequest) (
+	*openapi.GraphResectionalizePreviewResponse, *Error[openapi.GraphResectionalizePreviewErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sections, sectionsErr, sectionsOk := uc.sectiionsModelToEntity(req.Sections)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || !sectionsOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphResectionalizePreviewErrorResponse{
+				User:     openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:  openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:  openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Sections: *sectionsErr,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.PreviewResectionalization(*userId, *projectId, *chapterId, *sections)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphResectionalizePreviewErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphResectionalizePreviewErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	matches := make([]openapi.GraphMatch, len(entity.Matches()))
+	for i, match := range entity.Matches() {
+		matches[i] = openapi.GraphMatch{Name: match.Name().Value()}
+		if match.GraphId() != nil {
+			matches[i].GraphId = match.GraphId().Value()
+		}
+	}
+
+	return &openapi.GraphResectionalizePreviewResponse{
+		Matches: matches,
+		Orphans: uc.graphsEntityToModel(entity.Orphans()),
+	}, nil
+}
+
+func (uc graphUseCase) ResectionalizeGraph(req openapi.GraphResectionalizeRequest) (
+	*openapi.GraphResectionalizeResponse, *Error[openapi.GraphResectionalizeErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sections, sectionsErr, sectionsOk := uc.sectiionsModelToEntity(req.Sections)
+	orphanAction, orphanActionErr := domain.NewGraphOrphanActionObject(req.OrphanAction)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	orphanActionMsg := ""
+	if orphanActionErr != nil {
+		orphanActionMsg = orphanActionErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || !sectionsOk || orphanActionErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphResectionalizeErrorResponse{
+				User:         openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:      openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:      openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Sections:     *sectionsErr,
+				OrphanAction: orphanActionMsg,
+			},
+		)
+	}
+
+	graphs, orphans, sErr := uc.service.ResectionalizeGraphs(*userId, *projectId, *chapterId, *sections, *orphanAction)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphResectionalizeErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.ConflictError {
+		return nil, NewMessageBasedError[openapi.GraphResectionalizeErrorResponse](
+			ConflictError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphResectionalizeErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.GraphResectionalizeResponse{
+		Graphs:  uc.graphsEntityToModel(graphs),
+		Orphans: uc.graphsEntityToModel(orphans),
+	}, nil
+}
+
+func (uc graphUseCase) AddGraphNode(req openapi.GraphNodeAddRequest) (
+	*openapi.GraphNodeAddResponse, *Error[openapi.GraphNodeAddErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	parentPath, parentPathErr := domain.NewGraphPathObject(req.ParentPath)
+	children, childrenErr, childrenOk := uc.childrenModelToEntity([]openapi.GraphChild{req.Child})
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	parentPathMsg := ""
+	if parentPathErr != nil {
+		parentPathMsg = parentPathErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		parentPathErr != nil || !childrenOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphNodeAddErrorResponse{
+				User:       openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:    openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:    openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section:    openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				ParentPath: parentPathMsg,
+				Child:      childrenErr.Items[0],
+			},
+		)
+	}
+
+	entity, sErr := uc.service.AddGraphChild(*userId, *projectId, *chapterId, *sectionId, *parentPath, children.Value()[0])
+	if sErr != nil {
+		return nil, graphNodeErrorToUseCaseError[openapi.GraphNodeAddErrorResponse](sErr)
+	}
+
+	return &openapi.GraphNodeAddResponse{Graph: uc.graphEntityToModel(entity)}, nil
+}
+
+func (uc graphUseCase) RenameGraphNode(req openapi.GraphNodeRenameRequest) (
+	*openapi.GraphNodeRenameResponse, *Error[openapi.GraphNodeRenameErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	path, pathErr := domain.NewGraphPathObject(req.Path)
+	name, nameErr := domain.NewGraphNameObject(req.Name)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	pathMsg := ""
+	if pathErr != nil {
+		pathMsg = pathErr.Error()
+	}
+	nameMsg := ""
+	if nameErr != nil {
+		nameMsg = nameErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		pathErr != nil || nameErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphNodeRenameErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: userIdMsg},
+				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section: openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Path:    pathMsg,
+				Name:    nameMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.RenameGraphChild(*userId, *projectId, *chapterId, *sectionId, *path, *name)
+	if sErr != nil {
+		return nil, graphNodeErrorToUseCaseError[openapi.GraphNodeRenameErrorResponse](sErr)
+	}
+
+	return &openapi.GraphNodeRenameResponse{Graph: uc.graphEntityToModel(entity)}, nil
+}
+
+func (uc graphUseCase) UpdateGraphNode(req openapi.GraphNodeUpdateRequest) (
+	*openapi.GraphNodeUpdateResponse, *Error[openapi.GraphNodeUpdateErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	path, pathErr := domain.NewGraphPathObject(req.Path)
+	relation, relationErr := domain.NewGraphRelationObject(req.Relation)
+	description, descriptionErr := domain.NewGraphDescriptionObject(req.Description)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	pathMsg := ""
+	if pathErr != nil {
+		pathMsg = pathErr.Error()
+	}
+	relationMsg := ""
+	if relationErr != nil {
+		relationMsg = relationErr.Error()
+	}
+	descriptionMsg := ""
+	if descriptionErr != nil {
+		descriptionMsg = descriptionErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		pathErr != nil || relationErr != nil || descriptionErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphNodeUpdateErrorResponse{
+				User:        openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:     openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:     openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section:     openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Path:        pathMsg,
+				Relation:    relationMsg,
+				Description: descriptionMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.UpdateGraphChild(*userId, *projectId, *chapterId, *sectionId, *path, *relation, *description)
+	if sErr != nil {
+		return nil, graphNodeErrorToUseCaseError[openapi.GraphNodeUpdateErrorResponse](sErr)
+	}
+
+	return &openapi.GraphNodeUpdateResponse{Graph: uc.graphEntityToModel(entity)}, nil
+}
+
+func (uc graphUseCase) MoveGraphNode(req openapi.GraphNodeMoveRequest) (
+	*openapi.GraphNodeMoveResponse, *Error[openapi.GraphNodeMoveErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	path, pathErr := domain.NewGraphPathObject(req.Path)
+	parentPath, parentPathErr := domain.NewGraphPathObject(req.ParentPath)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	pathMsg := ""
+	if pathErr != nil {
+		pathMsg = pathErr.Error()
+	}
+	parentPathMsg := ""
+	if parentPathErr != nil {
+		parentPathMsg = parentPathErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		pathErr != nil || parentPathErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphNodeMoveErrorResponse{
+				User:       openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:    openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Chapter:    openapi.ChapterOnlyIdError{Id: chapterIdMsg},
+				Section:    openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Path:       pathMsg,
+				ParentPath: parentPathMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.MoveGraphChild(*userId, *projectId, *chapterId, *sectionId, *path, *parentPath)
+	if sErr != nil {
+		return nil, graphNodeErrorToUseCaseError[openapi.GraphNodeMoveErrorResponse](sErr)
+	}
+
+	return &openapi.GraphNodeMoveResponse{Graph: uc.graphEntityToModel(entity)}, nil
+}
+
+func (uc graphUseCase) DeleteGraphNode(req openapi.GraphNodeDeleteRequest) (
+	*openapi.GraphNodeDeleteResponse, *Error[openapi.GraphNodeDeleteErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	path, pathErr := domain.NewGraphPathObject(req.Path)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -108,64 +1023,179 @@ func (uc graphUseCase) UpdateGraph(req openapi.GraphUpdateRequest) (
 	if chapterIdErr != nil {
 		chapterIdMsg = chapterIdErr.Error()
 	}
-	graphIdMsg := ""
-	if graphIdErr != nil {
-		graphIdMsg = graphIdErr.Error()
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
 	}
-	graphParagraphMsg := ""
-	if graphParagraphErr != nil {
-		graphParagraphMsg = graphParagraphErr.Error()
+	pathMsg := ""
+	if pathErr != nil {
+		pathMsg = pathErr.Error()
 	}
 
-	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil ||
-		graphIdErr != nil || graphParagraphErr != nil || !graphChildrenOk {
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		pathErr != nil {
 		return nil, NewModelBasedError(
 			DomainValidationError,
-			openapi.GraphUpdateErrorResponse{
+			openapi.GraphNodeDeleteErrorResponse{
 				User:    openapi.UserOnlyIdError{Id: userIdMsg},
 				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
 				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
-				Graph: openapi.GraphContentError{
-					Id:        graphIdMsg,
-					Paragraph: graphParagraphMsg,
-					Children:  *graphChildrenErr,
-				},
+				Section: openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Path:    pathMsg,
 			},
 		)
 	}
 
-	graph := domain.NewGraphContentEntity(*graphParagraph, *graphChildren)
+	entity, sErr := uc.service.DeleteGraphChild(*userId, *projectId, *chapterId, *sectionId, *path)
+	if sErr != nil {
+		return nil, graphNodeErrorToUseCaseError[openapi.GraphNodeDeleteErrorResponse](sErr)
+	}
 
-	entity, uErr := uc.service.UpdateGraphContent(*userId, *projectId, *chapterId, *graphId, *graph)
+	return &openapi.GraphNodeDeleteResponse{Graph: uc.graphEntityToModel(entity)}, nil
+}
 
-	if uErr != nil && uErr.Code() == service.NotFoundError {
-		return nil, NewMessageBasedError[openapi.GraphUpdateErrorResponse](
+func (uc graphUseCase) ListGraphRevisions(req openapi.GraphRevisionListRequest) (
+	*openapi.GraphRevisionListResponse, *Error[openapi.GraphRevisionListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.SectionId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphRevisionListErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				ChapterId: chapterIdMsg,
+				SectionId: sectionIdMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListGraphRevisions(*userId, *projectId, *chapterId, *sectionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphRevisionListErrorResponse](
 			NotFoundError,
-			uErr.Unwrap().Error(),
+			sErr.Unwrap().Error(),
 		)
 	}
-	if uErr != nil {
-		return nil, NewMessageBasedError[openapi.GraphUpdateErrorResponse](
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphRevisionListErrorResponse](
 			InternalErrorPanic,
-			uErr.Unwrap().Error(),
+			sErr.Unwrap().Error(),
 		)
 	}
 
-	return &openapi.GraphUpdateResponse{
-		Graph: openapi.Graph{
+	revisions := make([]openapi.GraphRevisionSummary, len(entities))
+	for i, entity := range entities {
+		revisions[i] = openapi.GraphRevisionSummary{
+			Id:        entity.Id().Value(),
+			AuthorId:  entity.AuthorId().Value(),
+			CreatedAt: entity.CreatedAt().Value(),
+		}
+	}
+
+	return &openapi.GraphRevisionListResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+func (uc graphUseCase) FindGraphRevision(req openapi.GraphRevisionFindRequest) (
+	*openapi.GraphRevisionFindResponse, *Error[openapi.GraphRevisionFindErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.SectionId)
+	revisionId, revisionIdErr := domain.NewGraphRevisionIdObject(req.RevisionId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	revisionIdMsg := ""
+	if revisionIdErr != nil {
+		revisionIdMsg = revisionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		revisionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.GraphRevisionFindErrorResponse{
+				UserId:     userIdMsg,
+				ProjectId:  projectIdMsg,
+				ChapterId:  chapterIdMsg,
+				SectionId:  sectionIdMsg,
+				RevisionId: revisionIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.FindGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.GraphRevisionFindErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.GraphRevisionFindErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.GraphRevisionFindResponse{
+		Revision: openapi.GraphRevision{
 			Id:        entity.Id().Value(),
-			Name:      entity.Name().Value(),
 			Paragraph: entity.Paragraph().Value(),
 			Children:  uc.childrenEntityToModel(entity.Children()),
+			AuthorId:  entity.AuthorId().Value(),
+			CreatedAt: entity.CreatedAt().Value(),
 		},
 	}, nil
 }
 
-func (uc graphUseCase) DeleteGraph(req openapi.GraphDeleteRequest) *Error[openapi.GraphDeleteErrorResponse] {
-	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
-	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
-	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
-	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+func (uc graphUseCase) DiffGraphRevisions(req openapi.GraphRevisionDiffRequest) (
+	*openapi.GraphRevisionDiffResponse, *Error[openapi.GraphRevisionDiffErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.SectionId)
+	fromRevisionId, fromRevisionIdErr := domain.NewGraphRevisionIdObject(req.FromRevisionId)
+	toRevisionId, toRevisionIdErr := domain.NewGraphRevisionIdObject(req.ToRevisionId)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -183,43 +1213,73 @@ func (uc graphUseCase) DeleteGraph(req openapi.GraphDeleteRequest) *Error[openap
 	if sectionIdErr != nil {
 		sectionIdMsg = sectionIdErr.Error()
 	}
+	fromRevisionIdMsg := ""
+	if fromRevisionIdErr != nil {
+		fromRevisionIdMsg = fromRevisionIdErr.Error()
+	}
+	toRevisionIdMsg := ""
+	if toRevisionIdErr != nil {
+		toRevisionIdMsg = toRevisionIdErr.Error()
+	}
 
-	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil {
-		return NewModelBasedError(
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		fromRevisionIdErr != nil || toRevisionIdErr != nil {
+		return nil, NewModelBasedError(
 			DomainValidationError,
-			openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: userIdMsg},
-				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
-				Chapter: openapi.ChapterOnlyIdError{Id: chapterIdMsg},
-				Section: openapi.SectionOnlyIdError{Id: sectionIdMsg},
+			openapi.GraphRevisionDiffErrorResponse{
+				UserId:         userIdMsg,
+				ProjectId:      projectIdMsg,
+				ChapterId:      chapterIdMsg,
+				SectionId:      sectionIdMsg,
+				FromRevisionId: fromRevisionIdMsg,
+				ToRevisionId:   toRevisionIdMsg,
 			},
 		)
 	}
 
-	sErr := uc.service.DeleteGraph(*userId, *projectId, *chapterId, *sectionId)
+	diff, sErr := uc.service.DiffGraphRevisions(
+		*userId, *projectId, *chapterId, *sectionId, *fromRevisionId, *toRevisionId)
 
 	if sErr != nil && sErr.Code() == service.NotFoundError {
-		return NewMessageBasedError[openapi.GraphDeleteErrorResponse](
+		return nil, NewMessageBasedError[openapi.GraphRevisionDiffErrorResponse](
 			NotFoundError,
 			sErr.Unwrap().Error(),
 		)
 	}
 	if sErr != nil {
-		return NewMessageBasedError[openapi.GraphDeleteErrorResponse](
+		return nil, NewMessageBasedError[openapi.GraphRevisionDiffErrorResponse](
 			InternalErrorPanic,
 			sErr.Unwrap().Error(),
 		)
 	}
 
-	return nil
+	changes := make([]openapi.GraphRevisionChange, diff.Len())
+	for i, change := range diff.Value() {
+		changes[i] = openapi.GraphRevisionChange{
+			Type:      change.ChangeType().Value(),
+			FromPath:  uc.pathEntityToModel(change.FromPath()),
+			ToPath:    uc.pathEntityToModel(change.ToPath()),
+			FromValue: change.FromValue(),
+			ToValue:   change.ToValue(),
+		}
+	}
+
+	return &openapi.GraphRevisionDiffResponse{
+		Diff: openapi.GraphRevisionDiff{
+			FromRevisionId: fromRevisionId.Value(),
+			ToRevisionId:   toRevisionId.Value(),
+			Changes:        changes,
+		},
+	}, nil
 }
 
-func (uc graphUseCase) SectionalizeGraph(req openapi.GraphSectionalizeRequest) (
-	*openapi.GraphSectionalizeResponse, *Error[openapi.GraphSectionalizeErrorResponse]) {
+func (uc graphUseCase) RestoreGraphRevision(req openapi.GraphRevisionRestoreRequest) (
+	*openapi.GraphRevisionRestoreResponse, *Error[openapi.GraphRevisionRestoreErrorResponse]) {
 	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
 	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
 	chapterId, chapterIdErr := domain.NewChapterIdObject(req.Chapter.Id)
-	sections, sectionsErr, sectionsOk := uc.sectiionsModelToEntity(req.Sections)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.Section.Id)
+	revisionId, revisionIdErr := domain.NewGraphRevisionIdObject(req.Revision.Id)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -233,56 +1293,82 @@ func (uc graphUseCase) SectionalizeGraph(req openapi.GraphSectionalizeRequest) (
 	if chapterIdErr != nil {
 		chapterIdMsg = chapterIdErr.Error()
 	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+	revisionIdMsg := ""
+	if revisionIdErr != nil {
+		revisionIdMsg = revisionIdErr.Error()
+	}
 
-	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || !sectionsOk {
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || sectionIdErr != nil ||
+		revisionIdErr != nil {
 		return nil, NewModelBasedError(
 			DomainValidationError,
-			openapi.GraphSectionalizeErrorResponse{
+			openapi.GraphRevisionRestoreErrorResponse{
 				User:     openapi.UserOnlyIdError{Id: userIdMsg},
 				Project:  openapi.ProjectOnlyIdError{Id: projectIdMsg},
 				Chapter:  openapi.ChapterOnlyIdError{Id: chapterIdMsg},
-				Sections: *sectionsErr,
+				Section:  openapi.SectionOnlyIdError{Id: sectionIdMsg},
+				Revision: openapi.GraphRevisionOnlyIdError{Id: revisionIdMsg},
 			},
 		)
 	}
 
-	entities, sErr := uc.service.SectionalizeIntoGraphs(*userId, *projectId, *chapterId, *sections)
-	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
-		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
-			InvalidArgumentError,
-			sErr.Unwrap().Error(),
-		)
-	}
+	entity, sErr := uc.service.RestoreGraphRevision(*userId, *projectId, *chapterId, *sectionId, *revisionId)
+
 	if sErr != nil && sErr.Code() == service.NotFoundError {
-		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
+		return nil, NewMessageBasedError[openapi.GraphRevisionRestoreErrorResponse](
 			NotFoundError,
 			sErr.Unwrap().Error(),
 		)
 	}
 	if sErr != nil {
-		return nil, NewMessageBasedError[openapi.GraphSectionalizeErrorResponse](
+		return nil, NewMessageBasedError[openapi.GraphRevisionRestoreErrorResponse](
 			InternalErrorPanic,
 			sErr.Unwrap().Error(),
 		)
 	}
 
-	graphs := make([]openapi.Graph, len(entities))
-	for i, entity := range entities {
-		graphs[i] = openapi.Graph{
+	return &openapi.GraphRevisionRestoreResponse{
+		Graph: openapi.Graph{
 			Id:        entity.Id().Value(),
 			Name:      entity.Name().Value(),
 			Paragraph: entity.Paragraph().Value(),
 			Children:  uc.childrenEntityToModel(entity.Children()),
-		}
+			UpdatedAt: entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc graphUseCase) graphEntityToModel(entity *domain.GraphEntity) openapi.Graph {
+	return openapi.Graph{
+		Id:        entity.Id().Value(),
+		Name:      entity.Name().Value(),
+		Paragraph: entity.Paragraph().Value(),
+		Children:  uc.childrenEntityToModel(entity.Children()),
+		UpdatedAt: entity.UpdatedAt().Value(),
 	}
+}
 
-	return &openapi.GraphSectionalizeResponse{Graphs: graphs}, nil
+func (uc graphUseCase) graphsEntityToModel(entities []domain.GraphEntity) []openapi.Graph {
+	graphs := make([]openapi.Graph, len(entities))
+	for i := range entities {
+		graphs[i] = uc.graphEntityToModel(&entities[i])
+	}
+	return graphs
 }
 
 func (uc graphUseCase) childrenEntityToModel(entity *domain.GraphChildrenEntity) []openapi.GraphChild {
 	children := make([]openapi.GraphChild, len(entity.Value()))
 	for i, child := range entity.Value() {
+		id := ""
+		if child.Id() != nil {
+			id = child.Id().Value()
+		}
 		children[i] = openapi.GraphChild{
+			Id:          id,
 			Name:        child.Name().Value(),
 			Relation:    child.Relation().Value(),
 			Description: child.Description().Value(),
@@ -292,6 +1378,17 @@ func (uc graphUseCase) childrenEntityToModel(entity *domain.GraphChildrenEntity)
 	return children
 }
 
+func (uc graphUseCase) pathEntityToModel(path []domain.GraphNameObject) []string {
+	if len(path) == 0 {
+		return nil
+	}
+	names := make([]string, len(path))
+	for i, name := range path {
+		names[i] = name.Value()
+	}
+	return names
+}
+
 func (uc graphUseCase) childrenModelToEntity(children []openapi.GraphChild) (
 	*domain.GraphChildrenEntity, *openapi.GraphChildrenError, bool) {
 	childItems := make([]domain.GraphChildEntity, len(children))
@@ -299,6 +1396,10 @@ func (uc graphUseCase) childrenModelToEntity(children []openapi.GraphChild) (
 
 	childItemErrorExists := false
 	for i, child := range children {
+		var id *domain.GraphChildIdObject
+		if child.Id != "" {
+			id, _ = domain.NewGraphChildIdObject(child.Id)
+		}
 		name, nameErr := domain.NewGraphNameObject(child.Name)
 		if nameErr != nil {
 			childItemErrors[i].Name = nameErr.Error()
@@ -320,7 +1421,7 @@ func (uc graphUseCase) childrenModelToEntity(children []openapi.GraphChild) (
 			childItemErrorExists = true
 		}
 		if nameErr == nil && relationErr == nil && descErr == nil && childrenOk {
-			childItems[i] = *domain.NewGraphChildEntity(*name, *relation, *desc, *children)
+			childItems[i] = *domain.NewGraphChildEntity(id, *name, *relation, *desc, *children)
 		}
 	}
 
@@ -365,3 +1466,93 @@ func (uc graphUseCase) sectiionsModelToEntity(sections []openapi.SectionWithoutA
 	ok := sectionsErrorMessage == "" && !sectionItemErrorExists
 	return entity, &openapi.SectionWithoutAutofieldListError{Message: sectionsErrorMessage, Items: sectionItemErrors}, ok
 }
+
+func (uc graphUseCase) importNodesToEntity(nodes []importer.Node, location string) (
+	*domain.GraphChildrenEntity, []openapi.GraphImportNodeError) {
+	childItems := make([]domain.GraphChildEntity, len(nodes))
+	nodeErrors := []openapi.GraphImportNodeError{}
+
+	for i, node := range nodes {
+		name, nameErr := domain.NewGraphNameObject(node.Name)
+		relation, relationErr := domain.NewGraphRelationObject(node.Relation)
+		desc, descErr := domain.NewGraphDescriptionObject(node.Description)
+
+		if nameErr != nil || relationErr != nil || descErr != nil {
+			nodeError := openapi.GraphImportNodeError{Location: node.Location}
+			if nameErr != nil {
+				nodeError.Name = nameErr.Error()
+			}
+			if relationErr != nil {
+				nodeError.Relation = relationErr.Error()
+			}
+			if descErr != nil {
+				nodeError.Description = descErr.Error()
+			}
+			nodeErrors = append(nodeErrors, nodeError)
+		}
+
+		children, childrenErrors := uc.importNodesToEntity(node.Children, node.Location)
+		nodeErrors = append(nodeErrors, childrenErrors...)
+
+		if nameErr == nil && relationErr == nil && descErr == nil && len(childrenErrors) == 0 {
+			childItems[i] = *domain.NewGraphChildEntity(nil, *name, *relation, *desc, *children)
+		}
+	}
+
+	if len(nodeErrors) > 0 {
+		return nil, nodeErrors
+	}
+
+	entity, err := domain.NewGraphChildrenEntity(childItems)
+	if err != nil {
+		return nil, []openapi.GraphImportNodeError{{Location: location, Message: err.Error()}}
+	}
+	return entity, nil
+}
+
+func (uc graphUseCase) importWarningsToModel(warnings []importer.Warning) []openapi.GraphImportWarning {
+	models := make([]openapi.GraphImportWarning, len(warnings))
+	for i, warning := range warnings {
+		models[i] = openapi.GraphImportWarning{Location: warning.Location, Message: warning.Message}
+	}
+	return models
+}
+
+func graphNodeErrorToUseCaseError[ErrorResponse any](sErr *service.Error) *Error[ErrorResponse] {
+	if sErr.Code() == service.InvalidArgumentError {
+		return NewMessageBasedError[ErrorResponse](InvalidArgumentError, sErr.Unwrap().Error())
+	}
+	if sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[ErrorResponse](NotFoundError, sErr.Unwrap().Error())
+	}
+	return NewMessageBasedError[ErrorResponse](InternalErrorPanic, sErr.Unwrap().Error())
+}
+
+func (uc graphUseCase) exportGraph(
+	userId domain.UserIdObject,
+	projectId domain.ProjectIdObject,
+	chapterId *domain.ChapterIdObject,
+	sectionId *domain.SectionIdObject,
+) (*export.Graph, *service.Error) {
+	if sectionId != nil {
+		entity, sErr := uc.service.FindGraph(userId, projectId, *chapterId, *sectionId)
+		if sErr != nil {
+			return nil, sErr
+		}
+		return export.FromGraph(*entity), nil
+	}
+
+	if chapterId != nil {
+		entities, sErr := uc.service.ListGraphs(userId, projectId, *chapterId)
+		if sErr != nil {
+			return nil, sErr
+		}
+		return export.FromGraphs(entities), nil
+	}
+
+	entity, sErr := uc.projectGraphService.FindProjectGraph(userId, projectId)
+	if sErr != nil {
+		return nil, sErr
+	}
+	return export.FromProjectGraph(*entity), nil
+}
@@ -3,7 +3,9 @@ package usecase_test
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
@@ -37,17 +39,19 @@ func TestFindGraphValidEntity(t *testing.T) {
 	assert.Nil(t, err)
 	grandChildDescription, err := domain.NewGraphDescriptionObject("grandchild description")
 	assert.Nil(t, err)
-	grandChild := domain.NewGraphChildEntity(*grandChildName, *grandChildRelation, *grandChildDescription, *emptyChildren)
+	grandChild := domain.NewGraphChildEntity(nil, *grandChildName, *grandChildRelation, *grandChildDescription, *emptyChildren)
 	grandChildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{*grandChild})
 	assert.Nil(t, err)
 
+	childId, err := domain.NewGraphChildIdObject("4000000000000001")
+	assert.Nil(t, err)
 	childName, err := domain.NewGraphNameObject("Child")
 	assert.Nil(t, err)
 	childRelation, err := domain.NewGraphRelationObject("child relation")
 	assert.Nil(t, err)
 	childDescription, err := domain.NewGraphDescriptionObject("child description")
 	assert.Nil(t, err)
-	child := domain.NewGraphChildEntity(*childName, *childRelation, *childDescription, *grandChildren)
+	child := domain.NewGraphChildEntity(childId, *childName, *childRelation, *childDescription, *grandChildren)
 	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{*child})
 	assert.Nil(t, err)
 
@@ -73,7 +77,7 @@ func TestFindGraphValidEntity(t *testing.T) {
 		}).
 		Return(graph, nil)
 
-	uc := usecase.NewGraphUseCase(s)
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 	res, ucErr := uc.FindGraph(openapi.GraphFindRequest{
 		UserId:    testutil.ReadOnlyUserId(),
@@ -89,11 +93,13 @@ func TestFindGraphValidEntity(t *testing.T) {
 	assert.Equal(t, "This is graph paragraph", res.Graph.Paragraph)
 
 	assert.Equal(t, 1, len(res.Graph.Children))
+	assert.Equal(t, "4000000000000001", res.Graph.Children[0].Id)
 	assert.Equal(t, "Child", res.Graph.Children[0].Name)
 	assert.Equal(t, "child relation", res.Graph.Children[0].Relation)
 	assert.Equal(t, "child description", res.Graph.Children[0].Description)
 
 	assert.Equal(t, 1, len(res.Graph.Children[0].Children))
+	assert.Empty(t, res.Graph.Children[0].Children[0].Id)
 	assert.Equal(t, "GrandChild", res.Graph.Children[0].Children[0].Name)
 	assert.Equal(t, "grandchild relation", res.Graph.Children[0].Children[0].Relation)
 	assert.Equal(t, "grandchild description", res.Graph.Children[0].Children[0].Description)
@@ -180,7 +186,7 @@ func TestFindGraphDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 			res, ucErr := uc.FindGraph(openapi.GraphFindRequest{
 				UserId:    tc.userId,
@@ -230,7 +236,7 @@ func TestFindGraphServiceError(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 			s.EXPECT().
 				FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -250,6 +256,229 @@ func TestFindGraphServiceError(t *testing.T) {
 	}
 }
 
+func TestExportGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	gs := mock_service.NewMockProjectGraphService(ctrl)
+
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section 1", "paragraph"), nil)
+
+	s.EXPECT().
+		ListGraphs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, chapterId domain.ChapterIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+		}).
+		Return([]domain.GraphEntity{
+			*newLeafGraphEntity(t, "2000000000000001", "Section 1", "paragraph"),
+			*newLeafGraphEntity(t, "2000000000000002", "Section 2", "paragraph"),
+		}, nil)
+
+	sectionName, err := domain.NewGraphNameObject("Section 1")
+	assert.Nil(t, err)
+	gs.EXPECT().
+		FindProjectGraph(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return(domain.NewProjectGraphEntity(
+			[]domain.ProjectGraphNodeEntity{
+				*domain.NewProjectGraphNodeEntity(*sectionName, []domain.ProjectGraphOccurrenceEntity{}),
+			},
+			[]domain.ProjectGraphEdgeEntity{},
+		), nil)
+
+	uc := usecase.NewGraphUseCase(s, gs)
+
+	tt := []struct {
+		name     string
+		request  openapi.GraphExportRequest
+		expected openapi.GraphExport
+	}{
+		{
+			name: "should export graph of section",
+			request: openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+				Format:    "dot",
+			},
+			expected: openapi.GraphExport{
+				Format:    "dot",
+				MediaType: "text/vnd.graphviz",
+				Content:   "digraph {\n  n0 [label=\"Section 1\"];\n}\n",
+			},
+		},
+		{
+			name: "should export graphs of chapter",
+			request: openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				Format:    "mermaid",
+			},
+			expected: openapi.GraphExport{
+				Format:    "mermaid",
+				MediaType: "text/vnd.mermaid",
+				Content:   "flowchart TD\n  n0[\"Section 1\"]\n  n1[\"Section 2\"]\n",
+			},
+		},
+		{
+			name: "should export graph of project",
+			request: openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				Format:    "dot",
+			},
+			expected: openapi.GraphExport{
+				Format:    "dot",
+				MediaType: "text/vnd.graphviz",
+				Content:   "digraph {\n  n0 [label=\"Section 1\"];\n}\n",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ucErr := uc.ExportGraph(tc.request)
+			assert.Nil(t, ucErr)
+			assert.Equal(t, tc.expected, res.Export)
+		})
+	}
+}
+
+func TestExportGraphDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name     string
+		request  openapi.GraphExportRequest
+		expected openapi.GraphExportErrorResponse
+	}{
+		{
+			name: "should return error when format is not supported",
+			request: openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				Format:    "svg",
+			},
+			expected: openapi.GraphExportErrorResponse{
+				Format: "export format must be one of cytoscape, dot, graphml, mermaid, but got 'svg'",
+			},
+		},
+		{
+			name: "should return error when section id is passed without chapter id",
+			request: openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				SectionId: "2000000000000001",
+				Format:    "dot",
+			},
+			expected: openapi.GraphExportErrorResponse{
+				ChapterId: "chapter id is required, but got ''",
+			},
+		},
+		{
+			name:    "should return error when all fields are empty",
+			request: openapi.GraphExportRequest{},
+			expected: openapi.GraphExportErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "project id is required, but got ''",
+				Format:    "export format must be one of cytoscape, dot, graphml, mermaid, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.ExportGraph(tc.request)
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestExportGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when graph not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to list graphs",
+			expectedError: "not found: failed to list graphs",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			s.EXPECT().
+				ListGraphs(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.ExportGraph(openapi.GraphExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				Format:    "graphml",
+			})
+			assert.NotNil(t, ucErr)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, ucErr.Response())
+			assert.Nil(t, res)
+		})
+	}
+}
+
 func TestUpdateGraphContentValidEntity(t *testing.T) {
 	maxLengthParagraph := testutil.RandomString(40000)
 
@@ -303,7 +532,7 @@ func TestUpdateGraphContentValidEntity(t *testing.T) {
 			assert.Nil(t, err)
 			grandChildDescription, err := domain.NewGraphDescriptionObject(tc.description)
 			assert.Nil(t, err)
-			grandChild := domain.NewGraphChildEntity(*grandChildName, *grandChildRelation, *grandChildDescription, *emptyChildren)
+			grandChild := domain.NewGraphChildEntity(nil, *grandChildName, *grandChildRelation, *grandChildDescription, *emptyChildren)
 			grandChildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{*grandChild})
 			assert.Nil(t, err)
 
@@ -313,7 +542,7 @@ func TestUpdateGraphContentValidEntity(t *testing.T) {
 			assert.Nil(t, err)
 			childDescription, err := domain.NewGraphDescriptionObject(tc.description)
 			assert.Nil(t, err)
-			child := domain.NewGraphChildEntity(*childName, *childRelation, *childDescription, *grandChildren)
+			child := domain.NewGraphChildEntity(nil, *childName, *childRelation, *childDescription, *grandChildren)
 			children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{*child})
 			assert.Nil(t, err)
 
@@ -325,23 +554,25 @@ func TestUpdateGraphContentValidEntity(t *testing.T) {
 			graph := domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
 
 			s.EXPECT().
-				UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Do(func(
 					userId domain.UserIdObject,
 					projectId domain.ProjectIdObject,
 					chapterId domain.ChapterIdObject,
 					graphId domain.GraphIdObject,
 					graph domain.GraphContentEntity,
+					expectedUpdatedAt *domain.UpdatedAtObject,
 				) {
 					assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
 					assert.Equal(t, "0000000000000001", projectId.Value())
 					assert.Equal(t, "1000000000000001", chapterId.Value())
 					assert.Equal(t, "2000000000000001", graphId.Value())
 					assert.Equal(t, tc.paragraph, graph.Paragraph().Value())
+					assert.Nil(t, expectedUpdatedAt)
 				}).
 				Return(graph, nil)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 			res, ucErr := uc.UpdateGraph(openapi.GraphUpdateRequest{
 				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -770,7 +1001,7 @@ func TestUpdateGraphContentDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 			res, ucErr := uc.UpdateGraph(openapi.GraphUpdateRequest{
 				User:    openapi.UserOnlyId{Id: tc.userId},
@@ -824,10 +1055,10 @@ func TestUpdateGraphContentServiceError(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
 			s.EXPECT().
-				UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
 			res, ucErr := uc.UpdateGraph(openapi.GraphUpdateRequest{
@@ -847,110 +1078,293 @@ func TestUpdateGraphContentServiceError(t *testing.T) {
 	}
 }
 
-func TestDeleteGraphValidEntity(t *testing.T) {
+func TestUpdateGraphContentConflict(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	s := mock_service.NewMockGraphService(ctrl)
+
+	id, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	name, err := domain.NewGraphNameObject("Section One")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("current paragraph")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.Nil(t, err)
+
+	graph := domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
+
 	s.EXPECT().
-		DeleteGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Do(func(
 			userId domain.UserIdObject,
 			projectId domain.ProjectIdObject,
 			chapterId domain.ChapterIdObject,
-			sectionId domain.SectionIdObject,
+			graphId domain.GraphIdObject,
+			graph domain.GraphContentEntity,
+			expectedUpdatedAt *domain.UpdatedAtObject,
 		) {
-			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
-			assert.Equal(t, "0000000000000001", projectId.Value())
-			assert.Equal(t, "1000000000000001", chapterId.Value())
-			assert.Equal(t, "2000000000000001", sectionId.Value())
+			assert.Equal(t, testutil.Date(), expectedUpdatedAt.Value())
 		}).
-		Return(nil)
+		Return(graph, service.Errorf(service.ConflictError, "graph has been updated since it was fetched"))
 
-	uc := usecase.NewGraphUseCase(s)
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
-	ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
+	res, ucErr := uc.UpdateGraph(openapi.GraphUpdateRequest{
 		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
 		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
 		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
-		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Graph: openapi.GraphContent{
+			Id:        "2000000000000001",
+			Paragraph: "updated paragraph",
+			Children:  []openapi.GraphChild{},
+			UpdatedAt: testutil.Date(),
+		},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: graph has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+	assert.Nil(t, ucErr.Response())
+
+	assert.Equal(t, openapi.Graph{
+		Id:        "2000000000000001",
+		Name:      "Section One",
+		Paragraph: "current paragraph",
+		Children:  []openapi.GraphChild{},
+		UpdatedAt: testutil.Date().Add(time.Hour),
+	}, res.Graph)
+}
+
+func newPatchTargetGraphEntity(t *testing.T) *domain.GraphEntity {
+	newChild := func(childName string, children []domain.GraphChildEntity) domain.GraphChildEntity {
+		name, err := domain.NewGraphNameObject(childName)
+		assert.Nil(t, err)
+		relation, err := domain.NewGraphRelationObject("part of")
+		assert.Nil(t, err)
+		description, err := domain.NewGraphDescriptionObject("")
+		assert.Nil(t, err)
+		childrenEntity, err := domain.NewGraphChildrenEntity(children)
+		assert.Nil(t, err)
+		return *domain.NewGraphChildEntity(nil, *name, *relation, *description, *childrenEntity)
+	}
+
+	id, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	name, err := domain.NewGraphNameObject("Section")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("paragraph")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{
+		newChild("Node A", []domain.GraphChildEntity{newChild("Node A1", []domain.GraphChildEntity{})}),
+		newChild("Node B", []domain.GraphChildEntity{}),
 	})
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	return domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
+}
 
+func TestPatchGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newPatchTargetGraphEntity(t), nil)
+	s.EXPECT().
+		UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			graphId domain.GraphIdObject,
+			graph domain.GraphContentEntity,
+			updatedAt *domain.UpdatedAtObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", graphId.Value())
+			assert.Equal(t, testutil.Date(), updatedAt.Value())
+
+			assert.Equal(t, "patched paragraph", graph.Paragraph().Value())
+			children := graph.Children().Value()
+			assert.Len(t, children, 3)
+			assert.Equal(t, "Node A", children[0].Name().Value())
+			assert.Equal(t, 0, children[0].Children().Len())
+			assert.Equal(t, "Node B", children[1].Name().Value())
+			assert.Equal(t, 1, children[1].Children().Len())
+			assert.Equal(t, "Node A1", children[1].Children().Value()[0].Name().Value())
+			assert.Equal(t, "Node C", children[2].Name().Value())
+			assert.Equal(t, "see also", children[2].Relation().Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "patched paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.PatchGraph(openapi.GraphPatchRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+		ChapterId: "1000000000000001",
+		SectionId: "2000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "test", Path: "/children/0/name", Value: "Node A"},
+			{Op: "replace", Path: "/paragraph", Value: "patched paragraph"},
+			{Op: "move", From: "/children/0/children/0", Path: "/children/1/children/-"},
+			{Op: "add", Path: "/children/-", Value: map[string]any{
+				"name":        "Node C",
+				"relation":    "see also",
+				"description": "",
+				"children":    []any{},
+			}},
+		},
+	})
 	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Graph{
+		Id:        "2000000000000001",
+		Name:      "Section",
+		Paragraph: "patched paragraph",
+		Children:  []openapi.GraphChild{},
+		UpdatedAt: testutil.Date(),
+	}, res.Graph)
 }
 
-func TestDeleteGraphDomainValidationError(t *testing.T) {
+func TestPatchGraphDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.PatchGraph(openapi.GraphPatchRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+		ChapterId: "",
+		SectionId: "2000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "replace", Path: "/paragraph", Value: "patched paragraph"},
+			{Op: "update", Path: "paragraph", Value: "patched paragraph"},
+			{Op: "copy", Path: "/children/-", From: "/children/~2"},
+			{Op: "move", Path: "/children/0"},
+			{Op: "move", Path: "/children/0/children/0", From: "/children/0"},
+			{Op: "add", Path: "/children/-"},
+		},
+	})
+
+	expected := openapi.GraphPatchErrorResponse{
+		ChapterId: "chapter id is required, but got ''",
+		Patch: openapi.JsonPatchError{
+			Items: []openapi.JsonPatchOperationError{
+				{},
+				{
+					Op:   "json patch op must be one of add, remove, replace, move, copy, test, but got 'update'",
+					Path: "json pointer must be empty or start with '/', but got 'paragraph'",
+				},
+				{From: "json pointer must escape '~' as '~0', but got '/children/~2'"},
+				{Message: "json patch from is required for move operation"},
+				{Message: "json patch cannot move a value into its own child, " +
+					"but got from '/children/0' and path '/children/0/children/0'"},
+				{Message: "json patch value is required for add operation"},
+			},
+		},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestPatchGraphPatchedDocumentError(t *testing.T) {
 	tt := []struct {
-		name      string
-		userId    string
-		projectId string
-		chapterId string
-		sectionId string
-		expected  openapi.GraphDeleteErrorResponse
+		name     string
+		patch    []openapi.JsonPatchOperation
+		expected openapi.GraphPatchErrorResponse
 	}{
 		{
-			name:      "should return error when user id is empty",
-			userId:    "",
-			projectId: "0000000000000001",
-			chapterId: "1000000000000001",
-			sectionId: "2000000000000001",
-			expected: openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
-				Project: openapi.ProjectOnlyIdError{Id: ""},
-				Chapter: openapi.ChapterOnlyIdError{Id: ""},
-				Section: openapi.SectionOnlyIdError{Id: ""},
+			name: "should return error when test operation fails",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "replace", Path: "/paragraph", Value: "patched paragraph"},
+				{Op: "test", Path: "/children/1/name", Value: "Node A"},
+			},
+			expected: openapi.GraphPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Items: []openapi.JsonPatchOperationError{
+						{},
+						{Message: "json patch test failed, value at '/children/1/name' is not equal to the expected one"},
+					},
+				},
 			},
 		},
 		{
-			name:      "should return error when project id is empty",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "",
-			chapterId: "1000000000000001",
-			sectionId: "2000000000000001",
-			expected: openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: ""},
-				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
-				Chapter: openapi.ChapterOnlyIdError{Id: ""},
-				Section: openapi.SectionOnlyIdError{Id: ""},
+			name: "should return error when target does not exist",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "remove", Path: "/children/2"},
+			},
+			expected: openapi.GraphPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Items: []openapi.JsonPatchOperationError{
+						{Message: "json patch target does not exist, but got '/children/2'"},
+					},
+				},
 			},
 		},
 		{
-			name:      "should return error when chapter id is empty",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "0000000000000001",
-			chapterId: "",
-			sectionId: "2000000000000001",
-			expected: openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: ""},
-				Project: openapi.ProjectOnlyIdError{Id: ""},
-				Chapter: openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
-				Section: openapi.SectionOnlyIdError{Id: ""},
+			name: "should return error when array index is out of range",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "add", Path: "/children/3", Value: map[string]any{"name": "Node C"}},
+			},
+			expected: openapi.GraphPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Items: []openapi.JsonPatchOperationError{
+						{Message: "json patch array index is out of range, but got '/children/3'"},
+					},
+				},
 			},
 		},
 		{
-			name:      "should return error when section id is empty",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "0000000000000001",
-			chapterId: "1000000000000001",
-			sectionId: "",
-			expected: openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: ""},
-				Project: openapi.ProjectOnlyIdError{Id: ""},
-				Chapter: openapi.ChapterOnlyIdError{Id: ""},
-				Section: openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+			name: "should return error when patched document has unknown member",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "add", Path: "/name", Value: "Patched Section"},
+			},
+			expected: openapi.GraphPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Message: "patched document is invalid: json: unknown field \"name\"",
+				},
 			},
 		},
 		{
-			name:      "should return error when all fields are empty",
-			userId:    "",
-			projectId: "",
-			chapterId: "",
-			sectionId: "",
-			expected: openapi.GraphDeleteErrorResponse{
-				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
-				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
-				Chapter: openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
-				Section: openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+			name: "should return error when patched graph is invalid",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "copy", From: "/children/1", Path: "/children/-"},
+			},
+			expected: openapi.GraphPatchErrorResponse{
+				Graph: openapi.GraphContentWithoutAutofieldError{
+					Children: openapi.GraphChildrenError{
+						Message: "names of children must be unique, but got 'Node B' duplicated",
+						Items: []openapi.GraphChildError{
+							{Children: openapi.GraphChildrenError{
+								Items: []openapi.GraphChildError{
+									{Children: openapi.GraphChildrenError{Items: []openapi.GraphChildError{}}},
+								},
+							}},
+							{Children: openapi.GraphChildrenError{Items: []openapi.GraphChildError{}}},
+							{Children: openapi.GraphChildrenError{Items: []openapi.GraphChildError{}}},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -961,25 +1375,31 @@ func TestDeleteGraphDomainValidationError(t *testing.T) {
 			defer ctrl.Finish()
 
 			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(newPatchTargetGraphEntity(t), nil)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
-			ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
-				User:    openapi.UserOnlyId{Id: tc.userId},
-				Project: openapi.ProjectOnlyId{Id: tc.projectId},
-				Chapter: openapi.ChapterOnlyId{Id: tc.chapterId},
-				Section: openapi.SectionOnlyId{Id: tc.sectionId},
+			res, ucErr := uc.PatchGraph(openapi.GraphPatchRequest{
+				UserId:    testutil.ModifyOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+				Patch:     tc.patch,
 			})
 
 			expectedJson, _ := json.Marshal(tc.expected)
 			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
 			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
 			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
 		})
 	}
 }
 
-func TestDeleteGraphServiceError(t *testing.T) {
+func TestPatchGraphServiceError(t *testing.T) {
 	tt := []struct {
 		name          string
 		errorCode     service.ErrorCode
@@ -990,8 +1410,8 @@ func TestDeleteGraphServiceError(t *testing.T) {
 		{
 			name:          "should return error when graph not found",
 			errorCode:     service.NotFoundError,
-			errorMessage:  "failed to delete graph",
-			expectedError: "not found: failed to delete graph",
+			errorMessage:  "failed to find graph",
+			expectedError: "not found: failed to find graph",
 			expectedCode:  usecase.NotFoundError,
 		},
 		{
@@ -1009,108 +1429,184 @@ func TestDeleteGraphServiceError(t *testing.T) {
 			defer ctrl.Finish()
 
 			s := mock_service.NewMockGraphService(ctrl)
-
-			uc := usecase.NewGraphUseCase(s)
-
 			s.EXPECT().
-				DeleteGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+				FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
-				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
-				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
-				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
-				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.PatchGraph(openapi.GraphPatchRequest{
+				UserId:    testutil.ModifyOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+				Patch: []openapi.JsonPatchOperation{
+					{Op: "replace", Path: "/paragraph", Value: "patched paragraph"},
+				},
 			})
 
+			assert.Nil(t, res)
 			assert.Equal(t, tc.expectedError, ucErr.Error())
 			assert.Equal(t, tc.expectedCode, ucErr.Code())
 		})
 	}
 }
 
-func TestSectionalizeGraphValidEntity(t *testing.T) {
-	maxLengthSectionName := testutil.RandomString(100)
-	maxLengthSectionContent := testutil.RandomString(40000)
+func TestPatchGraphConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	maxLengthSectionIds := make([]string, 20)
-	for i := 0; i < 20; i++ {
-		maxLengthSectionIds[i] = testutil.RandomString(16)
-	}
-	maxLengthSections := make([]openapi.SectionWithoutAutofield, 20)
-	for i := 0; i < 20; i++ {
-		maxLengthSections[i] = openapi.SectionWithoutAutofield{
-			Name:    testutil.RandomString(10),
-			Content: testutil.RandomString(100),
-		}
-	}
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newPatchTargetGraphEntity(t), nil)
+	s.EXPECT().
+		UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(
+			newLeafGraphEntity(t, "2000000000000001", "Section", "current paragraph"),
+			service.Errorf(service.ConflictError, "graph has been updated since it was fetched"),
+		)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.PatchGraph(openapi.GraphPatchRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+		ChapterId: "1000000000000001",
+		SectionId: "2000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "replace", Path: "/paragraph", Value: "patched paragraph"},
+		},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: graph has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+
+	assert.Equal(t, "current paragraph", res.Graph.Paragraph)
+}
+
+func TestImportGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newPatchTargetGraphEntity(t), nil)
+	s.EXPECT().
+		UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			graphId domain.GraphIdObject,
+			graph domain.GraphContentEntity,
+			updatedAt *domain.UpdatedAtObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", graphId.Value())
+			assert.Equal(t, testutil.Date(), updatedAt.Value())
+
+			assert.Equal(t, "paragraph", graph.Paragraph().Value())
+			children := graph.Children().Value()
+			assert.Len(t, children, 2)
+			assert.Nil(t, children[0].Id())
+			assert.Equal(t, "Node X", children[0].Name().Value())
+			assert.Equal(t, "Description of Node X", children[0].Description().Value())
+			assert.Equal(t, 2, children[0].Children().Len())
+			assert.Equal(t, "Node X1", children[0].Children().Value()[0].Name().Value())
+			assert.Equal(t, "Node X1 (2)", children[0].Children().Value()[1].Name().Value())
+			assert.Equal(t, "Node Y", children[1].Name().Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ImportGraph(openapi.GraphImportRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Source: openapi.GraphImportSource{
+			Format: "markdown",
+			Content: "- Section\n" +
+				"  - Node X\n" +
+				"    Description of Node X\n" +
+				"    - Node X1\n" +
+				"    - Node X1\n" +
+				"  - Node Y\n",
+		},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Graph{
+		Id:        "2000000000000001",
+		Name:      "Section",
+		Paragraph: "paragraph",
+		Children:  []openapi.GraphChild{},
+		UpdatedAt: testutil.Date(),
+	}, res.Graph)
+	assert.Equal(t, []openapi.GraphImportWarning{
+		{Location: "line 5", Message: "duplicated sibling name 'Node X1' is renamed to 'Node X1 (2)'"},
+	}, res.Warnings)
+}
 
+func TestImportGraphDomainValidationError(t *testing.T) {
 	tt := []struct {
-		name       string
-		userId     string
-		projectId  string
-		chapterId  string
-		sectionIds []string
-		sections   []openapi.SectionWithoutAutofield
+		name     string
+		request  openapi.GraphImportRequest
+		expected openapi.GraphImportErrorResponse
 	}{
 		{
-			name:      "should sectionalize into graphs",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "0000000000000001",
-			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
-				"2000000000000002",
+			name: "should return error when ids and source are invalid",
+			request: openapi.GraphImportRequest{
+				User:    openapi.UserOnlyId{Id: ""},
+				Project: openapi.ProjectOnlyId{Id: ""},
+				Chapter: openapi.ChapterOnlyId{Id: ""},
+				Section: openapi.SectionOnlyId{Id: ""},
+				Source:  openapi.GraphImportSource{Format: "svg", Content: ""},
 			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    "Section 1",
-					Content: "This is the content of section 1. This is the content of section 1.",
-				},
-				{
-					Name:    "Section 2",
-					Content: "This is the content of section 2. This is the content of section 2.",
+			expected: openapi.GraphImportErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+				Chapter: openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
+				Section: openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+				Source: openapi.GraphImportSourceError{
+					Format:  "import format must be one of graphml, markdown, mermaid, opml, but got 'svg'",
+					Content: "import content is required, but got ''",
 				},
 			},
 		},
 		{
-			name:      "should sectionalize into graphs with max length name",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "0000000000000001",
-			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
+			name: "should return error when content is too long",
+			request: openapi.GraphImportRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+				Source:  openapi.GraphImportSource{Format: "markdown", Content: strings.Repeat("a", 1000001)},
 			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    maxLengthSectionName,
-					Content: "This is the content of section. This is the content of section.",
+			expected: openapi.GraphImportErrorResponse{
+				Source: openapi.GraphImportSourceError{
+					Content: "import content must be less than or equal to 1000000 bytes, but got 1000001 bytes",
 				},
 			},
 		},
 		{
-			name:      "should sectionalize into graphs with max length content",
-			userId:    testutil.ModifyOnlyUserId(),
-			projectId: "0000000000000001",
-			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
+			name: "should return error when content cannot be parsed",
+			request: openapi.GraphImportRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+				Source:  openapi.GraphImportSource{Format: "markdown", Content: "no list items"},
 			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    "Section",
-					Content: maxLengthSectionContent,
-				},
+			expected: openapi.GraphImportErrorResponse{
+				Source: openapi.GraphImportSourceError{Content: "content has no nodes to import"},
 			},
 		},
-		{
-			name:       "should sectionalize into graphs with max length sections",
-			userId:     testutil.ModifyOnlyUserId(),
-			projectId:  "0000000000000001",
-			chapterId:  "1000000000000001",
-			sectionIds: maxLengthSectionIds,
-			sections:   maxLengthSections,
-		},
 	}
 
 	for _, tc := range tt {
@@ -1120,116 +1616,194 @@ func TestSectionalizeGraphValidEntity(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			grapths := make([]domain.GraphEntity, len(tc.sections))
-			for i, section := range tc.sections {
-				id, err := domain.NewGraphIdObject(tc.sectionIds[i])
-				assert.Nil(t, err)
-				name, err := domain.NewGraphNameObject(section.Name)
-				assert.Nil(t, err)
-				paragraph, err := domain.NewGraphParagraphObject(section.Content)
-				assert.Nil(t, err)
-				children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
-				assert.Nil(t, err)
-				createdAt, err := domain.NewCreatedAtObject(testutil.Date())
-				assert.NoError(t, err)
-				updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
-				assert.NoError(t, err)
-				grapths[i] = *domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
-			}
-			s.EXPECT().
-				SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				Do(func(
-					userId domain.UserIdObject,
-					projectId domain.ProjectIdObject,
-					chapterId domain.ChapterIdObject,
-					sections domain.SectionWithoutAutofieldEntityList) {
-					assert.Equal(t, tc.userId, userId.Value())
-					assert.Equal(t, tc.projectId, projectId.Value())
-					assert.Equal(t, tc.chapterId, chapterId.Value())
-					assert.Equal(t, len(tc.sections), sections.Len())
-					for i, section := range tc.sections {
-						assert.Equal(t, section.Name, sections.Value()[i].Name().Value())
-						assert.Equal(t, section.Content, sections.Value()[i].Content().Value())
-					}
-				}).
-				Return(grapths, nil)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
-			uc := usecase.NewGraphUseCase(s)
+			res, ucErr := uc.ImportGraph(tc.request)
 
-			res, err := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
-				User:     openapi.UserOnlyId{Id: tc.userId},
-				Project:  openapi.ProjectOnlyId{Id: tc.projectId},
-				Chapter:  openapi.ChapterOnlyId{Id: tc.chapterId},
-				Sections: tc.sections,
-			})
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
 
-			assert.Nil(t, err)
+			assert.Nil(t, res)
+		})
+	}
+}
 
-			assert.Equal(t, len(tc.sections), len(res.Graphs))
-			for i, graph := range res.Graphs {
-				assert.Equal(t, tc.sectionIds[i], graph.Id)
-				assert.Equal(t, tc.sections[i].Name, graph.Name)
-				assert.Equal(t, tc.sections[i].Content, graph.Paragraph)
-			}
+func TestImportGraphNodeError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newPatchTargetGraphEntity(t), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ImportGraph(openapi.GraphImportRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Source: openapi.GraphImportSource{
+			Format: "opml",
+			Content: "<opml><body>\n" +
+				"<outline text=\"Node X\">\n" +
+				"<outline text=\"" + testutil.RandomString(101) + "\" relation=\"" + testutil.RandomString(101) + "\"/>\n" +
+				"</outline>\n" +
+				"<outline text=\"Node Y\" relation=\"" + testutil.RandomString(101) + "\"/>\n" +
+				"</body></opml>",
+		},
+	})
+
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	nodes := ucErr.Response().Source.Nodes
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, "line 3", nodes[0].Location)
+	assert.Regexp(t, "^graph name cannot be longer than 100 characters", nodes[0].Name)
+	assert.Regexp(t, "^graph relation cannot be longer than 100 characters", nodes[0].Relation)
+	assert.Equal(t, "line 5", nodes[1].Location)
+	assert.Empty(t, nodes[1].Name)
+	assert.Regexp(t, "^graph relation cannot be longer than 100 characters", nodes[1].Relation)
+
+	assert.Nil(t, res)
+}
+
+func TestImportGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when graph not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find graph",
+			expectedError: "not found: failed to find graph",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.ImportGraph(openapi.GraphImportRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+				Source:  openapi.GraphImportSource{Format: "markdown", Content: "- Node X\n"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
 		})
 	}
 }
 
-func TestSectionalizeGraphDomainValidationError(t *testing.T) {
-	tooLongSectionName := testutil.RandomString(101)
-	tooLongSectionContent := testutil.RandomString(40001)
+func TestImportGraphConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	tooLongSectionIds := make([]string, 21)
-	for i := 0; i < 21; i++ {
-		tooLongSectionIds[i] = testutil.RandomString(16)
-	}
-	tooLongSections := make([]openapi.SectionWithoutAutofield, 21)
-	for i := 0; i < 21; i++ {
-		tooLongSections[i] = openapi.SectionWithoutAutofield{
-			Name:    testutil.RandomString(10),
-			Content: testutil.RandomString(100),
-		}
-	}
-	tooLongSectionItemsError := make([]openapi.SectionWithoutAutofieldError, 21)
-	for i := 0; i < 21; i++ {
-		tooLongSectionItemsError[i] = openapi.SectionWithoutAutofieldError{}
-	}
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		FindGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(newPatchTargetGraphEntity(t), nil)
+	s.EXPECT().
+		UpdateGraphContent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(
+			newLeafGraphEntity(t, "2000000000000001", "Section", "current paragraph"),
+			service.Errorf(service.ConflictError, "graph has been updated since it was fetched"),
+		)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ImportGraph(openapi.GraphImportRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Source:  openapi.GraphImportSource{Format: "markdown", Content: "- Node X\n"},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: graph has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+
+	assert.Equal(t, "current paragraph", res.Graph.Paragraph)
+}
+
+func TestDeleteGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		DeleteGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+		}).
+		Return(nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+	})
+
+	assert.Nil(t, ucErr)
+}
 
+func TestDeleteGraphDomainValidationError(t *testing.T) {
 	tt := []struct {
-		name       string
-		userId     string
-		projectId  string
-		chapterId  string
-		sectionIds []string
-		sections   []openapi.SectionWithoutAutofield
-		expected   openapi.GraphSectionalizeErrorResponse
+		name      string
+		userId    string
+		projectId string
+		chapterId string
+		sectionId string
+		expected  openapi.GraphDeleteErrorResponse
 	}{
 		{
 			name:      "should return error when user id is empty",
 			userId:    "",
 			projectId: "0000000000000001",
 			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
-			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    "Section",
-					Content: "This is the content of section. This is the content of section.",
-				},
-			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				User: openapi.UserOnlyIdError{
-					Id: "user id is required, but got ''",
-				},
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "",
-						},
-					},
-				},
+			sectionId: "2000000000000001",
+			expected: openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+				Project: openapi.ProjectOnlyIdError{Id: ""},
+				Chapter: openapi.ChapterOnlyIdError{Id: ""},
+				Section: openapi.SectionOnlyIdError{Id: ""},
 			},
 		},
 		{
@@ -1237,27 +1811,12 @@ func TestSectionalizeGraphDomainValidationError(t *testing.T) {
 			userId:    testutil.ModifyOnlyUserId(),
 			projectId: "",
 			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
-			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    "Section",
-					Content: "This is the content of section. This is the content of section.",
-				},
-			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Project: openapi.ProjectOnlyIdError{
-					Id: "project id is required, but got ''",
-				},
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "",
-						},
-					},
-				},
+			sectionId: "2000000000000001",
+			expected: openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: ""},
+				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+				Chapter: openapi.ChapterOnlyIdError{Id: ""},
+				Section: openapi.SectionOnlyIdError{Id: ""},
 			},
 		},
 		{
@@ -1265,64 +1824,147 @@ func TestSectionalizeGraphDomainValidationError(t *testing.T) {
 			userId:    testutil.ModifyOnlyUserId(),
 			projectId: "0000000000000001",
 			chapterId: "",
-			sectionIds: []string{
-				"2000000000000001",
-			},
-			sections: []openapi.SectionWithoutAutofield{
-				{
-					Name:    "Section",
-					Content: "This is the content of section. This is the content of section.",
-				},
-			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Chapter: openapi.ChapterOnlyIdError{
-					Id: "chapter id is required, but got ''",
-				},
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "",
-						},
-					},
-				},
-			},
-		},
-		{
-			name:       "should return error when section is empty",
-			userId:     testutil.ModifyOnlyUserId(),
-			projectId:  "0000000000000001",
-			chapterId:  "1000000000000001",
-			sectionIds: []string{},
-			sections:   []openapi.SectionWithoutAutofield{},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Message: "sections are required, but got []",
-					Items:   []openapi.SectionWithoutAutofieldError{},
-				},
-			},
-		},
-		{
-			name:       "should return error when section is too long",
-			userId:     testutil.ModifyOnlyUserId(),
-			projectId:  "0000000000000001",
-			chapterId:  "1000000000000001",
-			sectionIds: tooLongSectionIds,
-			sections:   tooLongSections,
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Message: "sections length must be less than or equal to 20, but got 21",
-					Items:   tooLongSectionItemsError,
-				},
+			sectionId: "2000000000000001",
+			expected: openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: ""},
+				Project: openapi.ProjectOnlyIdError{Id: ""},
+				Chapter: openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
+				Section: openapi.SectionOnlyIdError{Id: ""},
 			},
 		},
 		{
-			name:      "should return error when section name is empty",
+			name:      "should return error when section id is empty",
 			userId:    testutil.ModifyOnlyUserId(),
 			projectId: "0000000000000001",
 			chapterId: "1000000000000001",
-			sectionIds: []string{
-				"2000000000000001",
+			sectionId: "",
+			expected: openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: ""},
+				Project: openapi.ProjectOnlyIdError{Id: ""},
+				Chapter: openapi.ChapterOnlyIdError{Id: ""},
+				Section: openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+			},
+		},
+		{
+			name:      "should return error when all fields are empty",
+			userId:    "",
+			projectId: "",
+			chapterId: "",
+			sectionId: "",
+			expected: openapi.GraphDeleteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+				Chapter: openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
+				Section: openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
+				User:    openapi.UserOnlyId{Id: tc.userId},
+				Project: openapi.ProjectOnlyId{Id: tc.projectId},
+				Chapter: openapi.ChapterOnlyId{Id: tc.chapterId},
+				Section: openapi.SectionOnlyId{Id: tc.sectionId},
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+		})
+	}
+}
+
+func TestDeleteGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when graph not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to delete graph",
+			expectedError: "not found: failed to delete graph",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			s.EXPECT().
+				DeleteGraph(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			ucErr := uc.DeleteGraph(openapi.GraphDeleteRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestSectionalizeGraphValidEntity(t *testing.T) {
+	maxLengthSectionName := testutil.RandomString(100)
+	maxLengthSectionContent := testutil.RandomString(40000)
+
+	maxLengthSectionIds := make([]string, 20)
+	for i := 0; i < 20; i++ {
+		maxLengthSectionIds[i] = testutil.RandomString(16)
+	}
+	maxLengthSections := make([]openapi.SectionWithoutAutofield, 20)
+	for i := 0; i < 20; i++ {
+		maxLengthSections[i] = openapi.SectionWithoutAutofield{
+			Name:    testutil.RandomString(10),
+			Content: testutil.RandomString(100),
+		}
+	}
+
+	tt := []struct {
+		name       string
+		userId     string
+		projectId  string
+		chapterId  string
+		sectionIds []string
+		sections   []openapi.SectionWithoutAutofield
+	}{
+		{
+			name:      "should sectionalize into graphs",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
 				"2000000000000002",
 			},
 			sections: []openapi.SectionWithoutAutofield{
@@ -1331,92 +1973,1100 @@ func TestSectionalizeGraphDomainValidationError(t *testing.T) {
 					Content: "This is the content of section 1. This is the content of section 1.",
 				},
 				{
-					Name:    "",
+					Name:    "Section 2",
 					Content: "This is the content of section 2. This is the content of section 2.",
 				},
 			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "",
-						},
-						{
-							Name:    "section name is required, but got ''",
-							Content: "",
-						},
-					},
-				},
-			},
 		},
 		{
-			name:      "should return error when section name is too long",
+			name:      "should sectionalize into graphs with max length name",
 			userId:    testutil.ModifyOnlyUserId(),
 			projectId: "0000000000000001",
 			chapterId: "1000000000000001",
 			sectionIds: []string{
 				"2000000000000001",
-				"2000000000000002",
 			},
 			sections: []openapi.SectionWithoutAutofield{
 				{
-					Name:    "Section 1",
-					Content: "This is the content of section 1. This is the content of section 1.",
-				},
-				{
-					Name:    tooLongSectionName,
-					Content: "This is the content of section 2. This is the content of section 2.",
-				},
-			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "",
-						},
-						{
-							Name: fmt.Sprintf("section name cannot be longer than 100 characters, but got '%v'",
-								tooLongSectionName),
-							Content: "",
-						},
-					},
+					Name:    maxLengthSectionName,
+					Content: "This is the content of section. This is the content of section.",
 				},
 			},
 		},
 		{
-			name:      "should return error when section content is too long",
+			name:      "should sectionalize into graphs with max length content",
 			userId:    testutil.ModifyOnlyUserId(),
 			projectId: "0000000000000001",
 			chapterId: "1000000000000001",
 			sectionIds: []string{
 				"2000000000000001",
-				"2000000000000002",
 			},
 			sections: []openapi.SectionWithoutAutofield{
 				{
-					Name:    "Section 1",
-					Content: tooLongSectionContent,
-				},
-				{
-					Name:    "Section 2",
-					Content: "This is the content of section 2. This is the content of section 2.",
+					Name:    "Section",
+					Content: maxLengthSectionContent,
 				},
 			},
-			expected: openapi.GraphSectionalizeErrorResponse{
-				Sections: openapi.SectionWithoutAutofieldListError{
-					Items: []openapi.SectionWithoutAutofieldError{
-						{
-							Name:    "",
-							Content: "section content must be less than or equal to 40000 bytes, but got 40001 bytes",
-						},
-						{
-							Name:    "",
-							Content: "",
-						},
-					},
-				},
+		},
+		{
+			name:       "should sectionalize into graphs with max length sections",
+			userId:     testutil.ModifyOnlyUserId(),
+			projectId:  "0000000000000001",
+			chapterId:  "1000000000000001",
+			sectionIds: maxLengthSectionIds,
+			sections:   maxLengthSections,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			grapths := make([]domain.GraphEntity, len(tc.sections))
+			for i, section := range tc.sections {
+				id, err := domain.NewGraphIdObject(tc.sectionIds[i])
+				assert.Nil(t, err)
+				name, err := domain.NewGraphNameObject(section.Name)
+				assert.Nil(t, err)
+				paragraph, err := domain.NewGraphParagraphObject(section.Content)
+				assert.Nil(t, err)
+				children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+				assert.Nil(t, err)
+				createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+				assert.NoError(t, err)
+				updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+				assert.NoError(t, err)
+				grapths[i] = *domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
+			}
+			s.EXPECT().
+				SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(
+					userId domain.UserIdObject,
+					projectId domain.ProjectIdObject,
+					chapterId domain.ChapterIdObject,
+					sections domain.SectionWithoutAutofieldEntityList) {
+					assert.Equal(t, tc.userId, userId.Value())
+					assert.Equal(t, tc.projectId, projectId.Value())
+					assert.Equal(t, tc.chapterId, chapterId.Value())
+					assert.Equal(t, len(tc.sections), sections.Len())
+					for i, section := range tc.sections {
+						assert.Equal(t, section.Name, sections.Value()[i].Name().Value())
+						assert.Equal(t, section.Content, sections.Value()[i].Content().Value())
+					}
+				}).
+				Return(grapths, nil)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, err := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
+				User:     openapi.UserOnlyId{Id: tc.userId},
+				Project:  openapi.ProjectOnlyId{Id: tc.projectId},
+				Chapter:  openapi.ChapterOnlyId{Id: tc.chapterId},
+				Sections: tc.sections,
+			})
+
+			assert.Nil(t, err)
+
+			assert.Equal(t, len(tc.sections), len(res.Graphs))
+			for i, graph := range res.Graphs {
+				assert.Equal(t, tc.sectionIds[i], graph.Id)
+				assert.Equal(t, tc.sections[i].Name, graph.Name)
+				assert.Equal(t, tc.sections[i].Content, graph.Paragraph)
+			}
+		})
+	}
+}
+
+func TestSectionalizeGraphDomainValidationError(t *testing.T) {
+	tooLongSectionName := testutil.RandomString(101)
+	tooLongSectionContent := testutil.RandomString(40001)
+
+	tooLongSectionIds := make([]string, 21)
+	for i := 0; i < 21; i++ {
+		tooLongSectionIds[i] = testutil.RandomString(16)
+	}
+	tooLongSections := make([]openapi.SectionWithoutAutofield, 21)
+	for i := 0; i < 21; i++ {
+		tooLongSections[i] = openapi.SectionWithoutAutofield{
+			Name:    testutil.RandomString(10),
+			Content: testutil.RandomString(100),
+		}
+	}
+	tooLongSectionItemsError := make([]openapi.SectionWithoutAutofieldError, 21)
+	for i := 0; i < 21; i++ {
+		tooLongSectionItemsError[i] = openapi.SectionWithoutAutofieldError{}
+	}
+
+	tt := []struct {
+		name       string
+		userId     string
+		projectId  string
+		chapterId  string
+		sectionIds []string
+		sections   []openapi.SectionWithoutAutofield
+		expected   openapi.GraphSectionalizeErrorResponse
+	}{
+		{
+			name:      "should return error when user id is empty",
+			userId:    "",
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section",
+					Content: "This is the content of section. This is the content of section.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: "user id is required, but got ''",
+				},
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "should return error when project id is empty",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section",
+					Content: "This is the content of section. This is the content of section.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Project: openapi.ProjectOnlyIdError{
+					Id: "project id is required, but got ''",
+				},
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "should return error when chapter id is empty",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "",
+			sectionIds: []string{
+				"2000000000000001",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section",
+					Content: "This is the content of section. This is the content of section.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Chapter: openapi.ChapterOnlyIdError{
+					Id: "chapter id is required, but got ''",
+				},
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "should return error when section is empty",
+			userId:     testutil.ModifyOnlyUserId(),
+			projectId:  "0000000000000001",
+			chapterId:  "1000000000000001",
+			sectionIds: []string{},
+			sections:   []openapi.SectionWithoutAutofield{},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Message: "sections are required, but got []",
+					Items:   []openapi.SectionWithoutAutofieldError{},
+				},
+			},
+		},
+		{
+			name:       "should return error when section is too long",
+			userId:     testutil.ModifyOnlyUserId(),
+			projectId:  "0000000000000001",
+			chapterId:  "1000000000000001",
+			sectionIds: tooLongSectionIds,
+			sections:   tooLongSections,
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Message: "sections length must be less than or equal to 20, but got 21",
+					Items:   tooLongSectionItemsError,
+				},
+			},
+		},
+		{
+			name:      "should return error when section name is empty",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
+				"2000000000000002",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section 1",
+					Content: "This is the content of section 1. This is the content of section 1.",
+				},
+				{
+					Name:    "",
+					Content: "This is the content of section 2. This is the content of section 2.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "",
+						},
+						{
+							Name:    "section name is required, but got ''",
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "should return error when section name is too long",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
+				"2000000000000002",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section 1",
+					Content: "This is the content of section 1. This is the content of section 1.",
+				},
+				{
+					Name:    tooLongSectionName,
+					Content: "This is the content of section 2. This is the content of section 2.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "",
+						},
+						{
+							Name: fmt.Sprintf("section name cannot be longer than 100 characters, but got '%v'",
+								tooLongSectionName),
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "should return error when section content is too long",
+			userId:    testutil.ModifyOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionIds: []string{
+				"2000000000000001",
+				"2000000000000002",
+			},
+			sections: []openapi.SectionWithoutAutofield{
+				{
+					Name:    "Section 1",
+					Content: tooLongSectionContent,
+				},
+				{
+					Name:    "Section 2",
+					Content: "This is the content of section 2. This is the content of section 2.",
+				},
+			},
+			expected: openapi.GraphSectionalizeErrorResponse{
+				Sections: openapi.SectionWithoutAutofieldListError{
+					Items: []openapi.SectionWithoutAutofieldError{
+						{
+							Name:    "",
+							Content: "section content must be less than or equal to 40000 bytes, but got 40001 bytes",
+						},
+						{
+							Name:    "",
+							Content: "",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
+				User:     openapi.UserOnlyId{Id: tc.userId},
+				Project:  openapi.ProjectOnlyId{Id: tc.projectId},
+				Chapter:  openapi.ChapterOnlyId{Id: tc.chapterId},
+				Sections: tc.sections,
+			})
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestSectionalizeGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when repository returns invalid argument error",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "graph already exists",
+			expectedError: "invalid argument: graph already exists",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when chapter not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find chapter",
+			expectedError: "not found: failed to find chapter",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Sections: []openapi.SectionWithoutAutofield{
+					{
+						Name:    "Section",
+						Content: "This is the content of section. This is the content of section.",
+					},
+				},
+			})
+			assert.NotNil(t, ucErr)
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func newLeafGraphEntity(t *testing.T, key string, graphName string, graphParagraph string) *domain.GraphEntity {
+	id, err := domain.NewGraphIdObject(key)
+	assert.Nil(t, err)
+	name, err := domain.NewGraphNameObject(graphName)
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject(graphParagraph)
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	return domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
+}
+
+func TestPreviewResectionalizationValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		PreviewResectionalization(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sections domain.SectionWithoutAutofieldEntityList,
+		) (*domain.GraphResectionalizationEntity, *service.Error) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, 2, sections.Len())
+
+			graphId, err := domain.NewGraphIdObject("2000000000000001")
+			assert.Nil(t, err)
+			matches := make([]domain.GraphMatchEntity, sections.Len())
+			matches[0] = *domain.NewGraphMatchEntity(*sections.Value()[0].Name(), graphId)
+			matches[1] = *domain.NewGraphMatchEntity(*sections.Value()[1].Name(), nil)
+			orphans := []domain.GraphEntity{
+				*newLeafGraphEntity(t, "2000000000000002", "Old Section", "Obsolete text."),
+			}
+			return domain.NewGraphResectionalizationEntity(matches, orphans), nil
+		})
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.PreviewResectionalization(openapi.GraphResectionalizePreviewRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Sections: []openapi.SectionWithoutAutofield{
+			{Name: "Introduction", Content: "This chapter introduces knowledge graphs."},
+			{Name: "Summary", Content: "A brand new summary."},
+		},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.GraphResectionalizePreviewResponse{
+		Matches: []openapi.GraphMatch{
+			{Name: "Introduction", GraphId: "2000000000000001"},
+			{Name: "Summary"},
+		},
+		Orphans: []openapi.Graph{
+			{
+				Id:        "2000000000000002",
+				Name:      "Old Section",
+				Paragraph: "Obsolete text.",
+				Children:  []openapi.GraphChild{},
+				UpdatedAt: testutil.Date(),
+			},
+		},
+	}, res)
+}
+
+func TestPreviewResectionalizationServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when chapter not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find chapter",
+			expectedError: "not found: failed to find chapter",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				PreviewResectionalization(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.PreviewResectionalization(openapi.GraphResectionalizePreviewRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Sections: []openapi.SectionWithoutAutofield{
+					{Name: "Section", Content: "This is the content of section."},
+				},
+			})
+			assert.NotNil(t, ucErr)
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestResectionalizeGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		ResectionalizeGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sections domain.SectionWithoutAutofieldEntityList,
+			orphanAction domain.GraphOrphanActionObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, 1, sections.Len())
+			assert.Equal(t, "delete", orphanAction.Value())
+		}).
+		Return(
+			[]domain.GraphEntity{
+				*newLeafGraphEntity(t, "2000000000000001", "Introduction", "This chapter introduces graphs."),
+			},
+			[]domain.GraphEntity{
+				*newLeafGraphEntity(t, "2000000000000002", "Old Section", "Obsolete text."),
+			},
+			nil,
+		)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ResectionalizeGraph(openapi.GraphResectionalizeRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Sections: []openapi.SectionWithoutAutofield{
+			{Name: "Introduction", Content: "This chapter introduces graphs."},
+		},
+		OrphanAction: "delete",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.GraphResectionalizeResponse{
+		Graphs: []openapi.Graph{
+			{
+				Id:        "2000000000000001",
+				Name:      "Introduction",
+				Paragraph: "This chapter introduces graphs.",
+				Children:  []openapi.GraphChild{},
+				UpdatedAt: testutil.Date(),
+			},
+		},
+		Orphans: []openapi.Graph{
+			{
+				Id:        "2000000000000002",
+				Name:      "Old Section",
+				Paragraph: "Obsolete text.",
+				Children:  []openapi.GraphChild{},
+				UpdatedAt: testutil.Date(),
+			},
+		},
+	}, res)
+}
+
+func TestResectionalizeGraphDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ResectionalizeGraph(openapi.GraphResectionalizeRequest{
+		User:         openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:      openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:      openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Sections:     []openapi.SectionWithoutAutofield{},
+		OrphanAction: "",
+	})
+
+	expected := openapi.GraphResectionalizeErrorResponse{
+		Sections:     openapi.SectionWithoutAutofieldListError{Message: "sections are required, but got []", Items: []openapi.SectionWithoutAutofieldError{}},
+		OrphanAction: "orphan action must be one of archive, delete, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestResectionalizeGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when chapter not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find chapter",
+			expectedError: "not found: failed to find chapter",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when graphs have been updated",
+			errorCode:     service.ConflictError,
+			errorMessage:  "graphs have been updated since they were fetched",
+			expectedError: "conflict: graphs have been updated since they were fetched",
+			expectedCode:  usecase.ConflictError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				ResectionalizeGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.ResectionalizeGraph(openapi.GraphResectionalizeRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Sections: []openapi.SectionWithoutAutofield{
+					{Name: "Section", Content: "This is the content of section."},
+				},
+				OrphanAction: "archive",
+			})
+			assert.NotNil(t, ucErr)
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestAddGraphNodeValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		AddGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			parentPath domain.GraphPathObject,
+			child domain.GraphChildEntity,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+			assert.Equal(t, 1, parentPath.Len())
+			assert.Equal(t, "Node A", parentPath.Value()[0].Value())
+			assert.Equal(t, "Node A1", child.Name().Value())
+			assert.Equal(t, "part of", child.Relation().Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.AddGraphNode(openapi.GraphNodeAddRequest{
+		User:       openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:    openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:    openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section:    openapi.SectionOnlyId{Id: "2000000000000001"},
+		ParentPath: []string{"Node A"},
+		Child: openapi.GraphChild{
+			Name:        "Node A1",
+			Relation:    "part of",
+			Description: "",
+			Children:    []openapi.GraphChild{},
+		},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Graph{
+		Id:        "2000000000000001",
+		Name:      "Section",
+		Paragraph: "paragraph",
+		Children:  []openapi.GraphChild{},
+		UpdatedAt: testutil.Date(),
+	}, res.Graph)
+}
+
+func TestAddGraphNodeDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.AddGraphNode(openapi.GraphNodeAddRequest{
+		User:       openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:    openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:    openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section:    openapi.SectionOnlyId{Id: ""},
+		ParentPath: []string{""},
+		Child: openapi.GraphChild{
+			Name:     "",
+			Children: []openapi.GraphChild{},
+		},
+	})
+
+	expected := openapi.GraphNodeAddErrorResponse{
+		Section:    openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+		ParentPath: "graph path is invalid at 0: graph name is required, but got ''",
+		Child: openapi.GraphChildError{
+			Name:     "graph name is required, but got ''",
+			Children: openapi.GraphChildrenError{Items: []openapi.GraphChildError{}},
+		},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRenameGraphNodeValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		RenameGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			path domain.GraphPathObject,
+			name domain.GraphNameObject,
+		) {
+			assert.Equal(t, 2, path.Len())
+			assert.Equal(t, "Node X", name.Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.RenameGraphNode(openapi.GraphNodeRenameRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Path:    []string{"Node A", "Node A1"},
+		Name:    "Node X",
+	})
+	assert.Nil(t, ucErr)
+	assert.Equal(t, "2000000000000001", res.Graph.Id)
+}
+
+func TestUpdateGraphNodeValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		UpdateGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			path domain.GraphPathObject,
+			relation domain.GraphRelationObject,
+			description domain.GraphDescriptionObject,
+		) {
+			assert.Equal(t, 1, path.Len())
+			assert.Equal(t, "depends on", relation.Value())
+			assert.Equal(t, "updated description", description.Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.UpdateGraphNode(openapi.GraphNodeUpdateRequest{
+		User:        openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:     openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:     openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section:     openapi.SectionOnlyId{Id: "2000000000000001"},
+		Path:        []string{"Node A"},
+		Relation:    "depends on",
+		Description: "updated description",
+	})
+	assert.Nil(t, ucErr)
+	assert.Equal(t, "2000000000000001", res.Graph.Id)
+}
+
+func TestMoveGraphNodeValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		MoveGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			path domain.GraphPathObject,
+			parentPath domain.GraphPathObject,
+		) {
+			assert.Equal(t, "Node A", path.Value()[0].Value())
+			assert.Equal(t, 0, parentPath.Len())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.MoveGraphNode(openapi.GraphNodeMoveRequest{
+		User:       openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:    openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:    openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section:    openapi.SectionOnlyId{Id: "2000000000000001"},
+		Path:       []string{"Node A"},
+		ParentPath: []string{},
+	})
+	assert.Nil(t, ucErr)
+	assert.Equal(t, "2000000000000001", res.Graph.Id)
+}
+
+func TestDeleteGraphNodeValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+	s.EXPECT().
+		DeleteGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			path domain.GraphPathObject,
+		) {
+			assert.Equal(t, "Node A", path.Value()[0].Value())
+		}).
+		Return(newLeafGraphEntity(t, "2000000000000001", "Section", "paragraph"), nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.DeleteGraphNode(openapi.GraphNodeDeleteRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+		Path:    []string{"Node A"},
+	})
+	assert.Nil(t, ucErr)
+	assert.Equal(t, "2000000000000001", res.Graph.Id)
+}
+
+func TestDeleteGraphNodeServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when node is not found",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "graph child is not found at [\"Node A\"]",
+			expectedError: "invalid argument: graph child is not found at [\"Node A\"]",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when graph is not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find graph",
+			expectedError: "not found: failed to find graph",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+			s.EXPECT().
+				DeleteGraphChild(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			res, ucErr := uc.DeleteGraphNode(openapi.GraphNodeDeleteRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section: openapi.SectionOnlyId{Id: "2000000000000001"},
+				Path:    []string{"Node A"},
+			})
+			assert.NotNil(t, ucErr)
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestListGraphRevisionsValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	id, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("This is graph paragraph")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	authorId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	revision := domain.NewGraphRevisionEntity(*id, *paragraph, *children, *authorId, *createdAt)
+
+	s.EXPECT().
+		ListGraphRevisions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+		}).
+		Return([]domain.GraphRevisionEntity{*revision}, nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.ListGraphRevisions(openapi.GraphRevisionListRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+		ChapterId: "1000000000000001",
+		SectionId: "2000000000000001",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Len(t, res.Revisions, 1)
+	assert.Equal(t, "3000000000000001", res.Revisions[0].Id)
+	assert.Equal(t, testutil.ReadOnlyUserId(), res.Revisions[0].AuthorId)
+	assert.Equal(t, testutil.Date(), res.Revisions[0].CreatedAt)
+}
+
+func TestListGraphRevisionsDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		chapterId string
+		sectionId string
+		expected  openapi.GraphRevisionListErrorResponse
+	}{
+		{
+			name:      "should return error when section id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "0000000000000001",
+			chapterId: "1000000000000001",
+			sectionId: "",
+			expected: openapi.GraphRevisionListErrorResponse{
+				SectionId: "section id is required, but got ''",
+			},
+		},
+		{
+			name:      "should return error when all fields are empty",
+			userId:    "",
+			projectId: "",
+			chapterId: "",
+			sectionId: "",
+			expected: openapi.GraphRevisionListErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "project id is required, but got ''",
+				ChapterId: "chapter id is required, but got ''",
+				SectionId: "section id is required, but got ''",
 			},
 		},
 	}
@@ -1428,15 +3078,14 @@ func TestSectionalizeGraphDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockGraphService(ctrl)
 
-			uc := usecase.NewGraphUseCase(s)
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
 
-			res, ucErr := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
-				User:     openapi.UserOnlyId{Id: tc.userId},
-				Project:  openapi.ProjectOnlyId{Id: tc.projectId},
-				Chapter:  openapi.ChapterOnlyId{Id: tc.chapterId},
-				Sections: tc.sections,
+			res, ucErr := uc.ListGraphRevisions(openapi.GraphRevisionListRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+				ChapterId: tc.chapterId,
+				SectionId: tc.sectionId,
 			})
-			assert.NotNil(t, ucErr)
 
 			expectedJson, _ := json.Marshal(tc.expected)
 			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
@@ -1448,7 +3097,7 @@ func TestSectionalizeGraphDomainValidationError(t *testing.T) {
 	}
 }
 
-func TestSectionalizeGraphServiceError(t *testing.T) {
+func TestListGraphRevisionsServiceError(t *testing.T) {
 	tt := []struct {
 		name          string
 		errorCode     service.ErrorCode
@@ -1457,17 +3106,10 @@ func TestSectionalizeGraphServiceError(t *testing.T) {
 		expectedCode  usecase.ErrorCode
 	}{
 		{
-			name:          "should return error when repository returns invalid argument error",
-			errorCode:     service.InvalidArgumentError,
-			errorMessage:  "graph already exists",
-			expectedError: "invalid argument: graph already exists",
-			expectedCode:  usecase.InvalidArgumentError,
-		},
-		{
-			name:          "should return error when chapter not found",
+			name:          "should return error when graph not found",
 			errorCode:     service.NotFoundError,
-			errorMessage:  "failed to find chapter",
-			expectedError: "not found: failed to find chapter",
+			errorMessage:  "failed to list graph revisions",
+			expectedError: "not found: failed to list graph revisions",
 			expectedCode:  usecase.NotFoundError,
 		},
 		{
@@ -1485,29 +3127,353 @@ func TestSectionalizeGraphServiceError(t *testing.T) {
 			defer ctrl.Finish()
 
 			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
 			s.EXPECT().
-				SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				ListGraphRevisions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			uc := usecase.NewGraphUseCase(s)
-
-			res, ucErr := uc.SectionalizeGraph(openapi.GraphSectionalizeRequest{
-				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
-				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
-				Chapter: openapi.ChapterOnlyId{Id: "1000000000000001"},
-				Sections: []openapi.SectionWithoutAutofield{
-					{
-						Name:    "Section",
-						Content: "This is the content of section. This is the content of section.",
-					},
-				},
+			res, ucErr := uc.ListGraphRevisions(openapi.GraphRevisionListRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
 			})
-			assert.NotNil(t, ucErr)
 
+			assert.Nil(t, res)
 			assert.Equal(t, tc.expectedError, ucErr.Error())
 			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestFindGraphRevisionValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	id, err := domain.NewGraphRevisionIdObject("3000000000000001")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("This is graph paragraph")
+	assert.Nil(t, err)
+	childName, err := domain.NewGraphNameObject("Child")
+	assert.Nil(t, err)
+	childRelation, err := domain.NewGraphRelationObject("relation")
+	assert.Nil(t, err)
+	childDescription, err := domain.NewGraphDescriptionObject("description")
+	assert.Nil(t, err)
+	grandchildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{
+		*domain.NewGraphChildEntity(nil, *childName, *childRelation, *childDescription, *grandchildren),
+	})
+	assert.Nil(t, err)
+	authorId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	revision := domain.NewGraphRevisionEntity(*id, *paragraph, *children, *authorId, *createdAt)
+
+	s.EXPECT().
+		FindGraphRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			revisionId domain.GraphRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+			assert.Equal(t, "3000000000000001", revisionId.Value())
+		}).
+		Return(revision, nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.FindGraphRevision(openapi.GraphRevisionFindRequest{
+		UserId:     testutil.ReadOnlyUserId(),
+		ProjectId:  "0000000000000001",
+		ChapterId:  "1000000000000001",
+		SectionId:  "2000000000000001",
+		RevisionId: "3000000000000001",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.GraphRevision{
+		Id:        "3000000000000001",
+		Paragraph: "This is graph paragraph",
+		Children: []openapi.GraphChild{
+			{Name: "Child", Relation: "relation", Description: "description", Children: []openapi.GraphChild{}},
+		},
+		AuthorId:  testutil.ReadOnlyUserId(),
+		CreatedAt: testutil.Date(),
+	}, res.Revision)
+}
+
+func TestFindGraphRevisionDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.FindGraphRevision(openapi.GraphRevisionFindRequest{
+		UserId:     testutil.ReadOnlyUserId(),
+		ProjectId:  "0000000000000001",
+		ChapterId:  "1000000000000001",
+		SectionId:  "2000000000000001",
+		RevisionId: "",
+	})
+
+	expected := openapi.GraphRevisionFindErrorResponse{
+		RevisionId: "graph revision id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestDiffGraphRevisionsValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	paragraphType, err := domain.NewGraphRevisionChangeTypeObject("paragraph")
+	assert.Nil(t, err)
+	movedType, err := domain.NewGraphRevisionChangeTypeObject("moved")
+	assert.Nil(t, err)
+	nameA, err := domain.NewGraphNameObject("A")
+	assert.Nil(t, err)
+	nameB, err := domain.NewGraphNameObject("B")
+	assert.Nil(t, err)
+	nameC, err := domain.NewGraphNameObject("C")
+	assert.Nil(t, err)
+
+	diff := domain.NewGraphRevisionDiffEntity([]domain.GraphRevisionChangeEntity{
+		*domain.NewGraphRevisionChangeEntity(*paragraphType, nil, nil, "old", "new"),
+		*domain.NewGraphRevisionChangeEntity(*movedType,
+			[]domain.GraphNameObject{*nameA, *nameB}, []domain.GraphNameObject{*nameC, *nameB}, "", ""),
+	})
+
+	s.EXPECT().
+		DiffGraphRevisions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			fromRevisionId domain.GraphRevisionIdObject,
+			toRevisionId domain.GraphRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+			assert.Equal(t, "3000000000000001", fromRevisionId.Value())
+			assert.Equal(t, "3000000000000002", toRevisionId.Value())
+		}).
+		Return(diff, nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.DiffGraphRevisions(openapi.GraphRevisionDiffRequest{
+		UserId:         testutil.ReadOnlyUserId(),
+		ProjectId:      "0000000000000001",
+		ChapterId:      "1000000000000001",
+		SectionId:      "2000000000000001",
+		FromRevisionId: "3000000000000001",
+		ToRevisionId:   "3000000000000002",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.GraphRevisionDiff{
+		FromRevisionId: "3000000000000001",
+		ToRevisionId:   "3000000000000002",
+		Changes: []openapi.GraphRevisionChange{
+			{Type: "paragraph", FromValue: "old", ToValue: "new"},
+			{Type: "moved", FromPath: []string{"A", "B"}, ToPath: []string{"C", "B"}},
+		},
+	}, res.Diff)
+}
+
+func TestDiffGraphRevisionsDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.DiffGraphRevisions(openapi.GraphRevisionDiffRequest{
+		UserId:         testutil.ReadOnlyUserId(),
+		ProjectId:      "0000000000000001",
+		ChapterId:      "1000000000000001",
+		SectionId:      "2000000000000001",
+		FromRevisionId: "",
+		ToRevisionId:   "",
+	})
+
+	expected := openapi.GraphRevisionDiffErrorResponse{
+		FromRevisionId: "graph revision id is required, but got ''",
+		ToRevisionId:   "graph revision id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRestoreGraphRevisionValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	id, err := domain.NewGraphIdObject("2000000000000001")
+	assert.Nil(t, err)
+	name, err := domain.NewGraphNameObject("Section")
+	assert.Nil(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("This is restored paragraph")
+	assert.Nil(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.Nil(t, err)
+
+	graph := domain.NewGraphEntity(*id, *name, *paragraph, *children, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		RestoreGraphRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			sectionId domain.SectionIdObject,
+			revisionId domain.GraphRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+			assert.Equal(t, "3000000000000001", revisionId.Value())
+		}).
+		Return(graph, nil)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.RestoreGraphRevision(openapi.GraphRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:  openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Chapter:  openapi.ChapterOnlyId{Id: "1000000000000001"},
+		Section:  openapi.SectionOnlyId{Id: "2000000000000001"},
+		Revision: openapi.GraphRevisionOnlyId{Id: "3000000000000001"},
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, "2000000000000001", res.Graph.Id)
+	assert.Equal(t, "Section", res.Graph.Name)
+	assert.Equal(t, "This is restored paragraph", res.Graph.Paragraph)
+	assert.Equal(t, []openapi.GraphChild{}, res.Graph.Children)
+	assert.Equal(t, testutil.Date().Add(time.Hour), res.Graph.UpdatedAt)
+}
+
+func TestRestoreGraphRevisionDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockGraphService(ctrl)
+
+	uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+	res, ucErr := uc.RestoreGraphRevision(openapi.GraphRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: ""},
+		Project:  openapi.ProjectOnlyId{Id: ""},
+		Chapter:  openapi.ChapterOnlyId{Id: ""},
+		Section:  openapi.SectionOnlyId{Id: ""},
+		Revision: openapi.GraphRevisionOnlyId{Id: ""},
+	})
+
+	expected := openapi.GraphRevisionRestoreErrorResponse{
+		User:     openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project:  openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+		Chapter:  openapi.ChapterOnlyIdError{Id: "chapter id is required, but got ''"},
+		Section:  openapi.SectionOnlyIdError{Id: "section id is required, but got ''"},
+		Revision: openapi.GraphRevisionOnlyIdError{Id: "graph revision id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRestoreGraphRevisionServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when graph revision not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find graph revision",
+			expectedError: "not found: failed to find graph revision",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockGraphService(ctrl)
+
+			uc := usecase.NewGraphUseCase(s, mock_service.NewMockProjectGraphService(ctrl))
+
+			s.EXPECT().
+				RestoreGraphRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			res, ucErr := uc.RestoreGraphRevision(openapi.GraphRevisionRestoreRequest{
+				User:     openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project:  openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Chapter:  openapi.ChapterOnlyId{Id: "1000000000000001"},
+				Section:  openapi.SectionOnlyId{Id: "2000000000000001"},
+				Revision: openapi.GraphRevisionOnlyId{Id: "3000000000000001"},
+			})
 
 			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
 		})
 	}
 }
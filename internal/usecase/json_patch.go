@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+)
+
+// JsonPatchNull stands for "value": null in a JSON Patch operation,
+// since a nil Value of the model cannot tell null from a missing value
+type JsonPatchNull struct{}
+
+func jsonPatchModelToEntity(patch []openapi.JsonPatchOperation) (
+	[]domain.JsonPatchOperationEntity, *openapi.JsonPatchError, bool) {
+	operations := make([]domain.JsonPatchOperationEntity, len(patch))
+	operationErrors := make([]openapi.JsonPatchOperationError, len(patch))
+
+	operationErrorExists := false
+	for i, operation := range patch {
+		op, opErr := domain.NewJsonPatchOpObject(operation.Op)
+		if opErr != nil {
+			operationErrors[i].Op = opErr.Error()
+			operationErrorExists = true
+		}
+		path, pathErr := domain.NewJsonPointerObject(operation.Path)
+		if pathErr != nil {
+			operationErrors[i].Path = pathErr.Error()
+			operationErrorExists = true
+		}
+		var from *domain.JsonPointerObject
+		var fromErr error
+		if operation.From != "" {
+			from, fromErr = domain.NewJsonPointerObject(operation.From)
+		}
+		if fromErr != nil {
+			operationErrors[i].From = fromErr.Error()
+			operationErrorExists = true
+		}
+		if opErr != nil || pathErr != nil || fromErr != nil {
+			continue
+		}
+
+		value, hasValue := operation.Value, operation.Value != nil
+		if _, ok := value.(JsonPatchNull); ok {
+			value = nil
+		}
+
+		entity, err := domain.NewJsonPatchOperationEntity(*op, *path, from, value, hasValue)
+		if err != nil {
+			operationErrors[i].Message = err.Error()
+			operationErrorExists = true
+			continue
+		}
+		operations[i] = *entity
+	}
+
+	return operations, &openapi.JsonPatchError{Items: operationErrors}, !operationErrorExists
+}
+
+// applyJsonPatch applies operations to the JSON representation of document in order
+// and decodes the result into patched, rejecting members which document does not have.
+func applyJsonPatch(operations []domain.JsonPatchOperationEntity, document any, patched any) (
+	*openapi.JsonPatchError, bool) {
+	source, err := json.Marshal(document)
+	if err != nil {
+		return &openapi.JsonPatchError{Message: fmt.Sprintf("failed to encode document: %v", err)}, false
+	}
+
+	var value any
+	if err := json.Unmarshal(source, &value); err != nil {
+		return &openapi.JsonPatchError{Message: fmt.Sprintf("failed to decode document: %v", err)}, false
+	}
+
+	operationErrors := make([]openapi.JsonPatchOperationError, len(operations))
+	for i, operation := range operations {
+		value, err = operation.Apply(value)
+		if err != nil {
+			operationErrors[i].Message = err.Error()
+			return &openapi.JsonPatchError{Items: operationErrors}, false
+		}
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return &openapi.JsonPatchError{Message: fmt.Sprintf("failed to encode patched document: %v", err)}, false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(patched); err != nil {
+		return &openapi.JsonPatchError{Message: fmt.Sprintf("patched document is invalid: %v", err)}, false
+	}
+	return &openapi.JsonPatchError{}, true
+}
@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type LinkUseCase interface {
+	ListLinks(req openapi.LinkListRequest) (
+		*openapi.LinkListResponse, *Error[openapi.LinkListErrorResponse])
+	CreateLink(req openapi.LinkCreateRequest) (
+		*openapi.LinkCreateResponse, *Error[openapi.LinkCreateErrorResponse])
+	UpdateLink(req openapi.LinkUpdateRequest) (
+		*openapi.LinkUpdateResponse, *Error[openapi.LinkUpdateErrorResponse])
+	DeleteLink(req openapi.LinkDeleteRequest) *Error[openapi.LinkDeleteErrorResponse]
+}
+
+type linkUseCase struct {
+	service service.LinkService
+}
+
+func NewLinkUseCase(service service.LinkService) LinkUseCase {
+	return linkUseCase{service: service}
+}
+
+func (uc linkUseCase) ListLinks(req openapi.LinkListRequest) (
+	*openapi.LinkListResponse, *Error[openapi.LinkListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.LinkListErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListLinks(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.LinkListErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.LinkListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	links := make([]openapi.Link, len(entities))
+	for i, entity := range entities {
+		links[i] = uc.linkEntityToModel(&entity)
+	}
+
+	return &openapi.LinkListResponse{Links: links}, nil
+}
+
+func (uc linkUseCase) CreateLink(req openapi.LinkCreateRequest) (
+	*openapi.LinkCreateResponse, *Error[openapi.LinkCreateErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	from, fromErr, fromOk := uc.endpointModelToEntity(req.Link.From)
+	to, toErr, toOk := uc.endpointModelToEntity(req.Link.To)
+	relation, relationErr := domain.NewGraphRelationObject(req.Link.Relation)
+	description, descriptionErr := domain.NewGraphDescriptionObject(req.Link.Description)
+
+	var link *domain.LinkWithoutAutofieldEntity
+	var linkErr error
+	if fromOk && toOk && relationErr == nil && descriptionErr == nil {
+		link, linkErr = domain.NewLinkWithoutAutofieldEntity(*from, *to, *relation, *description)
+	}
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	linkMsg := ""
+	if linkErr != nil {
+		linkMsg = linkErr.Error()
+	}
+	relationMsg := ""
+	if relationErr != nil {
+		relationMsg = relationErr.Error()
+	}
+	descriptionMsg := ""
+	if descriptionErr != nil {
+		descriptionMsg = descriptionErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || !fromOk || !toOk ||
+		relationErr != nil || descriptionErr != nil || linkErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.LinkCreateErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Link: openapi.LinkWithoutAutofieldError{
+					Message:     linkMsg,
+					From:        *fromErr,
+					To:          *toErr,
+					Relation:    relationMsg,
+					Description: descriptionMsg,
+				},
+			},
+		)
+	}
+
+	entity, sErr := uc.service.CreateLink(*userId, *projectId, *link)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.LinkCreateErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.LinkCreateErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.LinkCreateErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.LinkCreateResponse{Link: uc.linkEntityToModel(entity)}, nil
+}
+
+func (uc linkUseCase) UpdateLink(req openapi.LinkUpdateRequest) (
+	*openapi.LinkUpdateResponse, *Error[openapi.LinkUpdateErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	linkId, linkIdErr := domain.NewLinkIdObject(req.Link.Id)
+	from, fromErr, fromOk := uc.endpointModelToEntity(req.Link.From)
+	to, toErr, toOk := uc.endpointModelToEntity(req.Link.To)
+	relation, relationErr := domain.NewGraphRelationObject(req.Link.Relation)
+	description, descriptionErr := domain.NewGraphDescriptionObject(req.Link.Description)
+
+	var link *domain.LinkWithoutAutofieldEntity
+	var linkErr error
+	if fromOk && toOk && relationErr == nil && descriptionErr == nil {
+		link, linkErr = domain.NewLinkWithoutAutofieldEntity(*from, *to, *relation, *description)
+	}
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	linkMsg := ""
+	if linkErr != nil {
+		linkMsg = linkErr.Error()
+	}
+	linkIdMsg := ""
+	if linkIdErr != nil {
+		linkIdMsg = linkIdErr.Error()
+	}
+	relationMsg := ""
+	if relationErr != nil {
+		relationMsg = relationErr.Error()
+	}
+	descriptionMsg := ""
+	if descriptionErr != nil {
+		descriptionMsg = descriptionErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || linkIdErr != nil || !fromOk || !toOk ||
+		relationErr != nil || descriptionErr != nil || linkErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.LinkUpdateErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Link: openapi.LinkError{
+					Message:     linkMsg,
+					Id:          linkIdMsg,
+					From:        *fromErr,
+					To:          *toErr,
+					Relation:    relationMsg,
+					Description: descriptionMsg,
+				},
+			},
+		)
+	}
+
+	entity, sErr := uc.service.UpdateLink(*userId, *projectId, *linkId, *link)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.LinkUpdateErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.LinkUpdateErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.LinkUpdateErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.LinkUpdateResponse{Link: uc.linkEntityToModel(entity)}, nil
+}
+
+func (uc linkUseCase) DeleteLink(req openapi.LinkDeleteRequest) *Error[openapi.LinkDeleteErrorResponse] {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	linkId, linkIdErr := domain.NewLinkIdObject(req.Link.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	linkIdMsg := ""
+	if linkIdErr != nil {
+		linkIdMsg = linkIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || linkIdErr != nil {
+		return NewModelBasedError(
+			DomainValidationError,
+			openapi.LinkDeleteErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Link: openapi.LinkOnlyIdError{
+					Id: linkIdMsg,
+				},
+			},
+		)
+	}
+
+	sErr := uc.service.DeleteLink(*userId, *projectId, *linkId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.LinkDeleteErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return NewMessageBasedError[openapi.LinkDeleteErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return nil
+}
+
+func (uc linkUseCase) linkEntityToModel(entity *domain.LinkEntity) openapi.Link {
+	return openapi.Link{
+		Id:          entity.Id().Value(),
+		From:        uc.endpointEntityToModel(entity.From()),
+		To:          uc.endpointEntityToModel(entity.To()),
+		Relation:    entity.Relation().Value(),
+		Description: entity.Description().Value(),
+		UpdatedAt:   entity.UpdatedAt().Value(),
+	}
+}
+
+func (uc linkUseCase) endpointEntityToModel(entity *domain.LinkEndpointEntity) openapi.LinkEndpoint {
+	nodeId := ""
+	if entity.NodeId() != nil {
+		nodeId = entity.NodeId().Value()
+	}
+	return openapi.LinkEndpoint{
+		ChapterId: entity.ChapterId().Value(),
+		SectionId: entity.SectionId().Value(),
+		NodeId:    nodeId,
+	}
+}
+
+func (uc linkUseCase) endpointModelToEntity(endpoint openapi.LinkEndpoint) (
+	*domain.LinkEndpointEntity, *openapi.LinkEndpointError, bool) {
+	endpointErr := openapi.LinkEndpointError{}
+
+	chapterId, chapterIdErr := domain.NewChapterIdObject(endpoint.ChapterId)
+	if chapterIdErr != nil {
+		endpointErr.ChapterId = chapterIdErr.Error()
+	}
+	sectionId, sectionIdErr := domain.NewSectionIdObject(endpoint.SectionId)
+	if sectionIdErr != nil {
+		endpointErr.SectionId = sectionIdErr.Error()
+	}
+	var nodeId *domain.GraphChildIdObject
+	var nodeIdErr error
+	if endpoint.NodeId != "" {
+		nodeId, nodeIdErr = domain.NewGraphChildIdObject(endpoint.NodeId)
+		if nodeIdErr != nil {
+			endpointErr.NodeId = nodeIdErr.Error()
+		}
+	}
+
+	if chapterIdErr != nil || sectionIdErr != nil || nodeIdErr != nil {
+		return nil, &endpointErr, false
+	}
+	return domain.NewLinkEndpointEntity(*chapterId, *sectionId, nodeId), &endpointErr, true
+}
@@ -0,0 +1,662 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListLinksValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockLinkService(ctrl)
+
+	link := newLinkEntity(t, "4000000000000001", openapi.LinkWithoutAutofield{
+		From:        openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+		To:          openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+		Relation:    "related to",
+		Description: "description",
+	})
+
+	s.EXPECT().
+		ListLinks(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return([]domain.LinkEntity{*link}, nil)
+
+	uc := usecase.NewLinkUseCase(s)
+
+	res, ucErr := uc.ListLinks(openapi.LinkListRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, []openapi.Link{
+		{
+			Id:          "4000000000000001",
+			From:        openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+			To:          openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+			Relation:    "related to",
+			Description: "description",
+			UpdatedAt:   testutil.Date(),
+		},
+	}, res.Links)
+}
+
+func TestListLinksDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		expected  openapi.LinkListErrorResponse
+	}{
+		{
+			name:      "should return error when user id is empty",
+			userId:    "",
+			projectId: "0000000000000001",
+			expected: openapi.LinkListErrorResponse{
+				UserId: "user id is required, but got ''",
+			},
+		},
+		{
+			name:      "should return error when project id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "",
+			expected: openapi.LinkListErrorResponse{
+				ProjectId: "project id is required, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.ListLinks(openapi.LinkListRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestListLinksServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when service returns not found error",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "not found: failed to fetch project",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when service returns failure panic",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+			s.EXPECT().
+				ListLinks(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.ListLinks(openapi.LinkListRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestCreateLinkValidEntity(t *testing.T) {
+	tt := []struct {
+		name string
+		link openapi.LinkWithoutAutofield
+	}{
+		{
+			name: "should create link between child nodes",
+			link: openapi.LinkWithoutAutofield{
+				From:        openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				To:          openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002", NodeId: "3000000000000002"},
+				Relation:    "related to",
+				Description: "description",
+			},
+		},
+		{
+			name: "should create link between a child node and the root of its graph",
+			link: openapi.LinkWithoutAutofield{
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				Relation: "part of",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+			s.EXPECT().
+				CreateLink(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, link domain.LinkWithoutAutofieldEntity) {
+					assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+					assert.Equal(t, "0000000000000001", projectId.Value())
+					assert.Equal(t, tc.link.From.ChapterId, link.From().ChapterId().Value())
+					assert.Equal(t, tc.link.To.SectionId, link.To().SectionId().Value())
+					assert.Equal(t, tc.link.Relation, link.Relation().Value())
+					assert.Equal(t, tc.link.Description, link.Description().Value())
+				}).
+				Return(newLinkEntity(t, "4000000000000001", tc.link), nil)
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.CreateLink(openapi.LinkCreateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link:    tc.link,
+			})
+			assert.Nil(t, ucErr)
+
+			assert.Equal(t, openapi.Link{
+				Id:          "4000000000000001",
+				From:        tc.link.From,
+				To:          tc.link.To,
+				Relation:    tc.link.Relation,
+				Description: tc.link.Description,
+				UpdatedAt:   testutil.Date(),
+			}, res.Link)
+		})
+	}
+}
+
+func TestCreateLinkDomainValidationError(t *testing.T) {
+	tooLongRelation := testutil.RandomString(101)
+
+	tt := []struct {
+		name     string
+		userId   string
+		link     openapi.LinkWithoutAutofield
+		expected openapi.LinkCreateErrorResponse
+	}{
+		{
+			name:   "should return error when user id is empty",
+			userId: "",
+			link: openapi.LinkWithoutAutofield{
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation: "related to",
+			},
+			expected: openapi.LinkCreateErrorResponse{
+				User: openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+			},
+		},
+		{
+			name:   "should return error when endpoint ids are empty",
+			userId: testutil.ModifyOnlyUserId(),
+			link: openapi.LinkWithoutAutofield{
+				From:     openapi.LinkEndpoint{ChapterId: "", SectionId: "2000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: ""},
+				Relation: "related to",
+			},
+			expected: openapi.LinkCreateErrorResponse{
+				Link: openapi.LinkWithoutAutofieldError{
+					From: openapi.LinkEndpointError{ChapterId: "chapter id is required, but got ''"},
+					To:   openapi.LinkEndpointError{SectionId: "section id is required, but got ''"},
+				},
+			},
+		},
+		{
+			name:   "should return error when relation is too long",
+			userId: testutil.ModifyOnlyUserId(),
+			link: openapi.LinkWithoutAutofield{
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation: tooLongRelation,
+			},
+			expected: openapi.LinkCreateErrorResponse{
+				Link: openapi.LinkWithoutAutofieldError{
+					Relation: fmt.Sprintf("graph relation cannot be longer than 100 characters, but got '%v'",
+						tooLongRelation),
+				},
+			},
+		},
+		{
+			name:   "should return error when link connects a node to itself",
+			userId: testutil.ModifyOnlyUserId(),
+			link: openapi.LinkWithoutAutofield{
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				Relation: "related to",
+			},
+			expected: openapi.LinkCreateErrorResponse{
+				Link: openapi.LinkWithoutAutofieldError{
+					Message: "link cannot connect a node to itself",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.CreateLink(openapi.LinkCreateRequest{
+				User:    openapi.UserOnlyId{Id: tc.userId},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link:    tc.link,
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestCreateLinkServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when service returns invalid argument error",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "link endpoint does not exist",
+			expectedError: "invalid argument: link endpoint does not exist",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when service returns not found error",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to fetch project",
+			expectedError: "not found: failed to fetch project",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when service returns failure panic",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+			s.EXPECT().
+				CreateLink(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.CreateLink(openapi.LinkCreateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link: openapi.LinkWithoutAutofield{
+					From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+					To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+					Relation: "related to",
+				},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestUpdateLinkValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	link := openapi.LinkWithoutAutofield{
+		From:        openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+		To:          openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+		Relation:    "depends on",
+		Description: "updated description",
+	}
+
+	s := mock_service.NewMockLinkService(ctrl)
+	s.EXPECT().
+		UpdateLink(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, linkId domain.LinkIdObject,
+			link domain.LinkWithoutAutofieldEntity) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "4000000000000001", linkId.Value())
+			assert.Nil(t, link.From().NodeId())
+			assert.Equal(t, "3000000000000001", link.To().NodeId().Value())
+			assert.Equal(t, "depends on", link.Relation().Value())
+		}).
+		Return(newLinkEntity(t, "4000000000000001", link), nil)
+
+	uc := usecase.NewLinkUseCase(s)
+
+	res, ucErr := uc.UpdateLink(openapi.LinkUpdateRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Link: openapi.Link{
+			Id:          "4000000000000001",
+			From:        link.From,
+			To:          link.To,
+			Relation:    link.Relation,
+			Description: link.Description,
+		},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Link{
+		Id:          "4000000000000001",
+		From:        link.From,
+		To:          link.To,
+		Relation:    link.Relation,
+		Description: link.Description,
+		UpdatedAt:   testutil.Date(),
+	}, res.Link)
+}
+
+func TestUpdateLinkDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name     string
+		link     openapi.Link
+		expected openapi.LinkUpdateErrorResponse
+	}{
+		{
+			name: "should return error when link id is empty",
+			link: openapi.Link{
+				Id:       "",
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+				Relation: "related to",
+			},
+			expected: openapi.LinkUpdateErrorResponse{
+				Link: openapi.LinkError{Id: "link id is required, but got ''"},
+			},
+		},
+		{
+			name: "should return error when link connects a graph root to itself",
+			link: openapi.Link{
+				Id:       "4000000000000001",
+				From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				Relation: "related to",
+			},
+			expected: openapi.LinkUpdateErrorResponse{
+				Link: openapi.LinkError{Message: "link cannot connect a node to itself"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.UpdateLink(openapi.LinkUpdateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link:    tc.link,
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestUpdateLinkServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when service returns invalid argument error",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "link endpoint does not exist",
+			expectedError: "invalid argument: link endpoint does not exist",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when service returns not found error",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to fetch link",
+			expectedError: "not found: failed to fetch link",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when service returns failure panic",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+			s.EXPECT().
+				UpdateLink(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewLinkUseCase(s)
+
+			res, ucErr := uc.UpdateLink(openapi.LinkUpdateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link: openapi.Link{
+					Id:       "4000000000000001",
+					From:     openapi.LinkEndpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+					To:       openapi.LinkEndpoint{ChapterId: "1000000000000002", SectionId: "2000000000000002"},
+					Relation: "related to",
+				},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestDeleteLinkValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockLinkService(ctrl)
+	s.EXPECT().
+		DeleteLink(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, linkId domain.LinkIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "4000000000000001", linkId.Value())
+		}).
+		Return(nil)
+
+	uc := usecase.NewLinkUseCase(s)
+
+	ucErr := uc.DeleteLink(openapi.LinkDeleteRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Link:    openapi.LinkOnlyId{Id: "4000000000000001"},
+	})
+	assert.Nil(t, ucErr)
+}
+
+func TestDeleteLinkDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockLinkService(ctrl)
+
+	uc := usecase.NewLinkUseCase(s)
+
+	ucErr := uc.DeleteLink(openapi.LinkDeleteRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Link:    openapi.LinkOnlyId{Id: ""},
+	})
+
+	expected := openapi.LinkDeleteErrorResponse{
+		Link: openapi.LinkOnlyIdError{Id: "link id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+}
+
+func TestDeleteLinkServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when service returns not found error",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to fetch link",
+			expectedError: "not found: failed to fetch link",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when service returns failure panic",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockLinkService(ctrl)
+			s.EXPECT().
+				DeleteLink(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewLinkUseCase(s)
+
+			ucErr := uc.DeleteLink(openapi.LinkDeleteRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Link:    openapi.LinkOnlyId{Id: "4000000000000001"},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func newLinkEntity(t *testing.T, id string, link openapi.LinkWithoutAutofield) *domain.LinkEntity {
+	endpoint := func(model openapi.LinkEndpoint) *domain.LinkEndpointEntity {
+		chapterId, err := domain.NewChapterIdObject(model.ChapterId)
+		assert.Nil(t, err)
+		sectionId, err := domain.NewSectionIdObject(model.SectionId)
+		assert.Nil(t, err)
+		var nodeId *domain.GraphChildIdObject
+		if model.NodeId != "" {
+			nodeId, err = domain.NewGraphChildIdObject(model.NodeId)
+			assert.Nil(t, err)
+		}
+		return domain.NewLinkEndpointEntity(*chapterId, *sectionId, nodeId)
+	}
+
+	linkId, err := domain.NewLinkIdObject(id)
+	assert.Nil(t, err)
+	relation, err := domain.NewGraphRelationObject(link.Relation)
+	assert.Nil(t, err)
+	description, err := domain.NewGraphDescriptionObject(link.Description)
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date().Add(-1 * time.Hour))
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	return domain.NewLinkEntity(*linkId, *endpoint(link.From), *endpoint(link.To), *relation, *description,
+		*createdAt, *updatedAt)
+}
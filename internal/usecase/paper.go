@@ -13,14 +13,25 @@ type PaperUseCase interface {
 		*openapi.PaperFindResponse, *Error[openapi.PaperFindErrorResponse])
 	UpdatePaper(request openapi.PaperUpdateRequest) (
 		*openapi.PaperUpdateResponse, *Error[openapi.PaperUpdateErrorResponse])
+	ListPaperRevisions(request openapi.PaperRevisionListRequest) (
+		*openapi.PaperRevisionListResponse, *Error[openapi.PaperRevisionListErrorResponse])
+	FindPaperRevision(request openapi.PaperRevisionFindRequest) (
+		*openapi.PaperRevisionFindResponse, *Error[openapi.PaperRevisionFindErrorResponse])
+	DiffPaperRevisions(request openapi.PaperRevisionDiffRequest) (
+		*openapi.PaperRevisionDiffResponse, *Error[openapi.PaperRevisionDiffErrorResponse])
+	RestorePaperRevision(request openapi.PaperRevisionRestoreRequest) (
+		*openapi.PaperRevisionRestoreResponse, *Error[openapi.PaperRevisionRestoreErrorResponse])
+	SectionalizePaper(request openapi.PaperSectionalizeRequest) (
+		*openapi.PaperSectionalizeResponse, *Error[openapi.PaperSectionalizeErrorResponse])
 }
 
 type paperUseCase struct {
-	service service.PaperService
+	service      service.PaperService
+	graphService service.GraphService
 }
 
-func NewPaperUseCase(service service.PaperService) PaperUseCase {
-	return paperUseCase{service: service}
+func NewPaperUseCase(service service.PaperService, graphService service.GraphService) PaperUseCase {
+	return paperUseCase{service: service, graphService: graphService}
 }
 
 func (uc paperUseCase) FindPaper(req openapi.PaperFindRequest) (
@@ -70,8 +81,9 @@ func (uc paperUseCase) FindPaper(req openapi.PaperFindRequest) (
 
 	return &openapi.PaperFindResponse{
 		Paper: openapi.Paper{
-			Id:      entity.Id().Value(),
-			Content: entity.Content().Value(),
+			Id:        entity.Id().Value(),
+			Content:   entity.Content().Value(),
+			UpdatedAt: entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
@@ -82,6 +94,7 @@ func (uc paperUseCase) UpdatePaper(req openapi.PaperUpdateRequest) (
 	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
 	paperId, paperIdErr := domain.NewPaperIdObject(req.Paper.Id)
 	paperContent, paperContentErr := domain.NewPaperContentObject(req.Paper.Content)
+	updatedAt, updatedAtErr := updatedAtModelToObject(req.Paper.UpdatedAt)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -100,7 +113,7 @@ func (uc paperUseCase) UpdatePaper(req openapi.PaperUpdateRequest) (
 		paperContentMsg = paperContentErr.Error()
 	}
 
-	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil || paperContentErr != nil {
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil || paperContentErr != nil || updatedAtErr != nil {
 		return nil, NewModelBasedError(
 			DomainValidationError,
 			openapi.PaperUpdateErrorResponse{
@@ -113,7 +126,20 @@ func (uc paperUseCase) UpdatePaper(req openapi.PaperUpdateRequest) (
 
 	paper := domain.NewPaperWithoutAutofieldEntity(*paperContent)
 
-	entity, sErr := uc.service.UpdatePaper(*userId, *projectId, *paperId, *paper)
+	entity, sErr := uc.service.UpdatePaper(*userId, *projectId, *paperId, *paper, updatedAt)
+
+	if sErr != nil && sErr.Code() == service.ConflictError {
+		return &openapi.PaperUpdateResponse{
+			Paper: openapi.Paper{
+				Id:        entity.Id().Value(),
+				Content:   entity.Content().Value(),
+				UpdatedAt: entity.UpdatedAt().Value(),
+			},
+		}, NewMessageBasedError[openapi.PaperUpdateErrorResponse](
+			ConflictError,
+			sErr.Unwrap().Error(),
+		)
+	}
 
 	if sErr != nil && sErr.Code() == service.NotFoundError {
 		return nil, NewMessageBasedError[openapi.PaperUpdateErrorResponse](
@@ -130,8 +156,358 @@ func (uc paperUseCase) UpdatePaper(req openapi.PaperUpdateRequest) (
 
 	return &openapi.PaperUpdateResponse{
 		Paper: openapi.Paper{
-			Id:      entity.Id().Value(),
-			Content: entity.Content().Value(),
+			Id:        entity.Id().Value(),
+			Content:   entity.Content().Value(),
+			UpdatedAt: entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
+
+func (uc paperUseCase) ListPaperRevisions(req openapi.PaperRevisionListRequest) (
+	*openapi.PaperRevisionListResponse, *Error[openapi.PaperRevisionListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	paperId, paperIdErr := domain.NewPaperIdObject(req.PaperId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	paperIdMsg := ""
+	if paperIdErr != nil {
+		paperIdMsg = paperIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PaperRevisionListErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				PaperId:   paperIdMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListPaperRevisions(*userId, *projectId, *paperId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PaperRevisionListErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PaperRevisionListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	revisions := make([]openapi.PaperRevisionSummary, len(entities))
+	for i, entity := range entities {
+		revisions[i] = openapi.PaperRevisionSummary{
+			Id:        entity.Id().Value(),
+			AuthorId:  entity.AuthorId().Value(),
+			CreatedAt: entity.CreatedAt().Value(),
+		}
+	}
+
+	return &openapi.PaperRevisionListResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+func (uc paperUseCase) FindPaperRevision(req openapi.PaperRevisionFindRequest) (
+	*openapi.PaperRevisionFindResponse, *Error[openapi.PaperRevisionFindErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	paperId, paperIdErr := domain.NewPaperIdObject(req.PaperId)
+	revisionId, revisionIdErr := domain.NewPaperRevisionIdObject(req.RevisionId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	paperIdMsg := ""
+	if paperIdErr != nil {
+		paperIdMsg = paperIdErr.Error()
+	}
+	revisionIdMsg := ""
+	if revisionIdErr != nil {
+		revisionIdMsg = revisionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil || revisionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PaperRevisionFindErrorResponse{
+				UserId:     userIdMsg,
+				ProjectId:  projectIdMsg,
+				PaperId:    paperIdMsg,
+				RevisionId: revisionIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.FindPaperRevision(*userId, *projectId, *paperId, *revisionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PaperRevisionFindErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PaperRevisionFindErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.PaperRevisionFindResponse{
+		Revision: openapi.PaperRevision{
+			Id:        entity.Id().Value(),
+			Content:   entity.Content().Value(),
+			AuthorId:  entity.AuthorId().Value(),
+			CreatedAt: entity.CreatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc paperUseCase) DiffPaperRevisions(req openapi.PaperRevisionDiffRequest) (
+	*openapi.PaperRevisionDiffResponse, *Error[openapi.PaperRevisionDiffErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	paperId, paperIdErr := domain.NewPaperIdObject(req.PaperId)
+	fromRevisionId, fromRevisionIdErr := domain.NewPaperRevisionIdObject(req.FromRevisionId)
+	toRevisionId, toRevisionIdErr := domain.NewPaperRevisionIdObject(req.ToRevisionId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	paperIdMsg := ""
+	if paperIdErr != nil {
+		paperIdMsg = paperIdErr.Error()
+	}
+	fromRevisionIdMsg := ""
+	if fromRevisionIdErr != nil {
+		fromRevisionIdMsg = fromRevisionIdErr.Error()
+	}
+	toRevisionIdMsg := ""
+	if toRevisionIdErr != nil {
+		toRevisionIdMsg = toRevisionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil ||
+		fromRevisionIdErr != nil || toRevisionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PaperRevisionDiffErrorResponse{
+				UserId:         userIdMsg,
+				ProjectId:      projectIdMsg,
+				PaperId:        paperIdMsg,
+				FromRevisionId: fromRevisionIdMsg,
+				ToRevisionId:   toRevisionIdMsg,
+			},
+		)
+	}
+
+	diff, sErr := uc.service.DiffPaperRevisions(*userId, *projectId, *paperId, *fromRevisionId, *toRevisionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PaperRevisionDiffErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PaperRevisionDiffErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.PaperRevisionDiffResponse{
+		Diff: openapi.PaperRevisionDiff{
+			FromRevisionId: fromRevisionId.Value(),
+			ToRevisionId:   toRevisionId.Value(),
+			UnifiedDiff:    diff.Value(),
+		},
+	}, nil
+}
+
+func (uc paperUseCase) RestorePaperRevision(req openapi.PaperRevisionRestoreRequest) (
+	*openapi.PaperRevisionRestoreResponse, *Error[openapi.PaperRevisionRestoreErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	paperId, paperIdErr := domain.NewPaperIdObject(req.Paper.Id)
+	revisionId, revisionIdErr := domain.NewPaperRevisionIdObject(req.Revision.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	paperIdMsg := ""
+	if paperIdErr != nil {
+		paperIdMsg = paperIdErr.Error()
+	}
+	revisionIdMsg := ""
+	if revisionIdErr != nil {
+		revisionIdMsg = revisionIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil || revisionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PaperRevisionRestoreErrorResponse{
+				User:     openapi.UserOnlyIdError{Id: userIdMsg},
+				Project:  openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Paper:    openapi.PaperOnlyIdError{Id: paperIdMsg},
+				Revision: openapi.PaperRevisionOnlyIdError{Id: revisionIdMsg},
+			},
+		)
+	}
+
+	entity, sErr := uc.service.RestorePaperRevision(*userId, *projectId, *paperId, *revisionId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PaperRevisionRestoreErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PaperRevisionRestoreErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.PaperRevisionRestoreResponse{
+		Paper: openapi.Paper{
+			Id:        entity.Id().Value(),
+			Content:   entity.Content().Value(),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc paperUseCase) SectionalizePaper(req openapi.PaperSectionalizeRequest) (
+	*openapi.PaperSectionalizeResponse, *Error[openapi.PaperSectionalizeErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	paperId, paperIdErr := domain.NewPaperIdObject(req.Paper.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	paperIdMsg := ""
+	if paperIdErr != nil {
+		paperIdMsg = paperIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || paperIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PaperSectionalizeErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: userIdMsg},
+				Project: openapi.ProjectOnlyIdError{Id: projectIdMsg},
+				Paper:   openapi.PaperOnlyIdError{Id: paperIdMsg},
+			},
+		)
+	}
+
+	sections, sErr := uc.service.SectionalizePaper(*userId, *projectId, *paperId)
+	if sErr != nil {
+		return nil, uc.sectionalizeErrorToUseCaseError(sErr)
+	}
+
+	// paper shares its id with the chapter it belongs to
+	chapterId, err := domain.NewChapterIdObject(paperId.Value())
+	if err != nil {
+		return nil, NewMessageBasedError[openapi.PaperSectionalizeErrorResponse](
+			InternalErrorPanic,
+			err.Error(),
+		)
+	}
+
+	entities, sErr := uc.graphService.SectionalizeIntoGraphs(*userId, *projectId, *chapterId, *sections)
+	if sErr != nil {
+		return nil, uc.sectionalizeErrorToUseCaseError(sErr)
+	}
+
+	graphs := make([]openapi.Graph, len(entities))
+	for i, entity := range entities {
+		graphs[i] = openapi.Graph{
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Paragraph: entity.Paragraph().Value(),
+			Children:  uc.graphChildrenEntityToModel(entity.Children()),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		}
+	}
+
+	return &openapi.PaperSectionalizeResponse{Graphs: graphs}, nil
+}
+
+func (uc paperUseCase) sectionalizeErrorToUseCaseError(
+	sErr *service.Error,
+) *Error[openapi.PaperSectionalizeErrorResponse] {
+	if sErr.Code() == service.InvalidArgumentError {
+		return NewMessageBasedError[openapi.PaperSectionalizeErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.PaperSectionalizeErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	return NewMessageBasedError[openapi.PaperSectionalizeErrorResponse](
+		InternalErrorPanic,
+		sErr.Unwrap().Error(),
+	)
+}
+
+func (uc paperUseCase) graphChildrenEntityToModel(entity *domain.GraphChildrenEntity) []openapi.GraphChild {
+	children := make([]openapi.GraphChild, len(entity.Value()))
+	for i, child := range entity.Value() {
+		id := ""
+		if child.Id() != nil {
+			id = child.Id().Value()
+		}
+		children[i] = openapi.GraphChild{
+			Id:          id,
+			Name:        child.Name().Value(),
+			Relation:    child.Relation().Value(),
+			Description: child.Description().Value(),
+			Children:    uc.graphChildrenEntityToModel(child.Children()),
+		}
+	}
+	return children
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
@@ -41,7 +42,7 @@ func TestFindPaperValidEntity(t *testing.T) {
 		}).
 		Return(paper, nil)
 
-	uc := usecase.NewPaperUseCase(s)
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 	res, ucErr := uc.FindPaper(openapi.PaperFindRequest{
 		UserId:    testutil.ReadOnlyUserId(),
@@ -116,7 +117,7 @@ func TestFindPaperDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockPaperService(ctrl)
 
-			uc := usecase.NewPaperUseCase(s)
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 			res, ucErr := uc.FindPaper(openapi.PaperFindRequest{
 				UserId:    tc.userId,
@@ -165,7 +166,7 @@ func TestFindPaperServiceError(t *testing.T) {
 
 			s := mock_service.NewMockPaperService(ctrl)
 
-			uc := usecase.NewPaperUseCase(s)
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 			s.EXPECT().
 				FindPaper(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -220,16 +221,17 @@ func TestUpdatePaperValidEntity(t *testing.T) {
 			paper := domain.NewPaperEntity(*id, *content, *createdAt, *updatedAt)
 
 			s.EXPECT().
-				UpdatePaper(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, paperId domain.PaperIdObject, paper domain.PaperWithoutAutofieldEntity) {
+				UpdatePaper(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, paperId domain.PaperIdObject, paper domain.PaperWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
 					assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
 					assert.Equal(t, "0000000000000001", projectId.Value())
 					assert.Equal(t, "1000000000000001", paperId.Value())
 					assert.Equal(t, tc.content, paper.Content().Value())
+					assert.Nil(t, expectedUpdatedAt)
 				}).
 				Return(paper, nil)
 
-			uc := usecase.NewPaperUseCase(s)
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 			res, ucErr := uc.UpdatePaper(openapi.PaperUpdateRequest{
 				User:    openapi.UserOnlyId{Id: testutil.ReadOnlyUserId()},
@@ -319,7 +321,7 @@ func TestUpdatePaperDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockPaperService(ctrl)
 
-			uc := usecase.NewPaperUseCase(s)
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 			res, ucErr := uc.UpdatePaper(openapi.PaperUpdateRequest{
 				User:    openapi.UserOnlyId{Id: tc.userId},
@@ -371,10 +373,10 @@ func TestUpdatePaperServiceError(t *testing.T) {
 
 			s := mock_service.NewMockPaperService(ctrl)
 
-			uc := usecase.NewPaperUseCase(s)
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
 
 			s.EXPECT().
-				UpdatePaper(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				UpdatePaper(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
 			res, ucErr := uc.UpdatePaper(openapi.PaperUpdateRequest{
@@ -392,3 +394,680 @@ func TestUpdatePaperServiceError(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePaperConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	id, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("current content")
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.Nil(t, err)
+
+	paper := domain.NewPaperEntity(*id, *content, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		UpdatePaper(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, paperId domain.PaperIdObject,
+			paper domain.PaperWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
+			assert.Equal(t, testutil.Date(), expectedUpdatedAt.Value())
+		}).
+		Return(paper, service.Errorf(service.ConflictError, "paper has been updated since it was fetched"))
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.UpdatePaper(openapi.PaperUpdateRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Paper: openapi.Paper{
+			Id:        "1000000000000001",
+			Content:   "updated content",
+			UpdatedAt: testutil.Date(),
+		},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: paper has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+	assert.Nil(t, ucErr.Response())
+
+	assert.Equal(t, openapi.Paper{
+		Id:        "1000000000000001",
+		Content:   "current content",
+		UpdatedAt: testutil.Date().Add(time.Hour),
+	}, res.Paper)
+}
+
+func TestListPaperRevisionsValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	id, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("This is paper content")
+	assert.Nil(t, err)
+	authorId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	revision := domain.NewPaperRevisionEntity(*id, *content, *authorId, *createdAt)
+
+	s.EXPECT().
+		ListPaperRevisions(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, paperId domain.PaperIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", paperId.Value())
+		}).
+		Return([]domain.PaperRevisionEntity{*revision}, nil)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.ListPaperRevisions(openapi.PaperRevisionListRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+		PaperId:   "1000000000000001",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Len(t, res.Revisions, 1)
+	assert.Equal(t, "2000000000000001", res.Revisions[0].Id)
+	assert.Equal(t, testutil.ReadOnlyUserId(), res.Revisions[0].AuthorId)
+	assert.Equal(t, testutil.Date(), res.Revisions[0].CreatedAt)
+}
+
+func TestListPaperRevisionsDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		paperId   string
+		expected  openapi.PaperRevisionListErrorResponse
+	}{
+		{
+			name:      "should return error when paper id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "0000000000000001",
+			paperId:   "",
+			expected: openapi.PaperRevisionListErrorResponse{
+				UserId:    "",
+				ProjectId: "",
+				PaperId:   "paper id is required, but got ''",
+			},
+		},
+		{
+			name:      "should return error when all fields are empty",
+			userId:    "",
+			projectId: "",
+			paperId:   "",
+			expected: openapi.PaperRevisionListErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "project id is required, but got ''",
+				PaperId:   "paper id is required, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPaperService(ctrl)
+
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+			res, ucErr := uc.ListPaperRevisions(openapi.PaperRevisionListRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+				PaperId:   tc.paperId,
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestListPaperRevisionsServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when paper not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to list paper revisions",
+			expectedError: "not found: failed to list paper revisions",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPaperService(ctrl)
+
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+			s.EXPECT().
+				ListPaperRevisions(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			res, ucErr := uc.ListPaperRevisions(openapi.PaperRevisionListRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+				PaperId:   "1000000000000001",
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestFindPaperRevisionValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	id, err := domain.NewPaperRevisionIdObject("2000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("This is paper content")
+	assert.Nil(t, err)
+	authorId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+
+	revision := domain.NewPaperRevisionEntity(*id, *content, *authorId, *createdAt)
+
+	s.EXPECT().
+		FindPaperRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			paperId domain.PaperIdObject,
+			revisionId domain.PaperRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", paperId.Value())
+			assert.Equal(t, "2000000000000001", revisionId.Value())
+		}).
+		Return(revision, nil)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.FindPaperRevision(openapi.PaperRevisionFindRequest{
+		UserId:     testutil.ReadOnlyUserId(),
+		ProjectId:  "0000000000000001",
+		PaperId:    "1000000000000001",
+		RevisionId: "2000000000000001",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, "2000000000000001", res.Revision.Id)
+	assert.Equal(t, "This is paper content", res.Revision.Content)
+	assert.Equal(t, testutil.ReadOnlyUserId(), res.Revision.AuthorId)
+	assert.Equal(t, testutil.Date(), res.Revision.CreatedAt)
+}
+
+func TestFindPaperRevisionDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.FindPaperRevision(openapi.PaperRevisionFindRequest{
+		UserId:     testutil.ReadOnlyUserId(),
+		ProjectId:  "0000000000000001",
+		PaperId:    "1000000000000001",
+		RevisionId: "",
+	})
+
+	expected := openapi.PaperRevisionFindErrorResponse{
+		RevisionId: "paper revision id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestDiffPaperRevisionsValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	diff, err := domain.NewPaperRevisionDiffObject("--- 2000000000000001\n+++ 2000000000000002\n@@ -1 +1 @@\n-a\n+b\n")
+	assert.Nil(t, err)
+
+	s.EXPECT().
+		DiffPaperRevisions(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			paperId domain.PaperIdObject,
+			fromRevisionId domain.PaperRevisionIdObject,
+			toRevisionId domain.PaperRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", paperId.Value())
+			assert.Equal(t, "2000000000000001", fromRevisionId.Value())
+			assert.Equal(t, "2000000000000002", toRevisionId.Value())
+		}).
+		Return(diff, nil)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.DiffPaperRevisions(openapi.PaperRevisionDiffRequest{
+		UserId:         testutil.ReadOnlyUserId(),
+		ProjectId:      "0000000000000001",
+		PaperId:        "1000000000000001",
+		FromRevisionId: "2000000000000001",
+		ToRevisionId:   "2000000000000002",
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, "2000000000000001", res.Diff.FromRevisionId)
+	assert.Equal(t, "2000000000000002", res.Diff.ToRevisionId)
+	assert.Equal(t, "--- 2000000000000001\n+++ 2000000000000002\n@@ -1 +1 @@\n-a\n+b\n", res.Diff.UnifiedDiff)
+}
+
+func TestDiffPaperRevisionsDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.DiffPaperRevisions(openapi.PaperRevisionDiffRequest{
+		UserId:         testutil.ReadOnlyUserId(),
+		ProjectId:      "0000000000000001",
+		PaperId:        "1000000000000001",
+		FromRevisionId: "",
+		ToRevisionId:   "",
+	})
+
+	expected := openapi.PaperRevisionDiffErrorResponse{
+		FromRevisionId: "paper revision id is required, but got ''",
+		ToRevisionId:   "paper revision id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRestorePaperRevisionValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	id, err := domain.NewPaperIdObject("1000000000000001")
+	assert.Nil(t, err)
+	content, err := domain.NewPaperContentObject("This is restored content")
+	assert.Nil(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.Nil(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.Nil(t, err)
+
+	paper := domain.NewPaperEntity(*id, *content, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		RestorePaperRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			paperId domain.PaperIdObject,
+			revisionId domain.PaperRevisionIdObject,
+		) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", paperId.Value())
+			assert.Equal(t, "2000000000000001", revisionId.Value())
+		}).
+		Return(paper, nil)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.RestorePaperRevision(openapi.PaperRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project:  openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Paper:    openapi.PaperOnlyId{Id: "1000000000000001"},
+		Revision: openapi.PaperRevisionOnlyId{Id: "2000000000000001"},
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, "1000000000000001", res.Paper.Id)
+	assert.Equal(t, "This is restored content", res.Paper.Content)
+	assert.Equal(t, testutil.Date().Add(time.Hour), res.Paper.UpdatedAt)
+}
+
+func TestRestorePaperRevisionDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.RestorePaperRevision(openapi.PaperRevisionRestoreRequest{
+		User:     openapi.UserOnlyId{Id: ""},
+		Project:  openapi.ProjectOnlyId{Id: ""},
+		Paper:    openapi.PaperOnlyId{Id: ""},
+		Revision: openapi.PaperRevisionOnlyId{Id: ""},
+	})
+
+	expected := openapi.PaperRevisionRestoreErrorResponse{
+		User:     openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project:  openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+		Paper:    openapi.PaperOnlyIdError{Id: "paper id is required, but got ''"},
+		Revision: openapi.PaperRevisionOnlyIdError{Id: "paper revision id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRestorePaperRevisionServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when paper revision not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find paper revision",
+			expectedError: "not found: failed to find paper revision",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPaperService(ctrl)
+
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+			s.EXPECT().
+				RestorePaperRevision(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			res, ucErr := uc.RestorePaperRevision(openapi.PaperRevisionRestoreRequest{
+				User:     openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project:  openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Paper:    openapi.PaperOnlyId{Id: "1000000000000001"},
+				Revision: openapi.PaperRevisionOnlyId{Id: "2000000000000001"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestSectionalizePaperValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+	gs := mock_service.NewMockGraphService(ctrl)
+
+	sectionNames := []string{"Introduction", "Conclusion"}
+	sectionContents := []string{"This is the introduction.", "This is the conclusion."}
+
+	sectionItems := make([]domain.SectionWithoutAutofieldEntity, len(sectionNames))
+	graphs := make([]domain.GraphEntity, len(sectionNames))
+	for i := range sectionNames {
+		name, err := domain.NewSectionNameObject(sectionNames[i])
+		assert.Nil(t, err)
+		content, err := domain.NewSectionContentObject(sectionContents[i])
+		assert.Nil(t, err)
+		sectionItems[i] = *domain.NewSectionWithoutAutofieldEntity(*name, *content)
+
+		id, err := domain.NewGraphIdObject(fmt.Sprintf("200000000000000%d", i+1))
+		assert.Nil(t, err)
+		graphName, err := domain.NewGraphNameObject(sectionNames[i])
+		assert.Nil(t, err)
+		paragraph, err := domain.NewGraphParagraphObject(sectionContents[i])
+		assert.Nil(t, err)
+		children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+		assert.Nil(t, err)
+		createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+		assert.Nil(t, err)
+		updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+		assert.Nil(t, err)
+		graphs[i] = *domain.NewGraphEntity(*id, *graphName, *paragraph, *children, *createdAt, *updatedAt)
+	}
+	sections, err := domain.NewSectionWithoutAutofieldEntityList(sectionItems)
+	assert.Nil(t, err)
+
+	s.EXPECT().
+		SectionalizePaper(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, paperId domain.PaperIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", paperId.Value())
+		}).
+		Return(sections, nil)
+
+	gs.EXPECT().
+		SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			chapterId domain.ChapterIdObject,
+			actual domain.SectionWithoutAutofieldEntityList) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, *sections, actual)
+		}).
+		Return(graphs, nil)
+
+	uc := usecase.NewPaperUseCase(s, gs)
+
+	res, ucErr := uc.SectionalizePaper(openapi.PaperSectionalizeRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Paper:   openapi.PaperOnlyId{Id: "1000000000000001"},
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, []openapi.Graph{
+		{
+			Id:        "2000000000000001",
+			Name:      "Introduction",
+			Paragraph: "This is the introduction.",
+			Children:  []openapi.GraphChild{},
+			UpdatedAt: testutil.Date(),
+		},
+		{
+			Id:        "2000000000000002",
+			Name:      "Conclusion",
+			Paragraph: "This is the conclusion.",
+			Children:  []openapi.GraphChild{},
+			UpdatedAt: testutil.Date(),
+		},
+	}, res.Graphs)
+}
+
+func TestSectionalizePaperDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+
+	uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+	res, ucErr := uc.SectionalizePaper(openapi.PaperSectionalizeRequest{
+		User:    openapi.UserOnlyId{Id: ""},
+		Project: openapi.ProjectOnlyId{Id: ""},
+		Paper:   openapi.PaperOnlyId{Id: ""},
+	})
+
+	expected := openapi.PaperSectionalizeErrorResponse{
+		User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+		Paper:   openapi.PaperOnlyIdError{Id: "paper id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestSectionalizePaperServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when paper cannot be sectionalized",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "sections are required, but got []",
+			expectedError: "invalid argument: sections are required, but got []",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when paper not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to sectionalize paper",
+			expectedError: "not found: failed to sectionalize paper",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPaperService(ctrl)
+			s.EXPECT().
+				SectionalizePaper(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewPaperUseCase(s, mock_service.NewMockGraphService(ctrl))
+
+			res, ucErr := uc.SectionalizePaper(openapi.PaperSectionalizeRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Paper:   openapi.PaperOnlyId{Id: "1000000000000001"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestSectionalizePaperGraphServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPaperService(ctrl)
+	gs := mock_service.NewMockGraphService(ctrl)
+
+	name, err := domain.NewSectionNameObject("Introduction")
+	assert.Nil(t, err)
+	content, err := domain.NewSectionContentObject("This is the introduction.")
+	assert.Nil(t, err)
+	sections, err := domain.NewSectionWithoutAutofieldEntityList([]domain.SectionWithoutAutofieldEntity{
+		*domain.NewSectionWithoutAutofieldEntity(*name, *content),
+	})
+	assert.Nil(t, err)
+
+	s.EXPECT().
+		SectionalizePaper(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(sections, nil)
+	gs.EXPECT().
+		SectionalizeIntoGraphs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, service.Errorf(service.InvalidArgumentError, "failed to sectionalize into graphs: graph already exists"))
+
+	uc := usecase.NewPaperUseCase(s, gs)
+
+	res, ucErr := uc.SectionalizePaper(openapi.PaperSectionalizeRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Paper:   openapi.PaperOnlyId{Id: "1000000000000001"},
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, "invalid argument: failed to sectionalize into graphs: graph already exists", ucErr.Error())
+	assert.Equal(t, usecase.InvalidArgumentError, ucErr.Code())
+}
@@ -1,6 +1,11 @@
 package usecase
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/archive"
+	"github.com/kumachan-mis/knodeledge-api/internal/compile"
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
@@ -13,19 +18,35 @@ type ProjectUseCase interface {
 		*openapi.ProjectListResponse, *Error[openapi.ProjectListErrorResponse])
 	FindProject(req openapi.ProjectFindRequest) (
 		*openapi.ProjectFindResponse, *Error[openapi.ProjectFindErrorResponse])
+	FindProjectGraph(req openapi.ProjectGraphRequest) (
+		*openapi.ProjectGraphResponse, *Error[openapi.ProjectGraphErrorResponse])
+	ExportProject(req openapi.ProjectExportRequest) (
+		*openapi.ProjectExportResponse, *Error[openapi.ProjectExportErrorResponse])
+	ImportProject(req openapi.ProjectImportRequest) (
+		*openapi.ProjectImportResponse, *Error[openapi.ProjectImportErrorResponse])
+	CompileProject(req openapi.ProjectCompileRequest) (
+		*openapi.ProjectCompileResponse, *Error[openapi.ProjectCompileErrorResponse])
 	CreateProject(req openapi.ProjectCreateRequest) (
 		*openapi.ProjectCreateResponse, *Error[openapi.ProjectCreateErrorResponse])
 	UpdateProject(req openapi.ProjectUpdateRequest) (
 		*openapi.ProjectUpdateResponse, *Error[openapi.ProjectUpdateErrorResponse])
+	PatchProject(req openapi.ProjectPatchRequest) (
+		*openapi.ProjectUpdateResponse, *Error[openapi.ProjectPatchErrorResponse])
 	DeleteProject(req openapi.ProjectDeleteRequest) *Error[openapi.ProjectDeleteErrorResponse]
 }
 
 type projectUseCase struct {
-	service service.ProjectService
+	service        service.ProjectService
+	graphService   service.ProjectGraphService
+	archiveService service.ProjectArchiveService
 }
 
-func NewProjectUseCase(service service.ProjectService) ProjectUseCase {
-	return projectUseCase{service: service}
+func NewProjectUseCase(
+	service service.ProjectService,
+	graphService service.ProjectGraphService,
+	archiveService service.ProjectArchiveService,
+) ProjectUseCase {
+	return projectUseCase{service: service, graphService: graphService, archiveService: archiveService}
 }
 
 func (uc projectUseCase) ListProjects(req openapi.ProjectListRequest) (
@@ -52,6 +73,8 @@ func (uc projectUseCase) ListProjects(req openapi.ProjectListRequest) (
 			Id:          entity.Id().Value(),
 			Name:        entity.Name().Value(),
 			Description: entity.Description().Value(),
+			Role:        entity.Role().Value(),
+			UpdatedAt:   entity.UpdatedAt().Value(),
 		}
 	}
 	return &openapi.ProjectListResponse{Projects: projects}, nil
@@ -100,10 +123,241 @@ func (uc projectUseCase) FindProject(req openapi.ProjectFindRequest) (
 			Id:          entity.Id().Value(),
 			Name:        entity.Name().Value(),
 			Description: entity.Description().Value(),
+			Role:        entity.Role().Value(),
+			UpdatedAt:   entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc projectUseCase) FindProjectGraph(req openapi.ProjectGraphRequest) (
+	*openapi.ProjectGraphResponse, *Error[openapi.ProjectGraphErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectGraphErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.graphService.FindProjectGraph(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectGraphErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectGraphErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	nodes := make([]openapi.ProjectGraphNode, len(entity.Nodes()))
+	for i, node := range entity.Nodes() {
+		nodes[i] = openapi.ProjectGraphNode{
+			Name:        node.Name().Value(),
+			Occurrences: uc.occurrenceEntitiesToModels(node.Occurrences()),
+		}
+	}
+	edges := make([]openapi.ProjectGraphEdge, len(entity.Edges()))
+	for i, edge := range entity.Edges() {
+		edges[i] = openapi.ProjectGraphEdge{
+			Source:      edge.Source().Value(),
+			Target:      edge.Target().Value(),
+			Relation:    edge.Relation().Value(),
+			Description: edge.Description().Value(),
+			Occurrences: uc.occurrenceEntitiesToModels(edge.Occurrences()),
+		}
+	}
+
+	return &openapi.ProjectGraphResponse{
+		Graph: openapi.ProjectGraph{
+			Nodes: nodes,
+			Edges: edges,
+		},
+	}, nil
+}
+
+func (uc projectUseCase) ExportProject(req openapi.ProjectExportRequest) (
+	*openapi.ProjectExportResponse, *Error[openapi.ProjectExportErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectExportErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.archiveService.ExportProject(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectExportErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectExportErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	content, err := archive.Write(uc.archiveEntityToModel(entity))
+	if err != nil {
+		return nil, NewMessageBasedError[openapi.ProjectExportErrorResponse](
+			InternalErrorPanic,
+			err.Error(),
+		)
+	}
+
+	return &openapi.ProjectExportResponse{
+		FileName: fmt.Sprintf("%s.zip", projectId.Value()),
+		Archive:  content,
+	}, nil
+}
+
+func (uc projectUseCase) ImportProject(req openapi.ProjectImportRequest) (
+	*openapi.ProjectImportResponse, *Error[openapi.ProjectImportErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	content, contentErr := archive.Read(req.Archive)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	contentMsg := ""
+	if contentErr != nil {
+		contentMsg = contentErr.Error()
+	}
+
+	if userIdErr != nil || contentErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectImportErrorResponse{
+				UserId:  userIdMsg,
+				Archive: openapi.ProjectArchiveError{Message: contentMsg},
+			},
+		)
+	}
+
+	entity, archiveErr, archiveOk := uc.archiveModelToEntity(*content)
+	if !archiveOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectImportErrorResponse{Archive: *archiveErr},
+		)
+	}
+
+	project, sErr := uc.archiveService.ImportProject(*userId, *entity)
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectImportErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.ProjectImportResponse{
+		Project: openapi.Project{
+			Id:          project.Id().Value(),
+			Name:        project.Name().Value(),
+			Description: project.Description().Value(),
+			Role:        project.Role().Value(),
+			UpdatedAt:   project.UpdatedAt().Value(),
 		},
 	}, nil
 }
 
+func (uc projectUseCase) CompileProject(req openapi.ProjectCompileRequest) (
+	*openapi.ProjectCompileResponse, *Error[openapi.ProjectCompileErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	format, formatErr := domain.NewProjectCompileFormatObject(req.Format)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	formatMsg := ""
+	if formatErr != nil {
+		formatMsg = formatErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || formatErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectCompileErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				Format:    formatMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.archiveService.ExportProject(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectCompileErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectCompileErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	document := compile.FromProjectArchive(projectId.Value(), *entity, req.IncludeGraphs)
+	document.Modified = time.Now()
+
+	content, err := compile.Render(*document, format.Value())
+	if err != nil {
+		return nil, NewMessageBasedError[openapi.ProjectCompileErrorResponse](
+			InternalErrorPanic,
+			err.Error(),
+		)
+	}
+
+	return &openapi.ProjectCompileResponse{
+		FileName:  fmt.Sprintf("%s.%s", projectId.Value(), compile.Extension(format.Value())),
+		MediaType: compile.MediaType(format.Value()),
+		Content:   content,
+	}, nil
+}
+
 func (uc projectUseCase) CreateProject(req openapi.ProjectCreateRequest) (
 	*openapi.ProjectCreateResponse, *Error[openapi.ProjectCreateErrorResponse]) {
 	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
@@ -153,6 +407,8 @@ func (uc projectUseCase) CreateProject(req openapi.ProjectCreateRequest) (
 			Id:          entity.Id().Value(),
 			Name:        entity.Name().Value(),
 			Description: entity.Description().Value(),
+			Role:        entity.Role().Value(),
+			UpdatedAt:   entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
@@ -163,6 +419,7 @@ func (uc projectUseCase) UpdateProject(req openapi.ProjectUpdateRequest) (
 	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
 	projectName, projectNameErr := domain.NewProjectNameObject(req.Project.Name)
 	projectDesc, projectDescErr := domain.NewProjectDescriptionObject(req.Project.Description)
+	updatedAt, updatedAtErr := updatedAtModelToObject(req.Project.UpdatedAt)
 
 	userIdMsg := ""
 	if userIdErr != nil {
@@ -181,7 +438,7 @@ func (uc projectUseCase) UpdateProject(req openapi.ProjectUpdateRequest) (
 		projectDescMsg = projectDescErr.Error()
 	}
 
-	if userIdErr != nil || projectIdErr != nil || projectNameErr != nil || projectDescErr != nil {
+	if userIdErr != nil || projectIdErr != nil || projectNameErr != nil || projectDescErr != nil || updatedAtErr != nil {
 		return nil, NewModelBasedError(
 			DomainValidationError,
 			openapi.ProjectUpdateErrorResponse{
@@ -199,7 +456,21 @@ func (uc projectUseCase) UpdateProject(req openapi.ProjectUpdateRequest) (
 
 	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDesc)
 
-	entity, sErr := uc.service.UpdateProject(*userId, *projectId, *project)
+	entity, sErr := uc.service.UpdateProject(*userId, *projectId, *project, updatedAt)
+	if sErr != nil && sErr.Code() == service.ConflictError {
+		return &openapi.ProjectUpdateResponse{
+			Project: openapi.Project{
+				Id:          entity.Id().Value(),
+				Name:        entity.Name().Value(),
+				Description: entity.Description().Value(),
+				Role:        entity.Role().Value(),
+				UpdatedAt:   entity.UpdatedAt().Value(),
+			},
+		}, NewMessageBasedError[openapi.ProjectUpdateErrorResponse](
+			ConflictError,
+			sErr.Unwrap().Error(),
+		)
+	}
 	if sErr != nil && sErr.Code() == service.NotFoundError {
 		return nil, NewMessageBasedError[openapi.ProjectUpdateErrorResponse](
 			NotFoundError,
@@ -218,6 +489,125 @@ func (uc projectUseCase) UpdateProject(req openapi.ProjectUpdateRequest) (
 			Id:          entity.Id().Value(),
 			Name:        entity.Name().Value(),
 			Description: entity.Description().Value(),
+			Role:        entity.Role().Value(),
+			UpdatedAt:   entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc projectUseCase) PatchProject(req openapi.ProjectPatchRequest) (
+	*openapi.ProjectUpdateResponse, *Error[openapi.ProjectPatchErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+	operations, patchErr, patchOk := jsonPatchModelToEntity(req.Patch)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || !patchOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectPatchErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+				Patch:     *patchErr,
+			},
+		)
+	}
+
+	current, sErr := uc.service.FindProject(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectPatchErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectPatchErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	var content openapi.ProjectWithoutAutofield
+	patchErr, patchOk = applyJsonPatch(operations, map[string]any{
+		"name":        current.Name().Value(),
+		"description": current.Description().Value(),
+	}, &content)
+	if !patchOk {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectPatchErrorResponse{Patch: *patchErr},
+		)
+	}
+
+	projectName, projectNameErr := domain.NewProjectNameObject(content.Name)
+	projectDesc, projectDescErr := domain.NewProjectDescriptionObject(content.Description)
+
+	projectNameMsg := ""
+	if projectNameErr != nil {
+		projectNameMsg = projectNameErr.Error()
+	}
+	projectDescMsg := ""
+	if projectDescErr != nil {
+		projectDescMsg = projectDescErr.Error()
+	}
+
+	if projectNameErr != nil || projectDescErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectPatchErrorResponse{
+				Project: openapi.ProjectWithoutAutofieldError{
+					Name:        projectNameMsg,
+					Description: projectDescMsg,
+				},
+			},
+		)
+	}
+
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDesc)
+
+	entity, sErr := uc.service.UpdateProject(*userId, *projectId, *project, current.UpdatedAt())
+	if sErr != nil && sErr.Code() == service.ConflictError {
+		return &openapi.ProjectUpdateResponse{
+			Project: openapi.Project{
+				Id:          entity.Id().Value(),
+				Name:        entity.Name().Value(),
+				Description: entity.Description().Value(),
+				Role:        entity.Role().Value(),
+				UpdatedAt:   entity.UpdatedAt().Value(),
+			},
+		}, NewMessageBasedError[openapi.ProjectPatchErrorResponse](
+			ConflictError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectPatchErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectPatchErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.ProjectUpdateResponse{
+		Project: openapi.Project{
+			Id:          entity.Id().Value(),
+			Name:        entity.Name().Value(),
+			Description: entity.Description().Value(),
+			Role:        entity.Role().Value(),
+			UpdatedAt:   entity.UpdatedAt().Value(),
 		},
 	}, nil
 }
@@ -261,3 +651,248 @@ func (uc projectUseCase) DeleteProject(req openapi.ProjectDeleteRequest) *Error[
 
 	return nil
 }
+
+func (uc projectUseCase) occurrenceEntitiesToModels(
+	entities []domain.ProjectGraphOccurrenceEntity) []openapi.ProjectGraphOccurrence {
+	occurrences := make([]openapi.ProjectGraphOccurrence, len(entities))
+	for i, entity := range entities {
+		occurrences[i] = openapi.ProjectGraphOccurrence{
+			ChapterId: entity.ChapterId().Value(),
+			SectionId: entity.SectionId().Value(),
+		}
+		if entity.NodeId() != nil {
+			occurrences[i].NodeId = entity.NodeId().Value()
+		}
+	}
+	return occurrences
+}
+
+func (uc projectUseCase) archiveEntityToModel(entity *domain.ProjectArchiveEntity) archive.Archive {
+	chapters := make([]archive.Chapter, len(entity.Chapters()))
+	for i, chapter := range entity.Chapters() {
+		sections := make([]archive.Section, len(chapter.Sections()))
+		for j, section := range chapter.Sections() {
+			sections[j] = archive.Section{
+				Id:        section.Id().Value(),
+				Name:      section.Name().Value(),
+				Paragraph: section.Paragraph().Value(),
+				Children:  uc.archiveChildrenEntityToModel(section.Children()),
+			}
+		}
+		chapters[i] = archive.Chapter{
+			Id:       chapter.Id().Value(),
+			Name:     chapter.Name().Value(),
+			Paper:    chapter.Paper().Value(),
+			Sections: sections,
+		}
+	}
+
+	links := make([]archive.Link, len(entity.Links()))
+	for i, link := range entity.Links() {
+		links[i] = archive.Link{
+			From:        uc.archiveEndpointEntityToModel(link.From()),
+			To:          uc.archiveEndpointEntityToModel(link.To()),
+			Relation:    link.Relation().Value(),
+			Description: link.Description().Value(),
+		}
+	}
+
+	return archive.Archive{
+		Project: archive.Project{
+			Name:        entity.Project().Name().Value(),
+			Description: entity.Project().Description().Value(),
+		},
+		Chapters: chapters,
+		Links:    links,
+	}
+}
+
+func (uc projectUseCase) archiveChildrenEntityToModel(entity *domain.GraphChildrenEntity) []archive.Child {
+	children := make([]archive.Child, entity.Len())
+	for i, child := range entity.Value() {
+		id := ""
+		if child.Id() != nil {
+			id = child.Id().Value()
+		}
+		children[i] = archive.Child{
+			Id:          id,
+			Name:        child.Name().Value(),
+			Relation:    child.Relation().Value(),
+			Description: child.Description().Value(),
+			Children:    uc.archiveChildrenEntityToModel(child.Children()),
+		}
+	}
+	return children
+}
+
+func (uc projectUseCase) archiveEndpointEntityToModel(entity *domain.LinkEndpointEntity) archive.Endpoint {
+	endpoint := archive.Endpoint{
+		ChapterId: entity.ChapterId().Value(),
+		SectionId: entity.SectionId().Value(),
+	}
+	if entity.NodeId() != nil {
+		endpoint.NodeId = entity.NodeId().Value()
+	}
+	return endpoint
+}
+
+func (uc projectUseCase) archiveModelToEntity(model archive.Archive) (
+	*domain.ProjectArchiveEntity, *openapi.ProjectArchiveError, bool) {
+	archiveErr := openapi.ProjectArchiveError{}
+	ok := true
+
+	projectName, projectNameErr := domain.NewProjectNameObject(model.Project.Name)
+	if projectNameErr != nil {
+		archiveErr.Project.Name = projectNameErr.Error()
+		ok = false
+	}
+	projectDescription, projectDescriptionErr := domain.NewProjectDescriptionObject(model.Project.Description)
+	if projectDescriptionErr != nil {
+		archiveErr.Project.Description = projectDescriptionErr.Error()
+		ok = false
+	}
+
+	chapters := make([]domain.ProjectArchiveChapterEntity, len(model.Chapters))
+	archiveErr.Chapters = make([]openapi.ProjectArchiveChapterError, len(model.Chapters))
+	for i, chapter := range model.Chapters {
+		entity, chapterErr, chapterOk := uc.archiveChapterModelToEntity(chapter)
+		archiveErr.Chapters[i] = *chapterErr
+		if !chapterOk {
+			ok = false
+			continue
+		}
+		chapters[i] = *entity
+	}
+
+	links := make([]domain.LinkWithoutAutofieldEntity, len(model.Links))
+	archiveErr.Links = make([]openapi.LinkWithoutAutofieldError, len(model.Links))
+	for i, link := range model.Links {
+		entity, linkErr, linkOk := uc.archiveLinkModelToEntity(link)
+		archiveErr.Links[i] = *linkErr
+		if !linkOk {
+			ok = false
+			continue
+		}
+		links[i] = *entity
+	}
+
+	if !ok {
+		return nil, &archiveErr, false
+	}
+
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+	entity, err := domain.NewProjectArchiveEntity(*project, chapters, links)
+	if err != nil {
+		return nil, &openapi.ProjectArchiveError{Message: err.Error()}, false
+	}
+	return entity, &archiveErr, true
+}
+
+func (uc projectUseCase) archiveChapterModelToEntity(model archive.Chapter) (
+	*domain.ProjectArchiveChapterEntity, *openapi.ProjectArchiveChapterError, bool) {
+	chapterErr := openapi.ProjectArchiveChapterError{}
+	ok := true
+
+	id, idErr := domain.NewChapterIdObject(model.Id)
+	if idErr != nil {
+		chapterErr.Id = idErr.Error()
+		ok = false
+	}
+	name, nameErr := domain.NewChapterNameObject(model.Name)
+	if nameErr != nil {
+		chapterErr.Name = nameErr.Error()
+		ok = false
+	}
+	paper, paperErr := domain.NewPaperContentObject(model.Paper)
+	if paperErr != nil {
+		chapterErr.Paper = paperErr.Error()
+		ok = false
+	}
+
+	sections := make([]domain.ProjectArchiveSectionEntity, len(model.Sections))
+	chapterErr.Sections = make([]openapi.ProjectArchiveSectionError, len(model.Sections))
+	for i, section := range model.Sections {
+		sectionId, sectionIdErr := domain.NewSectionIdObject(section.Id)
+		if sectionIdErr != nil {
+			chapterErr.Sections[i].Id = sectionIdErr.Error()
+		}
+		sectionName, sectionNameErr := domain.NewSectionNameObject(section.Name)
+		if sectionNameErr != nil {
+			chapterErr.Sections[i].Name = sectionNameErr.Error()
+		}
+		paragraph, paragraphErr := domain.NewGraphParagraphObject(section.Paragraph)
+		if paragraphErr != nil {
+			chapterErr.Sections[i].Paragraph = paragraphErr.Error()
+		}
+		children, childrenErr, childrenOk := graphUseCase{}.childrenModelToEntity(uc.archiveChildrenToModel(section.Children))
+		chapterErr.Sections[i].Children = *childrenErr
+
+		if sectionIdErr != nil || sectionNameErr != nil || paragraphErr != nil || !childrenOk {
+			ok = false
+			continue
+		}
+		sections[i] = *domain.NewProjectArchiveSectionEntity(*sectionId, *sectionName, *paragraph, *children)
+	}
+
+	if !ok {
+		return nil, &chapterErr, false
+	}
+
+	entity, err := domain.NewProjectArchiveChapterEntity(*id, *name, *paper, sections)
+	if err != nil {
+		chapterErr.Message = err.Error()
+		return nil, &chapterErr, false
+	}
+	return entity, &chapterErr, true
+}
+
+func (uc projectUseCase) archiveChildrenToModel(children []archive.Child) []openapi.GraphChild {
+	models := make([]openapi.GraphChild, len(children))
+	for i, child := range children {
+		models[i] = openapi.GraphChild{
+			Id:          child.Id,
+			Name:        child.Name,
+			Relation:    child.Relation,
+			Description: child.Description,
+			Children:    uc.archiveChildrenToModel(child.Children),
+		}
+	}
+	return models
+}
+
+func (uc projectUseCase) archiveLinkModelToEntity(model archive.Link) (
+	*domain.LinkWithoutAutofieldEntity, *openapi.LinkWithoutAutofieldError, bool) {
+	linkErr := openapi.LinkWithoutAutofieldError{}
+
+	from, fromErr, fromOk := linkUseCase{}.endpointModelToEntity(uc.archiveEndpointToModel(model.From))
+	linkErr.From = *fromErr
+	to, toErr, toOk := linkUseCase{}.endpointModelToEntity(uc.archiveEndpointToModel(model.To))
+	linkErr.To = *toErr
+	relation, relationErr := domain.NewGraphRelationObject(model.Relation)
+	if relationErr != nil {
+		linkErr.Relation = relationErr.Error()
+	}
+	description, descriptionErr := domain.NewGraphDescriptionObject(model.Description)
+	if descriptionErr != nil {
+		linkErr.Description = descriptionErr.Error()
+	}
+
+	if !fromOk || !toOk || relationErr != nil || descriptionErr != nil {
+		return nil, &linkErr, false
+	}
+
+	entity, err := domain.NewLinkWithoutAutofieldEntity(*from, *to, *relation, *description)
+	if err != nil {
+		linkErr.Message = err.Error()
+		return nil, &linkErr, false
+	}
+	return entity, &linkErr, true
+}
+
+func (uc projectUseCase) archiveEndpointToModel(endpoint archive.Endpoint) openapi.LinkEndpoint {
+	return openapi.LinkEndpoint{
+		ChapterId: endpoint.ChapterId,
+		SectionId: endpoint.SectionId,
+		NodeId:    endpoint.NodeId,
+	}
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ProjectMemberUseCase interface {
+	ListProjectMembers(req openapi.ProjectMemberListRequest) (
+		*openapi.ProjectMemberListResponse, *Error[openapi.ProjectMemberListErrorResponse])
+	InviteProjectMember(req openapi.ProjectMemberInviteRequest) (
+		*openapi.ProjectMemberInviteResponse, *Error[openapi.ProjectMemberInviteErrorResponse])
+	RemoveProjectMember(req openapi.ProjectMemberRemoveRequest) *Error[openapi.ProjectMemberRemoveErrorResponse]
+}
+
+type projectMemberUseCase struct {
+	service service.ProjectMemberService
+}
+
+func NewProjectMemberUseCase(service service.ProjectMemberService) ProjectMemberUseCase {
+	return projectMemberUseCase{service: service}
+}
+
+func (uc projectMemberUseCase) ListProjectMembers(req openapi.ProjectMemberListRequest) (
+	*openapi.ProjectMemberListResponse, *Error[openapi.ProjectMemberListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectMemberListErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListProjectMembers(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectMemberListErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectMemberListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	members := make([]openapi.ProjectMember, len(entities))
+	for i, entity := range entities {
+		members[i] = uc.entityToProjectMember(entity)
+	}
+	return &openapi.ProjectMemberListResponse{Members: members}, nil
+}
+
+func (uc projectMemberUseCase) InviteProjectMember(req openapi.ProjectMemberInviteRequest) (
+	*openapi.ProjectMemberInviteResponse, *Error[openapi.ProjectMemberInviteErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	memberId, memberIdErr := domain.NewUserIdObject(req.Member.Id)
+	role, roleErr := domain.NewProjectMemberRoleObject(req.Member.Role)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	memberIdMsg := ""
+	if memberIdErr != nil {
+		memberIdMsg = memberIdErr.Error()
+	}
+	roleMsg := ""
+	if roleErr != nil {
+		roleMsg = roleErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || memberIdErr != nil || roleErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectMemberInviteErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Member: openapi.ProjectMemberError{
+					Id:   memberIdMsg,
+					Role: roleMsg,
+				},
+			},
+		)
+	}
+
+	entity, sErr := uc.service.InviteProjectMember(*userId, *projectId, *memberId, *role)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.ProjectMemberInviteErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ProjectMemberInviteErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ProjectMemberInviteErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.ProjectMemberInviteResponse{Member: uc.entityToProjectMember(*entity)}, nil
+}
+
+func (uc projectMemberUseCase) RemoveProjectMember(
+	req openapi.ProjectMemberRemoveRequest,
+) *Error[openapi.ProjectMemberRemoveErrorResponse] {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	memberId, memberIdErr := domain.NewUserIdObject(req.Member.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	memberIdMsg := ""
+	if memberIdErr != nil {
+		memberIdMsg = memberIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || memberIdErr != nil {
+		return NewModelBasedError(
+			DomainValidationError,
+			openapi.ProjectMemberRemoveErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Member: openapi.UserOnlyIdError{
+					Id: memberIdMsg,
+				},
+			},
+		)
+	}
+
+	sErr := uc.service.RemoveProjectMember(*userId, *projectId, *memberId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.ProjectMemberRemoveErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return NewMessageBasedError[openapi.ProjectMemberRemoveErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return nil
+}
+
+func (uc projectMemberUseCase) entityToProjectMember(entity domain.ProjectMemberEntity) openapi.ProjectMember {
+	return openapi.ProjectMember{
+		Id:   entity.UserId().Value(),
+		Role: entity.Role().Value(),
+	}
+}
@@ -0,0 +1,316 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListProjectMembersValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerId, err := domain.NewUserIdObject(testutil.ModifyOnlyUserId())
+	assert.NoError(t, err)
+	ownerRole, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	viewerId, err := domain.NewUserIdObject(testutil.ReadOnlyUserId())
+	assert.NoError(t, err)
+	viewerRole, err := domain.NewProjectRoleObject("viewer")
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockProjectMemberService(ctrl)
+	s.EXPECT().
+		ListProjectMembers(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return([]domain.ProjectMemberEntity{
+			*domain.NewProjectMemberEntity(*ownerId, *ownerRole),
+			*domain.NewProjectMemberEntity(*viewerId, *viewerRole),
+		}, nil)
+
+	uc := usecase.NewProjectMemberUseCase(s)
+
+	res, ucErr := uc.ListProjectMembers(openapi.ProjectMemberListRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.ProjectMemberListResponse{
+		Members: []openapi.ProjectMember{
+			{Id: testutil.ModifyOnlyUserId(), Role: "owner"},
+			{Id: testutil.ReadOnlyUserId(), Role: "viewer"},
+		},
+	}, res)
+}
+
+func TestListProjectMembersDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectMemberService(ctrl)
+
+	uc := usecase.NewProjectMemberUseCase(s)
+
+	res, ucErr := uc.ListProjectMembers(openapi.ProjectMemberListRequest{})
+
+	expected := openapi.ProjectMemberListErrorResponse{
+		UserId:    "user id is required, but got ''",
+		ProjectId: "project id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestInviteProjectMemberValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectMemberService(ctrl)
+	s.EXPECT().
+		InviteProjectMember(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			userId domain.UserIdObject,
+			projectId domain.ProjectIdObject,
+			memberId domain.UserIdObject,
+			role domain.ProjectRoleObject,
+		) (*domain.ProjectMemberEntity, *service.Error) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, testutil.ReadOnlyUserId(), memberId.Value())
+			assert.Equal(t, "editor", role.Value())
+			return domain.NewProjectMemberEntity(memberId, role), nil
+		})
+
+	uc := usecase.NewProjectMemberUseCase(s)
+
+	res, ucErr := uc.InviteProjectMember(openapi.ProjectMemberInviteRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Member:  openapi.ProjectMember{Id: testutil.ReadOnlyUserId(), Role: "editor"},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.ProjectMemberInviteResponse{
+		Member: openapi.ProjectMember{Id: testutil.ReadOnlyUserId(), Role: "editor"},
+	}, res)
+}
+
+func TestInviteProjectMemberDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name     string
+		request  openapi.ProjectMemberInviteRequest
+		expected openapi.ProjectMemberInviteErrorResponse
+	}{
+		{
+			name: "should return error when role is owner",
+			request: openapi.ProjectMemberInviteRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Member:  openapi.ProjectMember{Id: testutil.ReadOnlyUserId(), Role: "owner"},
+			},
+			expected: openapi.ProjectMemberInviteErrorResponse{
+				Member: openapi.ProjectMemberError{
+					Role: "project member role must be editor or viewer, but got 'owner'",
+				},
+			},
+		},
+		{
+			name:    "should return error when all fields are empty",
+			request: openapi.ProjectMemberInviteRequest{},
+			expected: openapi.ProjectMemberInviteErrorResponse{
+				User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+				Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+				Member: openapi.ProjectMemberError{
+					Id:   "user id is required, but got ''",
+					Role: "project member role must be editor or viewer, but got ''",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectMemberService(ctrl)
+
+			uc := usecase.NewProjectMemberUseCase(s)
+
+			res, ucErr := uc.InviteProjectMember(tc.request)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestInviteProjectMemberServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when member is owner",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "project owner cannot be a member",
+			expectedError: "invalid argument: project owner cannot be a member",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to invite project member",
+			expectedError: "not found: failed to invite project member",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectMemberService(ctrl)
+			s.EXPECT().
+				InviteProjectMember(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewProjectMemberUseCase(s)
+
+			res, ucErr := uc.InviteProjectMember(openapi.ProjectMemberInviteRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Member:  openapi.ProjectMember{Id: testutil.ReadOnlyUserId(), Role: "viewer"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestRemoveProjectMemberValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectMemberService(ctrl)
+	s.EXPECT().
+		RemoveProjectMember(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, memberId domain.UserIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, testutil.ReadOnlyUserId(), memberId.Value())
+		}).
+		Return(nil)
+
+	uc := usecase.NewProjectMemberUseCase(s)
+
+	ucErr := uc.RemoveProjectMember(openapi.ProjectMemberRemoveRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Member:  openapi.UserOnlyId{Id: testutil.ReadOnlyUserId()},
+	})
+	assert.Nil(t, ucErr)
+}
+
+func TestRemoveProjectMemberDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectMemberService(ctrl)
+
+	uc := usecase.NewProjectMemberUseCase(s)
+
+	ucErr := uc.RemoveProjectMember(openapi.ProjectMemberRemoveRequest{})
+
+	expected := openapi.ProjectMemberRemoveErrorResponse{
+		User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+		Member:  openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+}
+
+func TestRemoveProjectMemberServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when member not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to remove project member",
+			expectedError: "not found: failed to remove project member",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectMemberService(ctrl)
+			s.EXPECT().
+				RemoveProjectMember(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewProjectMemberUseCase(s)
+
+			ucErr := uc.RemoveProjectMember(openapi.ProjectMemberRemoveRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Member:  openapi.UserOnlyId{Id: testutil.ReadOnlyUserId()},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kumachan-mis/knodeledge-api/internal/archive"
 	"github.com/kumachan-mis/knodeledge-api/internal/domain"
 	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
 	"github.com/kumachan-mis/knodeledge-api/internal/service"
@@ -28,12 +29,14 @@ func TestListProjectsValidEntity(t *testing.T) {
 	assert.NoError(t, err)
 	description, err := domain.NewProjectDescriptionObject("This is a project")
 	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
 	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
 	assert.NoError(t, err)
 	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
 	assert.NoError(t, err)
 
-	projectWithDesc := domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt)
+	projectWithDesc := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
 
 	id, err = domain.NewProjectIdObject("0000000000000002")
 	assert.NoError(t, err)
@@ -41,12 +44,14 @@ func TestListProjectsValidEntity(t *testing.T) {
 	assert.NoError(t, err)
 	description, err = domain.NewProjectDescriptionObject("")
 	assert.NoError(t, err)
+	role, err = domain.NewProjectRoleObject("editor")
+	assert.NoError(t, err)
 	createdAt, err = domain.NewCreatedAtObject(testutil.Date().Add(-1 * time.Hour))
 	assert.NoError(t, err)
 	updatedAt, err = domain.NewUpdatedAtObject(testutil.Date().Add(-1 * time.Hour))
 	assert.NoError(t, err)
 
-	projectWithoutDesc := domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt)
+	projectWithoutDesc := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
 
 	s.EXPECT().
 		ListProjects(gomock.Any()).
@@ -55,7 +60,7 @@ func TestListProjectsValidEntity(t *testing.T) {
 		}).
 		Return([]domain.ProjectEntity{*projectWithDesc, *projectWithoutDesc}, nil)
 
-	uc := usecase.NewProjectUseCase(s)
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 	res, ucErr := uc.ListProjects(openapi.ProjectListRequest{
 		UserId: testutil.ReadOnlyUserId(),
@@ -68,11 +73,13 @@ func TestListProjectsValidEntity(t *testing.T) {
 	assert.Equal(t, "0000000000000001", project.Id)
 	assert.Equal(t, "Project With Description", project.Name)
 	assert.Equal(t, "This is a project", project.Description)
+	assert.Equal(t, "owner", project.Role)
 
 	project = res.Projects[1]
 	assert.Equal(t, "0000000000000002", project.Id)
 	assert.Equal(t, "Project Without Description", project.Name)
 	assert.Equal(t, "", project.Description)
+	assert.Equal(t, "editor", project.Role)
 }
 
 func TestListProjectsDomainValidationError(t *testing.T) {
@@ -98,37 +105,800 @@ func TestListProjectsDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockProjectService(ctrl)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.ListProjects(openapi.ProjectListRequest{
+				UserId: tc.userId,
+			})
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestListProjectsServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+
+	s.EXPECT().
+		ListProjects(gomock.Any()).
+		Return(nil, service.Errorf(service.RepositoryFailurePanic, "service error"))
+
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+	res, ucErr := uc.ListProjects(openapi.ProjectListRequest{
+		UserId: testutil.ReadOnlyUserId(),
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "internal error: service error", ucErr.Error())
+	assert.Equal(t, usecase.InternalErrorPanic, ucErr.Code())
+	assert.Nil(t, ucErr.Response())
+	assert.Nil(t, res)
+}
+
+func TestFindProjectValidEntity(t *testing.T) {
+	tt := []struct {
+		name               string
+		projectId          string
+		projectName        string
+		projectDescription string
+	}{
+		{
+			name:               "should find project with description",
+			projectId:          "0000000000000001",
+			projectName:        "Project With Description",
+			projectDescription: "This is a project",
+		},
+		{
+			name:               "should find project without description",
+			projectId:          "0000000000000002",
+			projectName:        "Project Without Description",
+			projectDescription: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+
+			id, err := domain.NewProjectIdObject(tc.projectId)
+			assert.NoError(t, err)
+			name, err := domain.NewProjectNameObject(tc.projectName)
+			assert.NoError(t, err)
+			description, err := domain.NewProjectDescriptionObject(tc.projectDescription)
+			assert.NoError(t, err)
+			role, err := domain.NewProjectRoleObject("owner")
+			assert.NoError(t, err)
+			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+			updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+
+			project := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
+
+			s.EXPECT().
+				FindProject(gomock.Any(), gomock.Any()).
+				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+					assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+					assert.Equal(t, tc.projectId, projectId.Value())
+				}).
+				Return(project, nil)
+
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: tc.projectId,
+			})
+			assert.Nil(t, ucErr)
+
+			assert.Equal(t, tc.projectId, res.Project.Id)
+			assert.Equal(t, tc.projectName, res.Project.Name)
+			assert.Equal(t, tc.projectDescription, res.Project.Description)
+		})
+	}
+}
+
+func TestFindProjectDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		expected  openapi.ProjectFindErrorResponse
+	}{
+		{
+			name:      "should return error when user id is empty",
+			userId:    "",
+			projectId: "0000000000000001",
+			expected: openapi.ProjectFindErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "",
+			},
+		},
+		{
+			name:      "should return error when project id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "",
+			expected: openapi.ProjectFindErrorResponse{
+				UserId:    "",
+				ProjectId: "project id is required, but got ''",
+			},
+		},
+		{
+			name:      "should return error when all fields are empty",
+			userId:    "",
+			projectId: "",
+			expected: openapi.ProjectFindErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "project id is required, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+			})
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestFindProjectServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find project",
+			expectedError: "not found: failed to find project",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+
+			s.EXPECT().
+				FindProject(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+			})
+			assert.NotNil(t, ucErr)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, ucErr.Response())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestFindProjectGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+	gs := mock_service.NewMockProjectGraphService(ctrl)
+
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.NoError(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.NoError(t, err)
+	nodeId, err := domain.NewGraphChildIdObject("3000000000000001")
+	assert.NoError(t, err)
+	sectionName, err := domain.NewGraphNameObject("Section 1")
+	assert.NoError(t, err)
+	conceptName, err := domain.NewGraphNameObject("Concept")
+	assert.NoError(t, err)
+	relation, err := domain.NewGraphRelationObject("part of")
+	assert.NoError(t, err)
+	description, err := domain.NewGraphDescriptionObject("description")
+	assert.NoError(t, err)
+
+	rootOccurrence := domain.NewProjectGraphOccurrenceEntity(*chapterId, *sectionId, nil)
+	childOccurrence := domain.NewProjectGraphOccurrenceEntity(*chapterId, *sectionId, nodeId)
+
+	graph := domain.NewProjectGraphEntity(
+		[]domain.ProjectGraphNodeEntity{
+			*domain.NewProjectGraphNodeEntity(*sectionName,
+				[]domain.ProjectGraphOccurrenceEntity{*rootOccurrence}),
+			*domain.NewProjectGraphNodeEntity(*conceptName,
+				[]domain.ProjectGraphOccurrenceEntity{*childOccurrence}),
+		},
+		[]domain.ProjectGraphEdgeEntity{
+			*domain.NewProjectGraphEdgeEntity(*sectionName, *conceptName, *relation, *description,
+				[]domain.ProjectGraphOccurrenceEntity{*childOccurrence}),
+		},
+	)
+
+	gs.EXPECT().
+		FindProjectGraph(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return(graph, nil)
+
+	uc := usecase.NewProjectUseCase(s, gs, mock_service.NewMockProjectArchiveService(ctrl))
+
+	res, ucErr := uc.FindProjectGraph(openapi.ProjectGraphRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.ProjectGraph{
+		Nodes: []openapi.ProjectGraphNode{
+			{
+				Name: "Section 1",
+				Occurrences: []openapi.ProjectGraphOccurrence{
+					{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				},
+			},
+			{
+				Name: "Concept",
+				Occurrences: []openapi.ProjectGraphOccurrence{
+					{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				},
+			},
+		},
+		Edges: []openapi.ProjectGraphEdge{
+			{
+				Source:      "Section 1",
+				Target:      "Concept",
+				Relation:    "part of",
+				Description: "description",
+				Occurrences: []openapi.ProjectGraphOccurrence{
+					{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				},
+			},
+		},
+	}, res.Graph)
+}
+
+func TestFindProjectGraphDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		expected  openapi.ProjectGraphErrorResponse
+	}{
+		{
+			name:      "should return error when user id is empty",
+			userId:    "",
+			projectId: "0000000000000001",
+			expected: openapi.ProjectGraphErrorResponse{
+				UserId:    "user id is required, but got ''",
+				ProjectId: "",
+			},
+		},
+		{
+			name:      "should return error when project id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "",
+			expected: openapi.ProjectGraphErrorResponse{
+				UserId:    "",
+				ProjectId: "project id is required, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.FindProjectGraph(openapi.ProjectGraphRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+			})
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestFindProjectGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find project graph",
+			expectedError: "not found: failed to find project graph",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+			gs := mock_service.NewMockProjectGraphService(ctrl)
+
+			gs.EXPECT().
+				FindProjectGraph(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewProjectUseCase(s, gs, mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.FindProjectGraph(openapi.ProjectGraphRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+			})
+			assert.NotNil(t, ucErr)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, ucErr.Response())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestExportProjectValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	as := mock_service.NewMockProjectArchiveService(ctrl)
+
+	projectName, err := domain.NewProjectNameObject("Project")
+	assert.NoError(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("Description")
+	assert.NoError(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.NoError(t, err)
+	chapterName, err := domain.NewChapterNameObject("Chapter 1")
+	assert.NoError(t, err)
+	paper, err := domain.NewPaperContentObject("## Section 1\n")
+	assert.NoError(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.NoError(t, err)
+	sectionName, err := domain.NewSectionNameObject("Section 1")
+	assert.NoError(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("paragraph")
+	assert.NoError(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.NoError(t, err)
+
+	section := domain.NewProjectArchiveSectionEntity(*sectionId, *sectionName, *paragraph, *children)
+	chapter, err := domain.NewProjectArchiveChapterEntity(
+		*chapterId, *chapterName, *paper, []domain.ProjectArchiveSectionEntity{*section})
+	assert.NoError(t, err)
+	project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+	entity, err := domain.NewProjectArchiveEntity(
+		*project, []domain.ProjectArchiveChapterEntity{*chapter}, []domain.LinkWithoutAutofieldEntity{})
+	assert.NoError(t, err)
+
+	as.EXPECT().
+		ExportProject(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return(entity, nil)
+
+	uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
+
+	res, ucErr := uc.ExportProject(openapi.ProjectExportRequest{
+		UserId:    testutil.ReadOnlyUserId(),
+		ProjectId: "0000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, "0000000000000001.zip", res.FileName)
+
+	content, err := archive.Read(res.Archive)
+	assert.NoError(t, err)
+	assert.Equal(t, archive.Archive{
+		Project: archive.Project{Name: "Project", Description: "Description"},
+		Chapters: []archive.Chapter{
+			{
+				Id:    "1000000000000001",
+				Name:  "Chapter 1",
+				Paper: "## Section 1\n",
+				Sections: []archive.Section{
+					{Id: "2000000000000001", Name: "Section 1", Paragraph: "paragraph", Children: []archive.Child{}},
+				},
+			},
+		},
+		Links: []archive.Link{},
+	}, *content)
+}
+
+func TestExportProjectDomainValidationError(t *testing.T) {
+	tt := []struct {
+		name      string
+		userId    string
+		projectId string
+		expected  openapi.ProjectExportErrorResponse
+	}{
+		{
+			name:      "should return error when user id is empty",
+			userId:    "",
+			projectId: "0000000000000001",
+			expected: openapi.ProjectExportErrorResponse{
+				UserId: "user id is required, but got ''",
+			},
+		},
+		{
+			name:      "should return error when project id is empty",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "",
+			expected: openapi.ProjectExportErrorResponse{
+				ProjectId: "project id is required, but got ''",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := usecase.NewProjectUseCase(
+				mock_service.NewMockProjectService(ctrl),
+				mock_service.NewMockProjectGraphService(ctrl),
+				mock_service.NewMockProjectArchiveService(ctrl),
+			)
+
+			res, ucErr := uc.ExportProject(openapi.ProjectExportRequest{
+				UserId:    tc.userId,
+				ProjectId: tc.projectId,
+			})
+			assert.NotNil(t, ucErr)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestExportProjectServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find project",
+			expectedError: "not found: failed to find project",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			as := mock_service.NewMockProjectArchiveService(ctrl)
+			as.EXPECT().
+				ExportProject(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
+
+			res, ucErr := uc.ExportProject(openapi.ProjectExportRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				ProjectId: "0000000000000001",
+			})
+			assert.NotNil(t, ucErr)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+			assert.Nil(t, ucErr.Response())
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestImportProjectValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	content, err := archive.Write(archive.Archive{
+		Project: archive.Project{Name: "Project", Description: "Description"},
+		Chapters: []archive.Chapter{
+			{
+				Id:    "1000000000000001",
+				Name:  "Chapter 1",
+				Paper: "## Section 1\n",
+				Sections: []archive.Section{
+					{Id: "2000000000000001", Name: "Section 1", Paragraph: "paragraph", Children: []archive.Child{
+						{Id: "3000000000000001", Name: "Concept", Relation: "part of", Children: []archive.Child{}},
+					}},
+				},
+			},
+		},
+		Links: []archive.Link{
+			{
+				From:     archive.Endpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+				To:       archive.Endpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001", NodeId: "3000000000000001"},
+				Relation: "refers to",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	projectName, err := domain.NewProjectNameObject("Project")
+	assert.NoError(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("Description")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	as := mock_service.NewMockProjectArchiveService(ctrl)
+	as.EXPECT().
+		ImportProject(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, entity domain.ProjectArchiveEntity) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "Project", entity.Project().Name().Value())
+			assert.Equal(t, "Description", entity.Project().Description().Value())
+			assert.Len(t, entity.Chapters(), 1)
+			assert.Equal(t, "Chapter 1", entity.Chapters()[0].Name().Value())
+			assert.Equal(t, "## Section 1\n", entity.Chapters()[0].Paper().Value())
+			assert.Len(t, entity.Chapters()[0].Sections(), 1)
+			assert.Equal(t, "paragraph", entity.Chapters()[0].Sections()[0].Paragraph().Value())
+			assert.Equal(t, 1, entity.Chapters()[0].Sections()[0].Children().Len())
+			assert.Len(t, entity.Links(), 1)
+			assert.Equal(t, "refers to", entity.Links()[0].Relation().Value())
+		}).
+		Return(domain.NewProjectEntity(*projectId, *projectName, *projectDescription, *role, *createdAt, *updatedAt), nil)
+
+	uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
+
+	res, ucErr := uc.ImportProject(openapi.ProjectImportRequest{
+		UserId:  testutil.ModifyOnlyUserId(),
+		Archive: content,
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Project{
+		Id:          "0000000000000001",
+		Name:        "Project",
+		Description: "Description",
+		Role:        "owner",
+		UpdatedAt:   testutil.Date(),
+	}, res.Project)
+}
+
+func TestImportProjectDomainValidationError(t *testing.T) {
+	validChapter := archive.Chapter{Id: "1000000000000001", Name: "Chapter 1", Sections: []archive.Section{
+		{Id: "2000000000000001", Name: "Section 1", Children: []archive.Child{}},
+	}}
+
+	tt := []struct {
+		name     string
+		userId   string
+		archive  func() []byte
+		expected func(t *testing.T, res openapi.ProjectImportErrorResponse)
+	}{
+		{
+			name:   "should return error when user id is empty",
+			userId: "",
+			archive: func() []byte {
+				content, _ := archive.Write(archive.Archive{Project: archive.Project{Name: "Project"}})
+				return content
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Equal(t, "user id is required, but got ''", res.UserId)
+				assert.Equal(t, "", res.Archive.Message)
+			},
+		},
+		{
+			name:   "should return error when archive is not a zip file",
+			userId: testutil.ModifyOnlyUserId(),
+			archive: func() []byte {
+				return []byte("not a zip file")
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Equal(t, "", res.UserId)
+				assert.Equal(t, "failed to read archive: zip: not a valid zip file", res.Archive.Message)
+			},
+		},
+		{
+			name:   "should return error when project name is empty",
+			userId: testutil.ModifyOnlyUserId(),
+			archive: func() []byte {
+				content, _ := archive.Write(archive.Archive{Project: archive.Project{Name: ""}})
+				return content
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Equal(t, "project name is required, but got ''", res.Archive.Project.Name)
+			},
+		},
+		{
+			name:   "should return error when chapter and section are invalid",
+			userId: testutil.ModifyOnlyUserId(),
+			archive: func() []byte {
+				content, _ := archive.Write(archive.Archive{
+					Project: archive.Project{Name: "Project"},
+					Chapters: []archive.Chapter{
+						validChapter,
+						{Id: "1000000000000002", Name: "", Sections: []archive.Section{
+							{Id: "2000000000000002", Name: "", Children: []archive.Child{}},
+						}},
+					},
+				})
+				return content
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Len(t, res.Archive.Chapters, 2)
+				assert.Equal(t, "", res.Archive.Chapters[0].Name)
+				assert.Equal(t, "chapter name is required, but got ''", res.Archive.Chapters[1].Name)
+				assert.Equal(t, "section name is required, but got ''", res.Archive.Chapters[1].Sections[0].Name)
+			},
+		},
+		{
+			name:   "should return error when chapter ids are duplicated",
+			userId: testutil.ModifyOnlyUserId(),
+			archive: func() []byte {
+				content, _ := archive.Write(archive.Archive{
+					Project:  archive.Project{Name: "Project"},
+					Chapters: []archive.Chapter{validChapter, validChapter},
+				})
+				return content
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Equal(t, "chapter ids must be unique, but got '1000000000000001' duplicated", res.Archive.Message)
+			},
+		},
+		{
+			name:   "should return error when link endpoint does not exist",
+			userId: testutil.ModifyOnlyUserId(),
+			archive: func() []byte {
+				content, _ := archive.Write(archive.Archive{
+					Project:  archive.Project{Name: "Project"},
+					Chapters: []archive.Chapter{validChapter},
+					Links: []archive.Link{
+						{
+							From:     archive.Endpoint{ChapterId: "1000000000000001", SectionId: "2000000000000001"},
+							To:       archive.Endpoint{ChapterId: "1000000000000001", SectionId: "2000000000000009"},
+							Relation: "refers to",
+						},
+					},
+				})
+				return content
+			},
+			expected: func(t *testing.T, res openapi.ProjectImportErrorResponse) {
+				assert.Equal(t, "link endpoint does not exist in archive at 0", res.Archive.Message)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := usecase.NewProjectUseCase(
+				mock_service.NewMockProjectService(ctrl),
+				mock_service.NewMockProjectGraphService(ctrl),
+				mock_service.NewMockProjectArchiveService(ctrl),
+			)
 
-			res, ucErr := uc.ListProjects(openapi.ProjectListRequest{
-				UserId: tc.userId,
+			res, ucErr := uc.ImportProject(openapi.ProjectImportRequest{
+				UserId:  tc.userId,
+				Archive: tc.archive(),
 			})
 			assert.NotNil(t, ucErr)
-
-			expectedJson, _ := json.Marshal(tc.expected)
-			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
 			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
-			assert.Equal(t, tc.expected, *ucErr.Response())
+			tc.expected(t, *ucErr.Response())
 
 			assert.Nil(t, res)
 		})
 	}
 }
 
-func TestListProjectsServiceError(t *testing.T) {
+func TestImportProjectServiceError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	s := mock_service.NewMockProjectService(ctrl)
+	content, err := archive.Write(archive.Archive{Project: archive.Project{Name: "Project"}})
+	assert.NoError(t, err)
 
-	s.EXPECT().
-		ListProjects(gomock.Any()).
+	as := mock_service.NewMockProjectArchiveService(ctrl)
+	as.EXPECT().
+		ImportProject(gomock.Any(), gomock.Any()).
 		Return(nil, service.Errorf(service.RepositoryFailurePanic, "service error"))
 
-	uc := usecase.NewProjectUseCase(s)
+	uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
 
-	res, ucErr := uc.ListProjects(openapi.ProjectListRequest{
-		UserId: testutil.ReadOnlyUserId(),
+	res, ucErr := uc.ImportProject(openapi.ProjectImportRequest{
+		UserId:  testutil.ModifyOnlyUserId(),
+		Archive: content,
 	})
 	assert.NotNil(t, ucErr)
 	assert.Equal(t, "internal error: service error", ucErr.Error())
@@ -137,24 +907,43 @@ func TestListProjectsServiceError(t *testing.T) {
 	assert.Nil(t, res)
 }
 
-func TestFindProjectValidEntity(t *testing.T) {
+func TestCompileProjectValidEntity(t *testing.T) {
 	tt := []struct {
-		name               string
-		projectId          string
-		projectName        string
-		projectDescription string
+		name          string
+		format        string
+		includeGraphs bool
+		fileName      string
+		mediaType     string
+		expected      []string
 	}{
 		{
-			name:               "should find project with description",
-			projectId:          "0000000000000001",
-			projectName:        "Project With Description",
-			projectDescription: "This is a project",
+			name:          "should compile project to markdown",
+			format:        "markdown",
+			includeGraphs: false,
+			fileName:      "0000000000000001.md",
+			mediaType:     "text/markdown; charset=utf-8",
+			expected:      []string{"# Project\n", "## 1. Chapter 1\n", "### Section 1\n"},
 		},
 		{
-			name:               "should find project without description",
-			projectId:          "0000000000000002",
-			projectName:        "Project Without Description",
-			projectDescription: "",
+			name:          "should compile project to html with graphs",
+			format:        "html",
+			includeGraphs: true,
+			fileName:      "0000000000000001.html",
+			mediaType:     "text/html; charset=utf-8",
+			expected: []string{
+				"<h1>Project</h1>",
+				"<h2>1. Chapter 1</h2>",
+				"<h3 id=\"chapter-1-section-1\">Section 1</h3>",
+				"<li><strong>Concept</strong> <em>(part of)</em></li>",
+			},
+		},
+		{
+			name:          "should compile project to epub",
+			format:        "epub",
+			includeGraphs: false,
+			fileName:      "0000000000000001.epub",
+			mediaType:     "application/epub+zip",
+			expected:      []string{"mimetypeapplication/epub+zip"},
 		},
 	}
 
@@ -163,76 +952,116 @@ func TestFindProjectValidEntity(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			s := mock_service.NewMockProjectService(ctrl)
-
-			id, err := domain.NewProjectIdObject(tc.projectId)
+			projectName, err := domain.NewProjectNameObject("Project")
 			assert.NoError(t, err)
-			name, err := domain.NewProjectNameObject(tc.projectName)
+			projectDescription, err := domain.NewProjectDescriptionObject("")
 			assert.NoError(t, err)
-			description, err := domain.NewProjectDescriptionObject(tc.projectDescription)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
 			assert.NoError(t, err)
-			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+			chapterName, err := domain.NewChapterNameObject("Chapter 1")
 			assert.NoError(t, err)
-			updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+			paper, err := domain.NewPaperContentObject("## Section 1\n\ncontent\n")
+			assert.NoError(t, err)
+			sectionId, err := domain.NewSectionIdObject("2000000000000001")
+			assert.NoError(t, err)
+			sectionName, err := domain.NewSectionNameObject("Section 1")
+			assert.NoError(t, err)
+			paragraph, err := domain.NewGraphParagraphObject("")
+			assert.NoError(t, err)
+			childName, err := domain.NewGraphNameObject("Concept")
+			assert.NoError(t, err)
+			childRelation, err := domain.NewGraphRelationObject("part of")
+			assert.NoError(t, err)
+			childDescription, err := domain.NewGraphDescriptionObject("")
+			assert.NoError(t, err)
+			grandChildren, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+			assert.NoError(t, err)
+			children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{
+				*domain.NewGraphChildEntity(nil, *childName, *childRelation, *childDescription, *grandChildren),
+			})
 			assert.NoError(t, err)
 
-			project := domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt)
+			section := domain.NewProjectArchiveSectionEntity(*sectionId, *sectionName, *paragraph, *children)
+			chapter, err := domain.NewProjectArchiveChapterEntity(
+				*chapterId, *chapterName, *paper, []domain.ProjectArchiveSectionEntity{*section})
+			assert.NoError(t, err)
+			project := domain.NewProjectWithoutAutofieldEntity(*projectName, *projectDescription)
+			entity, err := domain.NewProjectArchiveEntity(
+				*project, []domain.ProjectArchiveChapterEntity{*chapter}, []domain.LinkWithoutAutofieldEntity{})
+			assert.NoError(t, err)
 
-			s.EXPECT().
-				FindProject(gomock.Any(), gomock.Any()).
+			as := mock_service.NewMockProjectArchiveService(ctrl)
+			as.EXPECT().
+				ExportProject(gomock.Any(), gomock.Any()).
 				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
 					assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
-					assert.Equal(t, tc.projectId, projectId.Value())
+					assert.Equal(t, "0000000000000001", projectId.Value())
 				}).
-				Return(project, nil)
+				Return(entity, nil)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
 
-			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
-				UserId:    testutil.ReadOnlyUserId(),
-				ProjectId: tc.projectId,
+			res, ucErr := uc.CompileProject(openapi.ProjectCompileRequest{
+				UserId:        testutil.ReadOnlyUserId(),
+				ProjectId:     "0000000000000001",
+				Format:        tc.format,
+				IncludeGraphs: tc.includeGraphs,
 			})
 			assert.Nil(t, ucErr)
 
-			assert.Equal(t, tc.projectId, res.Project.Id)
-			assert.Equal(t, tc.projectName, res.Project.Name)
-			assert.Equal(t, tc.projectDescription, res.Project.Description)
+			assert.Equal(t, tc.fileName, res.FileName)
+			assert.Equal(t, tc.mediaType, res.MediaType)
+			for _, expected := range tc.expected {
+				assert.Contains(t, string(res.Content), expected)
+			}
 		})
 	}
 }
 
-func TestFindProjectDomainValidationError(t *testing.T) {
+func TestCompileProjectDomainValidationError(t *testing.T) {
 	tt := []struct {
 		name      string
 		userId    string
 		projectId string
-		expected  openapi.ProjectFindErrorResponse
+		format    string
+		expected  openapi.ProjectCompileErrorResponse
 	}{
 		{
 			name:      "should return error when user id is empty",
 			userId:    "",
 			projectId: "0000000000000001",
-			expected: openapi.ProjectFindErrorResponse{
-				UserId:    "user id is required, but got ''",
-				ProjectId: "",
+			format:    "markdown",
+			expected: openapi.ProjectCompileErrorResponse{
+				UserId: "user id is required, but got ''",
 			},
 		},
 		{
 			name:      "should return error when project id is empty",
 			userId:    testutil.ReadOnlyUserId(),
 			projectId: "",
-			expected: openapi.ProjectFindErrorResponse{
-				UserId:    "",
+			format:    "markdown",
+			expected: openapi.ProjectCompileErrorResponse{
 				ProjectId: "project id is required, but got ''",
 			},
 		},
+		{
+			name:      "should return error when format is unsupported",
+			userId:    testutil.ReadOnlyUserId(),
+			projectId: "0000000000000001",
+			format:    "pdf",
+			expected: openapi.ProjectCompileErrorResponse{
+				Format: "compile format must be one of epub, html, markdown, but got 'pdf'",
+			},
+		},
 		{
 			name:      "should return error when all fields are empty",
 			userId:    "",
 			projectId: "",
-			expected: openapi.ProjectFindErrorResponse{
+			format:    "",
+			expected: openapi.ProjectCompileErrorResponse{
 				UserId:    "user id is required, but got ''",
 				ProjectId: "project id is required, but got ''",
+				Format:    "compile format must be one of epub, html, markdown, but got ''",
 			},
 		},
 	}
@@ -242,13 +1071,16 @@ func TestFindProjectDomainValidationError(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			s := mock_service.NewMockProjectService(ctrl)
-
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(
+				mock_service.NewMockProjectService(ctrl),
+				mock_service.NewMockProjectGraphService(ctrl),
+				mock_service.NewMockProjectArchiveService(ctrl),
+			)
 
-			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
+			res, ucErr := uc.CompileProject(openapi.ProjectCompileRequest{
 				UserId:    tc.userId,
 				ProjectId: tc.projectId,
+				Format:    tc.format,
 			})
 			assert.NotNil(t, ucErr)
 
@@ -262,7 +1094,7 @@ func TestFindProjectDomainValidationError(t *testing.T) {
 	}
 }
 
-func TestFindProjectServiceError(t *testing.T) {
+func TestCompileProjectServiceError(t *testing.T) {
 	tt := []struct {
 		name          string
 		errorCode     service.ErrorCode
@@ -291,17 +1123,17 @@ func TestFindProjectServiceError(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			s := mock_service.NewMockProjectService(ctrl)
-
-			s.EXPECT().
-				FindProject(gomock.Any(), gomock.Any()).
+			as := mock_service.NewMockProjectArchiveService(ctrl)
+			as.EXPECT().
+				ExportProject(gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(mock_service.NewMockProjectService(ctrl), mock_service.NewMockProjectGraphService(ctrl), as)
 
-			res, ucErr := uc.FindProject(openapi.ProjectFindRequest{
+			res, ucErr := uc.CompileProject(openapi.ProjectCompileRequest{
 				UserId:    testutil.ReadOnlyUserId(),
 				ProjectId: "0000000000000001",
+				Format:    "html",
 			})
 			assert.NotNil(t, ucErr)
 			assert.Equal(t, tc.expectedError, ucErr.Error())
@@ -361,12 +1193,14 @@ func TestCreateProjectValidEntity(t *testing.T) {
 			assert.NoError(t, err)
 			description, err := domain.NewProjectDescriptionObject(tc.project.Description)
 			assert.NoError(t, err)
+			role, err := domain.NewProjectRoleObject("owner")
+			assert.NoError(t, err)
 			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
 			assert.NoError(t, err)
 			updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
 			assert.NoError(t, err)
 
-			project := domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt)
+			project := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
 
 			s.EXPECT().
 				CreateProject(gomock.Any(), gomock.Any()).
@@ -377,7 +1211,7 @@ func TestCreateProjectValidEntity(t *testing.T) {
 				}).
 				Return(project, nil)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			res, ucErr := uc.CreateProject(openapi.ProjectCreateRequest{
 				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -482,7 +1316,7 @@ func TestCreateProjectDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockProjectService(ctrl)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			res, ucErr := uc.CreateProject(openapi.ProjectCreateRequest{
 				User:    openapi.UserOnlyId{Id: tc.userId},
@@ -510,7 +1344,7 @@ func TestCreateProjectServiceError(t *testing.T) {
 		CreateProject(gomock.Any(), gomock.Any()).
 		Return(nil, service.Errorf(service.RepositoryFailurePanic, "service error"))
 
-	uc := usecase.NewProjectUseCase(s)
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 	res, ucErr := uc.CreateProject(openapi.ProjectCreateRequest{
 		User: openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -574,23 +1408,26 @@ func TestUpdateProjectValidEntity(t *testing.T) {
 			assert.NoError(t, err)
 			description, err := domain.NewProjectDescriptionObject(tc.project.Description)
 			assert.NoError(t, err)
+			role, err := domain.NewProjectRoleObject("owner")
+			assert.NoError(t, err)
 			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
 			assert.NoError(t, err)
 			updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
 			assert.NoError(t, err)
 
-			project := domain.NewProjectEntity(*id, *name, *description, *createdAt, *updatedAt)
+			project := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
 
 			s.EXPECT().
-				UpdateProject(gomock.Any(), gomock.Any(), gomock.Any()).
-				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, project domain.ProjectWithoutAutofieldEntity) {
+				UpdateProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, project domain.ProjectWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
 					assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
 					assert.Equal(t, "0000000000000001", projectId.Value())
 					assert.Equal(t, tc.project.Name, project.Name().Value())
 					assert.Equal(t, tc.project.Description, project.Description().Value())
+					assert.Nil(t, expectedUpdatedAt)
 				}).Return(project, nil)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			res, ucErr := uc.UpdateProject(openapi.ProjectUpdateRequest{
 				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -714,7 +1551,7 @@ func TestUpdateProjectDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockProjectService(ctrl)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			res, ucErr := uc.UpdateProject(openapi.ProjectUpdateRequest{
 				User:    openapi.UserOnlyId{Id: tc.userId},
@@ -764,10 +1601,10 @@ func TestUpdateProjectServiceError(t *testing.T) {
 			s := mock_service.NewMockProjectService(ctrl)
 
 			s.EXPECT().
-				UpdateProject(gomock.Any(), gomock.Any(), gomock.Any()).
+				UpdateProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			res, ucErr := uc.UpdateProject(openapi.ProjectUpdateRequest{
 				User: openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -785,6 +1622,316 @@ func TestUpdateProjectServiceError(t *testing.T) {
 	}
 }
 
+func TestUpdateProjectConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+
+	id, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	name, err := domain.NewProjectNameObject("Current Project")
+	assert.NoError(t, err)
+	description, err := domain.NewProjectDescriptionObject("This is current project")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date().Add(time.Hour))
+	assert.NoError(t, err)
+
+	project := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		UpdateProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject,
+			project domain.ProjectWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
+			assert.Equal(t, testutil.Date(), expectedUpdatedAt.Value())
+		}).
+		Return(project, service.Errorf(service.ConflictError, "project has been updated since it was fetched"))
+
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+	res, ucErr := uc.UpdateProject(openapi.ProjectUpdateRequest{
+		User: openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.Project{
+			Id:        "0000000000000001",
+			Name:      "Project Name",
+			UpdatedAt: testutil.Date(),
+		},
+	})
+	assert.NotNil(t, ucErr)
+	assert.Equal(t, "conflict: project has been updated since it was fetched", ucErr.Error())
+	assert.Equal(t, usecase.ConflictError, ucErr.Code())
+	assert.Nil(t, ucErr.Response())
+
+	assert.Equal(t, openapi.Project{
+		Id:          "0000000000000001",
+		Name:        "Current Project",
+		Description: "This is current project",
+		Role:        "owner",
+		UpdatedAt:   testutil.Date().Add(time.Hour),
+	}, res.Project)
+}
+
+func TestPatchProjectValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+
+	id, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	name, err := domain.NewProjectNameObject("Project Name")
+	assert.NoError(t, err)
+	description, err := domain.NewProjectDescriptionObject("")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	current := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
+
+	patchedName, err := domain.NewProjectNameObject("Patched Project Name")
+	assert.NoError(t, err)
+	patchedDescription, err := domain.NewProjectDescriptionObject("This is a patched project")
+	assert.NoError(t, err)
+
+	patched := domain.NewProjectEntity(*id, *patchedName, *patchedDescription, *role, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		FindProject(gomock.Any(), gomock.Any()).
+		Return(current, nil)
+	s.EXPECT().
+		UpdateProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject,
+			project domain.ProjectWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "Patched Project Name", project.Name().Value())
+			assert.Equal(t, "This is a patched project", project.Description().Value())
+			assert.Equal(t, testutil.Date(), expectedUpdatedAt.Value())
+		}).
+		Return(patched, nil)
+
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+	res, ucErr := uc.PatchProject(openapi.ProjectPatchRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "test", Path: "/name", Value: "Project Name"},
+			{Op: "copy", From: "/name", Path: "/description"},
+			{Op: "replace", Path: "/name", Value: "Patched Project Name"},
+			{Op: "replace", Path: "/description", Value: "This is a patched project"},
+		},
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.Project{
+		Id:          "0000000000000001",
+		Name:        "Patched Project Name",
+		Description: "This is a patched project",
+		Role:        "owner",
+		UpdatedAt:   testutil.Date(),
+	}, res.Project)
+}
+
+func TestPatchProjectNullValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+
+	id, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	name, err := domain.NewProjectNameObject("Project Name")
+	assert.NoError(t, err)
+	description, err := domain.NewProjectDescriptionObject("")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	current := domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt)
+
+	s.EXPECT().
+		FindProject(gomock.Any(), gomock.Any()).
+		Return(current, nil)
+	s.EXPECT().
+		UpdateProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject,
+			project domain.ProjectWithoutAutofieldEntity, expectedUpdatedAt *domain.UpdatedAtObject) {
+			assert.Equal(t, "Project Name", project.Name().Value())
+			assert.Equal(t, "", project.Description().Value())
+		}).
+		Return(current, nil)
+
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+	_, ucErr := uc.PatchProject(openapi.ProjectPatchRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "add", Path: "/description", Value: usecase.JsonPatchNull{}},
+			{Op: "test", Path: "/description", Value: usecase.JsonPatchNull{}},
+			{Op: "replace", Path: "/description", Value: ""},
+		},
+	})
+	assert.Nil(t, ucErr)
+}
+
+func TestPatchProjectDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockProjectService(ctrl)
+
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+	res, ucErr := uc.PatchProject(openapi.ProjectPatchRequest{
+		UserId:    "",
+		ProjectId: "0000000000000001",
+		Patch: []openapi.JsonPatchOperation{
+			{Op: "replace", Path: "/name"},
+		},
+	})
+
+	expected := openapi.ProjectPatchErrorResponse{
+		UserId: "user id is required, but got ''",
+		Patch: openapi.JsonPatchError{
+			Items: []openapi.JsonPatchOperationError{
+				{Message: "json patch value is required for replace operation"},
+			},
+		},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestPatchProjectPatchedDocumentError(t *testing.T) {
+	tooLongProjectName := testutil.RandomString(101)
+
+	tt := []struct {
+		name     string
+		patch    []openapi.JsonPatchOperation
+		expected openapi.ProjectPatchErrorResponse
+	}{
+		{
+			name: "should return error when test operation fails",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "test", Path: "/name", Value: "Other Project Name"},
+			},
+			expected: openapi.ProjectPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Items: []openapi.JsonPatchOperationError{
+						{Message: "json patch test failed, value at '/name' is not equal to the expected one"},
+					},
+				},
+			},
+		},
+		{
+			name: "should return error when whole document is removed",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "remove", Path: ""},
+			},
+			expected: openapi.ProjectPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Items: []openapi.JsonPatchOperationError{
+						{Message: "json patch cannot remove the whole document"},
+					},
+				},
+			},
+		},
+		{
+			name: "should return error when patched document has invalid type",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "replace", Path: "/name", Value: 1.0},
+			},
+			expected: openapi.ProjectPatchErrorResponse{
+				Patch: openapi.JsonPatchError{
+					Message: "patched document is invalid: " +
+						"json: cannot unmarshal number into Go struct field ProjectWithoutAutofield.name of type string",
+				},
+			},
+		},
+		{
+			name: "should return error when patched project is invalid",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "replace", Path: "/name", Value: tooLongProjectName},
+			},
+			expected: openapi.ProjectPatchErrorResponse{
+				Project: openapi.ProjectWithoutAutofieldError{
+					Name: fmt.Sprintf("project name cannot be longer than 100 characters, but got '%s'", tooLongProjectName),
+				},
+			},
+		},
+		{
+			name: "should return error when project name is removed",
+			patch: []openapi.JsonPatchOperation{
+				{Op: "remove", Path: "/name"},
+			},
+			expected: openapi.ProjectPatchErrorResponse{
+				Project: openapi.ProjectWithoutAutofieldError{
+					Name: "project name is required, but got ''",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockProjectService(ctrl)
+
+			id, err := domain.NewProjectIdObject("0000000000000001")
+			assert.NoError(t, err)
+			name, err := domain.NewProjectNameObject("Project Name")
+			assert.NoError(t, err)
+			description, err := domain.NewProjectDescriptionObject("")
+			assert.NoError(t, err)
+			role, err := domain.NewProjectRoleObject("owner")
+			assert.NoError(t, err)
+			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+			updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+
+			s.EXPECT().
+				FindProject(gomock.Any(), gomock.Any()).
+				Return(domain.NewProjectEntity(*id, *name, *description, *role, *createdAt, *updatedAt), nil)
+
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
+
+			res, ucErr := uc.PatchProject(openapi.ProjectPatchRequest{
+				UserId:    testutil.ModifyOnlyUserId(),
+				ProjectId: "0000000000000001",
+				Patch:     tc.patch,
+			})
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
 func TestDeleteProjectValidEntity(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -799,7 +1946,7 @@ func TestDeleteProjectValidEntity(t *testing.T) {
 		}).
 		Return(nil)
 
-	uc := usecase.NewProjectUseCase(s)
+	uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 	ucErr := uc.DeleteProject(openapi.ProjectDeleteRequest{
 		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -851,7 +1998,7 @@ func TestDeleteProjectDomainValidationError(t *testing.T) {
 
 			s := mock_service.NewMockProjectService(ctrl)
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			ucErr := uc.DeleteProject(openapi.ProjectDeleteRequest{
 				User:    openapi.UserOnlyId{Id: tc.userId},
@@ -902,7 +2049,7 @@ func TestDeleteProjectServiceError(t *testing.T) {
 				DeleteProject(gomock.Any(), gomock.Any()).
 				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
 
-			uc := usecase.NewProjectUseCase(s)
+			uc := usecase.NewProjectUseCase(s, mock_service.NewMockProjectGraphService(ctrl), mock_service.NewMockProjectArchiveService(ctrl))
 
 			ucErr := uc.DeleteProject(openapi.ProjectDeleteRequest{
 				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type PublicUseCase interface {
+	FindSharedProject(req openapi.PublicProjectFindRequest) (
+		*openapi.PublicProjectFindResponse, *Error[openapi.PublicProjectFindErrorResponse])
+	FindSharedPaper(req openapi.PublicPaperFindRequest) (
+		*openapi.PublicPaperFindResponse, *Error[openapi.PublicPaperFindErrorResponse])
+	FindSharedGraph(req openapi.PublicGraphFindRequest) (
+		*openapi.PublicGraphFindResponse, *Error[openapi.PublicGraphFindErrorResponse])
+}
+
+type publicUseCase struct {
+	service service.PublicService
+}
+
+func NewPublicUseCase(service service.PublicService) PublicUseCase {
+	return publicUseCase{service: service}
+}
+
+func (uc publicUseCase) FindSharedProject(req openapi.PublicProjectFindRequest) (
+	*openapi.PublicProjectFindResponse, *Error[openapi.PublicProjectFindErrorResponse]) {
+	token, tokenErr := domain.NewShareTokenObject(req.Token)
+
+	if tokenErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PublicProjectFindErrorResponse{
+				Token: tokenErr.Error(),
+			},
+		)
+	}
+
+	project, chapterEntities, sErr := uc.service.FindSharedProject(*token)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PublicProjectFindErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PublicProjectFindErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	chapters := make([]openapi.ChapterWithSections, len(chapterEntities))
+	for i, entity := range chapterEntities {
+		sections := make([]openapi.SectionOfChapter, len(entity.Sections()))
+		for j, section := range entity.Sections() {
+			sections[j] = openapi.SectionOfChapter{
+				Id:   section.Id().Value(),
+				Name: section.Name().Value(),
+			}
+		}
+
+		chapters[i] = openapi.ChapterWithSections{
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Number:    int32(entity.Number().Value()),
+			Sections:  sections,
+			UpdatedAt: entity.UpdatedAt().Value(),
+		}
+	}
+
+	// Role is left empty because it describes the share creator, not the viewer.
+	return &openapi.PublicProjectFindResponse{
+		Project: openapi.Project{
+			Id:          project.Id().Value(),
+			Name:        project.Name().Value(),
+			Description: project.Description().Value(),
+			UpdatedAt:   project.UpdatedAt().Value(),
+		},
+		Chapters: chapters,
+	}, nil
+}
+
+func (uc publicUseCase) FindSharedPaper(req openapi.PublicPaperFindRequest) (
+	*openapi.PublicPaperFindResponse, *Error[openapi.PublicPaperFindErrorResponse]) {
+	token, tokenErr := domain.NewShareTokenObject(req.Token)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+
+	tokenMsg := ""
+	if tokenErr != nil {
+		tokenMsg = tokenErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+
+	if tokenErr != nil || chapterIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PublicPaperFindErrorResponse{
+				Token:     tokenMsg,
+				ChapterId: chapterIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.FindSharedPaper(*token, *chapterId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PublicPaperFindErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PublicPaperFindErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.PublicPaperFindResponse{
+		Paper: openapi.Paper{
+			Id:        entity.Id().Value(),
+			Content:   entity.Content().Value(),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
+
+func (uc publicUseCase) FindSharedGraph(req openapi.PublicGraphFindRequest) (
+	*openapi.PublicGraphFindResponse, *Error[openapi.PublicGraphFindErrorResponse]) {
+	token, tokenErr := domain.NewShareTokenObject(req.Token)
+	chapterId, chapterIdErr := domain.NewChapterIdObject(req.ChapterId)
+	sectionId, sectionIdErr := domain.NewSectionIdObject(req.SectionId)
+
+	tokenMsg := ""
+	if tokenErr != nil {
+		tokenMsg = tokenErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	sectionIdMsg := ""
+	if sectionIdErr != nil {
+		sectionIdMsg = sectionIdErr.Error()
+	}
+
+	if tokenErr != nil || chapterIdErr != nil || sectionIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.PublicGraphFindErrorResponse{
+				Token:     tokenMsg,
+				ChapterId: chapterIdMsg,
+				SectionId: sectionIdMsg,
+			},
+		)
+	}
+
+	entity, sErr := uc.service.FindSharedGraph(*token, *chapterId, *sectionId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.PublicGraphFindErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.PublicGraphFindErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.PublicGraphFindResponse{
+		Graph: openapi.Graph{
+			Id:        entity.Id().Value(),
+			Name:      entity.Name().Value(),
+			Paragraph: entity.Paragraph().Value(),
+			Children:  graphUseCase{}.childrenEntityToModel(entity.Children()),
+			UpdatedAt: entity.UpdatedAt().Value(),
+		},
+	}, nil
+}
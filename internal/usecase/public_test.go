@@ -0,0 +1,386 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFindSharedProjectValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectId, err := domain.NewProjectIdObject("0000000000000001")
+	assert.NoError(t, err)
+	projectName, err := domain.NewProjectNameObject("Shared Project")
+	assert.NoError(t, err)
+	projectDescription, err := domain.NewProjectDescriptionObject("Shared Description")
+	assert.NoError(t, err)
+	role, err := domain.NewProjectRoleObject("owner")
+	assert.NoError(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.NoError(t, err)
+	chapterName, err := domain.NewChapterNameObject("Chapter One")
+	assert.NoError(t, err)
+	chapterNumber, err := domain.NewChapterNumberObject(1)
+	assert.NoError(t, err)
+	sectionId, err := domain.NewSectionIdObject("2000000000000001")
+	assert.NoError(t, err)
+	sectionName, err := domain.NewSectionNameObject("Section One")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockPublicService(ctrl)
+	s.EXPECT().
+		FindSharedProject(gomock.Any()).
+		Do(func(token domain.ShareTokenObject) {
+			assert.Equal(t, "SHARE_TOKEN", token.Value())
+		}).
+		Return(
+			domain.NewProjectEntity(*projectId, *projectName, *projectDescription, *role, *createdAt, *updatedAt),
+			[]domain.ChapterEntity{
+				*domain.NewChapterEntity(
+					*chapterId,
+					*chapterName,
+					*chapterNumber,
+					[]domain.SectionOfChapterEntity{
+						*domain.NewSectionOfChapterEntity(*sectionId, *sectionName, *createdAt, *updatedAt),
+					},
+					*createdAt,
+					*updatedAt,
+				),
+			},
+			nil,
+		)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedProject(openapi.PublicProjectFindRequest{Token: "SHARE_TOKEN"})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.PublicProjectFindResponse{
+		Project: openapi.Project{
+			Id:          "0000000000000001",
+			Name:        "Shared Project",
+			Description: "Shared Description",
+			UpdatedAt:   testutil.Date(),
+		},
+		Chapters: []openapi.ChapterWithSections{
+			{
+				Id:     "1000000000000001",
+				Name:   "Chapter One",
+				Number: 1,
+				Sections: []openapi.SectionOfChapter{
+					{Id: "2000000000000001", Name: "Section One"},
+				},
+				UpdatedAt: testutil.Date(),
+			},
+		},
+	}, res)
+}
+
+func TestFindSharedProjectDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPublicService(ctrl)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedProject(openapi.PublicProjectFindRequest{})
+
+	expected := openapi.PublicProjectFindErrorResponse{
+		Token: "share token is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestFindSharedProjectServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when share not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find share",
+			expectedError: "not found: failed to find share",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPublicService(ctrl)
+			s.EXPECT().
+				FindSharedProject(gomock.Any()).
+				Return(nil, nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewPublicUseCase(s)
+
+			res, ucErr := uc.FindSharedProject(openapi.PublicProjectFindRequest{Token: "SHARE_TOKEN"})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestFindSharedPaperValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	paperId, err := domain.NewPaperIdObject("1000000000000001")
+	assert.NoError(t, err)
+	content, err := domain.NewPaperContentObject("## Shared Section\n")
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockPublicService(ctrl)
+	s.EXPECT().
+		FindSharedPaper(gomock.Any(), gomock.Any()).
+		Do(func(token domain.ShareTokenObject, chapterId domain.ChapterIdObject) {
+			assert.Equal(t, "SHARE_TOKEN", token.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+		}).
+		Return(domain.NewPaperEntity(*paperId, *content, *createdAt, *updatedAt), nil)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedPaper(openapi.PublicPaperFindRequest{
+		Token:     "SHARE_TOKEN",
+		ChapterId: "1000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.PublicPaperFindResponse{
+		Paper: openapi.Paper{
+			Id:        "1000000000000001",
+			Content:   "## Shared Section\n",
+			UpdatedAt: testutil.Date(),
+		},
+	}, res)
+}
+
+func TestFindSharedPaperDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPublicService(ctrl)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedPaper(openapi.PublicPaperFindRequest{})
+
+	expected := openapi.PublicPaperFindErrorResponse{
+		Token:     "share token is required, but got ''",
+		ChapterId: "chapter id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestFindSharedPaperServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when chapter is not shared",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find shared chapter",
+			expectedError: "not found: failed to find shared chapter",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPublicService(ctrl)
+			s.EXPECT().
+				FindSharedPaper(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewPublicUseCase(s)
+
+			res, ucErr := uc.FindSharedPaper(openapi.PublicPaperFindRequest{
+				Token:     "SHARE_TOKEN",
+				ChapterId: "1000000000000001",
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestFindSharedGraphValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphId, err := domain.NewGraphIdObject("2000000000000001")
+	assert.NoError(t, err)
+	graphName, err := domain.NewGraphNameObject("Shared Section")
+	assert.NoError(t, err)
+	paragraph, err := domain.NewGraphParagraphObject("shared paragraph")
+	assert.NoError(t, err)
+	children, err := domain.NewGraphChildrenEntity([]domain.GraphChildEntity{})
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+	updatedAt, err := domain.NewUpdatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockPublicService(ctrl)
+	s.EXPECT().
+		FindSharedGraph(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(token domain.ShareTokenObject, chapterId domain.ChapterIdObject, sectionId domain.SectionIdObject) {
+			assert.Equal(t, "SHARE_TOKEN", token.Value())
+			assert.Equal(t, "1000000000000001", chapterId.Value())
+			assert.Equal(t, "2000000000000001", sectionId.Value())
+		}).
+		Return(domain.NewGraphEntity(*graphId, *graphName, *paragraph, *children, *createdAt, *updatedAt), nil)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedGraph(openapi.PublicGraphFindRequest{
+		Token:     "SHARE_TOKEN",
+		ChapterId: "1000000000000001",
+		SectionId: "2000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, &openapi.PublicGraphFindResponse{
+		Graph: openapi.Graph{
+			Id:        "2000000000000001",
+			Name:      "Shared Section",
+			Paragraph: "shared paragraph",
+			Children:  []openapi.GraphChild{},
+			UpdatedAt: testutil.Date(),
+		},
+	}, res)
+}
+
+func TestFindSharedGraphDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockPublicService(ctrl)
+
+	uc := usecase.NewPublicUseCase(s)
+
+	res, ucErr := uc.FindSharedGraph(openapi.PublicGraphFindRequest{})
+
+	expected := openapi.PublicGraphFindErrorResponse{
+		Token:     "share token is required, but got ''",
+		ChapterId: "chapter id is required, but got ''",
+		SectionId: "section id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestFindSharedGraphServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when share has expired",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to find share",
+			expectedError: "not found: failed to find share",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockPublicService(ctrl)
+			s.EXPECT().
+				FindSharedGraph(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewPublicUseCase(s)
+
+			res, ucErr := uc.FindSharedGraph(openapi.PublicGraphFindRequest{
+				Token:     "SHARE_TOKEN",
+				ChapterId: "1000000000000001",
+				SectionId: "2000000000000001",
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
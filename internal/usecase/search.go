@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+const defaultSearchLimit = 20
+
+type SearchUseCase interface {
+	Search(req openapi.SearchRequest) (
+		*openapi.SearchResponse, *Error[openapi.SearchErrorResponse])
+}
+
+type searchUseCase struct {
+	service service.SearchService
+}
+
+func NewSearchUseCase(service service.SearchService) SearchUseCase {
+	return searchUseCase{service: service}
+}
+
+func (uc searchUseCase) Search(req openapi.SearchRequest) (
+	*openapi.SearchResponse, *Error[openapi.SearchErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	query, queryErr := domain.NewSearchQueryObject(req.Query)
+
+	var projectId *domain.ProjectIdObject
+	var projectIdErr error
+	if req.ProjectId != "" {
+		projectId, projectIdErr = domain.NewProjectIdObject(req.ProjectId)
+	}
+
+	reqLimit := int(req.Limit)
+	if reqLimit == 0 {
+		reqLimit = defaultSearchLimit
+	}
+	limit, limitErr := domain.NewSearchLimitObject(reqLimit)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	queryMsg := ""
+	if queryErr != nil {
+		queryMsg = queryErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	limitMsg := ""
+	if limitErr != nil {
+		limitMsg = limitErr.Error()
+	}
+
+	if userIdErr != nil || queryErr != nil || projectIdErr != nil || limitErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.SearchErrorResponse{
+				UserId:    userIdMsg,
+				Query:     queryMsg,
+				ProjectId: projectIdMsg,
+				Limit:     limitMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.Search(*userId, *query, projectId, *limit)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.SearchErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.SearchErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	hits := make([]openapi.SearchHit, len(entities))
+	for i, entity := range entities {
+		hits[i] = uc.entityToSearchHit(entity)
+	}
+	return &openapi.SearchResponse{Hits: hits}, nil
+}
+
+func (uc searchUseCase) entityToSearchHit(entity domain.SearchHitEntity) openapi.SearchHit {
+	hit := openapi.SearchHit{
+		Kind:      entity.Kind(),
+		ProjectId: entity.ProjectId().Value(),
+		Title:     entity.Title(),
+		Score:     entity.Score(),
+	}
+	if entity.ChapterId() != nil {
+		hit.ChapterId = entity.ChapterId().Value()
+	}
+	if entity.SectionId() != nil {
+		hit.SectionId = entity.SectionId().Value()
+	}
+	if entity.NodeId() != nil {
+		hit.NodeId = entity.NodeId().Value()
+	}
+
+	highlights := make([]openapi.SearchHighlight, len(entity.Snippet().Highlights()))
+	for i, highlight := range entity.Snippet().Highlights() {
+		highlights[i] = openapi.SearchHighlight{
+			Offset: int32(highlight.Offset()),
+			Length: int32(highlight.Length()),
+		}
+	}
+	hit.Snippet = openapi.SearchSnippet{
+		Text:       entity.Snippet().Text(),
+		Highlights: highlights,
+	}
+	return hit
+}
@@ -0,0 +1,261 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchValidEntity(t *testing.T) {
+	tt := []struct {
+		name          string
+		request       openapi.SearchRequest
+		expectProject bool
+		expectedLimit int
+	}{
+		{
+			name: "should search all projects with default limit",
+			request: openapi.SearchRequest{
+				UserId: testutil.ReadOnlyUserId(),
+				Query:  "graph",
+			},
+			expectedLimit: 20,
+		},
+		{
+			name: "should search within project with limit",
+			request: openapi.SearchRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				Query:     "graph",
+				ProjectId: "0000000000000001",
+				Limit:     100,
+			},
+			expectProject: true,
+			expectedLimit: 100,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			projectId, err := domain.NewProjectIdObject("0000000000000001")
+			assert.Nil(t, err)
+			chapterId, err := domain.NewChapterIdObject("1000000000000001")
+			assert.Nil(t, err)
+			sectionId, err := domain.NewSectionIdObject("2000000000000001")
+			assert.Nil(t, err)
+			nodeId, err := domain.NewGraphChildIdObject("4000000000000001")
+			assert.Nil(t, err)
+
+			projectSnippet := domain.NewSearchSnippetObject(
+				"Knowledge Graph",
+				[]domain.SearchHighlightObject{*domain.NewSearchHighlightObject(10, 5)},
+			)
+			nodeSnippet := domain.NewSearchSnippetObject(
+				"graph",
+				[]domain.SearchHighlightObject{*domain.NewSearchHighlightObject(0, 5)},
+			)
+
+			s := mock_service.NewMockSearchService(ctrl)
+			s.EXPECT().
+				Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(
+					userId domain.UserIdObject,
+					query domain.SearchQueryObject,
+					project *domain.ProjectIdObject,
+					limit domain.SearchLimitObject,
+				) {
+					assert.Equal(t, testutil.ReadOnlyUserId(), userId.Value())
+					assert.Equal(t, "graph", query.Value())
+					if tc.expectProject {
+						assert.Equal(t, "0000000000000001", project.Value())
+					} else {
+						assert.Nil(t, project)
+					}
+					assert.Equal(t, tc.expectedLimit, limit.Value())
+				}).
+				Return([]domain.SearchHitEntity{
+					*domain.NewSearchHitEntity("project", *projectId, nil, nil, nil, "Knowledge Graph", *projectSnippet, 2.5),
+					*domain.NewSearchHitEntity("node", *projectId, chapterId, sectionId, nodeId, "graph", *nodeSnippet, 1.5),
+				}, nil)
+
+			uc := usecase.NewSearchUseCase(s)
+
+			res, ucErr := uc.Search(tc.request)
+			assert.Nil(t, ucErr)
+
+			assert.Equal(t, &openapi.SearchResponse{
+				Hits: []openapi.SearchHit{
+					{
+						Kind:      "project",
+						ProjectId: "0000000000000001",
+						Title:     "Knowledge Graph",
+						Snippet: openapi.SearchSnippet{
+							Text:       "Knowledge Graph",
+							Highlights: []openapi.SearchHighlight{{Offset: 10, Length: 5}},
+						},
+						Score: 2.5,
+					},
+					{
+						Kind:      "node",
+						ProjectId: "0000000000000001",
+						ChapterId: "1000000000000001",
+						SectionId: "2000000000000001",
+						NodeId:    "4000000000000001",
+						Title:     "graph",
+						Snippet: openapi.SearchSnippet{
+							Text:       "graph",
+							Highlights: []openapi.SearchHighlight{{Offset: 0, Length: 5}},
+						},
+						Score: 1.5,
+					},
+				},
+			}, res)
+		})
+	}
+}
+
+func TestSearchDomainValidationError(t *testing.T) {
+	tooLongQuery := testutil.RandomString(201)
+
+	tt := []struct {
+		name     string
+		request  openapi.SearchRequest
+		expected openapi.SearchErrorResponse
+	}{
+		{
+			name: "should return error when user id is empty",
+			request: openapi.SearchRequest{
+				UserId: "",
+				Query:  "graph",
+			},
+			expected: openapi.SearchErrorResponse{
+				UserId: "user id is required, but got ''",
+			},
+		},
+		{
+			name: "should return error when query is blank",
+			request: openapi.SearchRequest{
+				UserId: testutil.ReadOnlyUserId(),
+				Query:  "  ",
+			},
+			expected: openapi.SearchErrorResponse{
+				Query: "search query is required, but got '  '",
+			},
+		},
+		{
+			name: "should return error when query is too long",
+			request: openapi.SearchRequest{
+				UserId: testutil.ReadOnlyUserId(),
+				Query:  tooLongQuery,
+			},
+			expected: openapi.SearchErrorResponse{
+				Query: fmt.Sprintf("search query cannot be longer than 200 characters, but got '%v'", tooLongQuery),
+			},
+		},
+		{
+			name: "should return error when limit is out of range",
+			request: openapi.SearchRequest{
+				UserId: testutil.ReadOnlyUserId(),
+				Query:  "graph",
+				Limit:  101,
+			},
+			expected: openapi.SearchErrorResponse{
+				Limit: "search limit must be between 1 and 100, but got 101",
+			},
+		},
+		{
+			name: "should return error when all fields are invalid",
+			request: openapi.SearchRequest{
+				UserId: "",
+				Query:  "",
+				Limit:  -1,
+			},
+			expected: openapi.SearchErrorResponse{
+				UserId: "user id is required, but got ''",
+				Query:  "search query is required, but got ''",
+				Limit:  "search limit must be between 1 and 100, but got -1",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockSearchService(ctrl)
+
+			uc := usecase.NewSearchUseCase(s)
+
+			res, ucErr := uc.Search(tc.request)
+
+			expectedJson, _ := json.Marshal(tc.expected)
+			assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+			assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+			assert.Equal(t, tc.expected, *ucErr.Response())
+
+			assert.Nil(t, res)
+		})
+	}
+}
+
+func TestSearchServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to search",
+			expectedError: "not found: failed to search",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockSearchService(ctrl)
+
+			uc := usecase.NewSearchUseCase(s)
+
+			s.EXPECT().
+				Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			res, ucErr := uc.Search(openapi.SearchRequest{
+				UserId:    testutil.ReadOnlyUserId(),
+				Query:     "graph",
+				ProjectId: "0000000000000001",
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type ShareUseCase interface {
+	ListShares(req openapi.ShareListRequest) (
+		*openapi.ShareListResponse, *Error[openapi.ShareListErrorResponse])
+	CreateShare(req openapi.ShareCreateRequest) (
+		*openapi.ShareCreateResponse, *Error[openapi.ShareCreateErrorResponse])
+	RevokeShare(req openapi.ShareRevokeRequest) *Error[openapi.ShareRevokeErrorResponse]
+}
+
+type shareUseCase struct {
+	service service.ShareService
+}
+
+func NewShareUseCase(service service.ShareService) ShareUseCase {
+	return shareUseCase{service: service}
+}
+
+func (uc shareUseCase) ListShares(req openapi.ShareListRequest) (
+	*openapi.ShareListResponse, *Error[openapi.ShareListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.ProjectId)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ShareListErrorResponse{
+				UserId:    userIdMsg,
+				ProjectId: projectIdMsg,
+			},
+		)
+	}
+
+	entities, sErr := uc.service.ListShares(*userId, *projectId)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ShareListErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ShareListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	shares := make([]openapi.Share, len(entities))
+	for i, entity := range entities {
+		shares[i] = uc.entityToShare(entity)
+	}
+	return &openapi.ShareListResponse{Shares: shares}, nil
+}
+
+func (uc shareUseCase) CreateShare(req openapi.ShareCreateRequest) (
+	*openapi.ShareCreateResponse, *Error[openapi.ShareCreateErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+
+	var chapterId *domain.ChapterIdObject
+	var chapterIdErr error
+	if req.Share.ChapterId != "" {
+		chapterId, chapterIdErr = domain.NewChapterIdObject(req.Share.ChapterId)
+	}
+
+	var expiresAt *domain.ExpiresAtObject
+	var expiresAtErr error
+	if req.Share.ExpiresAt != nil && !req.Share.ExpiresAt.IsZero() {
+		expiresAt, expiresAtErr = domain.NewExpiresAtObject(*req.Share.ExpiresAt)
+	}
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	chapterIdMsg := ""
+	if chapterIdErr != nil {
+		chapterIdMsg = chapterIdErr.Error()
+	}
+	expiresAtMsg := ""
+	if expiresAtErr != nil {
+		expiresAtMsg = expiresAtErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || chapterIdErr != nil || expiresAtErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.ShareCreateErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Share: openapi.ShareWithoutAutofieldError{
+					ChapterId: chapterIdMsg,
+					ExpiresAt: expiresAtMsg,
+				},
+			},
+		)
+	}
+
+	share := domain.NewShareWithoutAutofieldEntity(chapterId, expiresAt)
+
+	entity, sErr := uc.service.CreateShare(*userId, *projectId, *share)
+	if sErr != nil && sErr.Code() == service.InvalidArgumentError {
+		return nil, NewMessageBasedError[openapi.ShareCreateErrorResponse](
+			InvalidArgumentError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.ShareCreateErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.ShareCreateErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.ShareCreateResponse{Share: uc.entityToShare(*entity)}, nil
+}
+
+func (uc shareUseCase) RevokeShare(req openapi.ShareRevokeRequest) *Error[openapi.ShareRevokeErrorResponse] {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	projectId, projectIdErr := domain.NewProjectIdObject(req.Project.Id)
+	token, tokenErr := domain.NewShareTokenObject(req.Share.Token)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	projectIdMsg := ""
+	if projectIdErr != nil {
+		projectIdMsg = projectIdErr.Error()
+	}
+	tokenMsg := ""
+	if tokenErr != nil {
+		tokenMsg = tokenErr.Error()
+	}
+
+	if userIdErr != nil || projectIdErr != nil || tokenErr != nil {
+		return NewModelBasedError(
+			DomainValidationError,
+			openapi.ShareRevokeErrorResponse{
+				User: openapi.UserOnlyIdError{
+					Id: userIdMsg,
+				},
+				Project: openapi.ProjectOnlyIdError{
+					Id: projectIdMsg,
+				},
+				Share: openapi.ShareOnlyTokenError{
+					Token: tokenMsg,
+				},
+			},
+		)
+	}
+
+	sErr := uc.service.RevokeShare(*userId, *projectId, *token)
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return NewMessageBasedError[openapi.ShareRevokeErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return NewMessageBasedError[openapi.ShareRevokeErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return nil
+}
+
+func (uc shareUseCase) entityToShare(entity domain.ShareEntity) openapi.Share {
+	share := openapi.Share{
+		Token:     entity.Token().Value(),
+		CreatedAt: entity.CreatedAt().Value(),
+	}
+	if entity.ChapterId() != nil {
+		share.ChapterId = entity.ChapterId().Value()
+	}
+	if entity.ExpiresAt() != nil {
+		expiresAt := entity.ExpiresAt().Value()
+		share.ExpiresAt = &expiresAt
+	}
+	return share
+}
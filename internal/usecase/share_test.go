@@ -0,0 +1,374 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSharesValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectToken, err := domain.NewShareTokenObject("PROJECT_SHARE_TOKEN")
+	assert.NoError(t, err)
+	chapterToken, err := domain.NewShareTokenObject("CHAPTER_SHARE_TOKEN")
+	assert.NoError(t, err)
+	chapterId, err := domain.NewChapterIdObject("1000000000000001")
+	assert.NoError(t, err)
+	expiresAt, err := domain.NewExpiresAtObject(testutil.Date().Add(24 * time.Hour))
+	assert.NoError(t, err)
+	createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+	assert.NoError(t, err)
+
+	s := mock_service.NewMockShareService(ctrl)
+	s.EXPECT().
+		ListShares(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+		}).
+		Return([]domain.ShareEntity{
+			*domain.NewShareEntity(*projectToken, nil, nil, *createdAt),
+			*domain.NewShareEntity(*chapterToken, chapterId, expiresAt, *createdAt),
+		}, nil)
+
+	uc := usecase.NewShareUseCase(s)
+
+	res, ucErr := uc.ListShares(openapi.ShareListRequest{
+		UserId:    testutil.ModifyOnlyUserId(),
+		ProjectId: "0000000000000001",
+	})
+	assert.Nil(t, ucErr)
+
+	expectedExpiresAt := testutil.Date().Add(24 * time.Hour)
+	assert.Equal(t, &openapi.ShareListResponse{
+		Shares: []openapi.Share{
+			{Token: "PROJECT_SHARE_TOKEN", CreatedAt: testutil.Date()},
+			{
+				Token:     "CHAPTER_SHARE_TOKEN",
+				ChapterId: "1000000000000001",
+				ExpiresAt: &expectedExpiresAt,
+				CreatedAt: testutil.Date(),
+			},
+		},
+	}, res)
+}
+
+func TestListSharesDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockShareService(ctrl)
+
+	uc := usecase.NewShareUseCase(s)
+
+	res, ucErr := uc.ListShares(openapi.ShareListRequest{})
+
+	expected := openapi.ShareListErrorResponse{
+		UserId:    "user id is required, but got ''",
+		ProjectId: "project id is required, but got ''",
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestListSharesServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to list shares",
+			expectedError: "not found: failed to list shares",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockShareService(ctrl)
+			s.EXPECT().
+				ListShares(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewShareUseCase(s)
+
+			res, ucErr := uc.ListShares(openapi.ShareListRequest{
+				UserId:    testutil.ModifyOnlyUserId(),
+				ProjectId: "0000000000000001",
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestCreateShareValidEntity(t *testing.T) {
+	expiresAt := testutil.Date().Add(24 * time.Hour)
+
+	tt := []struct {
+		name     string
+		share    openapi.ShareWithoutAutofield
+		expected openapi.Share
+	}{
+		{
+			name:  "should create share of whole project without expiration",
+			share: openapi.ShareWithoutAutofield{},
+			expected: openapi.Share{
+				Token:     "SHARE_TOKEN",
+				CreatedAt: testutil.Date(),
+			},
+		},
+		{
+			name: "should create share of chapter with expiration",
+			share: openapi.ShareWithoutAutofield{
+				ChapterId: "1000000000000001",
+				ExpiresAt: &expiresAt,
+			},
+			expected: openapi.Share{
+				Token:     "SHARE_TOKEN",
+				ChapterId: "1000000000000001",
+				ExpiresAt: &expiresAt,
+				CreatedAt: testutil.Date(),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			token, err := domain.NewShareTokenObject("SHARE_TOKEN")
+			assert.NoError(t, err)
+			createdAt, err := domain.NewCreatedAtObject(testutil.Date())
+			assert.NoError(t, err)
+
+			s := mock_service.NewMockShareService(ctrl)
+			s.EXPECT().
+				CreateShare(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					userId domain.UserIdObject,
+					projectId domain.ProjectIdObject,
+					share domain.ShareWithoutAutofieldEntity,
+				) (*domain.ShareEntity, *service.Error) {
+					assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+					assert.Equal(t, "0000000000000001", projectId.Value())
+					return domain.NewShareEntity(*token, share.ChapterId(), share.ExpiresAt(), *createdAt), nil
+				})
+
+			uc := usecase.NewShareUseCase(s)
+
+			res, ucErr := uc.CreateShare(openapi.ShareCreateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Share:   tc.share,
+			})
+			assert.Nil(t, ucErr)
+
+			assert.Equal(t, &openapi.ShareCreateResponse{Share: tc.expected}, res)
+		})
+	}
+}
+
+func TestCreateShareDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockShareService(ctrl)
+
+	uc := usecase.NewShareUseCase(s)
+
+	res, ucErr := uc.CreateShare(openapi.ShareCreateRequest{})
+
+	expected := openapi.ShareCreateErrorResponse{
+		User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestCreateShareServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when expiration is in the past",
+			errorCode:     service.InvalidArgumentError,
+			errorMessage:  "share expiration must be in the future",
+			expectedError: "invalid argument: share expiration must be in the future",
+			expectedCode:  usecase.InvalidArgumentError,
+		},
+		{
+			name:          "should return error when project not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to create share",
+			expectedError: "not found: failed to create share",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockShareService(ctrl)
+			s.EXPECT().
+				CreateShare(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewShareUseCase(s)
+
+			res, ucErr := uc.CreateShare(openapi.ShareCreateRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
+
+func TestRevokeShareValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockShareService(ctrl)
+	s.EXPECT().
+		RevokeShare(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, projectId domain.ProjectIdObject, token domain.ShareTokenObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "0000000000000001", projectId.Value())
+			assert.Equal(t, "SHARE_TOKEN", token.Value())
+		}).
+		Return(nil)
+
+	uc := usecase.NewShareUseCase(s)
+
+	ucErr := uc.RevokeShare(openapi.ShareRevokeRequest{
+		User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+		Share:   openapi.ShareOnlyToken{Token: "SHARE_TOKEN"},
+	})
+	assert.Nil(t, ucErr)
+}
+
+func TestRevokeShareDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockShareService(ctrl)
+
+	uc := usecase.NewShareUseCase(s)
+
+	ucErr := uc.RevokeShare(openapi.ShareRevokeRequest{})
+
+	expected := openapi.ShareRevokeErrorResponse{
+		User:    openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Project: openapi.ProjectOnlyIdError{Id: "project id is required, but got ''"},
+		Share:   openapi.ShareOnlyTokenError{Token: "share token is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+}
+
+func TestRevokeShareServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when share not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to revoke share",
+			expectedError: "not found: failed to revoke share",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockShareService(ctrl)
+			s.EXPECT().
+				RevokeShare(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			uc := usecase.NewShareUseCase(s)
+
+			ucErr := uc.RevokeShare(openapi.ShareRevokeRequest{
+				User:    openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Project: openapi.ProjectOnlyId{Id: "0000000000000001"},
+				Share:   openapi.ShareOnlyToken{Token: "SHARE_TOKEN"},
+			})
+
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
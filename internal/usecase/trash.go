@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/mock_$GOFILE -package=$GOPACKAGE
+
+type TrashUseCase interface {
+	ListTrashItems(req openapi.TrashItemListRequest) (
+		*openapi.TrashItemListResponse, *Error[openapi.TrashItemListErrorResponse])
+	RestoreTrashItem(req openapi.TrashItemRestoreRequest) (
+		*openapi.TrashItemRestoreResponse, *Error[openapi.TrashItemRestoreErrorResponse])
+}
+
+type trashUseCase struct {
+	service service.TrashService
+}
+
+func NewTrashUseCase(service service.TrashService) TrashUseCase {
+	return trashUseCase{service: service}
+}
+
+func (uc trashUseCase) ListTrashItems(req openapi.TrashItemListRequest) (
+	*openapi.TrashItemListResponse, *Error[openapi.TrashItemListErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.UserId)
+	if userIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.TrashItemListErrorResponse{UserId: userIdErr.Error()},
+		)
+	}
+
+	entities, sErr := uc.service.ListTrashItems(*userId)
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.TrashItemListErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	items := make([]openapi.TrashItem, len(entities))
+	for i, entity := range entities {
+		items[i] = uc.entityToTrashItem(entity)
+	}
+	return &openapi.TrashItemListResponse{Items: items}, nil
+}
+
+func (uc trashUseCase) RestoreTrashItem(req openapi.TrashItemRestoreRequest) (
+	*openapi.TrashItemRestoreResponse, *Error[openapi.TrashItemRestoreErrorResponse]) {
+	userId, userIdErr := domain.NewUserIdObject(req.User.Id)
+	itemId, itemIdErr := domain.NewTrashItemIdObject(req.Item.Id)
+
+	userIdMsg := ""
+	if userIdErr != nil {
+		userIdMsg = userIdErr.Error()
+	}
+	itemIdMsg := ""
+	if itemIdErr != nil {
+		itemIdMsg = itemIdErr.Error()
+	}
+
+	if userIdErr != nil || itemIdErr != nil {
+		return nil, NewModelBasedError(
+			DomainValidationError,
+			openapi.TrashItemRestoreErrorResponse{
+				User: openapi.UserOnlyIdError{Id: userIdMsg},
+				Item: openapi.TrashItemOnlyIdError{Id: itemIdMsg},
+			},
+		)
+	}
+
+	entity, sErr := uc.service.RestoreTrashItem(*userId, *itemId)
+
+	if sErr != nil && sErr.Code() == service.NotFoundError {
+		return nil, NewMessageBasedError[openapi.TrashItemRestoreErrorResponse](
+			NotFoundError,
+			sErr.Unwrap().Error(),
+		)
+	}
+	if sErr != nil {
+		return nil, NewMessageBasedError[openapi.TrashItemRestoreErrorResponse](
+			InternalErrorPanic,
+			sErr.Unwrap().Error(),
+		)
+	}
+
+	return &openapi.TrashItemRestoreResponse{Item: uc.entityToTrashItem(*entity)}, nil
+}
+
+func (uc trashUseCase) entityToTrashItem(entity domain.TrashItemEntity) openapi.TrashItem {
+	item := openapi.TrashItem{
+		Id:        entity.Id().Value(),
+		Type:      entity.Type().Value(),
+		Name:      entity.Name().Value(),
+		ProjectId: entity.ProjectId().Value(),
+		DeletedAt: entity.DeletedAt().Value(),
+	}
+	if entity.ChapterId() != nil {
+		item.ChapterId = entity.ChapterId().Value()
+	}
+	if entity.SectionId() != nil {
+		item.SectionId = entity.SectionId().Value()
+	}
+	return item
+}
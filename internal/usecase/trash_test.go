@@ -0,0 +1,245 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+	"github.com/kumachan-mis/knodeledge-api/internal/openapi"
+	"github.com/kumachan-mis/knodeledge-api/internal/service"
+	"github.com/kumachan-mis/knodeledge-api/internal/testutil"
+	"github.com/kumachan-mis/knodeledge-api/internal/usecase"
+	mock_service "github.com/kumachan-mis/knodeledge-api/mock/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTrashItemEntity(
+	t *testing.T,
+	key string,
+	itemType string,
+	name string,
+	projectKey string,
+	chapterKey string,
+	sectionKey string,
+	deletedAtTime time.Time,
+) *domain.TrashItemEntity {
+	id, err := domain.NewTrashItemIdObject(key)
+	assert.Nil(t, err)
+	typ, err := domain.NewTrashItemTypeObject(itemType)
+	assert.Nil(t, err)
+	itemName, err := domain.NewTrashItemNameObject(name)
+	assert.Nil(t, err)
+	projectId, err := domain.NewProjectIdObject(projectKey)
+	assert.Nil(t, err)
+	var chapterId *domain.ChapterIdObject
+	if chapterKey != "" {
+		chapterId, err = domain.NewChapterIdObject(chapterKey)
+		assert.Nil(t, err)
+	}
+	var sectionId *domain.SectionIdObject
+	if sectionKey != "" {
+		sectionId, err = domain.NewSectionIdObject(sectionKey)
+		assert.Nil(t, err)
+	}
+	deletedAt, err := domain.NewDeletedAtObject(deletedAtTime)
+	assert.Nil(t, err)
+
+	return domain.NewTrashItemEntity(*id, *typ, *itemName, *projectId, chapterId, sectionId, *deletedAt)
+}
+
+func TestListTrashItemsValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockTrashService(ctrl)
+
+	s.EXPECT().
+		ListTrashItems(gomock.Any()).
+		Do(func(userId domain.UserIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+		}).
+		Return([]domain.TrashItemEntity{
+			*newTrashItemEntity(t, "3000000000000002", "section", "Section Name",
+				"0000000000000001", "1000000000000001", "2000000000000001", testutil.Date().Add(time.Hour)),
+			*newTrashItemEntity(t, "3000000000000001", "project", "Project Name",
+				"0000000000000002", "", "", testutil.Date()),
+		}, nil)
+
+	uc := usecase.NewTrashUseCase(s)
+
+	res, ucErr := uc.ListTrashItems(openapi.TrashItemListRequest{
+		UserId: testutil.ModifyOnlyUserId(),
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, []openapi.TrashItem{
+		{
+			Id:        "3000000000000002",
+			Type:      "section",
+			Name:      "Section Name",
+			ProjectId: "0000000000000001",
+			ChapterId: "1000000000000001",
+			SectionId: "2000000000000001",
+			DeletedAt: testutil.Date().Add(time.Hour),
+		},
+		{
+			Id:        "3000000000000001",
+			Type:      "project",
+			Name:      "Project Name",
+			ProjectId: "0000000000000002",
+			DeletedAt: testutil.Date(),
+		},
+	}, res.Items)
+}
+
+func TestListTrashItemsDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockTrashService(ctrl)
+
+	uc := usecase.NewTrashUseCase(s)
+
+	res, ucErr := uc.ListTrashItems(openapi.TrashItemListRequest{UserId: ""})
+
+	expected := openapi.TrashItemListErrorResponse{UserId: "user id is required, but got ''"}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestListTrashItemsServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockTrashService(ctrl)
+
+	s.EXPECT().
+		ListTrashItems(gomock.Any()).
+		Return(nil, service.Errorf(service.RepositoryFailurePanic, "service error"))
+
+	uc := usecase.NewTrashUseCase(s)
+
+	res, ucErr := uc.ListTrashItems(openapi.TrashItemListRequest{
+		UserId: testutil.ModifyOnlyUserId(),
+	})
+
+	assert.Nil(t, res)
+	assert.Equal(t, "internal error: service error", ucErr.Error())
+	assert.Equal(t, usecase.InternalErrorPanic, ucErr.Code())
+}
+
+func TestRestoreTrashItemValidEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockTrashService(ctrl)
+
+	s.EXPECT().
+		RestoreTrashItem(gomock.Any(), gomock.Any()).
+		Do(func(userId domain.UserIdObject, itemId domain.TrashItemIdObject) {
+			assert.Equal(t, testutil.ModifyOnlyUserId(), userId.Value())
+			assert.Equal(t, "3000000000000001", itemId.Value())
+		}).
+		Return(newTrashItemEntity(t, "3000000000000001", "chapter", "Chapter Name",
+			"0000000000000001", "1000000000000001", "", testutil.Date()), nil)
+
+	uc := usecase.NewTrashUseCase(s)
+
+	res, ucErr := uc.RestoreTrashItem(openapi.TrashItemRestoreRequest{
+		User: openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+		Item: openapi.TrashItemOnlyId{Id: "3000000000000001"},
+	})
+
+	assert.Nil(t, ucErr)
+
+	assert.Equal(t, openapi.TrashItem{
+		Id:        "3000000000000001",
+		Type:      "chapter",
+		Name:      "Chapter Name",
+		ProjectId: "0000000000000001",
+		ChapterId: "1000000000000001",
+		DeletedAt: testutil.Date(),
+	}, res.Item)
+}
+
+func TestRestoreTrashItemDomainValidationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_service.NewMockTrashService(ctrl)
+
+	uc := usecase.NewTrashUseCase(s)
+
+	res, ucErr := uc.RestoreTrashItem(openapi.TrashItemRestoreRequest{
+		User: openapi.UserOnlyId{Id: ""},
+		Item: openapi.TrashItemOnlyId{Id: ""},
+	})
+
+	expected := openapi.TrashItemRestoreErrorResponse{
+		User: openapi.UserOnlyIdError{Id: "user id is required, but got ''"},
+		Item: openapi.TrashItemOnlyIdError{Id: "trash item id is required, but got ''"},
+	}
+	expectedJson, _ := json.Marshal(expected)
+	assert.Equal(t, fmt.Sprintf("domain validation error: %s", expectedJson), ucErr.Error())
+	assert.Equal(t, usecase.DomainValidationError, ucErr.Code())
+	assert.Equal(t, expected, *ucErr.Response())
+
+	assert.Nil(t, res)
+}
+
+func TestRestoreTrashItemServiceError(t *testing.T) {
+	tt := []struct {
+		name          string
+		errorCode     service.ErrorCode
+		errorMessage  string
+		expectedError string
+		expectedCode  usecase.ErrorCode
+	}{
+		{
+			name:          "should return error when trash item not found",
+			errorCode:     service.NotFoundError,
+			errorMessage:  "failed to restore trash item",
+			expectedError: "not found: failed to restore trash item",
+			expectedCode:  usecase.NotFoundError,
+		},
+		{
+			name:          "should return error when repository failure",
+			errorCode:     service.RepositoryFailurePanic,
+			errorMessage:  "service error",
+			expectedError: "internal error: service error",
+			expectedCode:  usecase.InternalErrorPanic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_service.NewMockTrashService(ctrl)
+
+			uc := usecase.NewTrashUseCase(s)
+
+			s.EXPECT().
+				RestoreTrashItem(gomock.Any(), gomock.Any()).
+				Return(nil, service.Errorf(tc.errorCode, "%s", tc.errorMessage))
+
+			res, ucErr := uc.RestoreTrashItem(openapi.TrashItemRestoreRequest{
+				User: openapi.UserOnlyId{Id: testutil.ModifyOnlyUserId()},
+				Item: openapi.TrashItemOnlyId{Id: "3000000000000001"},
+			})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tc.expectedError, ucErr.Error())
+			assert.Equal(t, tc.expectedCode, ucErr.Code())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/kumachan-mis/knodeledge-api/internal/domain"
+)
+
+func updatedAtModelToObject(updatedAt time.Time) (*domain.UpdatedAtObject, error) {
+	if updatedAt.IsZero() {
+		return nil, nil
+	}
+	return domain.NewUpdatedAtObject(updatedAt)
+}